@@ -14,8 +14,10 @@ import (
 	"github.com/technobecet/kaizoku-go/internal/database"
 	"github.com/technobecet/kaizoku-go/internal/job"
 	"github.com/technobecet/kaizoku-go/internal/server"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
 	settingssvc "github.com/technobecet/kaizoku-go/internal/service/settings"
 	"github.com/technobecet/kaizoku-go/internal/service/suwayomi"
+	"github.com/technobecet/kaizoku-go/internal/tracing"
 	"github.com/technobecet/kaizoku-go/internal/ws"
 )
 
@@ -33,6 +35,13 @@ func main() {
 		Str("storage", cfg.Storage.Folder).
 		Msg("starting Kaizoku.GO")
 
+	// Tracing must be set up before the database is opened so that queries
+	// are traced.
+	tracing.Init(cfg.Tracing)
+	if tracing.Enabled() {
+		log.Info().Str("endpoint", cfg.Tracing.Endpoint).Msg("exporting traces over OTLP")
+	}
+
 	// Setup Suwayomi: check Java, download JAR if needed, write initial config.
 	// Skipped entirely when UseCustomAPI is true.
 	runtimeDir := config.ConfigDir()
@@ -105,7 +114,44 @@ func main() {
 		log.Fatal().Err(err).Msg("failed to create River schema")
 	}
 
-	srv := server.New(cfg, db, sw, jobMgr, hub)
+	// Create auth service and make sure an admin user and an API key exist
+	authSvc := auth.NewService(db)
+	if !cfg.Auth.Enabled {
+		open, err := authSvc.OpenAccessAllowed(ctx)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to check whether authentication may be disabled")
+		}
+		if !open {
+			log.Warn().Msg("auth.enabled=false only applies to upgraded installs without users or API keys, authentication stays on")
+			cfg.Auth.Enabled = true
+		}
+	}
+	if cfg.Auth.Enabled {
+		password, err := authSvc.BootstrapAdmin(ctx)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to bootstrap admin user")
+		}
+		if password != "" {
+			log.Warn().
+				Str("username", "admin").
+				Str("password", password).
+				Msg("no users found, created admin user (shown only once, change the password after logging in)")
+		}
+
+		key, err := authSvc.BootstrapKey(ctx)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to bootstrap API key")
+		}
+		if key != "" {
+			log.Warn().
+				Str("key", key).
+				Msg("no API keys found, created bootstrap key (shown only once, store it now)")
+		}
+	} else {
+		log.Warn().Msg("authentication is disabled, the API is open to anyone who can reach it")
+	}
+
+	srv := server.New(cfg, db, sw, jobMgr, hub, authSvc)
 
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
@@ -161,4 +207,11 @@ func main() {
 	if swProcess != nil {
 		swProcess.Stop()
 	}
+
+	// Flush the spans recorded during shutdown
+	flushCtx, flushCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer flushCancel()
+	if err := tracing.Shutdown(flushCtx); err != nil {
+		log.Warn().Err(err).Msg("failed to flush traces")
+	}
 }
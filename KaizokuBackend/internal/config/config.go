@@ -15,15 +15,160 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig   `koanf:"server"`
-	Database DatabaseConfig `koanf:"database"`
-	Storage  StorageConfig  `koanf:"storage"`
-	Suwayomi SuwayomiConfig `koanf:"suwayomi"`
-	Settings SettingsConfig `koanf:"settings"`
+	Server     ServerConfig     `koanf:"server"`
+	Database   DatabaseConfig   `koanf:"database"`
+	Storage    StorageConfig    `koanf:"storage"`
+	Suwayomi   SuwayomiConfig   `koanf:"suwayomi"`
+	Settings   SettingsConfig   `koanf:"settings"`
+	Auth       AuthConfig       `koanf:"auth"`
+	Events     EventsConfig     `koanf:"events"`
+	Email      EmailConfig      `koanf:"email"`
+	MQTT       MQTTConfig       `koanf:"mqtt"`
+	Hooks      []HookConfig     `koanf:"hooks"`
+	Tracing    TracingConfig    `koanf:"tracing"`
+	RateLimits RateLimitsConfig `koanf:"rate_limits"`
 }
 
 type ServerConfig struct {
 	Port int `koanf:"port"`
+	// CORSOrigins lists the browser origins allowed to call the API cross-origin.
+	// The bundled frontend is served from the same origin and needs no entry.
+	CORSOrigins []string `koanf:"cors_origins"`
+	// TrustedProxies lists reverse proxies (CIDRs or IPs) allowed to assert the
+	// user identity via ProxyAuthHeader. Empty disables proxy authentication.
+	TrustedProxies []string `koanf:"trusted_proxies"`
+	// ProxyAuthHeader is the header carrying the authenticated username,
+	// e.g. Remote-User (Authelia) or X-Forwarded-User (oauth2-proxy).
+	ProxyAuthHeader string `koanf:"proxy_auth_header"`
+	// ProxyDefaultRole is the role given to users auto-provisioned from the proxy header.
+	ProxyDefaultRole string `koanf:"proxy_default_role"`
+}
+
+// AuthConfig controls authentication of the /api routes and the progress hub.
+type AuthConfig struct {
+	Enabled bool       `koanf:"enabled"`
+	OIDC    OIDCConfig `koanf:"oidc"`
+}
+
+// OIDCConfig configures OpenID Connect login (authorization code + PKCE).
+type OIDCConfig struct {
+	Enabled      bool   `koanf:"enabled"`
+	Name         string `koanf:"name"`
+	Issuer       string `koanf:"issuer"`
+	ClientID     string `koanf:"client_id"`
+	ClientSecret string `koanf:"client_secret"`
+	// RedirectURL must point at /api/auth/oidc/callback as seen by the browser.
+	RedirectURL   string   `koanf:"redirect_url"`
+	Scopes        []string `koanf:"scopes"`
+	UsernameClaim string   `koanf:"username_claim"`
+	GroupsClaim   string   `koanf:"groups_claim"`
+	AdminGroups   []string `koanf:"admin_groups"`
+	ManagerGroups []string `koanf:"manager_groups"`
+	// DefaultRole is given to users in none of the mapped groups. Empty denies them.
+	DefaultRole string `koanf:"default_role"`
+}
+
+// EventsConfig tunes events that are derived from statistics rather than
+// published by a single action.
+type EventsConfig struct {
+	// SourceErrorRate is the share of failed operations (0-1) at which a
+	// source.error_rate event fires.
+	SourceErrorRate float64 `koanf:"source_error_rate"`
+	// SourceErrorWindow is the period over which the error rate is measured.
+	SourceErrorWindow string `koanf:"source_error_window"`
+	// SourceErrorMinEvents is the fewest operations in a window for its
+	// error rate to count.
+	SourceErrorMinEvents int `koanf:"source_error_min_events"`
+}
+
+// EmailConfig configures the scheduled email digest of new chapters.
+type EmailConfig struct {
+	Enabled  bool   `koanf:"enabled"`
+	Host     string `koanf:"host"`
+	Port     int    `koanf:"port"`
+	Username string `koanf:"username"`
+	Password string `koanf:"password"`
+	// Security is "starttls", "tls" (implicit TLS, usually port 465) or
+	// "none" (plain SMTP, e.g. a local MailHog catcher).
+	Security string   `koanf:"security"`
+	From     string   `koanf:"from"`
+	To       []string `koanf:"to"`
+	// Frequency is "daily" or "weekly"; the digest is sent at Time (HH:MM,
+	// server time), on Weekday for weekly digests.
+	Frequency string `koanf:"frequency"`
+	Time      string `koanf:"time"`
+	Weekday   string `koanf:"weekday"`
+}
+
+// MQTTConfig configures publishing queue, source health and new-chapter
+// events to an MQTT broker.
+type MQTTConfig struct {
+	Enabled bool `koanf:"enabled"`
+	// Broker is the broker URL, e.g. tcp://mosquitto:1883 or ssl://host:8883.
+	Broker   string `koanf:"broker"`
+	ClientID string `koanf:"client_id"`
+	Username string `koanf:"username"`
+	Password string `koanf:"password"`
+	// Prefix is the first level of every published topic.
+	Prefix string `koanf:"prefix"`
+	// Discovery is the Home Assistant discovery prefix; empty disables
+	// discovery messages.
+	Discovery string `koanf:"discovery"`
+	// Interval is how often queue and source state is republished.
+	Interval string `koanf:"interval"`
+}
+
+// HookConfig configures an external command that runs on a library event.
+type HookConfig struct {
+	Name string `koanf:"name"`
+	// Event is chapter.downloaded, series.added or series.deleted.
+	Event string `koanf:"event"`
+	// Command is the program and its arguments. It is not run through a
+	// shell; use e.g. ["sh", "-c", "..."] for shell syntax.
+	Command []string `koanf:"command"`
+	// Timeout bounds one run; the command is killed when it expires.
+	Timeout string `koanf:"timeout"`
+	// OnFailure is "ignore", "retry" (up to Retries more runs, RetryDelay
+	// apart) or "fail". fail marks the downloaded chapter as failed and only
+	// applies to chapter.downloaded hooks.
+	OnFailure  string `koanf:"on_failure"`
+	Retries    int    `koanf:"retries"`
+	RetryDelay string `koanf:"retry_delay"`
+}
+
+// TracingConfig configures exporting traces to an OpenTelemetry collector.
+type TracingConfig struct {
+	Enabled bool `koanf:"enabled"`
+	// Endpoint is the collector's OTLP/HTTP base URL; spans are posted to
+	// <endpoint>/v1/traces.
+	Endpoint string `koanf:"endpoint"`
+	// Headers are sent with every export, e.g. an API key for a hosted
+	// collector.
+	Headers     map[string]string `koanf:"headers"`
+	ServiceName string            `koanf:"service_name"`
+	// SampleRatio is the fraction of new traces that are recorded, from 0
+	// to 1. Traces continued from a traceparent header follow the caller.
+	SampleRatio float64 `koanf:"sample_ratio"`
+}
+
+// RateLimitsConfig caps the request rate to sources, for sites that ban
+// clients by request rate rather than parallelism. The top-level limits
+// apply to every source without an entry in Sources. 0 means unlimited.
+type RateLimitsConfig struct {
+	// PagesPerMinute counts every request to the source: chapter pages,
+	// chapter lists and latest-series pages.
+	PagesPerMinute  int                     `koanf:"pages_per_minute"`
+	ChaptersPerHour int                     `koanf:"chapters_per_hour"`
+	Sources         []SourceRateLimitConfig `koanf:"sources"`
+}
+
+// SourceRateLimitConfig overrides the rate limits of one source.
+type SourceRateLimitConfig struct {
+	// Source is the source name as shown in Kaizoku, e.g. "MangaDex".
+	// Matching ignores case.
+	Source          string `koanf:"source"`
+	PagesPerMinute  int    `koanf:"pages_per_minute"`
+	ChaptersPerHour int    `koanf:"chapters_per_hour"`
 }
 
 type DatabaseConfig struct {
@@ -44,6 +189,9 @@ func (d DatabaseConfig) DSN() string {
 
 type StorageConfig struct {
 	Folder string `koanf:"folder"`
+	// MinFreeMB is the free space below which /health/ready reports the
+	// storage folder as failing. 0 disables the check.
+	MinFreeMB int `koanf:"min_free_mb"`
 }
 
 type SuwayomiConfig struct {
@@ -82,6 +230,42 @@ func Load() (*Config, error) {
 	// Defaults
 	defaults := map[string]interface{}{
 		"server.port":                       9833,
+		"server.cors_origins":               []string{"http://localhost:5001", "http://localhost:3000"},
+		"server.trusted_proxies":            []string{},
+		"server.proxy_auth_header":          "Remote-User",
+		"server.proxy_default_role":         "viewer",
+		"auth.enabled":                      true,
+		"auth.oidc.enabled":                 false,
+		"auth.oidc.name":                    "SSO",
+		"auth.oidc.scopes":                  []string{"openid", "profile", "email", "groups"},
+		"auth.oidc.username_claim":          "preferred_username",
+		"auth.oidc.groups_claim":            "groups",
+		"auth.oidc.admin_groups":            []string{},
+		"auth.oidc.manager_groups":          []string{},
+		"auth.oidc.default_role":            "viewer",
+		"events.source_error_rate":          0.5,
+		"events.source_error_window":        "1h",
+		"events.source_error_min_events":    10,
+		"email.enabled":                     false,
+		"email.port":                        587,
+		"email.security":                    "starttls",
+		"email.from":                        "kaizoku@localhost",
+		"email.to":                          []string{},
+		"email.frequency":                   "daily",
+		"email.time":                        "08:00",
+		"email.weekday":                     "monday",
+		"mqtt.enabled":                      false,
+		"mqtt.broker":                       "tcp://localhost:1883",
+		"mqtt.client_id":                    "kaizoku",
+		"mqtt.prefix":                       "kaizoku",
+		"mqtt.discovery":                    "homeassistant",
+		"mqtt.interval":                     "1m",
+		"tracing.enabled":                   false,
+		"tracing.endpoint":                  "http://localhost:4318",
+		"tracing.service_name":              "kaizoku",
+		"tracing.sample_ratio":              1.0,
+		"rate_limits.pages_per_minute":      0,
+		"rate_limits.chapters_per_hour":     0,
 		"database.host":                     "localhost",
 		"database.port":                     5432,
 		"database.user":                     "kaizoku",
@@ -89,6 +273,7 @@ func Load() (*Config, error) {
 		"database.dbname":                   "kaizoku",
 		"database.sslmode":                  "disable",
 		"storage.folder":                    "",
+		"storage.min_free_mb":               1024,
 		"suwayomi.use_preview":              true,
 		"suwayomi.version":                  "v2.0.1833",
 		"suwayomi.use_custom_api":           false,
@@ -11,6 +11,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/technobecet/kaizoku-go/internal/config"
 	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/tracing"
 )
 
 const (
@@ -40,7 +41,7 @@ func Open(cfg config.DatabaseConfig) (*ent.Client, error) {
 	delay := initialDelay
 	for attempt := 1; attempt <= maxRetries; attempt++ {
 		if err := drv.DB().Ping(); err == nil {
-			return ent.NewClient(ent.Driver(drv)), nil
+			return newClient(drv), nil
 		} else if attempt == maxRetries {
 			return nil, fmt.Errorf("database unreachable after %d attempts: %w", maxRetries, err)
 		} else {
@@ -54,7 +55,16 @@ func Open(cfg config.DatabaseConfig) (*ent.Client, error) {
 	}
 
 	// unreachable, but satisfies the compiler
-	return ent.NewClient(ent.Driver(drv)), nil
+	return newClient(drv), nil
+}
+
+// newClient creates the Ent client, tracing its queries when tracing is
+// enabled.
+func newClient(drv *sql.Driver) *ent.Client {
+	if tracing.Enabled() {
+		return ent.NewClient(ent.Driver(&tracedDriver{drv}))
+	}
+	return ent.NewClient(ent.Driver(drv))
 }
 
 // Migrate runs auto-migration on the database schema.
@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"github.com/technobecet/kaizoku-go/internal/tracing"
+)
+
+// tracedDriver records a client span for every query that runs inside a
+// traced request or job. Queries outside a trace are not recorded, so that
+// background polling does not start a trace of its own per query.
+type tracedDriver struct {
+	dialect.Driver
+}
+
+func (d *tracedDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	ctx, span := startQuerySpan(ctx, query)
+	err := d.Driver.Exec(ctx, query, args, v)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (d *tracedDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	ctx, span := startQuerySpan(ctx, query)
+	err := d.Driver.Query(ctx, query, args, v)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (d *tracedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedTx{tx}, nil
+}
+
+// BeginTx is used by ent for transactions with options.
+func (d *tracedDriver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *stdsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("driver does not support BeginTx")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedTx{tx}, nil
+}
+
+// tracedTx records a span for every query in a transaction.
+type tracedTx struct {
+	dialect.Tx
+}
+
+func (t *tracedTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	ctx, span := startQuerySpan(ctx, query)
+	err := t.Tx.Exec(ctx, query, args, v)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (t *tracedTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	ctx, span := startQuerySpan(ctx, query)
+	err := t.Tx.Query(ctx, query, args, v)
+	endQuerySpan(span, err)
+	return err
+}
+
+// startQuerySpan starts a span named after the statement's operation, e.g.
+// "db SELECT". The statement is recorded without its arguments.
+func startQuerySpan(ctx context.Context, query string) (context.Context, *tracing.Span) {
+	if !tracing.Recording(ctx) {
+		return ctx, nil
+	}
+	op := "QUERY"
+	if fields := strings.Fields(query); len(fields) > 0 {
+		op = strings.ToUpper(fields[0])
+	}
+	return tracing.Start(ctx, "db "+op, tracing.KindClient,
+		tracing.String("db.system", "postgresql"),
+		tracing.String("db.operation", op),
+		tracing.String("db.statement", query))
+}
+
+func endQuerySpan(span *tracing.Span, err error) {
+	if err != nil && err != stdsql.ErrNoRows {
+		span.RecordError(err)
+	}
+	span.End()
+}
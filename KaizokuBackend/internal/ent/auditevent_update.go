@@ -0,0 +1,547 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/technobecet/kaizoku-go/internal/ent/auditevent"
+	"github.com/technobecet/kaizoku-go/internal/ent/predicate"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// AuditEventUpdate is the builder for updating AuditEvent entities.
+type AuditEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (_u *AuditEventUpdate) Where(ps ...predicate.AuditEvent) *AuditEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetActorKind sets the "actor_kind" field.
+func (_u *AuditEventUpdate) SetActorKind(v string) *AuditEventUpdate {
+	_u.mutation.SetActorKind(v)
+	return _u
+}
+
+// SetNillableActorKind sets the "actor_kind" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableActorKind(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetActorKind(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AuditEventUpdate) SetActorID(v string) *AuditEventUpdate {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableActorID(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *AuditEventUpdate) ClearActorID() *AuditEventUpdate {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetActorName sets the "actor_name" field.
+func (_u *AuditEventUpdate) SetActorName(v string) *AuditEventUpdate {
+	_u.mutation.SetActorName(v)
+	return _u
+}
+
+// SetNillableActorName sets the "actor_name" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableActorName(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetActorName(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AuditEventUpdate) SetAction(v string) *AuditEventUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableAction(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetTargetType sets the "target_type" field.
+func (_u *AuditEventUpdate) SetTargetType(v string) *AuditEventUpdate {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableTargetType(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// SetTargetIds sets the "target_ids" field.
+func (_u *AuditEventUpdate) SetTargetIds(v []string) *AuditEventUpdate {
+	_u.mutation.SetTargetIds(v)
+	return _u
+}
+
+// AppendTargetIds appends value to the "target_ids" field.
+func (_u *AuditEventUpdate) AppendTargetIds(v []string) *AuditEventUpdate {
+	_u.mutation.AppendTargetIds(v)
+	return _u
+}
+
+// ClearTargetIds clears the value of the "target_ids" field.
+func (_u *AuditEventUpdate) ClearTargetIds() *AuditEventUpdate {
+	_u.mutation.ClearTargetIds()
+	return _u
+}
+
+// SetDiff sets the "diff" field.
+func (_u *AuditEventUpdate) SetDiff(v map[string]types.AuditChange) *AuditEventUpdate {
+	_u.mutation.SetDiff(v)
+	return _u
+}
+
+// ClearDiff clears the value of the "diff" field.
+func (_u *AuditEventUpdate) ClearDiff() *AuditEventUpdate {
+	_u.mutation.ClearDiff()
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *AuditEventUpdate) SetDetails(v map[string]interface{}) *AuditEventUpdate {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *AuditEventUpdate) ClearDetails() *AuditEventUpdate {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AuditEventUpdate) SetIP(v string) *AuditEventUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AuditEventUpdate) SetNillableIP(v *string) *AuditEventUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *AuditEventUpdate) ClearIP() *AuditEventUpdate {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (_u *AuditEventUpdate) Mutation() *AuditEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuditEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuditEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AuditEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ActorKind(); ok {
+		_spec.SetField(auditevent.FieldActorKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(auditevent.FieldActorID, field.TypeString, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditevent.FieldActorID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ActorName(); ok {
+		_spec.SetField(auditevent.FieldActorName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(auditevent.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetIds(); ok {
+		_spec.SetField(auditevent.FieldTargetIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditevent.FieldTargetIds, value)
+		})
+	}
+	if _u.mutation.TargetIdsCleared() {
+		_spec.ClearField(auditevent.FieldTargetIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Diff(); ok {
+		_spec.SetField(auditevent.FieldDiff, field.TypeJSON, value)
+	}
+	if _u.mutation.DiffCleared() {
+		_spec.ClearField(auditevent.FieldDiff, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(auditevent.FieldDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(auditevent.FieldDetails, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(auditevent.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(auditevent.FieldIP, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuditEventUpdateOne is the builder for updating a single AuditEvent entity.
+type AuditEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// SetActorKind sets the "actor_kind" field.
+func (_u *AuditEventUpdateOne) SetActorKind(v string) *AuditEventUpdateOne {
+	_u.mutation.SetActorKind(v)
+	return _u
+}
+
+// SetNillableActorKind sets the "actor_kind" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableActorKind(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetActorKind(*v)
+	}
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *AuditEventUpdateOne) SetActorID(v string) *AuditEventUpdateOne {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableActorID(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *AuditEventUpdateOne) ClearActorID() *AuditEventUpdateOne {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetActorName sets the "actor_name" field.
+func (_u *AuditEventUpdateOne) SetActorName(v string) *AuditEventUpdateOne {
+	_u.mutation.SetActorName(v)
+	return _u
+}
+
+// SetNillableActorName sets the "actor_name" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableActorName(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetActorName(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *AuditEventUpdateOne) SetAction(v string) *AuditEventUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableAction(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetTargetType sets the "target_type" field.
+func (_u *AuditEventUpdateOne) SetTargetType(v string) *AuditEventUpdateOne {
+	_u.mutation.SetTargetType(v)
+	return _u
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableTargetType(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetTargetType(*v)
+	}
+	return _u
+}
+
+// SetTargetIds sets the "target_ids" field.
+func (_u *AuditEventUpdateOne) SetTargetIds(v []string) *AuditEventUpdateOne {
+	_u.mutation.SetTargetIds(v)
+	return _u
+}
+
+// AppendTargetIds appends value to the "target_ids" field.
+func (_u *AuditEventUpdateOne) AppendTargetIds(v []string) *AuditEventUpdateOne {
+	_u.mutation.AppendTargetIds(v)
+	return _u
+}
+
+// ClearTargetIds clears the value of the "target_ids" field.
+func (_u *AuditEventUpdateOne) ClearTargetIds() *AuditEventUpdateOne {
+	_u.mutation.ClearTargetIds()
+	return _u
+}
+
+// SetDiff sets the "diff" field.
+func (_u *AuditEventUpdateOne) SetDiff(v map[string]types.AuditChange) *AuditEventUpdateOne {
+	_u.mutation.SetDiff(v)
+	return _u
+}
+
+// ClearDiff clears the value of the "diff" field.
+func (_u *AuditEventUpdateOne) ClearDiff() *AuditEventUpdateOne {
+	_u.mutation.ClearDiff()
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *AuditEventUpdateOne) SetDetails(v map[string]interface{}) *AuditEventUpdateOne {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *AuditEventUpdateOne) ClearDetails() *AuditEventUpdateOne {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *AuditEventUpdateOne) SetIP(v string) *AuditEventUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *AuditEventUpdateOne) SetNillableIP(v *string) *AuditEventUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *AuditEventUpdateOne) ClearIP() *AuditEventUpdateOne {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (_u *AuditEventUpdateOne) Mutation() *AuditEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (_u *AuditEventUpdateOne) Where(ps ...predicate.AuditEvent) *AuditEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuditEventUpdateOne) Select(field string, fields ...string) *AuditEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuditEvent entity.
+func (_u *AuditEventUpdateOne) Save(ctx context.Context) (*AuditEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuditEventUpdateOne) SaveX(ctx context.Context) *AuditEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuditEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuditEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AuditEventUpdateOne) sqlSave(ctx context.Context) (_node *AuditEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditevent.FieldID)
+		for _, f := range fields {
+			if !auditevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ActorKind(); ok {
+		_spec.SetField(auditevent.FieldActorKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(auditevent.FieldActorID, field.TypeString, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(auditevent.FieldActorID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ActorName(); ok {
+		_spec.SetField(auditevent.FieldActorName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetType(); ok {
+		_spec.SetField(auditevent.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetIds(); ok {
+		_spec.SetField(auditevent.FieldTargetIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, auditevent.FieldTargetIds, value)
+		})
+	}
+	if _u.mutation.TargetIdsCleared() {
+		_spec.ClearField(auditevent.FieldTargetIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Diff(); ok {
+		_spec.SetField(auditevent.FieldDiff, field.TypeJSON, value)
+	}
+	if _u.mutation.DiffCleared() {
+		_spec.ClearField(auditevent.FieldDiff, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(auditevent.FieldDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(auditevent.FieldDetails, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(auditevent.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(auditevent.FieldIP, field.TypeString)
+	}
+	_node = &AuditEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
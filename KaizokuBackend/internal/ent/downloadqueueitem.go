@@ -35,8 +35,12 @@ type DownloadQueueItem struct {
 	// CompletedAt holds the value of the "completed_at" field.
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
 	// Args holds the value of the "args" field.
-	Args         types.DownloadChapterArgs `json:"args,omitempty"`
-	selectValues sql.SelectValues
+	Args types.DownloadChapterArgs `json:"args,omitempty"`
+	// Dispatcher instance that claimed the running item
+	WorkerID *string `json:"worker_id,omitempty"`
+	// Running items whose lease expired are reclaimed by any instance
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
+	selectValues   sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -48,9 +52,9 @@ func (*DownloadQueueItem) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case downloadqueueitem.FieldStatus, downloadqueueitem.FieldPriority:
 			values[i] = new(sql.NullInt64)
-		case downloadqueueitem.FieldGroupKey:
+		case downloadqueueitem.FieldGroupKey, downloadqueueitem.FieldWorkerID:
 			values[i] = new(sql.NullString)
-		case downloadqueueitem.FieldScheduledAt, downloadqueueitem.FieldCreatedAt, downloadqueueitem.FieldStartedAt, downloadqueueitem.FieldCompletedAt:
+		case downloadqueueitem.FieldScheduledAt, downloadqueueitem.FieldCreatedAt, downloadqueueitem.FieldStartedAt, downloadqueueitem.FieldCompletedAt, downloadqueueitem.FieldLeaseExpiresAt:
 			values[i] = new(sql.NullTime)
 		case downloadqueueitem.FieldID:
 			values[i] = new(uuid.UUID)
@@ -127,6 +131,20 @@ func (_m *DownloadQueueItem) assignValues(columns []string, values []any) error
 					return fmt.Errorf("unmarshal field args: %w", err)
 				}
 			}
+		case downloadqueueitem.FieldWorkerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field worker_id", values[i])
+			} else if value.Valid {
+				_m.WorkerID = new(string)
+				*_m.WorkerID = value.String
+			}
+		case downloadqueueitem.FieldLeaseExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_expires_at", values[i])
+			} else if value.Valid {
+				_m.LeaseExpiresAt = new(time.Time)
+				*_m.LeaseExpiresAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -190,6 +208,16 @@ func (_m *DownloadQueueItem) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("args=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Args))
+	builder.WriteString(", ")
+	if v := _m.WorkerID; v != nil {
+		builder.WriteString("worker_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LeaseExpiresAt; v != nil {
+		builder.WriteString("lease_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
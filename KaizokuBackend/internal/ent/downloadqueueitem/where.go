@@ -90,6 +90,16 @@ func CompletedAt(v time.Time) predicate.DownloadQueueItem {
 	return predicate.DownloadQueueItem(sql.FieldEQ(FieldCompletedAt, v))
 }
 
+// WorkerID applies equality check predicate on the "worker_id" field. It's identical to WorkerIDEQ.
+func WorkerID(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldEQ(FieldWorkerID, v))
+}
+
+// LeaseExpiresAt applies equality check predicate on the "lease_expires_at" field. It's identical to LeaseExpiresAtEQ.
+func LeaseExpiresAt(v time.Time) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldEQ(FieldLeaseExpiresAt, v))
+}
+
 // GroupKeyEQ applies the EQ predicate on the "group_key" field.
 func GroupKeyEQ(v string) predicate.DownloadQueueItem {
 	return predicate.DownloadQueueItem(sql.FieldEQ(FieldGroupKey, v))
@@ -415,6 +425,131 @@ func CompletedAtNotNil() predicate.DownloadQueueItem {
 	return predicate.DownloadQueueItem(sql.FieldNotNull(FieldCompletedAt))
 }
 
+// WorkerIDEQ applies the EQ predicate on the "worker_id" field.
+func WorkerIDEQ(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldEQ(FieldWorkerID, v))
+}
+
+// WorkerIDNEQ applies the NEQ predicate on the "worker_id" field.
+func WorkerIDNEQ(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldNEQ(FieldWorkerID, v))
+}
+
+// WorkerIDIn applies the In predicate on the "worker_id" field.
+func WorkerIDIn(vs ...string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldIn(FieldWorkerID, vs...))
+}
+
+// WorkerIDNotIn applies the NotIn predicate on the "worker_id" field.
+func WorkerIDNotIn(vs ...string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldNotIn(FieldWorkerID, vs...))
+}
+
+// WorkerIDGT applies the GT predicate on the "worker_id" field.
+func WorkerIDGT(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldGT(FieldWorkerID, v))
+}
+
+// WorkerIDGTE applies the GTE predicate on the "worker_id" field.
+func WorkerIDGTE(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldGTE(FieldWorkerID, v))
+}
+
+// WorkerIDLT applies the LT predicate on the "worker_id" field.
+func WorkerIDLT(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldLT(FieldWorkerID, v))
+}
+
+// WorkerIDLTE applies the LTE predicate on the "worker_id" field.
+func WorkerIDLTE(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldLTE(FieldWorkerID, v))
+}
+
+// WorkerIDContains applies the Contains predicate on the "worker_id" field.
+func WorkerIDContains(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldContains(FieldWorkerID, v))
+}
+
+// WorkerIDHasPrefix applies the HasPrefix predicate on the "worker_id" field.
+func WorkerIDHasPrefix(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldHasPrefix(FieldWorkerID, v))
+}
+
+// WorkerIDHasSuffix applies the HasSuffix predicate on the "worker_id" field.
+func WorkerIDHasSuffix(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldHasSuffix(FieldWorkerID, v))
+}
+
+// WorkerIDIsNil applies the IsNil predicate on the "worker_id" field.
+func WorkerIDIsNil() predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldIsNull(FieldWorkerID))
+}
+
+// WorkerIDNotNil applies the NotNil predicate on the "worker_id" field.
+func WorkerIDNotNil() predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldNotNull(FieldWorkerID))
+}
+
+// WorkerIDEqualFold applies the EqualFold predicate on the "worker_id" field.
+func WorkerIDEqualFold(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldEqualFold(FieldWorkerID, v))
+}
+
+// WorkerIDContainsFold applies the ContainsFold predicate on the "worker_id" field.
+func WorkerIDContainsFold(v string) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldContainsFold(FieldWorkerID, v))
+}
+
+// LeaseExpiresAtEQ applies the EQ predicate on the "lease_expires_at" field.
+func LeaseExpiresAtEQ(v time.Time) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldEQ(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtNEQ applies the NEQ predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNEQ(v time.Time) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldNEQ(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtIn applies the In predicate on the "lease_expires_at" field.
+func LeaseExpiresAtIn(vs ...time.Time) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldIn(FieldLeaseExpiresAt, vs...))
+}
+
+// LeaseExpiresAtNotIn applies the NotIn predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNotIn(vs ...time.Time) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldNotIn(FieldLeaseExpiresAt, vs...))
+}
+
+// LeaseExpiresAtGT applies the GT predicate on the "lease_expires_at" field.
+func LeaseExpiresAtGT(v time.Time) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldGT(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtGTE applies the GTE predicate on the "lease_expires_at" field.
+func LeaseExpiresAtGTE(v time.Time) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldGTE(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtLT applies the LT predicate on the "lease_expires_at" field.
+func LeaseExpiresAtLT(v time.Time) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldLT(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtLTE applies the LTE predicate on the "lease_expires_at" field.
+func LeaseExpiresAtLTE(v time.Time) predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldLTE(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtIsNil applies the IsNil predicate on the "lease_expires_at" field.
+func LeaseExpiresAtIsNil() predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldIsNull(FieldLeaseExpiresAt))
+}
+
+// LeaseExpiresAtNotNil applies the NotNil predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNotNil() predicate.DownloadQueueItem {
+	return predicate.DownloadQueueItem(sql.FieldNotNull(FieldLeaseExpiresAt))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.DownloadQueueItem) predicate.DownloadQueueItem {
 	return predicate.DownloadQueueItem(sql.AndPredicates(predicates...))
@@ -121,6 +121,34 @@ func (_c *DownloadQueueItemCreate) SetArgs(v types.DownloadChapterArgs) *Downloa
 	return _c
 }
 
+// SetWorkerID sets the "worker_id" field.
+func (_c *DownloadQueueItemCreate) SetWorkerID(v string) *DownloadQueueItemCreate {
+	_c.mutation.SetWorkerID(v)
+	return _c
+}
+
+// SetNillableWorkerID sets the "worker_id" field if the given value is not nil.
+func (_c *DownloadQueueItemCreate) SetNillableWorkerID(v *string) *DownloadQueueItemCreate {
+	if v != nil {
+		_c.SetWorkerID(*v)
+	}
+	return _c
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (_c *DownloadQueueItemCreate) SetLeaseExpiresAt(v time.Time) *DownloadQueueItemCreate {
+	_c.mutation.SetLeaseExpiresAt(v)
+	return _c
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (_c *DownloadQueueItemCreate) SetNillableLeaseExpiresAt(v *time.Time) *DownloadQueueItemCreate {
+	if v != nil {
+		_c.SetLeaseExpiresAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *DownloadQueueItemCreate) SetID(v uuid.UUID) *DownloadQueueItemCreate {
 	_c.mutation.SetID(v)
@@ -280,6 +308,14 @@ func (_c *DownloadQueueItemCreate) createSpec() (*DownloadQueueItem, *sqlgraph.C
 		_spec.SetField(downloadqueueitem.FieldArgs, field.TypeJSON, value)
 		_node.Args = value
 	}
+	if value, ok := _c.mutation.WorkerID(); ok {
+		_spec.SetField(downloadqueueitem.FieldWorkerID, field.TypeString, value)
+		_node.WorkerID = &value
+	}
+	if value, ok := _c.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(downloadqueueitem.FieldLeaseExpiresAt, field.TypeTime, value)
+		_node.LeaseExpiresAt = &value
+	}
 	return _node, _spec
 }
 
@@ -440,6 +476,42 @@ func (u *DownloadQueueItemUpsert) UpdateArgs() *DownloadQueueItemUpsert {
 	return u
 }
 
+// SetWorkerID sets the "worker_id" field.
+func (u *DownloadQueueItemUpsert) SetWorkerID(v string) *DownloadQueueItemUpsert {
+	u.Set(downloadqueueitem.FieldWorkerID, v)
+	return u
+}
+
+// UpdateWorkerID sets the "worker_id" field to the value that was provided on create.
+func (u *DownloadQueueItemUpsert) UpdateWorkerID() *DownloadQueueItemUpsert {
+	u.SetExcluded(downloadqueueitem.FieldWorkerID)
+	return u
+}
+
+// ClearWorkerID clears the value of the "worker_id" field.
+func (u *DownloadQueueItemUpsert) ClearWorkerID() *DownloadQueueItemUpsert {
+	u.SetNull(downloadqueueitem.FieldWorkerID)
+	return u
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *DownloadQueueItemUpsert) SetLeaseExpiresAt(v time.Time) *DownloadQueueItemUpsert {
+	u.Set(downloadqueueitem.FieldLeaseExpiresAt, v)
+	return u
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *DownloadQueueItemUpsert) UpdateLeaseExpiresAt() *DownloadQueueItemUpsert {
+	u.SetExcluded(downloadqueueitem.FieldLeaseExpiresAt)
+	return u
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *DownloadQueueItemUpsert) ClearLeaseExpiresAt() *DownloadQueueItemUpsert {
+	u.SetNull(downloadqueueitem.FieldLeaseExpiresAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -617,6 +689,48 @@ func (u *DownloadQueueItemUpsertOne) UpdateArgs() *DownloadQueueItemUpsertOne {
 	})
 }
 
+// SetWorkerID sets the "worker_id" field.
+func (u *DownloadQueueItemUpsertOne) SetWorkerID(v string) *DownloadQueueItemUpsertOne {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.SetWorkerID(v)
+	})
+}
+
+// UpdateWorkerID sets the "worker_id" field to the value that was provided on create.
+func (u *DownloadQueueItemUpsertOne) UpdateWorkerID() *DownloadQueueItemUpsertOne {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.UpdateWorkerID()
+	})
+}
+
+// ClearWorkerID clears the value of the "worker_id" field.
+func (u *DownloadQueueItemUpsertOne) ClearWorkerID() *DownloadQueueItemUpsertOne {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.ClearWorkerID()
+	})
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *DownloadQueueItemUpsertOne) SetLeaseExpiresAt(v time.Time) *DownloadQueueItemUpsertOne {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.SetLeaseExpiresAt(v)
+	})
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *DownloadQueueItemUpsertOne) UpdateLeaseExpiresAt() *DownloadQueueItemUpsertOne {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.UpdateLeaseExpiresAt()
+	})
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *DownloadQueueItemUpsertOne) ClearLeaseExpiresAt() *DownloadQueueItemUpsertOne {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.ClearLeaseExpiresAt()
+	})
+}
+
 // Exec executes the query.
 func (u *DownloadQueueItemUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -961,6 +1075,48 @@ func (u *DownloadQueueItemUpsertBulk) UpdateArgs() *DownloadQueueItemUpsertBulk
 	})
 }
 
+// SetWorkerID sets the "worker_id" field.
+func (u *DownloadQueueItemUpsertBulk) SetWorkerID(v string) *DownloadQueueItemUpsertBulk {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.SetWorkerID(v)
+	})
+}
+
+// UpdateWorkerID sets the "worker_id" field to the value that was provided on create.
+func (u *DownloadQueueItemUpsertBulk) UpdateWorkerID() *DownloadQueueItemUpsertBulk {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.UpdateWorkerID()
+	})
+}
+
+// ClearWorkerID clears the value of the "worker_id" field.
+func (u *DownloadQueueItemUpsertBulk) ClearWorkerID() *DownloadQueueItemUpsertBulk {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.ClearWorkerID()
+	})
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *DownloadQueueItemUpsertBulk) SetLeaseExpiresAt(v time.Time) *DownloadQueueItemUpsertBulk {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.SetLeaseExpiresAt(v)
+	})
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *DownloadQueueItemUpsertBulk) UpdateLeaseExpiresAt() *DownloadQueueItemUpsertBulk {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.UpdateLeaseExpiresAt()
+	})
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *DownloadQueueItemUpsertBulk) ClearLeaseExpiresAt() *DownloadQueueItemUpsertBulk {
+	return u.Update(func(s *DownloadQueueItemUpsert) {
+		s.ClearLeaseExpiresAt()
+	})
+}
+
 // Exec executes the query.
 func (u *DownloadQueueItemUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
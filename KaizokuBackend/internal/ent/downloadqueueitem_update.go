@@ -153,6 +153,46 @@ func (_u *DownloadQueueItemUpdate) SetNillableArgs(v *types.DownloadChapterArgs)
 	return _u
 }
 
+// SetWorkerID sets the "worker_id" field.
+func (_u *DownloadQueueItemUpdate) SetWorkerID(v string) *DownloadQueueItemUpdate {
+	_u.mutation.SetWorkerID(v)
+	return _u
+}
+
+// SetNillableWorkerID sets the "worker_id" field if the given value is not nil.
+func (_u *DownloadQueueItemUpdate) SetNillableWorkerID(v *string) *DownloadQueueItemUpdate {
+	if v != nil {
+		_u.SetWorkerID(*v)
+	}
+	return _u
+}
+
+// ClearWorkerID clears the value of the "worker_id" field.
+func (_u *DownloadQueueItemUpdate) ClearWorkerID() *DownloadQueueItemUpdate {
+	_u.mutation.ClearWorkerID()
+	return _u
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (_u *DownloadQueueItemUpdate) SetLeaseExpiresAt(v time.Time) *DownloadQueueItemUpdate {
+	_u.mutation.SetLeaseExpiresAt(v)
+	return _u
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (_u *DownloadQueueItemUpdate) SetNillableLeaseExpiresAt(v *time.Time) *DownloadQueueItemUpdate {
+	if v != nil {
+		_u.SetLeaseExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (_u *DownloadQueueItemUpdate) ClearLeaseExpiresAt() *DownloadQueueItemUpdate {
+	_u.mutation.ClearLeaseExpiresAt()
+	return _u
+}
+
 // Mutation returns the DownloadQueueItemMutation object of the builder.
 func (_u *DownloadQueueItemUpdate) Mutation() *DownloadQueueItemMutation {
 	return _u.mutation
@@ -227,6 +267,18 @@ func (_u *DownloadQueueItemUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.Args(); ok {
 		_spec.SetField(downloadqueueitem.FieldArgs, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.WorkerID(); ok {
+		_spec.SetField(downloadqueueitem.FieldWorkerID, field.TypeString, value)
+	}
+	if _u.mutation.WorkerIDCleared() {
+		_spec.ClearField(downloadqueueitem.FieldWorkerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(downloadqueueitem.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(downloadqueueitem.FieldLeaseExpiresAt, field.TypeTime)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{downloadqueueitem.Label}
@@ -371,6 +423,46 @@ func (_u *DownloadQueueItemUpdateOne) SetNillableArgs(v *types.DownloadChapterAr
 	return _u
 }
 
+// SetWorkerID sets the "worker_id" field.
+func (_u *DownloadQueueItemUpdateOne) SetWorkerID(v string) *DownloadQueueItemUpdateOne {
+	_u.mutation.SetWorkerID(v)
+	return _u
+}
+
+// SetNillableWorkerID sets the "worker_id" field if the given value is not nil.
+func (_u *DownloadQueueItemUpdateOne) SetNillableWorkerID(v *string) *DownloadQueueItemUpdateOne {
+	if v != nil {
+		_u.SetWorkerID(*v)
+	}
+	return _u
+}
+
+// ClearWorkerID clears the value of the "worker_id" field.
+func (_u *DownloadQueueItemUpdateOne) ClearWorkerID() *DownloadQueueItemUpdateOne {
+	_u.mutation.ClearWorkerID()
+	return _u
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (_u *DownloadQueueItemUpdateOne) SetLeaseExpiresAt(v time.Time) *DownloadQueueItemUpdateOne {
+	_u.mutation.SetLeaseExpiresAt(v)
+	return _u
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (_u *DownloadQueueItemUpdateOne) SetNillableLeaseExpiresAt(v *time.Time) *DownloadQueueItemUpdateOne {
+	if v != nil {
+		_u.SetLeaseExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (_u *DownloadQueueItemUpdateOne) ClearLeaseExpiresAt() *DownloadQueueItemUpdateOne {
+	_u.mutation.ClearLeaseExpiresAt()
+	return _u
+}
+
 // Mutation returns the DownloadQueueItemMutation object of the builder.
 func (_u *DownloadQueueItemUpdateOne) Mutation() *DownloadQueueItemMutation {
 	return _u.mutation
@@ -475,6 +567,18 @@ func (_u *DownloadQueueItemUpdateOne) sqlSave(ctx context.Context) (_node *Downl
 	if value, ok := _u.mutation.Args(); ok {
 		_spec.SetField(downloadqueueitem.FieldArgs, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.WorkerID(); ok {
+		_spec.SetField(downloadqueueitem.FieldWorkerID, field.TypeString, value)
+	}
+	if _u.mutation.WorkerIDCleared() {
+		_spec.ClearField(downloadqueueitem.FieldWorkerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(downloadqueueitem.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(downloadqueueitem.FieldLeaseExpiresAt, field.TypeTime)
+	}
 	_node = &DownloadQueueItem{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
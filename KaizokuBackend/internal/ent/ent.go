@@ -12,15 +12,26 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/technobecet/kaizoku-go/internal/ent/apikey"
+	"github.com/technobecet/kaizoku-go/internal/ent/auditevent"
 	"github.com/technobecet/kaizoku-go/internal/ent/downloadqueueitem"
 	"github.com/technobecet/kaizoku-go/internal/ent/etagcache"
+	"github.com/technobecet/kaizoku-go/internal/ent/hookrun"
 	"github.com/technobecet/kaizoku-go/internal/ent/importentry"
 	"github.com/technobecet/kaizoku-go/internal/ent/latestseries"
+	"github.com/technobecet/kaizoku-go/internal/ent/notification"
+	"github.com/technobecet/kaizoku-go/internal/ent/notifier"
 	"github.com/technobecet/kaizoku-go/internal/ent/providerstorage"
+	"github.com/technobecet/kaizoku-go/internal/ent/readprogress"
 	"github.com/technobecet/kaizoku-go/internal/ent/series"
 	"github.com/technobecet/kaizoku-go/internal/ent/seriesprovider"
+	"github.com/technobecet/kaizoku-go/internal/ent/session"
 	"github.com/technobecet/kaizoku-go/internal/ent/setting"
 	"github.com/technobecet/kaizoku-go/internal/ent/sourceevent"
+	"github.com/technobecet/kaizoku-go/internal/ent/syncprogress"
+	"github.com/technobecet/kaizoku-go/internal/ent/user"
+	"github.com/technobecet/kaizoku-go/internal/ent/webhook"
+	"github.com/technobecet/kaizoku-go/internal/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -81,15 +92,26 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			apikey.Table:            apikey.ValidColumn,
+			auditevent.Table:        auditevent.ValidColumn,
 			downloadqueueitem.Table: downloadqueueitem.ValidColumn,
 			etagcache.Table:         etagcache.ValidColumn,
+			hookrun.Table:           hookrun.ValidColumn,
 			importentry.Table:       importentry.ValidColumn,
 			latestseries.Table:      latestseries.ValidColumn,
+			notification.Table:      notification.ValidColumn,
+			notifier.Table:          notifier.ValidColumn,
 			providerstorage.Table:   providerstorage.ValidColumn,
+			readprogress.Table:      readprogress.ValidColumn,
 			series.Table:            series.ValidColumn,
 			seriesprovider.Table:    seriesprovider.ValidColumn,
+			session.Table:           session.ValidColumn,
 			setting.Table:           setting.ValidColumn,
 			sourceevent.Table:       sourceevent.ValidColumn,
+			syncprogress.Table:      syncprogress.ValidColumn,
+			user.Table:              user.ValidColumn,
+			webhook.Table:           webhook.ValidColumn,
+			webhookdelivery.Table:   webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
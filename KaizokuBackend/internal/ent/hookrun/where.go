@@ -0,0 +1,821 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hookrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldID, id))
+}
+
+// Hook applies equality check predicate on the "hook" field. It's identical to HookEQ.
+func Hook(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldHook, v))
+}
+
+// Event applies equality check predicate on the "event" field. It's identical to EventEQ.
+func Event(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldEvent, v))
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesTitle applies equality check predicate on the "series_title" field. It's identical to SeriesTitleEQ.
+func SeriesTitle(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldSeriesTitle, v))
+}
+
+// ChapterNumber applies equality check predicate on the "chapter_number" field. It's identical to ChapterNumberEQ.
+func ChapterNumber(v float64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldChapterNumber, v))
+}
+
+// Attempt applies equality check predicate on the "attempt" field. It's identical to AttemptEQ.
+func Attempt(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldAttempt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// ExitCode applies equality check predicate on the "exit_code" field. It's identical to ExitCodeEQ.
+func ExitCode(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldExitCode, v))
+}
+
+// Output applies equality check predicate on the "output" field. It's identical to OutputEQ.
+func Output(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldOutput, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldError, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// HookEQ applies the EQ predicate on the "hook" field.
+func HookEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldHook, v))
+}
+
+// HookNEQ applies the NEQ predicate on the "hook" field.
+func HookNEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldHook, v))
+}
+
+// HookIn applies the In predicate on the "hook" field.
+func HookIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldHook, vs...))
+}
+
+// HookNotIn applies the NotIn predicate on the "hook" field.
+func HookNotIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldHook, vs...))
+}
+
+// HookGT applies the GT predicate on the "hook" field.
+func HookGT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldHook, v))
+}
+
+// HookGTE applies the GTE predicate on the "hook" field.
+func HookGTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldHook, v))
+}
+
+// HookLT applies the LT predicate on the "hook" field.
+func HookLT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldHook, v))
+}
+
+// HookLTE applies the LTE predicate on the "hook" field.
+func HookLTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldHook, v))
+}
+
+// HookContains applies the Contains predicate on the "hook" field.
+func HookContains(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContains(FieldHook, v))
+}
+
+// HookHasPrefix applies the HasPrefix predicate on the "hook" field.
+func HookHasPrefix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasPrefix(FieldHook, v))
+}
+
+// HookHasSuffix applies the HasSuffix predicate on the "hook" field.
+func HookHasSuffix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasSuffix(FieldHook, v))
+}
+
+// HookEqualFold applies the EqualFold predicate on the "hook" field.
+func HookEqualFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEqualFold(FieldHook, v))
+}
+
+// HookContainsFold applies the ContainsFold predicate on the "hook" field.
+func HookContainsFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContainsFold(FieldHook, v))
+}
+
+// EventEQ applies the EQ predicate on the "event" field.
+func EventEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldEvent, v))
+}
+
+// EventNEQ applies the NEQ predicate on the "event" field.
+func EventNEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldEvent, v))
+}
+
+// EventIn applies the In predicate on the "event" field.
+func EventIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldEvent, vs...))
+}
+
+// EventNotIn applies the NotIn predicate on the "event" field.
+func EventNotIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldEvent, vs...))
+}
+
+// EventGT applies the GT predicate on the "event" field.
+func EventGT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldEvent, v))
+}
+
+// EventGTE applies the GTE predicate on the "event" field.
+func EventGTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldEvent, v))
+}
+
+// EventLT applies the LT predicate on the "event" field.
+func EventLT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldEvent, v))
+}
+
+// EventLTE applies the LTE predicate on the "event" field.
+func EventLTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldEvent, v))
+}
+
+// EventContains applies the Contains predicate on the "event" field.
+func EventContains(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContains(FieldEvent, v))
+}
+
+// EventHasPrefix applies the HasPrefix predicate on the "event" field.
+func EventHasPrefix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasPrefix(FieldEvent, v))
+}
+
+// EventHasSuffix applies the HasSuffix predicate on the "event" field.
+func EventHasSuffix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasSuffix(FieldEvent, v))
+}
+
+// EventEqualFold applies the EqualFold predicate on the "event" field.
+func EventEqualFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEqualFold(FieldEvent, v))
+}
+
+// EventContainsFold applies the ContainsFold predicate on the "event" field.
+func EventContainsFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContainsFold(FieldEvent, v))
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldSeriesID, v))
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDGT applies the GT predicate on the "series_id" field.
+func SeriesIDGT(v uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldSeriesID, v))
+}
+
+// SeriesIDGTE applies the GTE predicate on the "series_id" field.
+func SeriesIDGTE(v uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldSeriesID, v))
+}
+
+// SeriesIDLT applies the LT predicate on the "series_id" field.
+func SeriesIDLT(v uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldSeriesID, v))
+}
+
+// SeriesIDLTE applies the LTE predicate on the "series_id" field.
+func SeriesIDLTE(v uuid.UUID) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldSeriesID, v))
+}
+
+// SeriesIDIsNil applies the IsNil predicate on the "series_id" field.
+func SeriesIDIsNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldIsNull(FieldSeriesID))
+}
+
+// SeriesIDNotNil applies the NotNil predicate on the "series_id" field.
+func SeriesIDNotNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotNull(FieldSeriesID))
+}
+
+// SeriesTitleEQ applies the EQ predicate on the "series_title" field.
+func SeriesTitleEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldSeriesTitle, v))
+}
+
+// SeriesTitleNEQ applies the NEQ predicate on the "series_title" field.
+func SeriesTitleNEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldSeriesTitle, v))
+}
+
+// SeriesTitleIn applies the In predicate on the "series_title" field.
+func SeriesTitleIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldSeriesTitle, vs...))
+}
+
+// SeriesTitleNotIn applies the NotIn predicate on the "series_title" field.
+func SeriesTitleNotIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldSeriesTitle, vs...))
+}
+
+// SeriesTitleGT applies the GT predicate on the "series_title" field.
+func SeriesTitleGT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldSeriesTitle, v))
+}
+
+// SeriesTitleGTE applies the GTE predicate on the "series_title" field.
+func SeriesTitleGTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldSeriesTitle, v))
+}
+
+// SeriesTitleLT applies the LT predicate on the "series_title" field.
+func SeriesTitleLT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldSeriesTitle, v))
+}
+
+// SeriesTitleLTE applies the LTE predicate on the "series_title" field.
+func SeriesTitleLTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldSeriesTitle, v))
+}
+
+// SeriesTitleContains applies the Contains predicate on the "series_title" field.
+func SeriesTitleContains(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContains(FieldSeriesTitle, v))
+}
+
+// SeriesTitleHasPrefix applies the HasPrefix predicate on the "series_title" field.
+func SeriesTitleHasPrefix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasPrefix(FieldSeriesTitle, v))
+}
+
+// SeriesTitleHasSuffix applies the HasSuffix predicate on the "series_title" field.
+func SeriesTitleHasSuffix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasSuffix(FieldSeriesTitle, v))
+}
+
+// SeriesTitleIsNil applies the IsNil predicate on the "series_title" field.
+func SeriesTitleIsNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldIsNull(FieldSeriesTitle))
+}
+
+// SeriesTitleNotNil applies the NotNil predicate on the "series_title" field.
+func SeriesTitleNotNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotNull(FieldSeriesTitle))
+}
+
+// SeriesTitleEqualFold applies the EqualFold predicate on the "series_title" field.
+func SeriesTitleEqualFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEqualFold(FieldSeriesTitle, v))
+}
+
+// SeriesTitleContainsFold applies the ContainsFold predicate on the "series_title" field.
+func SeriesTitleContainsFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContainsFold(FieldSeriesTitle, v))
+}
+
+// ChapterNumberEQ applies the EQ predicate on the "chapter_number" field.
+func ChapterNumberEQ(v float64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldChapterNumber, v))
+}
+
+// ChapterNumberNEQ applies the NEQ predicate on the "chapter_number" field.
+func ChapterNumberNEQ(v float64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldChapterNumber, v))
+}
+
+// ChapterNumberIn applies the In predicate on the "chapter_number" field.
+func ChapterNumberIn(vs ...float64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldChapterNumber, vs...))
+}
+
+// ChapterNumberNotIn applies the NotIn predicate on the "chapter_number" field.
+func ChapterNumberNotIn(vs ...float64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldChapterNumber, vs...))
+}
+
+// ChapterNumberGT applies the GT predicate on the "chapter_number" field.
+func ChapterNumberGT(v float64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldChapterNumber, v))
+}
+
+// ChapterNumberGTE applies the GTE predicate on the "chapter_number" field.
+func ChapterNumberGTE(v float64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldChapterNumber, v))
+}
+
+// ChapterNumberLT applies the LT predicate on the "chapter_number" field.
+func ChapterNumberLT(v float64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldChapterNumber, v))
+}
+
+// ChapterNumberLTE applies the LTE predicate on the "chapter_number" field.
+func ChapterNumberLTE(v float64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldChapterNumber, v))
+}
+
+// ChapterNumberIsNil applies the IsNil predicate on the "chapter_number" field.
+func ChapterNumberIsNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldIsNull(FieldChapterNumber))
+}
+
+// ChapterNumberNotNil applies the NotNil predicate on the "chapter_number" field.
+func ChapterNumberNotNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotNull(FieldChapterNumber))
+}
+
+// AttemptEQ applies the EQ predicate on the "attempt" field.
+func AttemptEQ(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldAttempt, v))
+}
+
+// AttemptNEQ applies the NEQ predicate on the "attempt" field.
+func AttemptNEQ(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldAttempt, v))
+}
+
+// AttemptIn applies the In predicate on the "attempt" field.
+func AttemptIn(vs ...int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldAttempt, vs...))
+}
+
+// AttemptNotIn applies the NotIn predicate on the "attempt" field.
+func AttemptNotIn(vs ...int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldAttempt, vs...))
+}
+
+// AttemptGT applies the GT predicate on the "attempt" field.
+func AttemptGT(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldAttempt, v))
+}
+
+// AttemptGTE applies the GTE predicate on the "attempt" field.
+func AttemptGTE(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldAttempt, v))
+}
+
+// AttemptLT applies the LT predicate on the "attempt" field.
+func AttemptLT(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldAttempt, v))
+}
+
+// AttemptLTE applies the LTE predicate on the "attempt" field.
+func AttemptLTE(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldAttempt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ExitCodeEQ applies the EQ predicate on the "exit_code" field.
+func ExitCodeEQ(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldExitCode, v))
+}
+
+// ExitCodeNEQ applies the NEQ predicate on the "exit_code" field.
+func ExitCodeNEQ(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldExitCode, v))
+}
+
+// ExitCodeIn applies the In predicate on the "exit_code" field.
+func ExitCodeIn(vs ...int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldExitCode, vs...))
+}
+
+// ExitCodeNotIn applies the NotIn predicate on the "exit_code" field.
+func ExitCodeNotIn(vs ...int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldExitCode, vs...))
+}
+
+// ExitCodeGT applies the GT predicate on the "exit_code" field.
+func ExitCodeGT(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldExitCode, v))
+}
+
+// ExitCodeGTE applies the GTE predicate on the "exit_code" field.
+func ExitCodeGTE(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldExitCode, v))
+}
+
+// ExitCodeLT applies the LT predicate on the "exit_code" field.
+func ExitCodeLT(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldExitCode, v))
+}
+
+// ExitCodeLTE applies the LTE predicate on the "exit_code" field.
+func ExitCodeLTE(v int) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldExitCode, v))
+}
+
+// ExitCodeIsNil applies the IsNil predicate on the "exit_code" field.
+func ExitCodeIsNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldIsNull(FieldExitCode))
+}
+
+// ExitCodeNotNil applies the NotNil predicate on the "exit_code" field.
+func ExitCodeNotNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotNull(FieldExitCode))
+}
+
+// OutputEQ applies the EQ predicate on the "output" field.
+func OutputEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldOutput, v))
+}
+
+// OutputNEQ applies the NEQ predicate on the "output" field.
+func OutputNEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldOutput, v))
+}
+
+// OutputIn applies the In predicate on the "output" field.
+func OutputIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldOutput, vs...))
+}
+
+// OutputNotIn applies the NotIn predicate on the "output" field.
+func OutputNotIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldOutput, vs...))
+}
+
+// OutputGT applies the GT predicate on the "output" field.
+func OutputGT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldOutput, v))
+}
+
+// OutputGTE applies the GTE predicate on the "output" field.
+func OutputGTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldOutput, v))
+}
+
+// OutputLT applies the LT predicate on the "output" field.
+func OutputLT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldOutput, v))
+}
+
+// OutputLTE applies the LTE predicate on the "output" field.
+func OutputLTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldOutput, v))
+}
+
+// OutputContains applies the Contains predicate on the "output" field.
+func OutputContains(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContains(FieldOutput, v))
+}
+
+// OutputHasPrefix applies the HasPrefix predicate on the "output" field.
+func OutputHasPrefix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasPrefix(FieldOutput, v))
+}
+
+// OutputHasSuffix applies the HasSuffix predicate on the "output" field.
+func OutputHasSuffix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasSuffix(FieldOutput, v))
+}
+
+// OutputIsNil applies the IsNil predicate on the "output" field.
+func OutputIsNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldIsNull(FieldOutput))
+}
+
+// OutputNotNil applies the NotNil predicate on the "output" field.
+func OutputNotNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotNull(FieldOutput))
+}
+
+// OutputEqualFold applies the EqualFold predicate on the "output" field.
+func OutputEqualFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEqualFold(FieldOutput, v))
+}
+
+// OutputContainsFold applies the ContainsFold predicate on the "output" field.
+func OutputContainsFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContainsFold(FieldOutput, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.HookRun {
+	return predicate.HookRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int64) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.HookRun {
+	return predicate.HookRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.HookRun {
+	return predicate.HookRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.HookRun {
+	return predicate.HookRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.HookRun {
+	return predicate.HookRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.HookRun {
+	return predicate.HookRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.HookRun) predicate.HookRun {
+	return predicate.HookRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.HookRun) predicate.HookRun {
+	return predicate.HookRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.HookRun) predicate.HookRun {
+	return predicate.HookRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,1324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/hookrun"
+)
+
+// HookRunCreate is the builder for creating a HookRun entity.
+type HookRunCreate struct {
+	config
+	mutation *HookRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetHook sets the "hook" field.
+func (_c *HookRunCreate) SetHook(v string) *HookRunCreate {
+	_c.mutation.SetHook(v)
+	return _c
+}
+
+// SetEvent sets the "event" field.
+func (_c *HookRunCreate) SetEvent(v string) *HookRunCreate {
+	_c.mutation.SetEvent(v)
+	return _c
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_c *HookRunCreate) SetSeriesID(v uuid.UUID) *HookRunCreate {
+	_c.mutation.SetSeriesID(v)
+	return _c
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_c *HookRunCreate) SetNillableSeriesID(v *uuid.UUID) *HookRunCreate {
+	if v != nil {
+		_c.SetSeriesID(*v)
+	}
+	return _c
+}
+
+// SetSeriesTitle sets the "series_title" field.
+func (_c *HookRunCreate) SetSeriesTitle(v string) *HookRunCreate {
+	_c.mutation.SetSeriesTitle(v)
+	return _c
+}
+
+// SetNillableSeriesTitle sets the "series_title" field if the given value is not nil.
+func (_c *HookRunCreate) SetNillableSeriesTitle(v *string) *HookRunCreate {
+	if v != nil {
+		_c.SetSeriesTitle(*v)
+	}
+	return _c
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (_c *HookRunCreate) SetChapterNumber(v float64) *HookRunCreate {
+	_c.mutation.SetChapterNumber(v)
+	return _c
+}
+
+// SetNillableChapterNumber sets the "chapter_number" field if the given value is not nil.
+func (_c *HookRunCreate) SetNillableChapterNumber(v *float64) *HookRunCreate {
+	if v != nil {
+		_c.SetChapterNumber(*v)
+	}
+	return _c
+}
+
+// SetAttempt sets the "attempt" field.
+func (_c *HookRunCreate) SetAttempt(v int) *HookRunCreate {
+	_c.mutation.SetAttempt(v)
+	return _c
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_c *HookRunCreate) SetNillableAttempt(v *int) *HookRunCreate {
+	if v != nil {
+		_c.SetAttempt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *HookRunCreate) SetStatus(v string) *HookRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetExitCode sets the "exit_code" field.
+func (_c *HookRunCreate) SetExitCode(v int) *HookRunCreate {
+	_c.mutation.SetExitCode(v)
+	return _c
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_c *HookRunCreate) SetNillableExitCode(v *int) *HookRunCreate {
+	if v != nil {
+		_c.SetExitCode(*v)
+	}
+	return _c
+}
+
+// SetOutput sets the "output" field.
+func (_c *HookRunCreate) SetOutput(v string) *HookRunCreate {
+	_c.mutation.SetOutput(v)
+	return _c
+}
+
+// SetNillableOutput sets the "output" field if the given value is not nil.
+func (_c *HookRunCreate) SetNillableOutput(v *string) *HookRunCreate {
+	if v != nil {
+		_c.SetOutput(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *HookRunCreate) SetError(v string) *HookRunCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *HookRunCreate) SetNillableError(v *string) *HookRunCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *HookRunCreate) SetDurationMs(v int64) *HookRunCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *HookRunCreate) SetNillableDurationMs(v *int64) *HookRunCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HookRunCreate) SetCreatedAt(v time.Time) *HookRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HookRunCreate) SetNillableCreatedAt(v *time.Time) *HookRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *HookRunCreate) SetID(v uuid.UUID) *HookRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *HookRunCreate) SetNillableID(v *uuid.UUID) *HookRunCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the HookRunMutation object of the builder.
+func (_c *HookRunCreate) Mutation() *HookRunMutation {
+	return _c.mutation
+}
+
+// Save creates the HookRun in the database.
+func (_c *HookRunCreate) Save(ctx context.Context) (*HookRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HookRunCreate) SaveX(ctx context.Context) *HookRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HookRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HookRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HookRunCreate) defaults() {
+	if _, ok := _c.mutation.Attempt(); !ok {
+		v := hookrun.DefaultAttempt
+		_c.mutation.SetAttempt(v)
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		v := hookrun.DefaultDurationMs
+		_c.mutation.SetDurationMs(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := hookrun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := hookrun.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HookRunCreate) check() error {
+	if _, ok := _c.mutation.Hook(); !ok {
+		return &ValidationError{Name: "hook", err: errors.New(`ent: missing required field "HookRun.hook"`)}
+	}
+	if _, ok := _c.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "HookRun.event"`)}
+	}
+	if _, ok := _c.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required field "HookRun.attempt"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "HookRun.status"`)}
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "HookRun.duration_ms"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HookRun.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *HookRunCreate) sqlSave(ctx context.Context) (*HookRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HookRunCreate) createSpec() (*HookRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HookRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(hookrun.Table, sqlgraph.NewFieldSpec(hookrun.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Hook(); ok {
+		_spec.SetField(hookrun.FieldHook, field.TypeString, value)
+		_node.Hook = value
+	}
+	if value, ok := _c.mutation.Event(); ok {
+		_spec.SetField(hookrun.FieldEvent, field.TypeString, value)
+		_node.Event = value
+	}
+	if value, ok := _c.mutation.SeriesID(); ok {
+		_spec.SetField(hookrun.FieldSeriesID, field.TypeUUID, value)
+		_node.SeriesID = &value
+	}
+	if value, ok := _c.mutation.SeriesTitle(); ok {
+		_spec.SetField(hookrun.FieldSeriesTitle, field.TypeString, value)
+		_node.SeriesTitle = value
+	}
+	if value, ok := _c.mutation.ChapterNumber(); ok {
+		_spec.SetField(hookrun.FieldChapterNumber, field.TypeFloat64, value)
+		_node.ChapterNumber = &value
+	}
+	if value, ok := _c.mutation.Attempt(); ok {
+		_spec.SetField(hookrun.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(hookrun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ExitCode(); ok {
+		_spec.SetField(hookrun.FieldExitCode, field.TypeInt, value)
+		_node.ExitCode = &value
+	}
+	if value, ok := _c.mutation.Output(); ok {
+		_spec.SetField(hookrun.FieldOutput, field.TypeString, value)
+		_node.Output = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(hookrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(hookrun.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(hookrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HookRun.Create().
+//		SetHook(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HookRunUpsert) {
+//			SetHook(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HookRunCreate) OnConflict(opts ...sql.ConflictOption) *HookRunUpsertOne {
+	_c.conflict = opts
+	return &HookRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HookRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HookRunCreate) OnConflictColumns(columns ...string) *HookRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HookRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// HookRunUpsertOne is the builder for "upsert"-ing
+	//  one HookRun node.
+	HookRunUpsertOne struct {
+		create *HookRunCreate
+	}
+
+	// HookRunUpsert is the "OnConflict" setter.
+	HookRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHook sets the "hook" field.
+func (u *HookRunUpsert) SetHook(v string) *HookRunUpsert {
+	u.Set(hookrun.FieldHook, v)
+	return u
+}
+
+// UpdateHook sets the "hook" field to the value that was provided on create.
+func (u *HookRunUpsert) UpdateHook() *HookRunUpsert {
+	u.SetExcluded(hookrun.FieldHook)
+	return u
+}
+
+// SetEvent sets the "event" field.
+func (u *HookRunUpsert) SetEvent(v string) *HookRunUpsert {
+	u.Set(hookrun.FieldEvent, v)
+	return u
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *HookRunUpsert) UpdateEvent() *HookRunUpsert {
+	u.SetExcluded(hookrun.FieldEvent)
+	return u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (u *HookRunUpsert) SetSeriesID(v uuid.UUID) *HookRunUpsert {
+	u.Set(hookrun.FieldSeriesID, v)
+	return u
+}
+
+// UpdateSeriesID sets the "series_id" field to the value that was provided on create.
+func (u *HookRunUpsert) UpdateSeriesID() *HookRunUpsert {
+	u.SetExcluded(hookrun.FieldSeriesID)
+	return u
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (u *HookRunUpsert) ClearSeriesID() *HookRunUpsert {
+	u.SetNull(hookrun.FieldSeriesID)
+	return u
+}
+
+// SetSeriesTitle sets the "series_title" field.
+func (u *HookRunUpsert) SetSeriesTitle(v string) *HookRunUpsert {
+	u.Set(hookrun.FieldSeriesTitle, v)
+	return u
+}
+
+// UpdateSeriesTitle sets the "series_title" field to the value that was provided on create.
+func (u *HookRunUpsert) UpdateSeriesTitle() *HookRunUpsert {
+	u.SetExcluded(hookrun.FieldSeriesTitle)
+	return u
+}
+
+// ClearSeriesTitle clears the value of the "series_title" field.
+func (u *HookRunUpsert) ClearSeriesTitle() *HookRunUpsert {
+	u.SetNull(hookrun.FieldSeriesTitle)
+	return u
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (u *HookRunUpsert) SetChapterNumber(v float64) *HookRunUpsert {
+	u.Set(hookrun.FieldChapterNumber, v)
+	return u
+}
+
+// UpdateChapterNumber sets the "chapter_number" field to the value that was provided on create.
+func (u *HookRunUpsert) UpdateChapterNumber() *HookRunUpsert {
+	u.SetExcluded(hookrun.FieldChapterNumber)
+	return u
+}
+
+// AddChapterNumber adds v to the "chapter_number" field.
+func (u *HookRunUpsert) AddChapterNumber(v float64) *HookRunUpsert {
+	u.Add(hookrun.FieldChapterNumber, v)
+	return u
+}
+
+// ClearChapterNumber clears the value of the "chapter_number" field.
+func (u *HookRunUpsert) ClearChapterNumber() *HookRunUpsert {
+	u.SetNull(hookrun.FieldChapterNumber)
+	return u
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *HookRunUpsert) SetAttempt(v int) *HookRunUpsert {
+	u.Set(hookrun.FieldAttempt, v)
+	return u
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *HookRunUpsert) UpdateAttempt() *HookRunUpsert {
+	u.SetExcluded(hookrun.FieldAttempt)
+	return u
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *HookRunUpsert) AddAttempt(v int) *HookRunUpsert {
+	u.Add(hookrun.FieldAttempt, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *HookRunUpsert) SetStatus(v string) *HookRunUpsert {
+	u.Set(hookrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HookRunUpsert) UpdateStatus() *HookRunUpsert {
+	u.SetExcluded(hookrun.FieldStatus)
+	return u
+}
+
+// SetExitCode sets the "exit_code" field.
+func (u *HookRunUpsert) SetExitCode(v int) *HookRunUpsert {
+	u.Set(hookrun.FieldExitCode, v)
+	return u
+}
+
+// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
+func (u *HookRunUpsert) UpdateExitCode() *HookRunUpsert {
+	u.SetExcluded(hookrun.FieldExitCode)
+	return u
+}
+
+// AddExitCode adds v to the "exit_code" field.
+func (u *HookRunUpsert) AddExitCode(v int) *HookRunUpsert {
+	u.Add(hookrun.FieldExitCode, v)
+	return u
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (u *HookRunUpsert) ClearExitCode() *HookRunUpsert {
+	u.SetNull(hookrun.FieldExitCode)
+	return u
+}
+
+// SetOutput sets the "output" field.
+func (u *HookRunUpsert) SetOutput(v string) *HookRunUpsert {
+	u.Set(hookrun.FieldOutput, v)
+	return u
+}
+
+// UpdateOutput sets the "output" field to the value that was provided on create.
+func (u *HookRunUpsert) UpdateOutput() *HookRunUpsert {
+	u.SetExcluded(hookrun.FieldOutput)
+	return u
+}
+
+// ClearOutput clears the value of the "output" field.
+func (u *HookRunUpsert) ClearOutput() *HookRunUpsert {
+	u.SetNull(hookrun.FieldOutput)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *HookRunUpsert) SetError(v string) *HookRunUpsert {
+	u.Set(hookrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *HookRunUpsert) UpdateError() *HookRunUpsert {
+	u.SetExcluded(hookrun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *HookRunUpsert) ClearError() *HookRunUpsert {
+	u.SetNull(hookrun.FieldError)
+	return u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *HookRunUpsert) SetDurationMs(v int64) *HookRunUpsert {
+	u.Set(hookrun.FieldDurationMs, v)
+	return u
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *HookRunUpsert) UpdateDurationMs() *HookRunUpsert {
+	u.SetExcluded(hookrun.FieldDurationMs)
+	return u
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *HookRunUpsert) AddDurationMs(v int64) *HookRunUpsert {
+	u.Add(hookrun.FieldDurationMs, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.HookRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hookrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HookRunUpsertOne) UpdateNewValues() *HookRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(hookrun.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(hookrun.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HookRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HookRunUpsertOne) Ignore() *HookRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HookRunUpsertOne) DoNothing() *HookRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HookRunCreate.OnConflict
+// documentation for more info.
+func (u *HookRunUpsertOne) Update(set func(*HookRunUpsert)) *HookRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HookRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHook sets the "hook" field.
+func (u *HookRunUpsertOne) SetHook(v string) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetHook(v)
+	})
+}
+
+// UpdateHook sets the "hook" field to the value that was provided on create.
+func (u *HookRunUpsertOne) UpdateHook() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateHook()
+	})
+}
+
+// SetEvent sets the "event" field.
+func (u *HookRunUpsertOne) SetEvent(v string) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetEvent(v)
+	})
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *HookRunUpsertOne) UpdateEvent() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateEvent()
+	})
+}
+
+// SetSeriesID sets the "series_id" field.
+func (u *HookRunUpsertOne) SetSeriesID(v uuid.UUID) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetSeriesID(v)
+	})
+}
+
+// UpdateSeriesID sets the "series_id" field to the value that was provided on create.
+func (u *HookRunUpsertOne) UpdateSeriesID() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateSeriesID()
+	})
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (u *HookRunUpsertOne) ClearSeriesID() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearSeriesID()
+	})
+}
+
+// SetSeriesTitle sets the "series_title" field.
+func (u *HookRunUpsertOne) SetSeriesTitle(v string) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetSeriesTitle(v)
+	})
+}
+
+// UpdateSeriesTitle sets the "series_title" field to the value that was provided on create.
+func (u *HookRunUpsertOne) UpdateSeriesTitle() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateSeriesTitle()
+	})
+}
+
+// ClearSeriesTitle clears the value of the "series_title" field.
+func (u *HookRunUpsertOne) ClearSeriesTitle() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearSeriesTitle()
+	})
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (u *HookRunUpsertOne) SetChapterNumber(v float64) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetChapterNumber(v)
+	})
+}
+
+// AddChapterNumber adds v to the "chapter_number" field.
+func (u *HookRunUpsertOne) AddChapterNumber(v float64) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.AddChapterNumber(v)
+	})
+}
+
+// UpdateChapterNumber sets the "chapter_number" field to the value that was provided on create.
+func (u *HookRunUpsertOne) UpdateChapterNumber() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateChapterNumber()
+	})
+}
+
+// ClearChapterNumber clears the value of the "chapter_number" field.
+func (u *HookRunUpsertOne) ClearChapterNumber() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearChapterNumber()
+	})
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *HookRunUpsertOne) SetAttempt(v int) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetAttempt(v)
+	})
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *HookRunUpsertOne) AddAttempt(v int) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.AddAttempt(v)
+	})
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *HookRunUpsertOne) UpdateAttempt() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateAttempt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *HookRunUpsertOne) SetStatus(v string) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HookRunUpsertOne) UpdateStatus() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExitCode sets the "exit_code" field.
+func (u *HookRunUpsertOne) SetExitCode(v int) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetExitCode(v)
+	})
+}
+
+// AddExitCode adds v to the "exit_code" field.
+func (u *HookRunUpsertOne) AddExitCode(v int) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.AddExitCode(v)
+	})
+}
+
+// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
+func (u *HookRunUpsertOne) UpdateExitCode() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateExitCode()
+	})
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (u *HookRunUpsertOne) ClearExitCode() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearExitCode()
+	})
+}
+
+// SetOutput sets the "output" field.
+func (u *HookRunUpsertOne) SetOutput(v string) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetOutput(v)
+	})
+}
+
+// UpdateOutput sets the "output" field to the value that was provided on create.
+func (u *HookRunUpsertOne) UpdateOutput() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateOutput()
+	})
+}
+
+// ClearOutput clears the value of the "output" field.
+func (u *HookRunUpsertOne) ClearOutput() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearOutput()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *HookRunUpsertOne) SetError(v string) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *HookRunUpsertOne) UpdateError() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *HookRunUpsertOne) ClearError() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *HookRunUpsertOne) SetDurationMs(v int64) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *HookRunUpsertOne) AddDurationMs(v int64) *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *HookRunUpsertOne) UpdateDurationMs() *HookRunUpsertOne {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// Exec executes the query.
+func (u *HookRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HookRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HookRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HookRunUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: HookRunUpsertOne.ID is not supported by MySQL driver. Use HookRunUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HookRunUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HookRunCreateBulk is the builder for creating many HookRun entities in bulk.
+type HookRunCreateBulk struct {
+	config
+	err      error
+	builders []*HookRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the HookRun entities in the database.
+func (_c *HookRunCreateBulk) Save(ctx context.Context) ([]*HookRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*HookRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HookRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HookRunCreateBulk) SaveX(ctx context.Context) []*HookRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HookRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HookRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HookRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HookRunUpsert) {
+//			SetHook(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HookRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *HookRunUpsertBulk {
+	_c.conflict = opts
+	return &HookRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HookRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HookRunCreateBulk) OnConflictColumns(columns ...string) *HookRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HookRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// HookRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of HookRun nodes.
+type HookRunUpsertBulk struct {
+	create *HookRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.HookRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hookrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HookRunUpsertBulk) UpdateNewValues() *HookRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(hookrun.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(hookrun.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HookRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HookRunUpsertBulk) Ignore() *HookRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HookRunUpsertBulk) DoNothing() *HookRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HookRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *HookRunUpsertBulk) Update(set func(*HookRunUpsert)) *HookRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HookRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHook sets the "hook" field.
+func (u *HookRunUpsertBulk) SetHook(v string) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetHook(v)
+	})
+}
+
+// UpdateHook sets the "hook" field to the value that was provided on create.
+func (u *HookRunUpsertBulk) UpdateHook() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateHook()
+	})
+}
+
+// SetEvent sets the "event" field.
+func (u *HookRunUpsertBulk) SetEvent(v string) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetEvent(v)
+	})
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *HookRunUpsertBulk) UpdateEvent() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateEvent()
+	})
+}
+
+// SetSeriesID sets the "series_id" field.
+func (u *HookRunUpsertBulk) SetSeriesID(v uuid.UUID) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetSeriesID(v)
+	})
+}
+
+// UpdateSeriesID sets the "series_id" field to the value that was provided on create.
+func (u *HookRunUpsertBulk) UpdateSeriesID() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateSeriesID()
+	})
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (u *HookRunUpsertBulk) ClearSeriesID() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearSeriesID()
+	})
+}
+
+// SetSeriesTitle sets the "series_title" field.
+func (u *HookRunUpsertBulk) SetSeriesTitle(v string) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetSeriesTitle(v)
+	})
+}
+
+// UpdateSeriesTitle sets the "series_title" field to the value that was provided on create.
+func (u *HookRunUpsertBulk) UpdateSeriesTitle() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateSeriesTitle()
+	})
+}
+
+// ClearSeriesTitle clears the value of the "series_title" field.
+func (u *HookRunUpsertBulk) ClearSeriesTitle() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearSeriesTitle()
+	})
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (u *HookRunUpsertBulk) SetChapterNumber(v float64) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetChapterNumber(v)
+	})
+}
+
+// AddChapterNumber adds v to the "chapter_number" field.
+func (u *HookRunUpsertBulk) AddChapterNumber(v float64) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.AddChapterNumber(v)
+	})
+}
+
+// UpdateChapterNumber sets the "chapter_number" field to the value that was provided on create.
+func (u *HookRunUpsertBulk) UpdateChapterNumber() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateChapterNumber()
+	})
+}
+
+// ClearChapterNumber clears the value of the "chapter_number" field.
+func (u *HookRunUpsertBulk) ClearChapterNumber() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearChapterNumber()
+	})
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *HookRunUpsertBulk) SetAttempt(v int) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetAttempt(v)
+	})
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *HookRunUpsertBulk) AddAttempt(v int) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.AddAttempt(v)
+	})
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *HookRunUpsertBulk) UpdateAttempt() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateAttempt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *HookRunUpsertBulk) SetStatus(v string) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HookRunUpsertBulk) UpdateStatus() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExitCode sets the "exit_code" field.
+func (u *HookRunUpsertBulk) SetExitCode(v int) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetExitCode(v)
+	})
+}
+
+// AddExitCode adds v to the "exit_code" field.
+func (u *HookRunUpsertBulk) AddExitCode(v int) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.AddExitCode(v)
+	})
+}
+
+// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
+func (u *HookRunUpsertBulk) UpdateExitCode() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateExitCode()
+	})
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (u *HookRunUpsertBulk) ClearExitCode() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearExitCode()
+	})
+}
+
+// SetOutput sets the "output" field.
+func (u *HookRunUpsertBulk) SetOutput(v string) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetOutput(v)
+	})
+}
+
+// UpdateOutput sets the "output" field to the value that was provided on create.
+func (u *HookRunUpsertBulk) UpdateOutput() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateOutput()
+	})
+}
+
+// ClearOutput clears the value of the "output" field.
+func (u *HookRunUpsertBulk) ClearOutput() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearOutput()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *HookRunUpsertBulk) SetError(v string) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *HookRunUpsertBulk) UpdateError() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *HookRunUpsertBulk) ClearError() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *HookRunUpsertBulk) SetDurationMs(v int64) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *HookRunUpsertBulk) AddDurationMs(v int64) *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *HookRunUpsertBulk) UpdateDurationMs() *HookRunUpsertBulk {
+	return u.Update(func(s *HookRunUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// Exec executes the query.
+func (u *HookRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HookRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HookRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HookRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
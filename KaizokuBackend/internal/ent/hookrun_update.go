@@ -0,0 +1,738 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/hookrun"
+	"github.com/technobecet/kaizoku-go/internal/ent/predicate"
+)
+
+// HookRunUpdate is the builder for updating HookRun entities.
+type HookRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HookRunMutation
+}
+
+// Where appends a list predicates to the HookRunUpdate builder.
+func (_u *HookRunUpdate) Where(ps ...predicate.HookRun) *HookRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHook sets the "hook" field.
+func (_u *HookRunUpdate) SetHook(v string) *HookRunUpdate {
+	_u.mutation.SetHook(v)
+	return _u
+}
+
+// SetNillableHook sets the "hook" field if the given value is not nil.
+func (_u *HookRunUpdate) SetNillableHook(v *string) *HookRunUpdate {
+	if v != nil {
+		_u.SetHook(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *HookRunUpdate) SetEvent(v string) *HookRunUpdate {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *HookRunUpdate) SetNillableEvent(v *string) *HookRunUpdate {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *HookRunUpdate) SetSeriesID(v uuid.UUID) *HookRunUpdate {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *HookRunUpdate) SetNillableSeriesID(v *uuid.UUID) *HookRunUpdate {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (_u *HookRunUpdate) ClearSeriesID() *HookRunUpdate {
+	_u.mutation.ClearSeriesID()
+	return _u
+}
+
+// SetSeriesTitle sets the "series_title" field.
+func (_u *HookRunUpdate) SetSeriesTitle(v string) *HookRunUpdate {
+	_u.mutation.SetSeriesTitle(v)
+	return _u
+}
+
+// SetNillableSeriesTitle sets the "series_title" field if the given value is not nil.
+func (_u *HookRunUpdate) SetNillableSeriesTitle(v *string) *HookRunUpdate {
+	if v != nil {
+		_u.SetSeriesTitle(*v)
+	}
+	return _u
+}
+
+// ClearSeriesTitle clears the value of the "series_title" field.
+func (_u *HookRunUpdate) ClearSeriesTitle() *HookRunUpdate {
+	_u.mutation.ClearSeriesTitle()
+	return _u
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (_u *HookRunUpdate) SetChapterNumber(v float64) *HookRunUpdate {
+	_u.mutation.ResetChapterNumber()
+	_u.mutation.SetChapterNumber(v)
+	return _u
+}
+
+// SetNillableChapterNumber sets the "chapter_number" field if the given value is not nil.
+func (_u *HookRunUpdate) SetNillableChapterNumber(v *float64) *HookRunUpdate {
+	if v != nil {
+		_u.SetChapterNumber(*v)
+	}
+	return _u
+}
+
+// AddChapterNumber adds value to the "chapter_number" field.
+func (_u *HookRunUpdate) AddChapterNumber(v float64) *HookRunUpdate {
+	_u.mutation.AddChapterNumber(v)
+	return _u
+}
+
+// ClearChapterNumber clears the value of the "chapter_number" field.
+func (_u *HookRunUpdate) ClearChapterNumber() *HookRunUpdate {
+	_u.mutation.ClearChapterNumber()
+	return _u
+}
+
+// SetAttempt sets the "attempt" field.
+func (_u *HookRunUpdate) SetAttempt(v int) *HookRunUpdate {
+	_u.mutation.ResetAttempt()
+	_u.mutation.SetAttempt(v)
+	return _u
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_u *HookRunUpdate) SetNillableAttempt(v *int) *HookRunUpdate {
+	if v != nil {
+		_u.SetAttempt(*v)
+	}
+	return _u
+}
+
+// AddAttempt adds value to the "attempt" field.
+func (_u *HookRunUpdate) AddAttempt(v int) *HookRunUpdate {
+	_u.mutation.AddAttempt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *HookRunUpdate) SetStatus(v string) *HookRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *HookRunUpdate) SetNillableStatus(v *string) *HookRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExitCode sets the "exit_code" field.
+func (_u *HookRunUpdate) SetExitCode(v int) *HookRunUpdate {
+	_u.mutation.ResetExitCode()
+	_u.mutation.SetExitCode(v)
+	return _u
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_u *HookRunUpdate) SetNillableExitCode(v *int) *HookRunUpdate {
+	if v != nil {
+		_u.SetExitCode(*v)
+	}
+	return _u
+}
+
+// AddExitCode adds value to the "exit_code" field.
+func (_u *HookRunUpdate) AddExitCode(v int) *HookRunUpdate {
+	_u.mutation.AddExitCode(v)
+	return _u
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (_u *HookRunUpdate) ClearExitCode() *HookRunUpdate {
+	_u.mutation.ClearExitCode()
+	return _u
+}
+
+// SetOutput sets the "output" field.
+func (_u *HookRunUpdate) SetOutput(v string) *HookRunUpdate {
+	_u.mutation.SetOutput(v)
+	return _u
+}
+
+// SetNillableOutput sets the "output" field if the given value is not nil.
+func (_u *HookRunUpdate) SetNillableOutput(v *string) *HookRunUpdate {
+	if v != nil {
+		_u.SetOutput(*v)
+	}
+	return _u
+}
+
+// ClearOutput clears the value of the "output" field.
+func (_u *HookRunUpdate) ClearOutput() *HookRunUpdate {
+	_u.mutation.ClearOutput()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *HookRunUpdate) SetError(v string) *HookRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *HookRunUpdate) SetNillableError(v *string) *HookRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *HookRunUpdate) ClearError() *HookRunUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *HookRunUpdate) SetDurationMs(v int64) *HookRunUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *HookRunUpdate) SetNillableDurationMs(v *int64) *HookRunUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *HookRunUpdate) AddDurationMs(v int64) *HookRunUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// Mutation returns the HookRunMutation object of the builder.
+func (_u *HookRunUpdate) Mutation() *HookRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HookRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HookRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HookRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HookRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *HookRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(hookrun.Table, hookrun.Columns, sqlgraph.NewFieldSpec(hookrun.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hook(); ok {
+		_spec.SetField(hookrun.FieldHook, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(hookrun.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(hookrun.FieldSeriesID, field.TypeUUID, value)
+	}
+	if _u.mutation.SeriesIDCleared() {
+		_spec.ClearField(hookrun.FieldSeriesID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SeriesTitle(); ok {
+		_spec.SetField(hookrun.FieldSeriesTitle, field.TypeString, value)
+	}
+	if _u.mutation.SeriesTitleCleared() {
+		_spec.ClearField(hookrun.FieldSeriesTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChapterNumber(); ok {
+		_spec.SetField(hookrun.FieldChapterNumber, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChapterNumber(); ok {
+		_spec.AddField(hookrun.FieldChapterNumber, field.TypeFloat64, value)
+	}
+	if _u.mutation.ChapterNumberCleared() {
+		_spec.ClearField(hookrun.FieldChapterNumber, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Attempt(); ok {
+		_spec.SetField(hookrun.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempt(); ok {
+		_spec.AddField(hookrun.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(hookrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExitCode(); ok {
+		_spec.SetField(hookrun.FieldExitCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExitCode(); ok {
+		_spec.AddField(hookrun.FieldExitCode, field.TypeInt, value)
+	}
+	if _u.mutation.ExitCodeCleared() {
+		_spec.ClearField(hookrun.FieldExitCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Output(); ok {
+		_spec.SetField(hookrun.FieldOutput, field.TypeString, value)
+	}
+	if _u.mutation.OutputCleared() {
+		_spec.ClearField(hookrun.FieldOutput, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(hookrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(hookrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(hookrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(hookrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hookrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HookRunUpdateOne is the builder for updating a single HookRun entity.
+type HookRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HookRunMutation
+}
+
+// SetHook sets the "hook" field.
+func (_u *HookRunUpdateOne) SetHook(v string) *HookRunUpdateOne {
+	_u.mutation.SetHook(v)
+	return _u
+}
+
+// SetNillableHook sets the "hook" field if the given value is not nil.
+func (_u *HookRunUpdateOne) SetNillableHook(v *string) *HookRunUpdateOne {
+	if v != nil {
+		_u.SetHook(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *HookRunUpdateOne) SetEvent(v string) *HookRunUpdateOne {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *HookRunUpdateOne) SetNillableEvent(v *string) *HookRunUpdateOne {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *HookRunUpdateOne) SetSeriesID(v uuid.UUID) *HookRunUpdateOne {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *HookRunUpdateOne) SetNillableSeriesID(v *uuid.UUID) *HookRunUpdateOne {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (_u *HookRunUpdateOne) ClearSeriesID() *HookRunUpdateOne {
+	_u.mutation.ClearSeriesID()
+	return _u
+}
+
+// SetSeriesTitle sets the "series_title" field.
+func (_u *HookRunUpdateOne) SetSeriesTitle(v string) *HookRunUpdateOne {
+	_u.mutation.SetSeriesTitle(v)
+	return _u
+}
+
+// SetNillableSeriesTitle sets the "series_title" field if the given value is not nil.
+func (_u *HookRunUpdateOne) SetNillableSeriesTitle(v *string) *HookRunUpdateOne {
+	if v != nil {
+		_u.SetSeriesTitle(*v)
+	}
+	return _u
+}
+
+// ClearSeriesTitle clears the value of the "series_title" field.
+func (_u *HookRunUpdateOne) ClearSeriesTitle() *HookRunUpdateOne {
+	_u.mutation.ClearSeriesTitle()
+	return _u
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (_u *HookRunUpdateOne) SetChapterNumber(v float64) *HookRunUpdateOne {
+	_u.mutation.ResetChapterNumber()
+	_u.mutation.SetChapterNumber(v)
+	return _u
+}
+
+// SetNillableChapterNumber sets the "chapter_number" field if the given value is not nil.
+func (_u *HookRunUpdateOne) SetNillableChapterNumber(v *float64) *HookRunUpdateOne {
+	if v != nil {
+		_u.SetChapterNumber(*v)
+	}
+	return _u
+}
+
+// AddChapterNumber adds value to the "chapter_number" field.
+func (_u *HookRunUpdateOne) AddChapterNumber(v float64) *HookRunUpdateOne {
+	_u.mutation.AddChapterNumber(v)
+	return _u
+}
+
+// ClearChapterNumber clears the value of the "chapter_number" field.
+func (_u *HookRunUpdateOne) ClearChapterNumber() *HookRunUpdateOne {
+	_u.mutation.ClearChapterNumber()
+	return _u
+}
+
+// SetAttempt sets the "attempt" field.
+func (_u *HookRunUpdateOne) SetAttempt(v int) *HookRunUpdateOne {
+	_u.mutation.ResetAttempt()
+	_u.mutation.SetAttempt(v)
+	return _u
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_u *HookRunUpdateOne) SetNillableAttempt(v *int) *HookRunUpdateOne {
+	if v != nil {
+		_u.SetAttempt(*v)
+	}
+	return _u
+}
+
+// AddAttempt adds value to the "attempt" field.
+func (_u *HookRunUpdateOne) AddAttempt(v int) *HookRunUpdateOne {
+	_u.mutation.AddAttempt(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *HookRunUpdateOne) SetStatus(v string) *HookRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *HookRunUpdateOne) SetNillableStatus(v *string) *HookRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExitCode sets the "exit_code" field.
+func (_u *HookRunUpdateOne) SetExitCode(v int) *HookRunUpdateOne {
+	_u.mutation.ResetExitCode()
+	_u.mutation.SetExitCode(v)
+	return _u
+}
+
+// SetNillableExitCode sets the "exit_code" field if the given value is not nil.
+func (_u *HookRunUpdateOne) SetNillableExitCode(v *int) *HookRunUpdateOne {
+	if v != nil {
+		_u.SetExitCode(*v)
+	}
+	return _u
+}
+
+// AddExitCode adds value to the "exit_code" field.
+func (_u *HookRunUpdateOne) AddExitCode(v int) *HookRunUpdateOne {
+	_u.mutation.AddExitCode(v)
+	return _u
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (_u *HookRunUpdateOne) ClearExitCode() *HookRunUpdateOne {
+	_u.mutation.ClearExitCode()
+	return _u
+}
+
+// SetOutput sets the "output" field.
+func (_u *HookRunUpdateOne) SetOutput(v string) *HookRunUpdateOne {
+	_u.mutation.SetOutput(v)
+	return _u
+}
+
+// SetNillableOutput sets the "output" field if the given value is not nil.
+func (_u *HookRunUpdateOne) SetNillableOutput(v *string) *HookRunUpdateOne {
+	if v != nil {
+		_u.SetOutput(*v)
+	}
+	return _u
+}
+
+// ClearOutput clears the value of the "output" field.
+func (_u *HookRunUpdateOne) ClearOutput() *HookRunUpdateOne {
+	_u.mutation.ClearOutput()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *HookRunUpdateOne) SetError(v string) *HookRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *HookRunUpdateOne) SetNillableError(v *string) *HookRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *HookRunUpdateOne) ClearError() *HookRunUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *HookRunUpdateOne) SetDurationMs(v int64) *HookRunUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *HookRunUpdateOne) SetNillableDurationMs(v *int64) *HookRunUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *HookRunUpdateOne) AddDurationMs(v int64) *HookRunUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// Mutation returns the HookRunMutation object of the builder.
+func (_u *HookRunUpdateOne) Mutation() *HookRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the HookRunUpdate builder.
+func (_u *HookRunUpdateOne) Where(ps ...predicate.HookRun) *HookRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HookRunUpdateOne) Select(field string, fields ...string) *HookRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated HookRun entity.
+func (_u *HookRunUpdateOne) Save(ctx context.Context) (*HookRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HookRunUpdateOne) SaveX(ctx context.Context) *HookRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HookRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HookRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *HookRunUpdateOne) sqlSave(ctx context.Context) (_node *HookRun, err error) {
+	_spec := sqlgraph.NewUpdateSpec(hookrun.Table, hookrun.Columns, sqlgraph.NewFieldSpec(hookrun.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HookRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hookrun.FieldID)
+		for _, f := range fields {
+			if !hookrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != hookrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hook(); ok {
+		_spec.SetField(hookrun.FieldHook, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(hookrun.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(hookrun.FieldSeriesID, field.TypeUUID, value)
+	}
+	if _u.mutation.SeriesIDCleared() {
+		_spec.ClearField(hookrun.FieldSeriesID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SeriesTitle(); ok {
+		_spec.SetField(hookrun.FieldSeriesTitle, field.TypeString, value)
+	}
+	if _u.mutation.SeriesTitleCleared() {
+		_spec.ClearField(hookrun.FieldSeriesTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChapterNumber(); ok {
+		_spec.SetField(hookrun.FieldChapterNumber, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChapterNumber(); ok {
+		_spec.AddField(hookrun.FieldChapterNumber, field.TypeFloat64, value)
+	}
+	if _u.mutation.ChapterNumberCleared() {
+		_spec.ClearField(hookrun.FieldChapterNumber, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Attempt(); ok {
+		_spec.SetField(hookrun.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempt(); ok {
+		_spec.AddField(hookrun.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(hookrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExitCode(); ok {
+		_spec.SetField(hookrun.FieldExitCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExitCode(); ok {
+		_spec.AddField(hookrun.FieldExitCode, field.TypeInt, value)
+	}
+	if _u.mutation.ExitCodeCleared() {
+		_spec.ClearField(hookrun.FieldExitCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Output(); ok {
+		_spec.SetField(hookrun.FieldOutput, field.TypeString, value)
+	}
+	if _u.mutation.OutputCleared() {
+		_spec.ClearField(hookrun.FieldOutput, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(hookrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(hookrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(hookrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(hookrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	_node = &HookRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hookrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,77 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_revoked_at",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[7]},
+			},
+			{
+				Name:    "apikey_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[2]},
+			},
+		},
+	}
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "actor_kind", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true},
+		{Name: "actor_name", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString},
+		{Name: "target_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "diff", Type: field.TypeJSON, Nullable: true},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[10]},
+			},
+			{
+				Name:    "auditevent_action_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[4], AuditEventsColumns[10]},
+			},
+			{
+				Name:    "auditevent_actor_name_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[3], AuditEventsColumns[10]},
+			},
+			{
+				Name:    "auditevent_target_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[5], AuditEventsColumns[10]},
+			},
+		},
+	}
 	// DownloadQueueItemsColumns holds the columns for the "download_queue_items" table.
 	DownloadQueueItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -19,6 +90,8 @@ var (
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "args", Type: field.TypeJSON},
+		{Name: "worker_id", Type: field.TypeString, Nullable: true},
+		{Name: "lease_expires_at", Type: field.TypeTime, Nullable: true},
 	}
 	// DownloadQueueItemsTable holds the schema information for the "download_queue_items" table.
 	DownloadQueueItemsTable = &schema.Table{
@@ -57,6 +130,45 @@ var (
 			},
 		},
 	}
+	// HookRunsColumns holds the columns for the "hook_runs" table.
+	HookRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "hook", Type: field.TypeString},
+		{Name: "event", Type: field.TypeString},
+		{Name: "series_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "series_title", Type: field.TypeString, Nullable: true},
+		{Name: "chapter_number", Type: field.TypeFloat64, Nullable: true},
+		{Name: "attempt", Type: field.TypeInt, Default: 1},
+		{Name: "status", Type: field.TypeString},
+		{Name: "exit_code", Type: field.TypeInt, Nullable: true},
+		{Name: "output", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// HookRunsTable holds the schema information for the "hook_runs" table.
+	HookRunsTable = &schema.Table{
+		Name:       "hook_runs",
+		Columns:    HookRunsColumns,
+		PrimaryKey: []*schema.Column{HookRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hookrun_hook_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{HookRunsColumns[1], HookRunsColumns[12]},
+			},
+			{
+				Name:    "hookrun_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{HookRunsColumns[7], HookRunsColumns[12]},
+			},
+			{
+				Name:    "hookrun_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{HookRunsColumns[12]},
+			},
+		},
+	}
 	// ImportEntriesColumns holds the columns for the "import_entries" table.
 	ImportEntriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -133,6 +245,60 @@ var (
 			},
 		},
 	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "notifier_id", Type: field.TypeUUID},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "message", Type: field.TypeString, Size: 2147483647},
+		{Name: "urgent", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_notifier_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[1], NotificationsColumns[6]},
+			},
+			{
+				Name:    "notification_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[8]},
+			},
+		},
+	}
+	// NotifiersColumns holds the columns for the "notifiers" table.
+	NotifiersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "topic", Type: field.TypeString, Nullable: true},
+		{Name: "token", Type: field.TypeString, Nullable: true},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "title_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "message_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "quiet_start", Type: field.TypeString, Nullable: true},
+		{Name: "quiet_end", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// NotifiersTable holds the schema information for the "notifiers" table.
+	NotifiersTable = &schema.Table{
+		Name:       "notifiers",
+		Columns:    NotifiersColumns,
+		PrimaryKey: []*schema.Column{NotifiersColumns[0]},
+	}
 	// ProviderStoragesColumns holds the columns for the "provider_storages" table.
 	ProviderStoragesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -143,6 +309,7 @@ var (
 		{Name: "version_code", Type: field.TypeInt64, Default: 0},
 		{Name: "is_storage", Type: field.TypeBool, Default: true},
 		{Name: "is_disabled", Type: field.TypeBool, Default: false},
+		{Name: "is_obsolete", Type: field.TypeBool, Default: false},
 		{Name: "mappings", Type: field.TypeJSON, Nullable: true},
 	}
 	// ProviderStoragesTable holds the schema information for the "provider_storages" table.
@@ -158,6 +325,40 @@ var (
 			},
 		},
 	}
+	// ReadProgressesColumns holds the columns for the "read_progresses" table.
+	ReadProgressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "series_id", Type: field.TypeUUID},
+		{Name: "chapter_number", Type: field.TypeFloat64},
+		{Name: "page", Type: field.TypeInt, Default: 0},
+		{Name: "page_count", Type: field.TypeInt, Default: 0},
+		{Name: "completed", Type: field.TypeBool, Default: false},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ReadProgressesTable holds the schema information for the "read_progresses" table.
+	ReadProgressesTable = &schema.Table{
+		Name:       "read_progresses",
+		Columns:    ReadProgressesColumns,
+		PrimaryKey: []*schema.Column{ReadProgressesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "readprogress_user_id_series_id_chapter_number",
+				Unique:  true,
+				Columns: []*schema.Column{ReadProgressesColumns[1], ReadProgressesColumns[2], ReadProgressesColumns[3]},
+			},
+			{
+				Name:    "readprogress_user_id_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReadProgressesColumns[1], ReadProgressesColumns[7]},
+			},
+			{
+				Name:    "readprogress_series_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReadProgressesColumns[2]},
+			},
+		},
+	}
 	// SeriesColumns holds the columns for the "series" table.
 	SeriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -172,6 +373,7 @@ var (
 		{Name: "type", Type: field.TypeString, Nullable: true},
 		{Name: "chapter_count", Type: field.TypeInt, Default: 0},
 		{Name: "pause_downloads", Type: field.TypeBool, Default: false},
+		{Name: "notify", Type: field.TypeBool, Default: false},
 	}
 	// SeriesTable holds the schema information for the "series" table.
 	SeriesTable = &schema.Table{
@@ -243,6 +445,32 @@ var (
 			},
 		},
 	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[2]},
+			},
+			{
+				Name:    "session_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[4]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -297,17 +525,142 @@ var (
 			},
 		},
 	}
+	// SyncProgressesColumns holds the columns for the "sync_progresses" table.
+	SyncProgressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "document", Type: field.TypeString},
+		{Name: "progress", Type: field.TypeString},
+		{Name: "percentage", Type: field.TypeFloat64},
+		{Name: "device", Type: field.TypeString, Default: ""},
+		{Name: "device_id", Type: field.TypeString, Default: ""},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SyncProgressesTable holds the schema information for the "sync_progresses" table.
+	SyncProgressesTable = &schema.Table{
+		Name:       "sync_progresses",
+		Columns:    SyncProgressesColumns,
+		PrimaryKey: []*schema.Column{SyncProgressesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "syncprogress_user_id_document",
+				Unique:  true,
+				Columns: []*schema.Column{SyncProgressesColumns[1], SyncProgressesColumns[2]},
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "username", Type: field.TypeString, Unique: true},
+		{Name: "password_hash", Type: field.TypeString, Nullable: true},
+		{Name: "kosync_hash", Type: field.TypeString, Nullable: true},
+		{Name: "role", Type: field.TypeString, Default: "viewer"},
+		{Name: "source", Type: field.TypeString, Default: "local"},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
+		{Name: "feed_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "disabled", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_role",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[4]},
+			},
+			{
+				Name:    "user_source_external_id",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[5], UsersColumns[6]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "secret", Type: field.TypeString, Nullable: true},
+		{Name: "body_template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "webhook_id", Type: field.TypeUUID},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "response_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1], WebhookDeliveriesColumns[12]},
+			},
+			{
+				Name:    "webhookdelivery_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[5], WebhookDeliveriesColumns[12]},
+			},
+			{
+				Name:    "webhookdelivery_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[12]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		AuditEventsTable,
 		DownloadQueueItemsTable,
 		EtagCachesTable,
+		HookRunsTable,
 		ImportEntriesTable,
 		LatestSeriesTable,
+		NotificationsTable,
+		NotifiersTable,
 		ProviderStoragesTable,
+		ReadProgressesTable,
 		SeriesTable,
 		SeriesProvidersTable,
+		SessionsTable,
 		SettingsTable,
 		SourceEventsTable,
+		SyncProgressesTable,
+		UsersTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
@@ -12,16 +12,27 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/apikey"
+	"github.com/technobecet/kaizoku-go/internal/ent/auditevent"
 	"github.com/technobecet/kaizoku-go/internal/ent/downloadqueueitem"
 	"github.com/technobecet/kaizoku-go/internal/ent/etagcache"
+	"github.com/technobecet/kaizoku-go/internal/ent/hookrun"
 	"github.com/technobecet/kaizoku-go/internal/ent/importentry"
 	"github.com/technobecet/kaizoku-go/internal/ent/latestseries"
+	"github.com/technobecet/kaizoku-go/internal/ent/notification"
+	"github.com/technobecet/kaizoku-go/internal/ent/notifier"
 	"github.com/technobecet/kaizoku-go/internal/ent/predicate"
 	"github.com/technobecet/kaizoku-go/internal/ent/providerstorage"
+	"github.com/technobecet/kaizoku-go/internal/ent/readprogress"
 	"github.com/technobecet/kaizoku-go/internal/ent/series"
 	"github.com/technobecet/kaizoku-go/internal/ent/seriesprovider"
+	"github.com/technobecet/kaizoku-go/internal/ent/session"
 	"github.com/technobecet/kaizoku-go/internal/ent/setting"
 	"github.com/technobecet/kaizoku-go/internal/ent/sourceevent"
+	"github.com/technobecet/kaizoku-go/internal/ent/syncprogress"
+	"github.com/technobecet/kaizoku-go/internal/ent/user"
+	"github.com/technobecet/kaizoku-go/internal/ent/webhook"
+	"github.com/technobecet/kaizoku-go/internal/ent/webhookdelivery"
 	"github.com/technobecet/kaizoku-go/internal/types"
 )
 
@@ -34,50 +45,58 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeAPIKey            = "APIKey"
+	TypeAuditEvent        = "AuditEvent"
 	TypeDownloadQueueItem = "DownloadQueueItem"
 	TypeEtagCache         = "EtagCache"
+	TypeHookRun           = "HookRun"
 	TypeImportEntry       = "ImportEntry"
 	TypeLatestSeries      = "LatestSeries"
+	TypeNotification      = "Notification"
+	TypeNotifier          = "Notifier"
 	TypeProviderStorage   = "ProviderStorage"
+	TypeReadProgress      = "ReadProgress"
 	TypeSeries            = "Series"
 	TypeSeriesProvider    = "SeriesProvider"
+	TypeSession           = "Session"
 	TypeSetting           = "Setting"
 	TypeSourceEvent       = "SourceEvent"
+	TypeSyncProgress      = "SyncProgress"
+	TypeUser              = "User"
+	TypeWebhook           = "Webhook"
+	TypeWebhookDelivery   = "WebhookDelivery"
 )
 
-// DownloadQueueItemMutation represents an operation that mutates the DownloadQueueItem nodes in the graph.
-type DownloadQueueItemMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
-	group_key     *string
-	status        *int
-	addstatus     *int
-	priority      *int
-	addpriority   *int
-	scheduled_at  *time.Time
+	name          *string
+	user_id       *uuid.UUID
+	prefix        *string
+	key_hash      *string
 	created_at    *time.Time
-	started_at    *time.Time
-	completed_at  *time.Time
-	args          *types.DownloadChapterArgs
+	last_used_at  *time.Time
+	revoked_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*DownloadQueueItem, error)
-	predicates    []predicate.DownloadQueueItem
+	oldValue      func(context.Context) (*APIKey, error)
+	predicates    []predicate.APIKey
 }
 
-var _ ent.Mutation = (*DownloadQueueItemMutation)(nil)
+var _ ent.Mutation = (*APIKeyMutation)(nil)
 
-// downloadqueueitemOption allows management of the mutation configuration using functional options.
-type downloadqueueitemOption func(*DownloadQueueItemMutation)
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
 
-// newDownloadQueueItemMutation creates new mutation for the DownloadQueueItem entity.
-func newDownloadQueueItemMutation(c config, op Op, opts ...downloadqueueitemOption) *DownloadQueueItemMutation {
-	m := &DownloadQueueItemMutation{
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDownloadQueueItem,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -86,20 +105,20 @@ func newDownloadQueueItemMutation(c config, op Op, opts ...downloadqueueitemOpti
 	return m
 }
 
-// withDownloadQueueItemID sets the ID field of the mutation.
-func withDownloadQueueItemID(id uuid.UUID) downloadqueueitemOption {
-	return func(m *DownloadQueueItemMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id uuid.UUID) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DownloadQueueItem
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*DownloadQueueItem, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DownloadQueueItem.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -108,10 +127,10 @@ func withDownloadQueueItemID(id uuid.UUID) downloadqueueitemOption {
 	}
 }
 
-// withDownloadQueueItem sets the old DownloadQueueItem of the mutation.
-func withDownloadQueueItem(node *DownloadQueueItem) downloadqueueitemOption {
-	return func(m *DownloadQueueItemMutation) {
-		m.oldValue = func(context.Context) (*DownloadQueueItem, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -120,7 +139,7 @@ func withDownloadQueueItem(node *DownloadQueueItem) downloadqueueitemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DownloadQueueItemMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -128,7 +147,7 @@ func (m DownloadQueueItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DownloadQueueItemMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -138,14 +157,14 @@ func (m DownloadQueueItemMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DownloadQueueItem entities.
-func (m *DownloadQueueItemMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of APIKey entities.
+func (m *APIKeyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DownloadQueueItemMutation) ID() (id uuid.UUID, exists bool) {
+func (m *APIKeyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -156,7 +175,7 @@ func (m *DownloadQueueItemMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DownloadQueueItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -165,203 +184,176 @@ func (m *DownloadQueueItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DownloadQueueItem.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetGroupKey sets the "group_key" field.
-func (m *DownloadQueueItemMutation) SetGroupKey(s string) {
-	m.group_key = &s
+// SetName sets the "name" field.
+func (m *APIKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// GroupKey returns the value of the "group_key" field in the mutation.
-func (m *DownloadQueueItemMutation) GroupKey() (r string, exists bool) {
-	v := m.group_key
+// Name returns the value of the "name" field in the mutation.
+func (m *APIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroupKey returns the old "group_key" field's value of the DownloadQueueItem entity.
-// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DownloadQueueItemMutation) OldGroupKey(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupKey requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.GroupKey, nil
+	return oldValue.Name, nil
 }
 
-// ResetGroupKey resets all changes to the "group_key" field.
-func (m *DownloadQueueItemMutation) ResetGroupKey() {
-	m.group_key = nil
+// ResetName resets all changes to the "name" field.
+func (m *APIKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *DownloadQueueItemMutation) SetStatus(i int) {
-	m.status = &i
-	m.addstatus = nil
+// SetUserID sets the "user_id" field.
+func (m *APIKeyMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *DownloadQueueItemMutation) Status() (r int, exists bool) {
-	v := m.status
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *APIKeyMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the DownloadQueueItem entity.
-// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DownloadQueueItemMutation) OldStatus(ctx context.Context) (v int, err error) {
+func (m *APIKeyMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.UserID, nil
 }
 
-// AddStatus adds i to the "status" field.
-func (m *DownloadQueueItemMutation) AddStatus(i int) {
-	if m.addstatus != nil {
-		*m.addstatus += i
-	} else {
-		m.addstatus = &i
-	}
+// ClearUserID clears the value of the "user_id" field.
+func (m *APIKeyMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[apikey.FieldUserID] = struct{}{}
 }
 
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *DownloadQueueItemMutation) AddedStatus() (r int, exists bool) {
-	v := m.addstatus
-	if v == nil {
-		return
-	}
-	return *v, true
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *APIKeyMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldUserID]
+	return ok
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *DownloadQueueItemMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *APIKeyMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, apikey.FieldUserID)
 }
 
-// SetPriority sets the "priority" field.
-func (m *DownloadQueueItemMutation) SetPriority(i int) {
-	m.priority = &i
-	m.addpriority = nil
+// SetPrefix sets the "prefix" field.
+func (m *APIKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// Priority returns the value of the "priority" field in the mutation.
-func (m *DownloadQueueItemMutation) Priority() (r int, exists bool) {
-	v := m.priority
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APIKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriority returns the old "priority" field's value of the DownloadQueueItem entity.
-// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DownloadQueueItemMutation) OldPriority(ctx context.Context) (v int, err error) {
+func (m *APIKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
-	}
-	return oldValue.Priority, nil
-}
-
-// AddPriority adds i to the "priority" field.
-func (m *DownloadQueueItemMutation) AddPriority(i int) {
-	if m.addpriority != nil {
-		*m.addpriority += i
-	} else {
-		m.addpriority = &i
-	}
-}
-
-// AddedPriority returns the value that was added to the "priority" field in this mutation.
-func (m *DownloadQueueItemMutation) AddedPriority() (r int, exists bool) {
-	v := m.addpriority
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return *v, true
+	return oldValue.Prefix, nil
 }
 
-// ResetPriority resets all changes to the "priority" field.
-func (m *DownloadQueueItemMutation) ResetPriority() {
-	m.priority = nil
-	m.addpriority = nil
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APIKeyMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetScheduledAt sets the "scheduled_at" field.
-func (m *DownloadQueueItemMutation) SetScheduledAt(t time.Time) {
-	m.scheduled_at = &t
+// SetKeyHash sets the "key_hash" field.
+func (m *APIKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
 }
 
-// ScheduledAt returns the value of the "scheduled_at" field in the mutation.
-func (m *DownloadQueueItemMutation) ScheduledAt() (r time.Time, exists bool) {
-	v := m.scheduled_at
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *APIKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScheduledAt returns the old "scheduled_at" field's value of the DownloadQueueItem entity.
-// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyHash returns the old "key_hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DownloadQueueItemMutation) OldScheduledAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScheduledAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScheduledAt requires an ID field in the mutation")
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScheduledAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
 	}
-	return oldValue.ScheduledAt, nil
+	return oldValue.KeyHash, nil
 }
 
-// ResetScheduledAt resets all changes to the "scheduled_at" field.
-func (m *DownloadQueueItemMutation) ResetScheduledAt() {
-	m.scheduled_at = nil
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *APIKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DownloadQueueItemMutation) SetCreatedAt(t time.Time) {
+func (m *APIKeyMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DownloadQueueItemMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *APIKeyMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -369,10 +361,10 @@ func (m *DownloadQueueItemMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DownloadQueueItem entity.
-// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DownloadQueueItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -387,153 +379,117 @@ func (m *DownloadQueueItemMutation) OldCreatedAt(ctx context.Context) (v time.Ti
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DownloadQueueItemMutation) ResetCreatedAt() {
+func (m *APIKeyMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *DownloadQueueItemMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *DownloadQueueItemMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the DownloadQueueItem entity.
-// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DownloadQueueItemMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *APIKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.StartedAt, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearStartedAt clears the value of the "started_at" field.
-func (m *DownloadQueueItemMutation) ClearStartedAt() {
-	m.started_at = nil
-	m.clearedFields[downloadqueueitem.FieldStartedAt] = struct{}{}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APIKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
 }
 
-// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
-func (m *DownloadQueueItemMutation) StartedAtCleared() bool {
-	_, ok := m.clearedFields[downloadqueueitem.FieldStartedAt]
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
 	return ok
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *DownloadQueueItemMutation) ResetStartedAt() {
-	m.started_at = nil
-	delete(m.clearedFields, downloadqueueitem.FieldStartedAt)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *DownloadQueueItemMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APIKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *DownloadQueueItemMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APIKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the DownloadQueueItem entity.
-// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DownloadQueueItemMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *APIKeyMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.CompletedAt, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *DownloadQueueItemMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[downloadqueueitem.FieldCompletedAt] = struct{}{}
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *APIKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikey.FieldRevokedAt] = struct{}{}
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *DownloadQueueItemMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[downloadqueueitem.FieldCompletedAt]
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *APIKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldRevokedAt]
 	return ok
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *DownloadQueueItemMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, downloadqueueitem.FieldCompletedAt)
-}
-
-// SetArgs sets the "args" field.
-func (m *DownloadQueueItemMutation) SetArgs(tca types.DownloadChapterArgs) {
-	m.args = &tca
-}
-
-// Args returns the value of the "args" field in the mutation.
-func (m *DownloadQueueItemMutation) Args() (r types.DownloadChapterArgs, exists bool) {
-	v := m.args
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldArgs returns the old "args" field's value of the DownloadQueueItem entity.
-// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DownloadQueueItemMutation) OldArgs(ctx context.Context) (v types.DownloadChapterArgs, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldArgs is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldArgs requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldArgs: %w", err)
-	}
-	return oldValue.Args, nil
-}
-
-// ResetArgs resets all changes to the "args" field.
-func (m *DownloadQueueItemMutation) ResetArgs() {
-	m.args = nil
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APIKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikey.FieldRevokedAt)
 }
 
-// Where appends a list predicates to the DownloadQueueItemMutation builder.
-func (m *DownloadQueueItemMutation) Where(ps ...predicate.DownloadQueueItem) {
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DownloadQueueItemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DownloadQueueItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DownloadQueueItem, len(ps))
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -541,48 +497,45 @@ func (m *DownloadQueueItemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DownloadQueueItemMutation) Op() Op {
+func (m *APIKeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DownloadQueueItemMutation) SetOp(op Op) {
+func (m *APIKeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DownloadQueueItem).
-func (m *DownloadQueueItemMutation) Type() string {
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DownloadQueueItemMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.group_key != nil {
-		fields = append(fields, downloadqueueitem.FieldGroupKey)
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
 	}
-	if m.status != nil {
-		fields = append(fields, downloadqueueitem.FieldStatus)
+	if m.user_id != nil {
+		fields = append(fields, apikey.FieldUserID)
 	}
-	if m.priority != nil {
-		fields = append(fields, downloadqueueitem.FieldPriority)
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
 	}
-	if m.scheduled_at != nil {
-		fields = append(fields, downloadqueueitem.FieldScheduledAt)
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
 	}
 	if m.created_at != nil {
-		fields = append(fields, downloadqueueitem.FieldCreatedAt)
+		fields = append(fields, apikey.FieldCreatedAt)
 	}
-	if m.started_at != nil {
-		fields = append(fields, downloadqueueitem.FieldStartedAt)
-	}
-	if m.completed_at != nil {
-		fields = append(fields, downloadqueueitem.FieldCompletedAt)
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
 	}
-	if m.args != nil {
-		fields = append(fields, downloadqueueitem.FieldArgs)
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
@@ -590,24 +543,22 @@ func (m *DownloadQueueItemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DownloadQueueItemMutation) Field(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case downloadqueueitem.FieldGroupKey:
-		return m.GroupKey()
-	case downloadqueueitem.FieldStatus:
-		return m.Status()
-	case downloadqueueitem.FieldPriority:
-		return m.Priority()
-	case downloadqueueitem.FieldScheduledAt:
-		return m.ScheduledAt()
-	case downloadqueueitem.FieldCreatedAt:
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldUserID:
+		return m.UserID()
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldCreatedAt:
 		return m.CreatedAt()
-	case downloadqueueitem.FieldStartedAt:
-		return m.StartedAt()
-	case downloadqueueitem.FieldCompletedAt:
-		return m.CompletedAt()
-	case downloadqueueitem.FieldArgs:
-		return m.Args()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
 	}
 	return nil, false
 }
@@ -615,281 +566,257 @@ func (m *DownloadQueueItemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DownloadQueueItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case downloadqueueitem.FieldGroupKey:
-		return m.OldGroupKey(ctx)
-	case downloadqueueitem.FieldStatus:
-		return m.OldStatus(ctx)
-	case downloadqueueitem.FieldPriority:
-		return m.OldPriority(ctx)
-	case downloadqueueitem.FieldScheduledAt:
-		return m.OldScheduledAt(ctx)
-	case downloadqueueitem.FieldCreatedAt:
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldUserID:
+		return m.OldUserID(ctx)
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case downloadqueueitem.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case downloadqueueitem.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case downloadqueueitem.FieldArgs:
-		return m.OldArgs(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DownloadQueueItem field %s", name)
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DownloadQueueItemMutation) SetField(name string, value ent.Value) error {
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case downloadqueueitem.FieldGroupKey:
+	case apikey.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGroupKey(v)
+		m.SetName(v)
 		return nil
-	case downloadqueueitem.FieldStatus:
-		v, ok := value.(int)
+	case apikey.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetUserID(v)
 		return nil
-	case downloadqueueitem.FieldPriority:
-		v, ok := value.(int)
+	case apikey.FieldPrefix:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPriority(v)
+		m.SetPrefix(v)
 		return nil
-	case downloadqueueitem.FieldScheduledAt:
-		v, ok := value.(time.Time)
+	case apikey.FieldKeyHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScheduledAt(v)
+		m.SetKeyHash(v)
 		return nil
-	case downloadqueueitem.FieldCreatedAt:
+	case apikey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case downloadqueueitem.FieldStartedAt:
+	case apikey.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartedAt(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case downloadqueueitem.FieldCompletedAt:
+	case apikey.FieldRevokedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletedAt(v)
-		return nil
-	case downloadqueueitem.FieldArgs:
-		v, ok := value.(types.DownloadChapterArgs)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetArgs(v)
+		m.SetRevokedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DownloadQueueItem field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DownloadQueueItemMutation) AddedFields() []string {
-	var fields []string
-	if m.addstatus != nil {
-		fields = append(fields, downloadqueueitem.FieldStatus)
-	}
-	if m.addpriority != nil {
-		fields = append(fields, downloadqueueitem.FieldPriority)
-	}
-	return fields
+func (m *APIKeyMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DownloadQueueItemMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case downloadqueueitem.FieldStatus:
-		return m.AddedStatus()
-	case downloadqueueitem.FieldPriority:
-		return m.AddedPriority()
-	}
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DownloadQueueItemMutation) AddField(name string, value ent.Value) error {
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case downloadqueueitem.FieldStatus:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatus(v)
-		return nil
-	case downloadqueueitem.FieldPriority:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPriority(v)
-		return nil
 	}
-	return fmt.Errorf("unknown DownloadQueueItem numeric field %s", name)
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DownloadQueueItemMutation) ClearedFields() []string {
+func (m *APIKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(downloadqueueitem.FieldStartedAt) {
-		fields = append(fields, downloadqueueitem.FieldStartedAt)
+	if m.FieldCleared(apikey.FieldUserID) {
+		fields = append(fields, apikey.FieldUserID)
 	}
-	if m.FieldCleared(downloadqueueitem.FieldCompletedAt) {
-		fields = append(fields, downloadqueueitem.FieldCompletedAt)
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.FieldCleared(apikey.FieldRevokedAt) {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DownloadQueueItemMutation) FieldCleared(name string) bool {
+func (m *APIKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DownloadQueueItemMutation) ClearField(name string) error {
+func (m *APIKeyMutation) ClearField(name string) error {
 	switch name {
-	case downloadqueueitem.FieldStartedAt:
-		m.ClearStartedAt()
+	case apikey.FieldUserID:
+		m.ClearUserID()
 		return nil
-	case downloadqueueitem.FieldCompletedAt:
-		m.ClearCompletedAt()
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DownloadQueueItem nullable field %s", name)
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DownloadQueueItemMutation) ResetField(name string) error {
+func (m *APIKeyMutation) ResetField(name string) error {
 	switch name {
-	case downloadqueueitem.FieldGroupKey:
-		m.ResetGroupKey()
+	case apikey.FieldName:
+		m.ResetName()
 		return nil
-	case downloadqueueitem.FieldStatus:
-		m.ResetStatus()
+	case apikey.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case downloadqueueitem.FieldPriority:
-		m.ResetPriority()
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
 		return nil
-	case downloadqueueitem.FieldScheduledAt:
-		m.ResetScheduledAt()
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
 		return nil
-	case downloadqueueitem.FieldCreatedAt:
+	case apikey.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case downloadqueueitem.FieldStartedAt:
-		m.ResetStartedAt()
-		return nil
-	case downloadqueueitem.FieldCompletedAt:
-		m.ResetCompletedAt()
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
-	case downloadqueueitem.FieldArgs:
-		m.ResetArgs()
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DownloadQueueItem field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DownloadQueueItemMutation) AddedEdges() []string {
+func (m *APIKeyMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DownloadQueueItemMutation) AddedIDs(name string) []ent.Value {
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DownloadQueueItemMutation) RemovedEdges() []string {
+func (m *APIKeyMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DownloadQueueItemMutation) RemovedIDs(name string) []ent.Value {
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DownloadQueueItemMutation) ClearedEdges() []string {
+func (m *APIKeyMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DownloadQueueItemMutation) EdgeCleared(name string) bool {
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DownloadQueueItemMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown DownloadQueueItem unique edge %s", name)
+func (m *APIKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DownloadQueueItemMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown DownloadQueueItem edge %s", name)
+func (m *APIKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown APIKey edge %s", name)
 }
 
-// EtagCacheMutation represents an operation that mutates the EtagCache nodes in the graph.
-type EtagCacheMutation struct {
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *string
-	etag          *string
-	last_updated  *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*EtagCache, error)
-	predicates    []predicate.EtagCache
-}
-
-var _ ent.Mutation = (*EtagCacheMutation)(nil)
-
-// etagcacheOption allows management of the mutation configuration using functional options.
-type etagcacheOption func(*EtagCacheMutation)
-
-// newEtagCacheMutation creates new mutation for the EtagCache entity.
-func newEtagCacheMutation(c config, op Op, opts ...etagcacheOption) *EtagCacheMutation {
-	m := &EtagCacheMutation{
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	actor_kind       *string
+	actor_id         *string
+	actor_name       *string
+	action           *string
+	target_type      *string
+	target_ids       *[]string
+	appendtarget_ids []string
+	diff             *map[string]types.AuditChange
+	details          *map[string]interface{}
+	ip               *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*AuditEvent, error)
+	predicates       []predicate.AuditEvent
+}
+
+var _ ent.Mutation = (*AuditEventMutation)(nil)
+
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
+
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEtagCache,
+		typ:           TypeAuditEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -898,20 +825,20 @@ func newEtagCacheMutation(c config, op Op, opts ...etagcacheOption) *EtagCacheMu
 	return m
 }
 
-// withEtagCacheID sets the ID field of the mutation.
-func withEtagCacheID(id string) etagcacheOption {
-	return func(m *EtagCacheMutation) {
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id uuid.UUID) auditeventOption {
+	return func(m *AuditEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EtagCache
+			value *AuditEvent
 		)
-		m.oldValue = func(ctx context.Context) (*EtagCache, error) {
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EtagCache.Get(ctx, id)
+					value, err = m.Client().AuditEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -920,10 +847,10 @@ func withEtagCacheID(id string) etagcacheOption {
 	}
 }
 
-// withEtagCache sets the old EtagCache of the mutation.
-func withEtagCache(node *EtagCache) etagcacheOption {
-	return func(m *EtagCacheMutation) {
-		m.oldValue = func(context.Context) (*EtagCache, error) {
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -932,7 +859,7 @@ func withEtagCache(node *EtagCache) etagcacheOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EtagCacheMutation) Client() *Client {
+func (m AuditEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -940,7 +867,7 @@ func (m EtagCacheMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EtagCacheMutation) Tx() (*Tx, error) {
+func (m AuditEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -950,14 +877,14 @@ func (m EtagCacheMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EtagCache entities.
-func (m *EtagCacheMutation) SetID(id string) {
+// operation is only accepted on creation of AuditEvent entities.
+func (m *AuditEventMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EtagCacheMutation) ID() (id string, exists bool) {
+func (m *AuditEventMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -968,1118 +895,862 @@ func (m *EtagCacheMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EtagCacheMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EtagCache.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEtag sets the "etag" field.
-func (m *EtagCacheMutation) SetEtag(s string) {
-	m.etag = &s
+// SetActorKind sets the "actor_kind" field.
+func (m *AuditEventMutation) SetActorKind(s string) {
+	m.actor_kind = &s
 }
 
-// Etag returns the value of the "etag" field in the mutation.
-func (m *EtagCacheMutation) Etag() (r string, exists bool) {
-	v := m.etag
+// ActorKind returns the value of the "actor_kind" field in the mutation.
+func (m *AuditEventMutation) ActorKind() (r string, exists bool) {
+	v := m.actor_kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEtag returns the old "etag" field's value of the EtagCache entity.
-// If the EtagCache object wasn't provided to the builder, the object is fetched from the database.
+// OldActorKind returns the old "actor_kind" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EtagCacheMutation) OldEtag(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldActorKind(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
+		return v, errors.New("OldActorKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEtag requires an ID field in the mutation")
+		return v, errors.New("OldActorKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
+		return v, fmt.Errorf("querying old value for OldActorKind: %w", err)
 	}
-	return oldValue.Etag, nil
+	return oldValue.ActorKind, nil
 }
 
-// ResetEtag resets all changes to the "etag" field.
-func (m *EtagCacheMutation) ResetEtag() {
-	m.etag = nil
+// ResetActorKind resets all changes to the "actor_kind" field.
+func (m *AuditEventMutation) ResetActorKind() {
+	m.actor_kind = nil
 }
 
-// SetLastUpdated sets the "last_updated" field.
-func (m *EtagCacheMutation) SetLastUpdated(t time.Time) {
-	m.last_updated = &t
+// SetActorID sets the "actor_id" field.
+func (m *AuditEventMutation) SetActorID(s string) {
+	m.actor_id = &s
 }
 
-// LastUpdated returns the value of the "last_updated" field in the mutation.
-func (m *EtagCacheMutation) LastUpdated() (r time.Time, exists bool) {
-	v := m.last_updated
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *AuditEventMutation) ActorID() (r string, exists bool) {
+	v := m.actor_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUpdated returns the old "last_updated" field's value of the EtagCache entity.
-// If the EtagCache object wasn't provided to the builder, the object is fetched from the database.
+// OldActorID returns the old "actor_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EtagCacheMutation) OldLastUpdated(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldActorID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUpdated is only allowed on UpdateOne operations")
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUpdated requires an ID field in the mutation")
+		return v, errors.New("OldActorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUpdated: %w", err)
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
 	}
-	return oldValue.LastUpdated, nil
-}
-
-// ResetLastUpdated resets all changes to the "last_updated" field.
-func (m *EtagCacheMutation) ResetLastUpdated() {
-	m.last_updated = nil
+	return oldValue.ActorID, nil
 }
 
-// Where appends a list predicates to the EtagCacheMutation builder.
-func (m *EtagCacheMutation) Where(ps ...predicate.EtagCache) {
-	m.predicates = append(m.predicates, ps...)
+// ClearActorID clears the value of the "actor_id" field.
+func (m *AuditEventMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[auditevent.FieldActorID] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the EtagCacheMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EtagCacheMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EtagCache, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *AuditEventMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldActorID]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *EtagCacheMutation) Op() Op {
-	return m.op
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *AuditEventMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, auditevent.FieldActorID)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EtagCacheMutation) SetOp(op Op) {
-	m.op = op
+// SetActorName sets the "actor_name" field.
+func (m *AuditEventMutation) SetActorName(s string) {
+	m.actor_name = &s
 }
 
-// Type returns the node type of this mutation (EtagCache).
-func (m *EtagCacheMutation) Type() string {
-	return m.typ
+// ActorName returns the value of the "actor_name" field in the mutation.
+func (m *AuditEventMutation) ActorName() (r string, exists bool) {
+	v := m.actor_name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EtagCacheMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.etag != nil {
-		fields = append(fields, etagcache.FieldEtag)
+// OldActorName returns the old "actor_name" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldActorName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorName is only allowed on UpdateOne operations")
 	}
-	if m.last_updated != nil {
-		fields = append(fields, etagcache.FieldLastUpdated)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorName requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorName: %w", err)
+	}
+	return oldValue.ActorName, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *EtagCacheMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case etagcache.FieldEtag:
-		return m.Etag()
-	case etagcache.FieldLastUpdated:
-		return m.LastUpdated()
-	}
-	return nil, false
+// ResetActorName resets all changes to the "actor_name" field.
+func (m *AuditEventMutation) ResetActorName() {
+	m.actor_name = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *EtagCacheMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case etagcache.FieldEtag:
-		return m.OldEtag(ctx)
-	case etagcache.FieldLastUpdated:
-		return m.OldLastUpdated(ctx)
+// SetAction sets the "action" field.
+func (m *AuditEventMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditEventMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown EtagCache field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EtagCacheMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case etagcache.FieldEtag:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEtag(v)
-		return nil
-	case etagcache.FieldLastUpdated:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastUpdated(v)
-		return nil
+// OldAction returns the old "action" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown EtagCache field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *EtagCacheMutation) AddedFields() []string {
-	return nil
+// ResetAction resets all changes to the "action" field.
+func (m *AuditEventMutation) ResetAction() {
+	m.action = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EtagCacheMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetTargetType sets the "target_type" field.
+func (m *AuditEventMutation) SetTargetType(s string) {
+	m.target_type = &s
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EtagCacheMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *AuditEventMutation) TargetType() (r string, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown EtagCache numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *EtagCacheMutation) ClearedFields() []string {
-	return nil
+// OldTargetType returns the old "target_type" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldTargetType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
+	}
+	return oldValue.TargetType, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *EtagCacheMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *AuditEventMutation) ResetTargetType() {
+	m.target_type = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *EtagCacheMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown EtagCache nullable field %s", name)
+// SetTargetIds sets the "target_ids" field.
+func (m *AuditEventMutation) SetTargetIds(s []string) {
+	m.target_ids = &s
+	m.appendtarget_ids = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *EtagCacheMutation) ResetField(name string) error {
-	switch name {
-	case etagcache.FieldEtag:
-		m.ResetEtag()
-		return nil
-	case etagcache.FieldLastUpdated:
-		m.ResetLastUpdated()
-		return nil
+// TargetIds returns the value of the "target_ids" field in the mutation.
+func (m *AuditEventMutation) TargetIds() (r []string, exists bool) {
+	v := m.target_ids
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown EtagCache field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EtagCacheMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EtagCacheMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// OldTargetIds returns the old "target_ids" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldTargetIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetIds: %w", err)
+	}
+	return oldValue.TargetIds, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EtagCacheMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// AppendTargetIds adds s to the "target_ids" field.
+func (m *AuditEventMutation) AppendTargetIds(s []string) {
+	m.appendtarget_ids = append(m.appendtarget_ids, s...)
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EtagCacheMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// AppendedTargetIds returns the list of values that were appended to the "target_ids" field in this mutation.
+func (m *AuditEventMutation) AppendedTargetIds() ([]string, bool) {
+	if len(m.appendtarget_ids) == 0 {
+		return nil, false
+	}
+	return m.appendtarget_ids, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EtagCacheMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearTargetIds clears the value of the "target_ids" field.
+func (m *AuditEventMutation) ClearTargetIds() {
+	m.target_ids = nil
+	m.appendtarget_ids = nil
+	m.clearedFields[auditevent.FieldTargetIds] = struct{}{}
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EtagCacheMutation) EdgeCleared(name string) bool {
-	return false
+// TargetIdsCleared returns if the "target_ids" field was cleared in this mutation.
+func (m *AuditEventMutation) TargetIdsCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldTargetIds]
+	return ok
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EtagCacheMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown EtagCache unique edge %s", name)
+// ResetTargetIds resets all changes to the "target_ids" field.
+func (m *AuditEventMutation) ResetTargetIds() {
+	m.target_ids = nil
+	m.appendtarget_ids = nil
+	delete(m.clearedFields, auditevent.FieldTargetIds)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EtagCacheMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown EtagCache edge %s", name)
+// SetDiff sets the "diff" field.
+func (m *AuditEventMutation) SetDiff(mc map[string]types.AuditChange) {
+	m.diff = &mc
 }
 
-// ImportEntryMutation represents an operation that mutates the ImportEntry nodes in the graph.
-type ImportEntryMutation struct {
-	config
-	op                        Op
-	typ                       string
-	id                        *string
-	title                     *string
-	status                    *int
-	addstatus                 *int
-	action                    *int
-	addaction                 *int
-	info                      **types.KaizokuInfo
-	series                    *[]map[string]interface{}
-	appendseries              []map[string]interface{}
-	continue_after_chapter    *float64
-	addcontinue_after_chapter *float64
-	clearedFields             map[string]struct{}
-	done                      bool
-	oldValue                  func(context.Context) (*ImportEntry, error)
-	predicates                []predicate.ImportEntry
+// Diff returns the value of the "diff" field in the mutation.
+func (m *AuditEventMutation) Diff() (r map[string]types.AuditChange, exists bool) {
+	v := m.diff
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-var _ ent.Mutation = (*ImportEntryMutation)(nil)
-
-// importentryOption allows management of the mutation configuration using functional options.
-type importentryOption func(*ImportEntryMutation)
-
-// newImportEntryMutation creates new mutation for the ImportEntry entity.
-func newImportEntryMutation(c config, op Op, opts ...importentryOption) *ImportEntryMutation {
-	m := &ImportEntryMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeImportEntry,
-		clearedFields: make(map[string]struct{}),
+// OldDiff returns the old "diff" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldDiff(ctx context.Context) (v map[string]types.AuditChange, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiff is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiff requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiff: %w", err)
+	}
+	return oldValue.Diff, nil
 }
 
-// withImportEntryID sets the ID field of the mutation.
-func withImportEntryID(id string) importentryOption {
-	return func(m *ImportEntryMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ImportEntry
-		)
-		m.oldValue = func(ctx context.Context) (*ImportEntry, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ImportEntry.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ClearDiff clears the value of the "diff" field.
+func (m *AuditEventMutation) ClearDiff() {
+	m.diff = nil
+	m.clearedFields[auditevent.FieldDiff] = struct{}{}
 }
 
-// withImportEntry sets the old ImportEntry of the mutation.
-func withImportEntry(node *ImportEntry) importentryOption {
-	return func(m *ImportEntryMutation) {
-		m.oldValue = func(context.Context) (*ImportEntry, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// DiffCleared returns if the "diff" field was cleared in this mutation.
+func (m *AuditEventMutation) DiffCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldDiff]
+	return ok
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ImportEntryMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetDiff resets all changes to the "diff" field.
+func (m *AuditEventMutation) ResetDiff() {
+	m.diff = nil
+	delete(m.clearedFields, auditevent.FieldDiff)
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ImportEntryMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetDetails sets the "details" field.
+func (m *AuditEventMutation) SetDetails(value map[string]interface{}) {
+	m.details = &value
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ImportEntry entities.
-func (m *ImportEntryMutation) SetID(id string) {
-	m.id = &id
+// Details returns the value of the "details" field in the mutation.
+func (m *AuditEventMutation) Details() (r map[string]interface{}, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ImportEntryMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ImportEntryMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ImportEntry.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetTitle sets the "title" field.
-func (m *ImportEntryMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *ImportEntryMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the ImportEntry entity.
-// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldDetails returns the old "details" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportEntryMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldDetails(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldDetails requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Details, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ImportEntryMutation) ResetTitle() {
-	m.title = nil
+// ClearDetails clears the value of the "details" field.
+func (m *AuditEventMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[auditevent.FieldDetails] = struct{}{}
 }
 
-// SetStatus sets the "status" field.
-func (m *ImportEntryMutation) SetStatus(i int) {
-	m.status = &i
-	m.addstatus = nil
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *AuditEventMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldDetails]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ImportEntryMutation) Status() (r int, exists bool) {
-	v := m.status
+// ResetDetails resets all changes to the "details" field.
+func (m *AuditEventMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, auditevent.FieldDetails)
+}
+
+// SetIP sets the "ip" field.
+func (m *AuditEventMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *AuditEventMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the ImportEntry entity.
-// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportEntryMutation) OldStatus(ctx context.Context) (v int, err error) {
+func (m *AuditEventMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.IP, nil
 }
 
-// AddStatus adds i to the "status" field.
-func (m *ImportEntryMutation) AddStatus(i int) {
-	if m.addstatus != nil {
-		*m.addstatus += i
-	} else {
-		m.addstatus = &i
-	}
+// ClearIP clears the value of the "ip" field.
+func (m *AuditEventMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[auditevent.FieldIP] = struct{}{}
 }
 
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *ImportEntryMutation) AddedStatus() (r int, exists bool) {
-	v := m.addstatus
-	if v == nil {
-		return
-	}
-	return *v, true
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *AuditEventMutation) IPCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldIP]
+	return ok
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ImportEntryMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
+// ResetIP resets all changes to the "ip" field.
+func (m *AuditEventMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, auditevent.FieldIP)
 }
 
-// SetAction sets the "action" field.
-func (m *ImportEntryMutation) SetAction(i int) {
-	m.action = &i
-	m.addaction = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Action returns the value of the "action" field in the mutation.
-func (m *ImportEntryMutation) Action() (r int, exists bool) {
-	v := m.action
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAction returns the old "action" field's value of the ImportEntry entity.
-// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportEntryMutation) OldAction(ctx context.Context) (v int, err error) {
+func (m *AuditEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAction requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Action, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddAction adds i to the "action" field.
-func (m *ImportEntryMutation) AddAction(i int) {
-	if m.addaction != nil {
-		*m.addaction += i
-	} else {
-		m.addaction = &i
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditEventMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedAction returns the value that was added to the "action" field in this mutation.
-func (m *ImportEntryMutation) AddedAction() (r int, exists bool) {
-	v := m.addaction
-	if v == nil {
-		return
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetAction resets all changes to the "action" field.
-func (m *ImportEntryMutation) ResetAction() {
-	m.action = nil
-	m.addaction = nil
+// Op returns the operation name.
+func (m *AuditEventMutation) Op() Op {
+	return m.op
 }
 
-// SetInfo sets the "info" field.
-func (m *ImportEntryMutation) SetInfo(ti *types.KaizokuInfo) {
-	m.info = &ti
+// SetOp allows setting the mutation operation.
+func (m *AuditEventMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Info returns the value of the "info" field in the mutation.
-func (m *ImportEntryMutation) Info() (r *types.KaizokuInfo, exists bool) {
-	v := m.info
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
+	return m.typ
 }
 
-// OldInfo returns the old "info" field's value of the ImportEntry entity.
-// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportEntryMutation) OldInfo(ctx context.Context) (v *types.KaizokuInfo, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInfo is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.actor_kind != nil {
+		fields = append(fields, auditevent.FieldActorKind)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInfo requires an ID field in the mutation")
+	if m.actor_id != nil {
+		fields = append(fields, auditevent.FieldActorID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInfo: %w", err)
+	if m.actor_name != nil {
+		fields = append(fields, auditevent.FieldActorName)
 	}
-	return oldValue.Info, nil
-}
-
-// ClearInfo clears the value of the "info" field.
-func (m *ImportEntryMutation) ClearInfo() {
-	m.info = nil
-	m.clearedFields[importentry.FieldInfo] = struct{}{}
-}
-
-// InfoCleared returns if the "info" field was cleared in this mutation.
-func (m *ImportEntryMutation) InfoCleared() bool {
-	_, ok := m.clearedFields[importentry.FieldInfo]
-	return ok
-}
-
-// ResetInfo resets all changes to the "info" field.
-func (m *ImportEntryMutation) ResetInfo() {
-	m.info = nil
-	delete(m.clearedFields, importentry.FieldInfo)
-}
-
-// SetSeries sets the "series" field.
-func (m *ImportEntryMutation) SetSeries(value []map[string]interface{}) {
-	m.series = &value
-	m.appendseries = nil
-}
-
-// Series returns the value of the "series" field in the mutation.
-func (m *ImportEntryMutation) Series() (r []map[string]interface{}, exists bool) {
-	v := m.series
-	if v == nil {
-		return
+	if m.action != nil {
+		fields = append(fields, auditevent.FieldAction)
 	}
-	return *v, true
-}
-
-// OldSeries returns the old "series" field's value of the ImportEntry entity.
-// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportEntryMutation) OldSeries(ctx context.Context) (v []map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeries is only allowed on UpdateOne operations")
+	if m.target_type != nil {
+		fields = append(fields, auditevent.FieldTargetType)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeries requires an ID field in the mutation")
+	if m.target_ids != nil {
+		fields = append(fields, auditevent.FieldTargetIds)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeries: %w", err)
+	if m.diff != nil {
+		fields = append(fields, auditevent.FieldDiff)
 	}
-	return oldValue.Series, nil
-}
-
-// AppendSeries adds value to the "series" field.
-func (m *ImportEntryMutation) AppendSeries(value []map[string]interface{}) {
-	m.appendseries = append(m.appendseries, value...)
-}
-
-// AppendedSeries returns the list of values that were appended to the "series" field in this mutation.
-func (m *ImportEntryMutation) AppendedSeries() ([]map[string]interface{}, bool) {
-	if len(m.appendseries) == 0 {
-		return nil, false
+	if m.details != nil {
+		fields = append(fields, auditevent.FieldDetails)
 	}
-	return m.appendseries, true
+	if m.ip != nil {
+		fields = append(fields, auditevent.FieldIP)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditevent.FieldCreatedAt)
+	}
+	return fields
 }
 
-// ClearSeries clears the value of the "series" field.
-func (m *ImportEntryMutation) ClearSeries() {
-	m.series = nil
-	m.appendseries = nil
-	m.clearedFields[importentry.FieldSeries] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditevent.FieldActorKind:
+		return m.ActorKind()
+	case auditevent.FieldActorID:
+		return m.ActorID()
+	case auditevent.FieldActorName:
+		return m.ActorName()
+	case auditevent.FieldAction:
+		return m.Action()
+	case auditevent.FieldTargetType:
+		return m.TargetType()
+	case auditevent.FieldTargetIds:
+		return m.TargetIds()
+	case auditevent.FieldDiff:
+		return m.Diff()
+	case auditevent.FieldDetails:
+		return m.Details()
+	case auditevent.FieldIP:
+		return m.IP()
+	case auditevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// SeriesCleared returns if the "series" field was cleared in this mutation.
-func (m *ImportEntryMutation) SeriesCleared() bool {
-	_, ok := m.clearedFields[importentry.FieldSeries]
-	return ok
-}
-
-// ResetSeries resets all changes to the "series" field.
-func (m *ImportEntryMutation) ResetSeries() {
-	m.series = nil
-	m.appendseries = nil
-	delete(m.clearedFields, importentry.FieldSeries)
-}
-
-// SetContinueAfterChapter sets the "continue_after_chapter" field.
-func (m *ImportEntryMutation) SetContinueAfterChapter(f float64) {
-	m.continue_after_chapter = &f
-	m.addcontinue_after_chapter = nil
-}
-
-// ContinueAfterChapter returns the value of the "continue_after_chapter" field in the mutation.
-func (m *ImportEntryMutation) ContinueAfterChapter() (r float64, exists bool) {
-	v := m.continue_after_chapter
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldContinueAfterChapter returns the old "continue_after_chapter" field's value of the ImportEntry entity.
-// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImportEntryMutation) OldContinueAfterChapter(ctx context.Context) (v *float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContinueAfterChapter is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContinueAfterChapter requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContinueAfterChapter: %w", err)
-	}
-	return oldValue.ContinueAfterChapter, nil
-}
-
-// AddContinueAfterChapter adds f to the "continue_after_chapter" field.
-func (m *ImportEntryMutation) AddContinueAfterChapter(f float64) {
-	if m.addcontinue_after_chapter != nil {
-		*m.addcontinue_after_chapter += f
-	} else {
-		m.addcontinue_after_chapter = &f
-	}
-}
-
-// AddedContinueAfterChapter returns the value that was added to the "continue_after_chapter" field in this mutation.
-func (m *ImportEntryMutation) AddedContinueAfterChapter() (r float64, exists bool) {
-	v := m.addcontinue_after_chapter
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearContinueAfterChapter clears the value of the "continue_after_chapter" field.
-func (m *ImportEntryMutation) ClearContinueAfterChapter() {
-	m.continue_after_chapter = nil
-	m.addcontinue_after_chapter = nil
-	m.clearedFields[importentry.FieldContinueAfterChapter] = struct{}{}
-}
-
-// ContinueAfterChapterCleared returns if the "continue_after_chapter" field was cleared in this mutation.
-func (m *ImportEntryMutation) ContinueAfterChapterCleared() bool {
-	_, ok := m.clearedFields[importentry.FieldContinueAfterChapter]
-	return ok
-}
-
-// ResetContinueAfterChapter resets all changes to the "continue_after_chapter" field.
-func (m *ImportEntryMutation) ResetContinueAfterChapter() {
-	m.continue_after_chapter = nil
-	m.addcontinue_after_chapter = nil
-	delete(m.clearedFields, importentry.FieldContinueAfterChapter)
-}
-
-// Where appends a list predicates to the ImportEntryMutation builder.
-func (m *ImportEntryMutation) Where(ps ...predicate.ImportEntry) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the ImportEntryMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ImportEntryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ImportEntry, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *ImportEntryMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *ImportEntryMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (ImportEntry).
-func (m *ImportEntryMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ImportEntryMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.title != nil {
-		fields = append(fields, importentry.FieldTitle)
-	}
-	if m.status != nil {
-		fields = append(fields, importentry.FieldStatus)
-	}
-	if m.action != nil {
-		fields = append(fields, importentry.FieldAction)
-	}
-	if m.info != nil {
-		fields = append(fields, importentry.FieldInfo)
-	}
-	if m.series != nil {
-		fields = append(fields, importentry.FieldSeries)
-	}
-	if m.continue_after_chapter != nil {
-		fields = append(fields, importentry.FieldContinueAfterChapter)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ImportEntryMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case importentry.FieldTitle:
-		return m.Title()
-	case importentry.FieldStatus:
-		return m.Status()
-	case importentry.FieldAction:
-		return m.Action()
-	case importentry.FieldInfo:
-		return m.Info()
-	case importentry.FieldSeries:
-		return m.Series()
-	case importentry.FieldContinueAfterChapter:
-		return m.ContinueAfterChapter()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ImportEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case importentry.FieldTitle:
-		return m.OldTitle(ctx)
-	case importentry.FieldStatus:
-		return m.OldStatus(ctx)
-	case importentry.FieldAction:
-		return m.OldAction(ctx)
-	case importentry.FieldInfo:
-		return m.OldInfo(ctx)
-	case importentry.FieldSeries:
-		return m.OldSeries(ctx)
-	case importentry.FieldContinueAfterChapter:
-		return m.OldContinueAfterChapter(ctx)
-	}
-	return nil, fmt.Errorf("unknown ImportEntry field %s", name)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditevent.FieldActorKind:
+		return m.OldActorKind(ctx)
+	case auditevent.FieldActorID:
+		return m.OldActorID(ctx)
+	case auditevent.FieldActorName:
+		return m.OldActorName(ctx)
+	case auditevent.FieldAction:
+		return m.OldAction(ctx)
+	case auditevent.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case auditevent.FieldTargetIds:
+		return m.OldTargetIds(ctx)
+	case auditevent.FieldDiff:
+		return m.OldDiff(ctx)
+	case auditevent.FieldDetails:
+		return m.OldDetails(ctx)
+	case auditevent.FieldIP:
+		return m.OldIP(ctx)
+	case auditevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImportEntryMutation) SetField(name string, value ent.Value) error {
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case importentry.FieldTitle:
+	case auditevent.FieldActorKind:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetActorKind(v)
 		return nil
-	case importentry.FieldStatus:
-		v, ok := value.(int)
+	case auditevent.FieldActorID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetActorID(v)
 		return nil
-	case importentry.FieldAction:
-		v, ok := value.(int)
+	case auditevent.FieldActorName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorName(v)
+		return nil
+	case auditevent.FieldAction:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAction(v)
 		return nil
-	case importentry.FieldInfo:
-		v, ok := value.(*types.KaizokuInfo)
+	case auditevent.FieldTargetType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInfo(v)
+		m.SetTargetType(v)
 		return nil
-	case importentry.FieldSeries:
-		v, ok := value.([]map[string]interface{})
+	case auditevent.FieldTargetIds:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSeries(v)
+		m.SetTargetIds(v)
 		return nil
-	case importentry.FieldContinueAfterChapter:
-		v, ok := value.(float64)
+	case auditevent.FieldDiff:
+		v, ok := value.(map[string]types.AuditChange)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContinueAfterChapter(v)
+		m.SetDiff(v)
+		return nil
+	case auditevent.FieldDetails:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	case auditevent.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case auditevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ImportEntry field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ImportEntryMutation) AddedFields() []string {
-	var fields []string
-	if m.addstatus != nil {
-		fields = append(fields, importentry.FieldStatus)
-	}
-	if m.addaction != nil {
-		fields = append(fields, importentry.FieldAction)
-	}
-	if m.addcontinue_after_chapter != nil {
-		fields = append(fields, importentry.FieldContinueAfterChapter)
-	}
-	return fields
+func (m *AuditEventMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ImportEntryMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case importentry.FieldStatus:
-		return m.AddedStatus()
-	case importentry.FieldAction:
-		return m.AddedAction()
-	case importentry.FieldContinueAfterChapter:
-		return m.AddedContinueAfterChapter()
-	}
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImportEntryMutation) AddField(name string, value ent.Value) error {
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case importentry.FieldStatus:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatus(v)
-		return nil
-	case importentry.FieldAction:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAction(v)
-		return nil
-	case importentry.FieldContinueAfterChapter:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddContinueAfterChapter(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ImportEntry numeric field %s", name)
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ImportEntryMutation) ClearedFields() []string {
+func (m *AuditEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(importentry.FieldInfo) {
-		fields = append(fields, importentry.FieldInfo)
+	if m.FieldCleared(auditevent.FieldActorID) {
+		fields = append(fields, auditevent.FieldActorID)
 	}
-	if m.FieldCleared(importentry.FieldSeries) {
-		fields = append(fields, importentry.FieldSeries)
+	if m.FieldCleared(auditevent.FieldTargetIds) {
+		fields = append(fields, auditevent.FieldTargetIds)
 	}
-	if m.FieldCleared(importentry.FieldContinueAfterChapter) {
-		fields = append(fields, importentry.FieldContinueAfterChapter)
+	if m.FieldCleared(auditevent.FieldDiff) {
+		fields = append(fields, auditevent.FieldDiff)
+	}
+	if m.FieldCleared(auditevent.FieldDetails) {
+		fields = append(fields, auditevent.FieldDetails)
+	}
+	if m.FieldCleared(auditevent.FieldIP) {
+		fields = append(fields, auditevent.FieldIP)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ImportEntryMutation) FieldCleared(name string) bool {
+func (m *AuditEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ImportEntryMutation) ClearField(name string) error {
+func (m *AuditEventMutation) ClearField(name string) error {
 	switch name {
-	case importentry.FieldInfo:
-		m.ClearInfo()
+	case auditevent.FieldActorID:
+		m.ClearActorID()
 		return nil
-	case importentry.FieldSeries:
-		m.ClearSeries()
+	case auditevent.FieldTargetIds:
+		m.ClearTargetIds()
 		return nil
-	case importentry.FieldContinueAfterChapter:
-		m.ClearContinueAfterChapter()
+	case auditevent.FieldDiff:
+		m.ClearDiff()
+		return nil
+	case auditevent.FieldDetails:
+		m.ClearDetails()
+		return nil
+	case auditevent.FieldIP:
+		m.ClearIP()
 		return nil
 	}
-	return fmt.Errorf("unknown ImportEntry nullable field %s", name)
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ImportEntryMutation) ResetField(name string) error {
+func (m *AuditEventMutation) ResetField(name string) error {
 	switch name {
-	case importentry.FieldTitle:
-		m.ResetTitle()
+	case auditevent.FieldActorKind:
+		m.ResetActorKind()
 		return nil
-	case importentry.FieldStatus:
-		m.ResetStatus()
+	case auditevent.FieldActorID:
+		m.ResetActorID()
 		return nil
-	case importentry.FieldAction:
+	case auditevent.FieldActorName:
+		m.ResetActorName()
+		return nil
+	case auditevent.FieldAction:
 		m.ResetAction()
 		return nil
-	case importentry.FieldInfo:
-		m.ResetInfo()
+	case auditevent.FieldTargetType:
+		m.ResetTargetType()
 		return nil
-	case importentry.FieldSeries:
-		m.ResetSeries()
+	case auditevent.FieldTargetIds:
+		m.ResetTargetIds()
 		return nil
-	case importentry.FieldContinueAfterChapter:
-		m.ResetContinueAfterChapter()
+	case auditevent.FieldDiff:
+		m.ResetDiff()
+		return nil
+	case auditevent.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case auditevent.FieldIP:
+		m.ResetIP()
+		return nil
+	case auditevent.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ImportEntry field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ImportEntryMutation) AddedEdges() []string {
+func (m *AuditEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ImportEntryMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ImportEntryMutation) RemovedEdges() []string {
+func (m *AuditEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ImportEntryMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ImportEntryMutation) ClearedEdges() []string {
+func (m *AuditEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ImportEntryMutation) EdgeCleared(name string) bool {
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ImportEntryMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ImportEntry unique edge %s", name)
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ImportEntryMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ImportEntry edge %s", name)
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
 }
 
-// LatestSeriesMutation represents an operation that mutates the LatestSeries nodes in the graph.
-type LatestSeriesMutation struct {
+// DownloadQueueItemMutation represents an operation that mutates the DownloadQueueItem nodes in the graph.
+type DownloadQueueItemMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	suwayomi_source_id   *string
-	provider             *string
-	language             *string
-	url                  *string
-	title                *string
-	thumbnail_url        *string
-	artist               *string
-	author               *string
-	description          *string
-	genre                *[]string
-	appendgenre          []string
-	fetch_date           *time.Time
-	chapter_count        *int64
-	addchapter_count     *int64
-	latest_chapter       *float64
-	addlatest_chapter    *float64
-	latest_chapter_title *string
-	status               *string
-	in_library           *int
-	addin_library        *int
-	chapters             *[]types.SuwayomiChapter
-	appendchapters       []types.SuwayomiChapter
-	clearedFields        map[string]struct{}
-	series               *uuid.UUID
-	clearedseries        bool
-	done                 bool
-	oldValue             func(context.Context) (*LatestSeries, error)
-	predicates           []predicate.LatestSeries
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	group_key        *string
+	status           *int
+	addstatus        *int
+	priority         *int
+	addpriority      *int
+	scheduled_at     *time.Time
+	created_at       *time.Time
+	started_at       *time.Time
+	completed_at     *time.Time
+	args             *types.DownloadChapterArgs
+	worker_id        *string
+	lease_expires_at *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*DownloadQueueItem, error)
+	predicates       []predicate.DownloadQueueItem
 }
 
-var _ ent.Mutation = (*LatestSeriesMutation)(nil)
+var _ ent.Mutation = (*DownloadQueueItemMutation)(nil)
 
-// latestseriesOption allows management of the mutation configuration using functional options.
-type latestseriesOption func(*LatestSeriesMutation)
+// downloadqueueitemOption allows management of the mutation configuration using functional options.
+type downloadqueueitemOption func(*DownloadQueueItemMutation)
 
-// newLatestSeriesMutation creates new mutation for the LatestSeries entity.
-func newLatestSeriesMutation(c config, op Op, opts ...latestseriesOption) *LatestSeriesMutation {
-	m := &LatestSeriesMutation{
+// newDownloadQueueItemMutation creates new mutation for the DownloadQueueItem entity.
+func newDownloadQueueItemMutation(c config, op Op, opts ...downloadqueueitemOption) *DownloadQueueItemMutation {
+	m := &DownloadQueueItemMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLatestSeries,
+		typ:           TypeDownloadQueueItem,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2088,20 +1759,20 @@ func newLatestSeriesMutation(c config, op Op, opts ...latestseriesOption) *Lates
 	return m
 }
 
-// withLatestSeriesID sets the ID field of the mutation.
-func withLatestSeriesID(id int) latestseriesOption {
-	return func(m *LatestSeriesMutation) {
+// withDownloadQueueItemID sets the ID field of the mutation.
+func withDownloadQueueItemID(id uuid.UUID) downloadqueueitemOption {
+	return func(m *DownloadQueueItemMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *LatestSeries
+			value *DownloadQueueItem
 		)
-		m.oldValue = func(ctx context.Context) (*LatestSeries, error) {
+		m.oldValue = func(ctx context.Context) (*DownloadQueueItem, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().LatestSeries.Get(ctx, id)
+					value, err = m.Client().DownloadQueueItem.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2110,10 +1781,10 @@ func withLatestSeriesID(id int) latestseriesOption {
 	}
 }
 
-// withLatestSeries sets the old LatestSeries of the mutation.
-func withLatestSeries(node *LatestSeries) latestseriesOption {
-	return func(m *LatestSeriesMutation) {
-		m.oldValue = func(context.Context) (*LatestSeries, error) {
+// withDownloadQueueItem sets the old DownloadQueueItem of the mutation.
+func withDownloadQueueItem(node *DownloadQueueItem) downloadqueueitemOption {
+	return func(m *DownloadQueueItemMutation) {
+		m.oldValue = func(context.Context) (*DownloadQueueItem, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2122,7 +1793,7 @@ func withLatestSeries(node *LatestSeries) latestseriesOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LatestSeriesMutation) Client() *Client {
+func (m DownloadQueueItemMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2130,7 +1801,7 @@ func (m LatestSeriesMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LatestSeriesMutation) Tx() (*Tx, error) {
+func (m DownloadQueueItemMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2140,14 +1811,14 @@ func (m LatestSeriesMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of LatestSeries entities.
-func (m *LatestSeriesMutation) SetID(id int) {
+// operation is only accepted on creation of DownloadQueueItem entities.
+func (m *DownloadQueueItemMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LatestSeriesMutation) ID() (id int, exists bool) {
+func (m *DownloadQueueItemMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2158,836 +1829,10790 @@ func (m *LatestSeriesMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LatestSeriesMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DownloadQueueItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LatestSeries.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DownloadQueueItem.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSuwayomiSourceID sets the "suwayomi_source_id" field.
-func (m *LatestSeriesMutation) SetSuwayomiSourceID(s string) {
-	m.suwayomi_source_id = &s
+// SetGroupKey sets the "group_key" field.
+func (m *DownloadQueueItemMutation) SetGroupKey(s string) {
+	m.group_key = &s
 }
 
-// SuwayomiSourceID returns the value of the "suwayomi_source_id" field in the mutation.
-func (m *LatestSeriesMutation) SuwayomiSourceID() (r string, exists bool) {
-	v := m.suwayomi_source_id
+// GroupKey returns the value of the "group_key" field in the mutation.
+func (m *DownloadQueueItemMutation) GroupKey() (r string, exists bool) {
+	v := m.group_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuwayomiSourceID returns the old "suwayomi_source_id" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldGroupKey returns the old "group_key" field's value of the DownloadQueueItem entity.
+// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldSuwayomiSourceID(ctx context.Context) (v string, err error) {
+func (m *DownloadQueueItemMutation) OldGroupKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuwayomiSourceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGroupKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuwayomiSourceID requires an ID field in the mutation")
+		return v, errors.New("OldGroupKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuwayomiSourceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGroupKey: %w", err)
 	}
-	return oldValue.SuwayomiSourceID, nil
+	return oldValue.GroupKey, nil
 }
 
-// ResetSuwayomiSourceID resets all changes to the "suwayomi_source_id" field.
-func (m *LatestSeriesMutation) ResetSuwayomiSourceID() {
-	m.suwayomi_source_id = nil
+// ResetGroupKey resets all changes to the "group_key" field.
+func (m *DownloadQueueItemMutation) ResetGroupKey() {
+	m.group_key = nil
 }
 
-// SetProvider sets the "provider" field.
-func (m *LatestSeriesMutation) SetProvider(s string) {
-	m.provider = &s
+// SetStatus sets the "status" field.
+func (m *DownloadQueueItemMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
 }
 
-// Provider returns the value of the "provider" field in the mutation.
-func (m *LatestSeriesMutation) Provider() (r string, exists bool) {
-	v := m.provider
+// Status returns the value of the "status" field in the mutation.
+func (m *DownloadQueueItemMutation) Status() (r int, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the DownloadQueueItem entity.
+// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldProvider(ctx context.Context) (v string, err error) {
+func (m *DownloadQueueItemMutation) OldStatus(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Provider, nil
+	return oldValue.Status, nil
 }
 
-// ResetProvider resets all changes to the "provider" field.
-func (m *LatestSeriesMutation) ResetProvider() {
-	m.provider = nil
+// AddStatus adds i to the "status" field.
+func (m *DownloadQueueItemMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
 }
 
-// SetLanguage sets the "language" field.
-func (m *LatestSeriesMutation) SetLanguage(s string) {
-	m.language = &s
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *DownloadQueueItemMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Language returns the value of the "language" field in the mutation.
-func (m *LatestSeriesMutation) Language() (r string, exists bool) {
-	v := m.language
+// ResetStatus resets all changes to the "status" field.
+func (m *DownloadQueueItemMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *DownloadQueueItemMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *DownloadQueueItemMutation) Priority() (r int, exists bool) {
+	v := m.priority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLanguage returns the old "language" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldPriority returns the old "priority" field's value of the DownloadQueueItem entity.
+// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldLanguage(ctx context.Context) (v string, err error) {
+func (m *DownloadQueueItemMutation) OldPriority(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLanguage requires an ID field in the mutation")
+		return v, errors.New("OldPriority requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
 	}
-	return oldValue.Language, nil
-}
-
-// ResetLanguage resets all changes to the "language" field.
-func (m *LatestSeriesMutation) ResetLanguage() {
-	m.language = nil
+	return oldValue.Priority, nil
 }
 
-// SetURL sets the "url" field.
-func (m *LatestSeriesMutation) SetURL(s string) {
-	m.url = &s
+// AddPriority adds i to the "priority" field.
+func (m *DownloadQueueItemMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *LatestSeriesMutation) URL() (r string, exists bool) {
-	v := m.url
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *DownloadQueueItemMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// ResetPriority resets all changes to the "priority" field.
+func (m *DownloadQueueItemMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (m *DownloadQueueItemMutation) SetScheduledAt(t time.Time) {
+	m.scheduled_at = &t
+}
+
+// ScheduledAt returns the value of the "scheduled_at" field in the mutation.
+func (m *DownloadQueueItemMutation) ScheduledAt() (r time.Time, exists bool) {
+	v := m.scheduled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledAt returns the old "scheduled_at" field's value of the DownloadQueueItem entity.
+// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldURL(ctx context.Context) (v *string, err error) {
+func (m *DownloadQueueItemMutation) OldScheduledAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduledAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldScheduledAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduledAt: %w", err)
 	}
-	return oldValue.URL, nil
-}
-
-// ClearURL clears the value of the "url" field.
-func (m *LatestSeriesMutation) ClearURL() {
-	m.url = nil
-	m.clearedFields[latestseries.FieldURL] = struct{}{}
-}
-
-// URLCleared returns if the "url" field was cleared in this mutation.
-func (m *LatestSeriesMutation) URLCleared() bool {
-	_, ok := m.clearedFields[latestseries.FieldURL]
-	return ok
+	return oldValue.ScheduledAt, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *LatestSeriesMutation) ResetURL() {
-	m.url = nil
-	delete(m.clearedFields, latestseries.FieldURL)
+// ResetScheduledAt resets all changes to the "scheduled_at" field.
+func (m *DownloadQueueItemMutation) ResetScheduledAt() {
+	m.scheduled_at = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *LatestSeriesMutation) SetTitle(s string) {
-	m.title = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *DownloadQueueItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *LatestSeriesMutation) Title() (r string, exists bool) {
-	v := m.title
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DownloadQueueItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DownloadQueueItem entity.
+// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *DownloadQueueItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *LatestSeriesMutation) ResetTitle() {
-	m.title = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DownloadQueueItemMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetThumbnailURL sets the "thumbnail_url" field.
-func (m *LatestSeriesMutation) SetThumbnailURL(s string) {
-	m.thumbnail_url = &s
+// SetStartedAt sets the "started_at" field.
+func (m *DownloadQueueItemMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
-func (m *LatestSeriesMutation) ThumbnailURL() (r string, exists bool) {
-	v := m.thumbnail_url
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *DownloadQueueItemMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnailURL returns the old "thumbnail_url" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the DownloadQueueItem entity.
+// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldThumbnailURL(ctx context.Context) (v *string, err error) {
+func (m *DownloadQueueItemMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.ThumbnailURL, nil
+	return oldValue.StartedAt, nil
 }
 
-// ClearThumbnailURL clears the value of the "thumbnail_url" field.
-func (m *LatestSeriesMutation) ClearThumbnailURL() {
-	m.thumbnail_url = nil
-	m.clearedFields[latestseries.FieldThumbnailURL] = struct{}{}
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *DownloadQueueItemMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[downloadqueueitem.FieldStartedAt] = struct{}{}
 }
 
-// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
-func (m *LatestSeriesMutation) ThumbnailURLCleared() bool {
-	_, ok := m.clearedFields[latestseries.FieldThumbnailURL]
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *DownloadQueueItemMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[downloadqueueitem.FieldStartedAt]
 	return ok
 }
 
-// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
-func (m *LatestSeriesMutation) ResetThumbnailURL() {
-	m.thumbnail_url = nil
-	delete(m.clearedFields, latestseries.FieldThumbnailURL)
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *DownloadQueueItemMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, downloadqueueitem.FieldStartedAt)
 }
 
-// SetArtist sets the "artist" field.
-func (m *LatestSeriesMutation) SetArtist(s string) {
-	m.artist = &s
+// SetCompletedAt sets the "completed_at" field.
+func (m *DownloadQueueItemMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
 }
 
-// Artist returns the value of the "artist" field in the mutation.
-func (m *LatestSeriesMutation) Artist() (r string, exists bool) {
-	v := m.artist
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *DownloadQueueItemMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldArtist returns the old "artist" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletedAt returns the old "completed_at" field's value of the DownloadQueueItem entity.
+// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldArtist(ctx context.Context) (v *string, err error) {
+func (m *DownloadQueueItemMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldArtist is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldArtist requires an ID field in the mutation")
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldArtist: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
 	}
-	return oldValue.Artist, nil
+	return oldValue.CompletedAt, nil
 }
 
-// ClearArtist clears the value of the "artist" field.
-func (m *LatestSeriesMutation) ClearArtist() {
-	m.artist = nil
-	m.clearedFields[latestseries.FieldArtist] = struct{}{}
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *DownloadQueueItemMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[downloadqueueitem.FieldCompletedAt] = struct{}{}
 }
 
-// ArtistCleared returns if the "artist" field was cleared in this mutation.
-func (m *LatestSeriesMutation) ArtistCleared() bool {
-	_, ok := m.clearedFields[latestseries.FieldArtist]
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *DownloadQueueItemMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[downloadqueueitem.FieldCompletedAt]
 	return ok
 }
 
-// ResetArtist resets all changes to the "artist" field.
-func (m *LatestSeriesMutation) ResetArtist() {
-	m.artist = nil
-	delete(m.clearedFields, latestseries.FieldArtist)
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *DownloadQueueItemMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, downloadqueueitem.FieldCompletedAt)
 }
 
-// SetAuthor sets the "author" field.
-func (m *LatestSeriesMutation) SetAuthor(s string) {
-	m.author = &s
+// SetArgs sets the "args" field.
+func (m *DownloadQueueItemMutation) SetArgs(tca types.DownloadChapterArgs) {
+	m.args = &tca
 }
 
-// Author returns the value of the "author" field in the mutation.
-func (m *LatestSeriesMutation) Author() (r string, exists bool) {
-	v := m.author
+// Args returns the value of the "args" field in the mutation.
+func (m *DownloadQueueItemMutation) Args() (r types.DownloadChapterArgs, exists bool) {
+	v := m.args
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthor returns the old "author" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldArgs returns the old "args" field's value of the DownloadQueueItem entity.
+// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldAuthor(ctx context.Context) (v *string, err error) {
+func (m *DownloadQueueItemMutation) OldArgs(ctx context.Context) (v types.DownloadChapterArgs, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+		return v, errors.New("OldArgs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthor requires an ID field in the mutation")
+		return v, errors.New("OldArgs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+		return v, fmt.Errorf("querying old value for OldArgs: %w", err)
 	}
-	return oldValue.Author, nil
-}
-
-// ClearAuthor clears the value of the "author" field.
-func (m *LatestSeriesMutation) ClearAuthor() {
-	m.author = nil
-	m.clearedFields[latestseries.FieldAuthor] = struct{}{}
-}
-
-// AuthorCleared returns if the "author" field was cleared in this mutation.
-func (m *LatestSeriesMutation) AuthorCleared() bool {
-	_, ok := m.clearedFields[latestseries.FieldAuthor]
-	return ok
+	return oldValue.Args, nil
 }
 
-// ResetAuthor resets all changes to the "author" field.
-func (m *LatestSeriesMutation) ResetAuthor() {
-	m.author = nil
-	delete(m.clearedFields, latestseries.FieldAuthor)
+// ResetArgs resets all changes to the "args" field.
+func (m *DownloadQueueItemMutation) ResetArgs() {
+	m.args = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *LatestSeriesMutation) SetDescription(s string) {
-	m.description = &s
+// SetWorkerID sets the "worker_id" field.
+func (m *DownloadQueueItemMutation) SetWorkerID(s string) {
+	m.worker_id = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *LatestSeriesMutation) Description() (r string, exists bool) {
-	v := m.description
+// WorkerID returns the value of the "worker_id" field in the mutation.
+func (m *DownloadQueueItemMutation) WorkerID() (r string, exists bool) {
+	v := m.worker_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldWorkerID returns the old "worker_id" field's value of the DownloadQueueItem entity.
+// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *DownloadQueueItemMutation) OldWorkerID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldWorkerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldWorkerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldWorkerID: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.WorkerID, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *LatestSeriesMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[latestseries.FieldDescription] = struct{}{}
+// ClearWorkerID clears the value of the "worker_id" field.
+func (m *DownloadQueueItemMutation) ClearWorkerID() {
+	m.worker_id = nil
+	m.clearedFields[downloadqueueitem.FieldWorkerID] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *LatestSeriesMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[latestseries.FieldDescription]
+// WorkerIDCleared returns if the "worker_id" field was cleared in this mutation.
+func (m *DownloadQueueItemMutation) WorkerIDCleared() bool {
+	_, ok := m.clearedFields[downloadqueueitem.FieldWorkerID]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *LatestSeriesMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, latestseries.FieldDescription)
+// ResetWorkerID resets all changes to the "worker_id" field.
+func (m *DownloadQueueItemMutation) ResetWorkerID() {
+	m.worker_id = nil
+	delete(m.clearedFields, downloadqueueitem.FieldWorkerID)
 }
 
-// SetGenre sets the "genre" field.
-func (m *LatestSeriesMutation) SetGenre(s []string) {
-	m.genre = &s
-	m.appendgenre = nil
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (m *DownloadQueueItemMutation) SetLeaseExpiresAt(t time.Time) {
+	m.lease_expires_at = &t
 }
 
-// Genre returns the value of the "genre" field in the mutation.
-func (m *LatestSeriesMutation) Genre() (r []string, exists bool) {
-	v := m.genre
+// LeaseExpiresAt returns the value of the "lease_expires_at" field in the mutation.
+func (m *DownloadQueueItemMutation) LeaseExpiresAt() (r time.Time, exists bool) {
+	v := m.lease_expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGenre returns the old "genre" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldLeaseExpiresAt returns the old "lease_expires_at" field's value of the DownloadQueueItem entity.
+// If the DownloadQueueItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldGenre(ctx context.Context) (v []string, err error) {
+func (m *DownloadQueueItemMutation) OldLeaseExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+		return v, errors.New("OldLeaseExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGenre requires an ID field in the mutation")
+		return v, errors.New("OldLeaseExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
-	}
-	return oldValue.Genre, nil
-}
-
-// AppendGenre adds s to the "genre" field.
-func (m *LatestSeriesMutation) AppendGenre(s []string) {
-	m.appendgenre = append(m.appendgenre, s...)
-}
-
-// AppendedGenre returns the list of values that were appended to the "genre" field in this mutation.
-func (m *LatestSeriesMutation) AppendedGenre() ([]string, bool) {
-	if len(m.appendgenre) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldLeaseExpiresAt: %w", err)
 	}
-	return m.appendgenre, true
+	return oldValue.LeaseExpiresAt, nil
 }
 
-// ClearGenre clears the value of the "genre" field.
-func (m *LatestSeriesMutation) ClearGenre() {
-	m.genre = nil
-	m.appendgenre = nil
-	m.clearedFields[latestseries.FieldGenre] = struct{}{}
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (m *DownloadQueueItemMutation) ClearLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	m.clearedFields[downloadqueueitem.FieldLeaseExpiresAt] = struct{}{}
 }
 
-// GenreCleared returns if the "genre" field was cleared in this mutation.
-func (m *LatestSeriesMutation) GenreCleared() bool {
-	_, ok := m.clearedFields[latestseries.FieldGenre]
+// LeaseExpiresAtCleared returns if the "lease_expires_at" field was cleared in this mutation.
+func (m *DownloadQueueItemMutation) LeaseExpiresAtCleared() bool {
+	_, ok := m.clearedFields[downloadqueueitem.FieldLeaseExpiresAt]
 	return ok
 }
 
-// ResetGenre resets all changes to the "genre" field.
-func (m *LatestSeriesMutation) ResetGenre() {
-	m.genre = nil
-	m.appendgenre = nil
-	delete(m.clearedFields, latestseries.FieldGenre)
-}
-
-// SetFetchDate sets the "fetch_date" field.
-func (m *LatestSeriesMutation) SetFetchDate(t time.Time) {
-	m.fetch_date = &t
+// ResetLeaseExpiresAt resets all changes to the "lease_expires_at" field.
+func (m *DownloadQueueItemMutation) ResetLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	delete(m.clearedFields, downloadqueueitem.FieldLeaseExpiresAt)
 }
 
-// FetchDate returns the value of the "fetch_date" field in the mutation.
-func (m *LatestSeriesMutation) FetchDate() (r time.Time, exists bool) {
-	v := m.fetch_date
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the DownloadQueueItemMutation builder.
+func (m *DownloadQueueItemMutation) Where(ps ...predicate.DownloadQueueItem) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldFetchDate returns the old "fetch_date" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldFetchDate(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFetchDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFetchDate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFetchDate: %w", err)
+// WhereP appends storage-level predicates to the DownloadQueueItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DownloadQueueItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DownloadQueueItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.FetchDate, nil
+	m.Where(p...)
 }
 
-// ResetFetchDate resets all changes to the "fetch_date" field.
-func (m *LatestSeriesMutation) ResetFetchDate() {
-	m.fetch_date = nil
+// Op returns the operation name.
+func (m *DownloadQueueItemMutation) Op() Op {
+	return m.op
 }
 
-// SetChapterCount sets the "chapter_count" field.
-func (m *LatestSeriesMutation) SetChapterCount(i int64) {
-	m.chapter_count = &i
-	m.addchapter_count = nil
+// SetOp allows setting the mutation operation.
+func (m *DownloadQueueItemMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ChapterCount returns the value of the "chapter_count" field in the mutation.
-func (m *LatestSeriesMutation) ChapterCount() (r int64, exists bool) {
-	v := m.chapter_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (DownloadQueueItem).
+func (m *DownloadQueueItemMutation) Type() string {
+	return m.typ
 }
 
-// OldChapterCount returns the old "chapter_count" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldChapterCount(ctx context.Context) (v *int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChapterCount is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DownloadQueueItemMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.group_key != nil {
+		fields = append(fields, downloadqueueitem.FieldGroupKey)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChapterCount requires an ID field in the mutation")
+	if m.status != nil {
+		fields = append(fields, downloadqueueitem.FieldStatus)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChapterCount: %w", err)
+	if m.priority != nil {
+		fields = append(fields, downloadqueueitem.FieldPriority)
 	}
-	return oldValue.ChapterCount, nil
-}
-
-// AddChapterCount adds i to the "chapter_count" field.
-func (m *LatestSeriesMutation) AddChapterCount(i int64) {
-	if m.addchapter_count != nil {
-		*m.addchapter_count += i
-	} else {
-		m.addchapter_count = &i
+	if m.scheduled_at != nil {
+		fields = append(fields, downloadqueueitem.FieldScheduledAt)
 	}
-}
-
-// AddedChapterCount returns the value that was added to the "chapter_count" field in this mutation.
-func (m *LatestSeriesMutation) AddedChapterCount() (r int64, exists bool) {
-	v := m.addchapter_count
-	if v == nil {
-		return
+	if m.created_at != nil {
+		fields = append(fields, downloadqueueitem.FieldCreatedAt)
 	}
-	return *v, true
-}
-
-// ClearChapterCount clears the value of the "chapter_count" field.
-func (m *LatestSeriesMutation) ClearChapterCount() {
-	m.chapter_count = nil
-	m.addchapter_count = nil
-	m.clearedFields[latestseries.FieldChapterCount] = struct{}{}
-}
-
-// ChapterCountCleared returns if the "chapter_count" field was cleared in this mutation.
-func (m *LatestSeriesMutation) ChapterCountCleared() bool {
-	_, ok := m.clearedFields[latestseries.FieldChapterCount]
-	return ok
-}
-
-// ResetChapterCount resets all changes to the "chapter_count" field.
-func (m *LatestSeriesMutation) ResetChapterCount() {
-	m.chapter_count = nil
-	m.addchapter_count = nil
-	delete(m.clearedFields, latestseries.FieldChapterCount)
-}
-
-// SetLatestChapter sets the "latest_chapter" field.
-func (m *LatestSeriesMutation) SetLatestChapter(f float64) {
-	m.latest_chapter = &f
-	m.addlatest_chapter = nil
-}
-
-// LatestChapter returns the value of the "latest_chapter" field in the mutation.
-func (m *LatestSeriesMutation) LatestChapter() (r float64, exists bool) {
-	v := m.latest_chapter
-	if v == nil {
-		return
+	if m.started_at != nil {
+		fields = append(fields, downloadqueueitem.FieldStartedAt)
 	}
-	return *v, true
-}
-
-// OldLatestChapter returns the old "latest_chapter" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldLatestChapter(ctx context.Context) (v *float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLatestChapter is only allowed on UpdateOne operations")
+	if m.completed_at != nil {
+		fields = append(fields, downloadqueueitem.FieldCompletedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLatestChapter requires an ID field in the mutation")
+	if m.args != nil {
+		fields = append(fields, downloadqueueitem.FieldArgs)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLatestChapter: %w", err)
+	if m.worker_id != nil {
+		fields = append(fields, downloadqueueitem.FieldWorkerID)
 	}
-	return oldValue.LatestChapter, nil
-}
-
-// AddLatestChapter adds f to the "latest_chapter" field.
-func (m *LatestSeriesMutation) AddLatestChapter(f float64) {
-	if m.addlatest_chapter != nil {
-		*m.addlatest_chapter += f
-	} else {
-		m.addlatest_chapter = &f
+	if m.lease_expires_at != nil {
+		fields = append(fields, downloadqueueitem.FieldLeaseExpiresAt)
 	}
+	return fields
 }
 
-// AddedLatestChapter returns the value that was added to the "latest_chapter" field in this mutation.
-func (m *LatestSeriesMutation) AddedLatestChapter() (r float64, exists bool) {
-	v := m.addlatest_chapter
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DownloadQueueItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case downloadqueueitem.FieldGroupKey:
+		return m.GroupKey()
+	case downloadqueueitem.FieldStatus:
+		return m.Status()
+	case downloadqueueitem.FieldPriority:
+		return m.Priority()
+	case downloadqueueitem.FieldScheduledAt:
+		return m.ScheduledAt()
+	case downloadqueueitem.FieldCreatedAt:
+		return m.CreatedAt()
+	case downloadqueueitem.FieldStartedAt:
+		return m.StartedAt()
+	case downloadqueueitem.FieldCompletedAt:
+		return m.CompletedAt()
+	case downloadqueueitem.FieldArgs:
+		return m.Args()
+	case downloadqueueitem.FieldWorkerID:
+		return m.WorkerID()
+	case downloadqueueitem.FieldLeaseExpiresAt:
+		return m.LeaseExpiresAt()
 	}
-	return *v, true
-}
-
-// ClearLatestChapter clears the value of the "latest_chapter" field.
-func (m *LatestSeriesMutation) ClearLatestChapter() {
-	m.latest_chapter = nil
-	m.addlatest_chapter = nil
-	m.clearedFields[latestseries.FieldLatestChapter] = struct{}{}
-}
-
-// LatestChapterCleared returns if the "latest_chapter" field was cleared in this mutation.
-func (m *LatestSeriesMutation) LatestChapterCleared() bool {
-	_, ok := m.clearedFields[latestseries.FieldLatestChapter]
-	return ok
+	return nil, false
 }
 
-// ResetLatestChapter resets all changes to the "latest_chapter" field.
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DownloadQueueItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case downloadqueueitem.FieldGroupKey:
+		return m.OldGroupKey(ctx)
+	case downloadqueueitem.FieldStatus:
+		return m.OldStatus(ctx)
+	case downloadqueueitem.FieldPriority:
+		return m.OldPriority(ctx)
+	case downloadqueueitem.FieldScheduledAt:
+		return m.OldScheduledAt(ctx)
+	case downloadqueueitem.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case downloadqueueitem.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case downloadqueueitem.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case downloadqueueitem.FieldArgs:
+		return m.OldArgs(ctx)
+	case downloadqueueitem.FieldWorkerID:
+		return m.OldWorkerID(ctx)
+	case downloadqueueitem.FieldLeaseExpiresAt:
+		return m.OldLeaseExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DownloadQueueItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DownloadQueueItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case downloadqueueitem.FieldGroupKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupKey(v)
+		return nil
+	case downloadqueueitem.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case downloadqueueitem.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case downloadqueueitem.FieldScheduledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledAt(v)
+		return nil
+	case downloadqueueitem.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case downloadqueueitem.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case downloadqueueitem.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case downloadqueueitem.FieldArgs:
+		v, ok := value.(types.DownloadChapterArgs)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArgs(v)
+		return nil
+	case downloadqueueitem.FieldWorkerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkerID(v)
+		return nil
+	case downloadqueueitem.FieldLeaseExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DownloadQueueItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DownloadQueueItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, downloadqueueitem.FieldStatus)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, downloadqueueitem.FieldPriority)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DownloadQueueItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case downloadqueueitem.FieldStatus:
+		return m.AddedStatus()
+	case downloadqueueitem.FieldPriority:
+		return m.AddedPriority()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DownloadQueueItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case downloadqueueitem.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case downloadqueueitem.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DownloadQueueItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DownloadQueueItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(downloadqueueitem.FieldStartedAt) {
+		fields = append(fields, downloadqueueitem.FieldStartedAt)
+	}
+	if m.FieldCleared(downloadqueueitem.FieldCompletedAt) {
+		fields = append(fields, downloadqueueitem.FieldCompletedAt)
+	}
+	if m.FieldCleared(downloadqueueitem.FieldWorkerID) {
+		fields = append(fields, downloadqueueitem.FieldWorkerID)
+	}
+	if m.FieldCleared(downloadqueueitem.FieldLeaseExpiresAt) {
+		fields = append(fields, downloadqueueitem.FieldLeaseExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DownloadQueueItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DownloadQueueItemMutation) ClearField(name string) error {
+	switch name {
+	case downloadqueueitem.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case downloadqueueitem.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case downloadqueueitem.FieldWorkerID:
+		m.ClearWorkerID()
+		return nil
+	case downloadqueueitem.FieldLeaseExpiresAt:
+		m.ClearLeaseExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DownloadQueueItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DownloadQueueItemMutation) ResetField(name string) error {
+	switch name {
+	case downloadqueueitem.FieldGroupKey:
+		m.ResetGroupKey()
+		return nil
+	case downloadqueueitem.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case downloadqueueitem.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case downloadqueueitem.FieldScheduledAt:
+		m.ResetScheduledAt()
+		return nil
+	case downloadqueueitem.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case downloadqueueitem.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case downloadqueueitem.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case downloadqueueitem.FieldArgs:
+		m.ResetArgs()
+		return nil
+	case downloadqueueitem.FieldWorkerID:
+		m.ResetWorkerID()
+		return nil
+	case downloadqueueitem.FieldLeaseExpiresAt:
+		m.ResetLeaseExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DownloadQueueItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DownloadQueueItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DownloadQueueItemMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DownloadQueueItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DownloadQueueItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DownloadQueueItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DownloadQueueItemMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DownloadQueueItemMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DownloadQueueItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DownloadQueueItemMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DownloadQueueItem edge %s", name)
+}
+
+// EtagCacheMutation represents an operation that mutates the EtagCache nodes in the graph.
+type EtagCacheMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	etag          *string
+	last_updated  *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EtagCache, error)
+	predicates    []predicate.EtagCache
+}
+
+var _ ent.Mutation = (*EtagCacheMutation)(nil)
+
+// etagcacheOption allows management of the mutation configuration using functional options.
+type etagcacheOption func(*EtagCacheMutation)
+
+// newEtagCacheMutation creates new mutation for the EtagCache entity.
+func newEtagCacheMutation(c config, op Op, opts ...etagcacheOption) *EtagCacheMutation {
+	m := &EtagCacheMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEtagCache,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEtagCacheID sets the ID field of the mutation.
+func withEtagCacheID(id string) etagcacheOption {
+	return func(m *EtagCacheMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EtagCache
+		)
+		m.oldValue = func(ctx context.Context) (*EtagCache, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EtagCache.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEtagCache sets the old EtagCache of the mutation.
+func withEtagCache(node *EtagCache) etagcacheOption {
+	return func(m *EtagCacheMutation) {
+		m.oldValue = func(context.Context) (*EtagCache, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EtagCacheMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EtagCacheMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EtagCache entities.
+func (m *EtagCacheMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EtagCacheMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EtagCacheMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EtagCache.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEtag sets the "etag" field.
+func (m *EtagCacheMutation) SetEtag(s string) {
+	m.etag = &s
+}
+
+// Etag returns the value of the "etag" field in the mutation.
+func (m *EtagCacheMutation) Etag() (r string, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEtag returns the old "etag" field's value of the EtagCache entity.
+// If the EtagCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EtagCacheMutation) OldEtag(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEtag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEtag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEtag: %w", err)
+	}
+	return oldValue.Etag, nil
+}
+
+// ResetEtag resets all changes to the "etag" field.
+func (m *EtagCacheMutation) ResetEtag() {
+	m.etag = nil
+}
+
+// SetLastUpdated sets the "last_updated" field.
+func (m *EtagCacheMutation) SetLastUpdated(t time.Time) {
+	m.last_updated = &t
+}
+
+// LastUpdated returns the value of the "last_updated" field in the mutation.
+func (m *EtagCacheMutation) LastUpdated() (r time.Time, exists bool) {
+	v := m.last_updated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUpdated returns the old "last_updated" field's value of the EtagCache entity.
+// If the EtagCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EtagCacheMutation) OldLastUpdated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUpdated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUpdated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUpdated: %w", err)
+	}
+	return oldValue.LastUpdated, nil
+}
+
+// ResetLastUpdated resets all changes to the "last_updated" field.
+func (m *EtagCacheMutation) ResetLastUpdated() {
+	m.last_updated = nil
+}
+
+// Where appends a list predicates to the EtagCacheMutation builder.
+func (m *EtagCacheMutation) Where(ps ...predicate.EtagCache) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EtagCacheMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EtagCacheMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EtagCache, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EtagCacheMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EtagCacheMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EtagCache).
+func (m *EtagCacheMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EtagCacheMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.etag != nil {
+		fields = append(fields, etagcache.FieldEtag)
+	}
+	if m.last_updated != nil {
+		fields = append(fields, etagcache.FieldLastUpdated)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EtagCacheMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case etagcache.FieldEtag:
+		return m.Etag()
+	case etagcache.FieldLastUpdated:
+		return m.LastUpdated()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EtagCacheMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case etagcache.FieldEtag:
+		return m.OldEtag(ctx)
+	case etagcache.FieldLastUpdated:
+		return m.OldLastUpdated(ctx)
+	}
+	return nil, fmt.Errorf("unknown EtagCache field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EtagCacheMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case etagcache.FieldEtag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case etagcache.FieldLastUpdated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUpdated(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EtagCache field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EtagCacheMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EtagCacheMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EtagCacheMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EtagCache numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EtagCacheMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EtagCacheMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EtagCacheMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EtagCache nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EtagCacheMutation) ResetField(name string) error {
+	switch name {
+	case etagcache.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case etagcache.FieldLastUpdated:
+		m.ResetLastUpdated()
+		return nil
+	}
+	return fmt.Errorf("unknown EtagCache field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EtagCacheMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EtagCacheMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EtagCacheMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EtagCacheMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EtagCacheMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EtagCacheMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EtagCacheMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EtagCache unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EtagCacheMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EtagCache edge %s", name)
+}
+
+// HookRunMutation represents an operation that mutates the HookRun nodes in the graph.
+type HookRunMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	hook              *string
+	event             *string
+	series_id         *uuid.UUID
+	series_title      *string
+	chapter_number    *float64
+	addchapter_number *float64
+	attempt           *int
+	addattempt        *int
+	status            *string
+	exit_code         *int
+	addexit_code      *int
+	output            *string
+	error             *string
+	duration_ms       *int64
+	addduration_ms    *int64
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*HookRun, error)
+	predicates        []predicate.HookRun
+}
+
+var _ ent.Mutation = (*HookRunMutation)(nil)
+
+// hookrunOption allows management of the mutation configuration using functional options.
+type hookrunOption func(*HookRunMutation)
+
+// newHookRunMutation creates new mutation for the HookRun entity.
+func newHookRunMutation(c config, op Op, opts ...hookrunOption) *HookRunMutation {
+	m := &HookRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHookRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHookRunID sets the ID field of the mutation.
+func withHookRunID(id uuid.UUID) hookrunOption {
+	return func(m *HookRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HookRun
+		)
+		m.oldValue = func(ctx context.Context) (*HookRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HookRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHookRun sets the old HookRun of the mutation.
+func withHookRun(node *HookRun) hookrunOption {
+	return func(m *HookRunMutation) {
+		m.oldValue = func(context.Context) (*HookRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HookRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HookRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of HookRun entities.
+func (m *HookRunMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HookRunMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HookRunMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HookRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHook sets the "hook" field.
+func (m *HookRunMutation) SetHook(s string) {
+	m.hook = &s
+}
+
+// Hook returns the value of the "hook" field in the mutation.
+func (m *HookRunMutation) Hook() (r string, exists bool) {
+	v := m.hook
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHook returns the old "hook" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldHook(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHook is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHook requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHook: %w", err)
+	}
+	return oldValue.Hook, nil
+}
+
+// ResetHook resets all changes to the "hook" field.
+func (m *HookRunMutation) ResetHook() {
+	m.hook = nil
+}
+
+// SetEvent sets the "event" field.
+func (m *HookRunMutation) SetEvent(s string) {
+	m.event = &s
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *HookRunMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *HookRunMutation) ResetEvent() {
+	m.event = nil
+}
+
+// SetSeriesID sets the "series_id" field.
+func (m *HookRunMutation) SetSeriesID(u uuid.UUID) {
+	m.series_id = &u
+}
+
+// SeriesID returns the value of the "series_id" field in the mutation.
+func (m *HookRunMutation) SeriesID() (r uuid.UUID, exists bool) {
+	v := m.series_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesID returns the old "series_id" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldSeriesID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesID: %w", err)
+	}
+	return oldValue.SeriesID, nil
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (m *HookRunMutation) ClearSeriesID() {
+	m.series_id = nil
+	m.clearedFields[hookrun.FieldSeriesID] = struct{}{}
+}
+
+// SeriesIDCleared returns if the "series_id" field was cleared in this mutation.
+func (m *HookRunMutation) SeriesIDCleared() bool {
+	_, ok := m.clearedFields[hookrun.FieldSeriesID]
+	return ok
+}
+
+// ResetSeriesID resets all changes to the "series_id" field.
+func (m *HookRunMutation) ResetSeriesID() {
+	m.series_id = nil
+	delete(m.clearedFields, hookrun.FieldSeriesID)
+}
+
+// SetSeriesTitle sets the "series_title" field.
+func (m *HookRunMutation) SetSeriesTitle(s string) {
+	m.series_title = &s
+}
+
+// SeriesTitle returns the value of the "series_title" field in the mutation.
+func (m *HookRunMutation) SeriesTitle() (r string, exists bool) {
+	v := m.series_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesTitle returns the old "series_title" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldSeriesTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesTitle: %w", err)
+	}
+	return oldValue.SeriesTitle, nil
+}
+
+// ClearSeriesTitle clears the value of the "series_title" field.
+func (m *HookRunMutation) ClearSeriesTitle() {
+	m.series_title = nil
+	m.clearedFields[hookrun.FieldSeriesTitle] = struct{}{}
+}
+
+// SeriesTitleCleared returns if the "series_title" field was cleared in this mutation.
+func (m *HookRunMutation) SeriesTitleCleared() bool {
+	_, ok := m.clearedFields[hookrun.FieldSeriesTitle]
+	return ok
+}
+
+// ResetSeriesTitle resets all changes to the "series_title" field.
+func (m *HookRunMutation) ResetSeriesTitle() {
+	m.series_title = nil
+	delete(m.clearedFields, hookrun.FieldSeriesTitle)
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (m *HookRunMutation) SetChapterNumber(f float64) {
+	m.chapter_number = &f
+	m.addchapter_number = nil
+}
+
+// ChapterNumber returns the value of the "chapter_number" field in the mutation.
+func (m *HookRunMutation) ChapterNumber() (r float64, exists bool) {
+	v := m.chapter_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChapterNumber returns the old "chapter_number" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldChapterNumber(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChapterNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChapterNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChapterNumber: %w", err)
+	}
+	return oldValue.ChapterNumber, nil
+}
+
+// AddChapterNumber adds f to the "chapter_number" field.
+func (m *HookRunMutation) AddChapterNumber(f float64) {
+	if m.addchapter_number != nil {
+		*m.addchapter_number += f
+	} else {
+		m.addchapter_number = &f
+	}
+}
+
+// AddedChapterNumber returns the value that was added to the "chapter_number" field in this mutation.
+func (m *HookRunMutation) AddedChapterNumber() (r float64, exists bool) {
+	v := m.addchapter_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChapterNumber clears the value of the "chapter_number" field.
+func (m *HookRunMutation) ClearChapterNumber() {
+	m.chapter_number = nil
+	m.addchapter_number = nil
+	m.clearedFields[hookrun.FieldChapterNumber] = struct{}{}
+}
+
+// ChapterNumberCleared returns if the "chapter_number" field was cleared in this mutation.
+func (m *HookRunMutation) ChapterNumberCleared() bool {
+	_, ok := m.clearedFields[hookrun.FieldChapterNumber]
+	return ok
+}
+
+// ResetChapterNumber resets all changes to the "chapter_number" field.
+func (m *HookRunMutation) ResetChapterNumber() {
+	m.chapter_number = nil
+	m.addchapter_number = nil
+	delete(m.clearedFields, hookrun.FieldChapterNumber)
+}
+
+// SetAttempt sets the "attempt" field.
+func (m *HookRunMutation) SetAttempt(i int) {
+	m.attempt = &i
+	m.addattempt = nil
+}
+
+// Attempt returns the value of the "attempt" field in the mutation.
+func (m *HookRunMutation) Attempt() (r int, exists bool) {
+	v := m.attempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempt returns the old "attempt" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldAttempt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempt: %w", err)
+	}
+	return oldValue.Attempt, nil
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (m *HookRunMutation) AddAttempt(i int) {
+	if m.addattempt != nil {
+		*m.addattempt += i
+	} else {
+		m.addattempt = &i
+	}
+}
+
+// AddedAttempt returns the value that was added to the "attempt" field in this mutation.
+func (m *HookRunMutation) AddedAttempt() (r int, exists bool) {
+	v := m.addattempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempt resets all changes to the "attempt" field.
+func (m *HookRunMutation) ResetAttempt() {
+	m.attempt = nil
+	m.addattempt = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *HookRunMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *HookRunMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *HookRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExitCode sets the "exit_code" field.
+func (m *HookRunMutation) SetExitCode(i int) {
+	m.exit_code = &i
+	m.addexit_code = nil
+}
+
+// ExitCode returns the value of the "exit_code" field in the mutation.
+func (m *HookRunMutation) ExitCode() (r int, exists bool) {
+	v := m.exit_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExitCode returns the old "exit_code" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldExitCode(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExitCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExitCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExitCode: %w", err)
+	}
+	return oldValue.ExitCode, nil
+}
+
+// AddExitCode adds i to the "exit_code" field.
+func (m *HookRunMutation) AddExitCode(i int) {
+	if m.addexit_code != nil {
+		*m.addexit_code += i
+	} else {
+		m.addexit_code = &i
+	}
+}
+
+// AddedExitCode returns the value that was added to the "exit_code" field in this mutation.
+func (m *HookRunMutation) AddedExitCode() (r int, exists bool) {
+	v := m.addexit_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (m *HookRunMutation) ClearExitCode() {
+	m.exit_code = nil
+	m.addexit_code = nil
+	m.clearedFields[hookrun.FieldExitCode] = struct{}{}
+}
+
+// ExitCodeCleared returns if the "exit_code" field was cleared in this mutation.
+func (m *HookRunMutation) ExitCodeCleared() bool {
+	_, ok := m.clearedFields[hookrun.FieldExitCode]
+	return ok
+}
+
+// ResetExitCode resets all changes to the "exit_code" field.
+func (m *HookRunMutation) ResetExitCode() {
+	m.exit_code = nil
+	m.addexit_code = nil
+	delete(m.clearedFields, hookrun.FieldExitCode)
+}
+
+// SetOutput sets the "output" field.
+func (m *HookRunMutation) SetOutput(s string) {
+	m.output = &s
+}
+
+// Output returns the value of the "output" field in the mutation.
+func (m *HookRunMutation) Output() (r string, exists bool) {
+	v := m.output
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutput returns the old "output" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldOutput(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutput is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutput requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutput: %w", err)
+	}
+	return oldValue.Output, nil
+}
+
+// ClearOutput clears the value of the "output" field.
+func (m *HookRunMutation) ClearOutput() {
+	m.output = nil
+	m.clearedFields[hookrun.FieldOutput] = struct{}{}
+}
+
+// OutputCleared returns if the "output" field was cleared in this mutation.
+func (m *HookRunMutation) OutputCleared() bool {
+	_, ok := m.clearedFields[hookrun.FieldOutput]
+	return ok
+}
+
+// ResetOutput resets all changes to the "output" field.
+func (m *HookRunMutation) ResetOutput() {
+	m.output = nil
+	delete(m.clearedFields, hookrun.FieldOutput)
+}
+
+// SetError sets the "error" field.
+func (m *HookRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *HookRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *HookRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[hookrun.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *HookRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[hookrun.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *HookRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, hookrun.FieldError)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *HookRunMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *HookRunMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *HookRunMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *HookRunMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *HookRunMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HookRunMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HookRunMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the HookRun entity.
+// If the HookRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HookRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HookRunMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the HookRunMutation builder.
+func (m *HookRunMutation) Where(ps ...predicate.HookRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HookRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HookRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HookRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HookRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HookRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HookRun).
+func (m *HookRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HookRunMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.hook != nil {
+		fields = append(fields, hookrun.FieldHook)
+	}
+	if m.event != nil {
+		fields = append(fields, hookrun.FieldEvent)
+	}
+	if m.series_id != nil {
+		fields = append(fields, hookrun.FieldSeriesID)
+	}
+	if m.series_title != nil {
+		fields = append(fields, hookrun.FieldSeriesTitle)
+	}
+	if m.chapter_number != nil {
+		fields = append(fields, hookrun.FieldChapterNumber)
+	}
+	if m.attempt != nil {
+		fields = append(fields, hookrun.FieldAttempt)
+	}
+	if m.status != nil {
+		fields = append(fields, hookrun.FieldStatus)
+	}
+	if m.exit_code != nil {
+		fields = append(fields, hookrun.FieldExitCode)
+	}
+	if m.output != nil {
+		fields = append(fields, hookrun.FieldOutput)
+	}
+	if m.error != nil {
+		fields = append(fields, hookrun.FieldError)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, hookrun.FieldDurationMs)
+	}
+	if m.created_at != nil {
+		fields = append(fields, hookrun.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HookRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hookrun.FieldHook:
+		return m.Hook()
+	case hookrun.FieldEvent:
+		return m.Event()
+	case hookrun.FieldSeriesID:
+		return m.SeriesID()
+	case hookrun.FieldSeriesTitle:
+		return m.SeriesTitle()
+	case hookrun.FieldChapterNumber:
+		return m.ChapterNumber()
+	case hookrun.FieldAttempt:
+		return m.Attempt()
+	case hookrun.FieldStatus:
+		return m.Status()
+	case hookrun.FieldExitCode:
+		return m.ExitCode()
+	case hookrun.FieldOutput:
+		return m.Output()
+	case hookrun.FieldError:
+		return m.Error()
+	case hookrun.FieldDurationMs:
+		return m.DurationMs()
+	case hookrun.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HookRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hookrun.FieldHook:
+		return m.OldHook(ctx)
+	case hookrun.FieldEvent:
+		return m.OldEvent(ctx)
+	case hookrun.FieldSeriesID:
+		return m.OldSeriesID(ctx)
+	case hookrun.FieldSeriesTitle:
+		return m.OldSeriesTitle(ctx)
+	case hookrun.FieldChapterNumber:
+		return m.OldChapterNumber(ctx)
+	case hookrun.FieldAttempt:
+		return m.OldAttempt(ctx)
+	case hookrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case hookrun.FieldExitCode:
+		return m.OldExitCode(ctx)
+	case hookrun.FieldOutput:
+		return m.OldOutput(ctx)
+	case hookrun.FieldError:
+		return m.OldError(ctx)
+	case hookrun.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case hookrun.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown HookRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HookRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hookrun.FieldHook:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHook(v)
+		return nil
+	case hookrun.FieldEvent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
+	case hookrun.FieldSeriesID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesID(v)
+		return nil
+	case hookrun.FieldSeriesTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesTitle(v)
+		return nil
+	case hookrun.FieldChapterNumber:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChapterNumber(v)
+		return nil
+	case hookrun.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempt(v)
+		return nil
+	case hookrun.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case hookrun.FieldExitCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExitCode(v)
+		return nil
+	case hookrun.FieldOutput:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutput(v)
+		return nil
+	case hookrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case hookrun.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case hookrun.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HookRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HookRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addchapter_number != nil {
+		fields = append(fields, hookrun.FieldChapterNumber)
+	}
+	if m.addattempt != nil {
+		fields = append(fields, hookrun.FieldAttempt)
+	}
+	if m.addexit_code != nil {
+		fields = append(fields, hookrun.FieldExitCode)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, hookrun.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HookRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case hookrun.FieldChapterNumber:
+		return m.AddedChapterNumber()
+	case hookrun.FieldAttempt:
+		return m.AddedAttempt()
+	case hookrun.FieldExitCode:
+		return m.AddedExitCode()
+	case hookrun.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HookRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case hookrun.FieldChapterNumber:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChapterNumber(v)
+		return nil
+	case hookrun.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempt(v)
+		return nil
+	case hookrun.FieldExitCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExitCode(v)
+		return nil
+	case hookrun.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HookRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HookRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(hookrun.FieldSeriesID) {
+		fields = append(fields, hookrun.FieldSeriesID)
+	}
+	if m.FieldCleared(hookrun.FieldSeriesTitle) {
+		fields = append(fields, hookrun.FieldSeriesTitle)
+	}
+	if m.FieldCleared(hookrun.FieldChapterNumber) {
+		fields = append(fields, hookrun.FieldChapterNumber)
+	}
+	if m.FieldCleared(hookrun.FieldExitCode) {
+		fields = append(fields, hookrun.FieldExitCode)
+	}
+	if m.FieldCleared(hookrun.FieldOutput) {
+		fields = append(fields, hookrun.FieldOutput)
+	}
+	if m.FieldCleared(hookrun.FieldError) {
+		fields = append(fields, hookrun.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HookRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HookRunMutation) ClearField(name string) error {
+	switch name {
+	case hookrun.FieldSeriesID:
+		m.ClearSeriesID()
+		return nil
+	case hookrun.FieldSeriesTitle:
+		m.ClearSeriesTitle()
+		return nil
+	case hookrun.FieldChapterNumber:
+		m.ClearChapterNumber()
+		return nil
+	case hookrun.FieldExitCode:
+		m.ClearExitCode()
+		return nil
+	case hookrun.FieldOutput:
+		m.ClearOutput()
+		return nil
+	case hookrun.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown HookRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HookRunMutation) ResetField(name string) error {
+	switch name {
+	case hookrun.FieldHook:
+		m.ResetHook()
+		return nil
+	case hookrun.FieldEvent:
+		m.ResetEvent()
+		return nil
+	case hookrun.FieldSeriesID:
+		m.ResetSeriesID()
+		return nil
+	case hookrun.FieldSeriesTitle:
+		m.ResetSeriesTitle()
+		return nil
+	case hookrun.FieldChapterNumber:
+		m.ResetChapterNumber()
+		return nil
+	case hookrun.FieldAttempt:
+		m.ResetAttempt()
+		return nil
+	case hookrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case hookrun.FieldExitCode:
+		m.ResetExitCode()
+		return nil
+	case hookrun.FieldOutput:
+		m.ResetOutput()
+		return nil
+	case hookrun.FieldError:
+		m.ResetError()
+		return nil
+	case hookrun.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case hookrun.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HookRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HookRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HookRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HookRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HookRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HookRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HookRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HookRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown HookRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HookRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown HookRun edge %s", name)
+}
+
+// ImportEntryMutation represents an operation that mutates the ImportEntry nodes in the graph.
+type ImportEntryMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *string
+	title                     *string
+	status                    *int
+	addstatus                 *int
+	action                    *int
+	addaction                 *int
+	info                      **types.KaizokuInfo
+	series                    *[]map[string]interface{}
+	appendseries              []map[string]interface{}
+	continue_after_chapter    *float64
+	addcontinue_after_chapter *float64
+	clearedFields             map[string]struct{}
+	done                      bool
+	oldValue                  func(context.Context) (*ImportEntry, error)
+	predicates                []predicate.ImportEntry
+}
+
+var _ ent.Mutation = (*ImportEntryMutation)(nil)
+
+// importentryOption allows management of the mutation configuration using functional options.
+type importentryOption func(*ImportEntryMutation)
+
+// newImportEntryMutation creates new mutation for the ImportEntry entity.
+func newImportEntryMutation(c config, op Op, opts ...importentryOption) *ImportEntryMutation {
+	m := &ImportEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeImportEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withImportEntryID sets the ID field of the mutation.
+func withImportEntryID(id string) importentryOption {
+	return func(m *ImportEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ImportEntry
+		)
+		m.oldValue = func(ctx context.Context) (*ImportEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ImportEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withImportEntry sets the old ImportEntry of the mutation.
+func withImportEntry(node *ImportEntry) importentryOption {
+	return func(m *ImportEntryMutation) {
+		m.oldValue = func(context.Context) (*ImportEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ImportEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ImportEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ImportEntry entities.
+func (m *ImportEntryMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ImportEntryMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ImportEntryMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ImportEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *ImportEntryMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ImportEntryMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the ImportEntry entity.
+// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportEntryMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ImportEntryMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ImportEntryMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ImportEntryMutation) Status() (r int, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ImportEntry entity.
+// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportEntryMutation) OldStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *ImportEntryMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *ImportEntryMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ImportEntryMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetAction sets the "action" field.
+func (m *ImportEntryMutation) SetAction(i int) {
+	m.action = &i
+	m.addaction = nil
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ImportEntryMutation) Action() (r int, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ImportEntry entity.
+// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportEntryMutation) OldAction(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// AddAction adds i to the "action" field.
+func (m *ImportEntryMutation) AddAction(i int) {
+	if m.addaction != nil {
+		*m.addaction += i
+	} else {
+		m.addaction = &i
+	}
+}
+
+// AddedAction returns the value that was added to the "action" field in this mutation.
+func (m *ImportEntryMutation) AddedAction() (r int, exists bool) {
+	v := m.addaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ImportEntryMutation) ResetAction() {
+	m.action = nil
+	m.addaction = nil
+}
+
+// SetInfo sets the "info" field.
+func (m *ImportEntryMutation) SetInfo(ti *types.KaizokuInfo) {
+	m.info = &ti
+}
+
+// Info returns the value of the "info" field in the mutation.
+func (m *ImportEntryMutation) Info() (r *types.KaizokuInfo, exists bool) {
+	v := m.info
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInfo returns the old "info" field's value of the ImportEntry entity.
+// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportEntryMutation) OldInfo(ctx context.Context) (v *types.KaizokuInfo, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInfo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInfo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInfo: %w", err)
+	}
+	return oldValue.Info, nil
+}
+
+// ClearInfo clears the value of the "info" field.
+func (m *ImportEntryMutation) ClearInfo() {
+	m.info = nil
+	m.clearedFields[importentry.FieldInfo] = struct{}{}
+}
+
+// InfoCleared returns if the "info" field was cleared in this mutation.
+func (m *ImportEntryMutation) InfoCleared() bool {
+	_, ok := m.clearedFields[importentry.FieldInfo]
+	return ok
+}
+
+// ResetInfo resets all changes to the "info" field.
+func (m *ImportEntryMutation) ResetInfo() {
+	m.info = nil
+	delete(m.clearedFields, importentry.FieldInfo)
+}
+
+// SetSeries sets the "series" field.
+func (m *ImportEntryMutation) SetSeries(value []map[string]interface{}) {
+	m.series = &value
+	m.appendseries = nil
+}
+
+// Series returns the value of the "series" field in the mutation.
+func (m *ImportEntryMutation) Series() (r []map[string]interface{}, exists bool) {
+	v := m.series
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeries returns the old "series" field's value of the ImportEntry entity.
+// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportEntryMutation) OldSeries(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeries: %w", err)
+	}
+	return oldValue.Series, nil
+}
+
+// AppendSeries adds value to the "series" field.
+func (m *ImportEntryMutation) AppendSeries(value []map[string]interface{}) {
+	m.appendseries = append(m.appendseries, value...)
+}
+
+// AppendedSeries returns the list of values that were appended to the "series" field in this mutation.
+func (m *ImportEntryMutation) AppendedSeries() ([]map[string]interface{}, bool) {
+	if len(m.appendseries) == 0 {
+		return nil, false
+	}
+	return m.appendseries, true
+}
+
+// ClearSeries clears the value of the "series" field.
+func (m *ImportEntryMutation) ClearSeries() {
+	m.series = nil
+	m.appendseries = nil
+	m.clearedFields[importentry.FieldSeries] = struct{}{}
+}
+
+// SeriesCleared returns if the "series" field was cleared in this mutation.
+func (m *ImportEntryMutation) SeriesCleared() bool {
+	_, ok := m.clearedFields[importentry.FieldSeries]
+	return ok
+}
+
+// ResetSeries resets all changes to the "series" field.
+func (m *ImportEntryMutation) ResetSeries() {
+	m.series = nil
+	m.appendseries = nil
+	delete(m.clearedFields, importentry.FieldSeries)
+}
+
+// SetContinueAfterChapter sets the "continue_after_chapter" field.
+func (m *ImportEntryMutation) SetContinueAfterChapter(f float64) {
+	m.continue_after_chapter = &f
+	m.addcontinue_after_chapter = nil
+}
+
+// ContinueAfterChapter returns the value of the "continue_after_chapter" field in the mutation.
+func (m *ImportEntryMutation) ContinueAfterChapter() (r float64, exists bool) {
+	v := m.continue_after_chapter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContinueAfterChapter returns the old "continue_after_chapter" field's value of the ImportEntry entity.
+// If the ImportEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportEntryMutation) OldContinueAfterChapter(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContinueAfterChapter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContinueAfterChapter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContinueAfterChapter: %w", err)
+	}
+	return oldValue.ContinueAfterChapter, nil
+}
+
+// AddContinueAfterChapter adds f to the "continue_after_chapter" field.
+func (m *ImportEntryMutation) AddContinueAfterChapter(f float64) {
+	if m.addcontinue_after_chapter != nil {
+		*m.addcontinue_after_chapter += f
+	} else {
+		m.addcontinue_after_chapter = &f
+	}
+}
+
+// AddedContinueAfterChapter returns the value that was added to the "continue_after_chapter" field in this mutation.
+func (m *ImportEntryMutation) AddedContinueAfterChapter() (r float64, exists bool) {
+	v := m.addcontinue_after_chapter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearContinueAfterChapter clears the value of the "continue_after_chapter" field.
+func (m *ImportEntryMutation) ClearContinueAfterChapter() {
+	m.continue_after_chapter = nil
+	m.addcontinue_after_chapter = nil
+	m.clearedFields[importentry.FieldContinueAfterChapter] = struct{}{}
+}
+
+// ContinueAfterChapterCleared returns if the "continue_after_chapter" field was cleared in this mutation.
+func (m *ImportEntryMutation) ContinueAfterChapterCleared() bool {
+	_, ok := m.clearedFields[importentry.FieldContinueAfterChapter]
+	return ok
+}
+
+// ResetContinueAfterChapter resets all changes to the "continue_after_chapter" field.
+func (m *ImportEntryMutation) ResetContinueAfterChapter() {
+	m.continue_after_chapter = nil
+	m.addcontinue_after_chapter = nil
+	delete(m.clearedFields, importentry.FieldContinueAfterChapter)
+}
+
+// Where appends a list predicates to the ImportEntryMutation builder.
+func (m *ImportEntryMutation) Where(ps ...predicate.ImportEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImportEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImportEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImportEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImportEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImportEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ImportEntry).
+func (m *ImportEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImportEntryMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.title != nil {
+		fields = append(fields, importentry.FieldTitle)
+	}
+	if m.status != nil {
+		fields = append(fields, importentry.FieldStatus)
+	}
+	if m.action != nil {
+		fields = append(fields, importentry.FieldAction)
+	}
+	if m.info != nil {
+		fields = append(fields, importentry.FieldInfo)
+	}
+	if m.series != nil {
+		fields = append(fields, importentry.FieldSeries)
+	}
+	if m.continue_after_chapter != nil {
+		fields = append(fields, importentry.FieldContinueAfterChapter)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImportEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case importentry.FieldTitle:
+		return m.Title()
+	case importentry.FieldStatus:
+		return m.Status()
+	case importentry.FieldAction:
+		return m.Action()
+	case importentry.FieldInfo:
+		return m.Info()
+	case importentry.FieldSeries:
+		return m.Series()
+	case importentry.FieldContinueAfterChapter:
+		return m.ContinueAfterChapter()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImportEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case importentry.FieldTitle:
+		return m.OldTitle(ctx)
+	case importentry.FieldStatus:
+		return m.OldStatus(ctx)
+	case importentry.FieldAction:
+		return m.OldAction(ctx)
+	case importentry.FieldInfo:
+		return m.OldInfo(ctx)
+	case importentry.FieldSeries:
+		return m.OldSeries(ctx)
+	case importentry.FieldContinueAfterChapter:
+		return m.OldContinueAfterChapter(ctx)
+	}
+	return nil, fmt.Errorf("unknown ImportEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case importentry.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case importentry.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case importentry.FieldAction:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case importentry.FieldInfo:
+		v, ok := value.(*types.KaizokuInfo)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInfo(v)
+		return nil
+	case importentry.FieldSeries:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeries(v)
+		return nil
+	case importentry.FieldContinueAfterChapter:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContinueAfterChapter(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImportEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, importentry.FieldStatus)
+	}
+	if m.addaction != nil {
+		fields = append(fields, importentry.FieldAction)
+	}
+	if m.addcontinue_after_chapter != nil {
+		fields = append(fields, importentry.FieldContinueAfterChapter)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImportEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case importentry.FieldStatus:
+		return m.AddedStatus()
+	case importentry.FieldAction:
+		return m.AddedAction()
+	case importentry.FieldContinueAfterChapter:
+		return m.AddedContinueAfterChapter()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case importentry.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case importentry.FieldAction:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAction(v)
+		return nil
+	case importentry.FieldContinueAfterChapter:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddContinueAfterChapter(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImportEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(importentry.FieldInfo) {
+		fields = append(fields, importentry.FieldInfo)
+	}
+	if m.FieldCleared(importentry.FieldSeries) {
+		fields = append(fields, importentry.FieldSeries)
+	}
+	if m.FieldCleared(importentry.FieldContinueAfterChapter) {
+		fields = append(fields, importentry.FieldContinueAfterChapter)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImportEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImportEntryMutation) ClearField(name string) error {
+	switch name {
+	case importentry.FieldInfo:
+		m.ClearInfo()
+		return nil
+	case importentry.FieldSeries:
+		m.ClearSeries()
+		return nil
+	case importentry.FieldContinueAfterChapter:
+		m.ClearContinueAfterChapter()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImportEntryMutation) ResetField(name string) error {
+	switch name {
+	case importentry.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case importentry.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case importentry.FieldAction:
+		m.ResetAction()
+		return nil
+	case importentry.FieldInfo:
+		m.ResetInfo()
+		return nil
+	case importentry.FieldSeries:
+		m.ResetSeries()
+		return nil
+	case importentry.FieldContinueAfterChapter:
+		m.ResetContinueAfterChapter()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImportEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImportEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImportEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImportEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImportEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImportEntryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImportEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ImportEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImportEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ImportEntry edge %s", name)
+}
+
+// LatestSeriesMutation represents an operation that mutates the LatestSeries nodes in the graph.
+type LatestSeriesMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	suwayomi_source_id   *string
+	provider             *string
+	language             *string
+	url                  *string
+	title                *string
+	thumbnail_url        *string
+	artist               *string
+	author               *string
+	description          *string
+	genre                *[]string
+	appendgenre          []string
+	fetch_date           *time.Time
+	chapter_count        *int64
+	addchapter_count     *int64
+	latest_chapter       *float64
+	addlatest_chapter    *float64
+	latest_chapter_title *string
+	status               *string
+	in_library           *int
+	addin_library        *int
+	chapters             *[]types.SuwayomiChapter
+	appendchapters       []types.SuwayomiChapter
+	clearedFields        map[string]struct{}
+	series               *uuid.UUID
+	clearedseries        bool
+	done                 bool
+	oldValue             func(context.Context) (*LatestSeries, error)
+	predicates           []predicate.LatestSeries
+}
+
+var _ ent.Mutation = (*LatestSeriesMutation)(nil)
+
+// latestseriesOption allows management of the mutation configuration using functional options.
+type latestseriesOption func(*LatestSeriesMutation)
+
+// newLatestSeriesMutation creates new mutation for the LatestSeries entity.
+func newLatestSeriesMutation(c config, op Op, opts ...latestseriesOption) *LatestSeriesMutation {
+	m := &LatestSeriesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLatestSeries,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLatestSeriesID sets the ID field of the mutation.
+func withLatestSeriesID(id int) latestseriesOption {
+	return func(m *LatestSeriesMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LatestSeries
+		)
+		m.oldValue = func(ctx context.Context) (*LatestSeries, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LatestSeries.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLatestSeries sets the old LatestSeries of the mutation.
+func withLatestSeries(node *LatestSeries) latestseriesOption {
+	return func(m *LatestSeriesMutation) {
+		m.oldValue = func(context.Context) (*LatestSeries, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LatestSeriesMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LatestSeriesMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LatestSeries entities.
+func (m *LatestSeriesMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LatestSeriesMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LatestSeriesMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LatestSeries.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSuwayomiSourceID sets the "suwayomi_source_id" field.
+func (m *LatestSeriesMutation) SetSuwayomiSourceID(s string) {
+	m.suwayomi_source_id = &s
+}
+
+// SuwayomiSourceID returns the value of the "suwayomi_source_id" field in the mutation.
+func (m *LatestSeriesMutation) SuwayomiSourceID() (r string, exists bool) {
+	v := m.suwayomi_source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuwayomiSourceID returns the old "suwayomi_source_id" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldSuwayomiSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuwayomiSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuwayomiSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuwayomiSourceID: %w", err)
+	}
+	return oldValue.SuwayomiSourceID, nil
+}
+
+// ResetSuwayomiSourceID resets all changes to the "suwayomi_source_id" field.
+func (m *LatestSeriesMutation) ResetSuwayomiSourceID() {
+	m.suwayomi_source_id = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *LatestSeriesMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *LatestSeriesMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *LatestSeriesMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *LatestSeriesMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *LatestSeriesMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *LatestSeriesMutation) ResetLanguage() {
+	m.language = nil
+}
+
+// SetURL sets the "url" field.
+func (m *LatestSeriesMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *LatestSeriesMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *LatestSeriesMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[latestseries.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *LatestSeriesMutation) URLCleared() bool {
+	_, ok := m.clearedFields[latestseries.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *LatestSeriesMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, latestseries.FieldURL)
+}
+
+// SetTitle sets the "title" field.
+func (m *LatestSeriesMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *LatestSeriesMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *LatestSeriesMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (m *LatestSeriesMutation) SetThumbnailURL(s string) {
+	m.thumbnail_url = &s
+}
+
+// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
+func (m *LatestSeriesMutation) ThumbnailURL() (r string, exists bool) {
+	v := m.thumbnail_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailURL returns the old "thumbnail_url" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldThumbnailURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+	}
+	return oldValue.ThumbnailURL, nil
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (m *LatestSeriesMutation) ClearThumbnailURL() {
+	m.thumbnail_url = nil
+	m.clearedFields[latestseries.FieldThumbnailURL] = struct{}{}
+}
+
+// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
+func (m *LatestSeriesMutation) ThumbnailURLCleared() bool {
+	_, ok := m.clearedFields[latestseries.FieldThumbnailURL]
+	return ok
+}
+
+// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
+func (m *LatestSeriesMutation) ResetThumbnailURL() {
+	m.thumbnail_url = nil
+	delete(m.clearedFields, latestseries.FieldThumbnailURL)
+}
+
+// SetArtist sets the "artist" field.
+func (m *LatestSeriesMutation) SetArtist(s string) {
+	m.artist = &s
+}
+
+// Artist returns the value of the "artist" field in the mutation.
+func (m *LatestSeriesMutation) Artist() (r string, exists bool) {
+	v := m.artist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtist returns the old "artist" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldArtist(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtist is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtist requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtist: %w", err)
+	}
+	return oldValue.Artist, nil
+}
+
+// ClearArtist clears the value of the "artist" field.
+func (m *LatestSeriesMutation) ClearArtist() {
+	m.artist = nil
+	m.clearedFields[latestseries.FieldArtist] = struct{}{}
+}
+
+// ArtistCleared returns if the "artist" field was cleared in this mutation.
+func (m *LatestSeriesMutation) ArtistCleared() bool {
+	_, ok := m.clearedFields[latestseries.FieldArtist]
+	return ok
+}
+
+// ResetArtist resets all changes to the "artist" field.
+func (m *LatestSeriesMutation) ResetArtist() {
+	m.artist = nil
+	delete(m.clearedFields, latestseries.FieldArtist)
+}
+
+// SetAuthor sets the "author" field.
+func (m *LatestSeriesMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *LatestSeriesMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldAuthor(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (m *LatestSeriesMutation) ClearAuthor() {
+	m.author = nil
+	m.clearedFields[latestseries.FieldAuthor] = struct{}{}
+}
+
+// AuthorCleared returns if the "author" field was cleared in this mutation.
+func (m *LatestSeriesMutation) AuthorCleared() bool {
+	_, ok := m.clearedFields[latestseries.FieldAuthor]
+	return ok
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *LatestSeriesMutation) ResetAuthor() {
+	m.author = nil
+	delete(m.clearedFields, latestseries.FieldAuthor)
+}
+
+// SetDescription sets the "description" field.
+func (m *LatestSeriesMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *LatestSeriesMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *LatestSeriesMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[latestseries.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *LatestSeriesMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[latestseries.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *LatestSeriesMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, latestseries.FieldDescription)
+}
+
+// SetGenre sets the "genre" field.
+func (m *LatestSeriesMutation) SetGenre(s []string) {
+	m.genre = &s
+	m.appendgenre = nil
+}
+
+// Genre returns the value of the "genre" field in the mutation.
+func (m *LatestSeriesMutation) Genre() (r []string, exists bool) {
+	v := m.genre
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenre returns the old "genre" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldGenre(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenre requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
+	}
+	return oldValue.Genre, nil
+}
+
+// AppendGenre adds s to the "genre" field.
+func (m *LatestSeriesMutation) AppendGenre(s []string) {
+	m.appendgenre = append(m.appendgenre, s...)
+}
+
+// AppendedGenre returns the list of values that were appended to the "genre" field in this mutation.
+func (m *LatestSeriesMutation) AppendedGenre() ([]string, bool) {
+	if len(m.appendgenre) == 0 {
+		return nil, false
+	}
+	return m.appendgenre, true
+}
+
+// ClearGenre clears the value of the "genre" field.
+func (m *LatestSeriesMutation) ClearGenre() {
+	m.genre = nil
+	m.appendgenre = nil
+	m.clearedFields[latestseries.FieldGenre] = struct{}{}
+}
+
+// GenreCleared returns if the "genre" field was cleared in this mutation.
+func (m *LatestSeriesMutation) GenreCleared() bool {
+	_, ok := m.clearedFields[latestseries.FieldGenre]
+	return ok
+}
+
+// ResetGenre resets all changes to the "genre" field.
+func (m *LatestSeriesMutation) ResetGenre() {
+	m.genre = nil
+	m.appendgenre = nil
+	delete(m.clearedFields, latestseries.FieldGenre)
+}
+
+// SetFetchDate sets the "fetch_date" field.
+func (m *LatestSeriesMutation) SetFetchDate(t time.Time) {
+	m.fetch_date = &t
+}
+
+// FetchDate returns the value of the "fetch_date" field in the mutation.
+func (m *LatestSeriesMutation) FetchDate() (r time.Time, exists bool) {
+	v := m.fetch_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFetchDate returns the old "fetch_date" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldFetchDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFetchDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFetchDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFetchDate: %w", err)
+	}
+	return oldValue.FetchDate, nil
+}
+
+// ResetFetchDate resets all changes to the "fetch_date" field.
+func (m *LatestSeriesMutation) ResetFetchDate() {
+	m.fetch_date = nil
+}
+
+// SetChapterCount sets the "chapter_count" field.
+func (m *LatestSeriesMutation) SetChapterCount(i int64) {
+	m.chapter_count = &i
+	m.addchapter_count = nil
+}
+
+// ChapterCount returns the value of the "chapter_count" field in the mutation.
+func (m *LatestSeriesMutation) ChapterCount() (r int64, exists bool) {
+	v := m.chapter_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChapterCount returns the old "chapter_count" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldChapterCount(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChapterCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChapterCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChapterCount: %w", err)
+	}
+	return oldValue.ChapterCount, nil
+}
+
+// AddChapterCount adds i to the "chapter_count" field.
+func (m *LatestSeriesMutation) AddChapterCount(i int64) {
+	if m.addchapter_count != nil {
+		*m.addchapter_count += i
+	} else {
+		m.addchapter_count = &i
+	}
+}
+
+// AddedChapterCount returns the value that was added to the "chapter_count" field in this mutation.
+func (m *LatestSeriesMutation) AddedChapterCount() (r int64, exists bool) {
+	v := m.addchapter_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChapterCount clears the value of the "chapter_count" field.
+func (m *LatestSeriesMutation) ClearChapterCount() {
+	m.chapter_count = nil
+	m.addchapter_count = nil
+	m.clearedFields[latestseries.FieldChapterCount] = struct{}{}
+}
+
+// ChapterCountCleared returns if the "chapter_count" field was cleared in this mutation.
+func (m *LatestSeriesMutation) ChapterCountCleared() bool {
+	_, ok := m.clearedFields[latestseries.FieldChapterCount]
+	return ok
+}
+
+// ResetChapterCount resets all changes to the "chapter_count" field.
+func (m *LatestSeriesMutation) ResetChapterCount() {
+	m.chapter_count = nil
+	m.addchapter_count = nil
+	delete(m.clearedFields, latestseries.FieldChapterCount)
+}
+
+// SetLatestChapter sets the "latest_chapter" field.
+func (m *LatestSeriesMutation) SetLatestChapter(f float64) {
+	m.latest_chapter = &f
+	m.addlatest_chapter = nil
+}
+
+// LatestChapter returns the value of the "latest_chapter" field in the mutation.
+func (m *LatestSeriesMutation) LatestChapter() (r float64, exists bool) {
+	v := m.latest_chapter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatestChapter returns the old "latest_chapter" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldLatestChapter(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatestChapter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatestChapter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatestChapter: %w", err)
+	}
+	return oldValue.LatestChapter, nil
+}
+
+// AddLatestChapter adds f to the "latest_chapter" field.
+func (m *LatestSeriesMutation) AddLatestChapter(f float64) {
+	if m.addlatest_chapter != nil {
+		*m.addlatest_chapter += f
+	} else {
+		m.addlatest_chapter = &f
+	}
+}
+
+// AddedLatestChapter returns the value that was added to the "latest_chapter" field in this mutation.
+func (m *LatestSeriesMutation) AddedLatestChapter() (r float64, exists bool) {
+	v := m.addlatest_chapter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLatestChapter clears the value of the "latest_chapter" field.
+func (m *LatestSeriesMutation) ClearLatestChapter() {
+	m.latest_chapter = nil
+	m.addlatest_chapter = nil
+	m.clearedFields[latestseries.FieldLatestChapter] = struct{}{}
+}
+
+// LatestChapterCleared returns if the "latest_chapter" field was cleared in this mutation.
+func (m *LatestSeriesMutation) LatestChapterCleared() bool {
+	_, ok := m.clearedFields[latestseries.FieldLatestChapter]
+	return ok
+}
+
+// ResetLatestChapter resets all changes to the "latest_chapter" field.
 func (m *LatestSeriesMutation) ResetLatestChapter() {
 	m.latest_chapter = nil
 	m.addlatest_chapter = nil
 	delete(m.clearedFields, latestseries.FieldLatestChapter)
 }
 
-// SetLatestChapterTitle sets the "latest_chapter_title" field.
-func (m *LatestSeriesMutation) SetLatestChapterTitle(s string) {
-	m.latest_chapter_title = &s
+// SetLatestChapterTitle sets the "latest_chapter_title" field.
+func (m *LatestSeriesMutation) SetLatestChapterTitle(s string) {
+	m.latest_chapter_title = &s
+}
+
+// LatestChapterTitle returns the value of the "latest_chapter_title" field in the mutation.
+func (m *LatestSeriesMutation) LatestChapterTitle() (r string, exists bool) {
+	v := m.latest_chapter_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatestChapterTitle returns the old "latest_chapter_title" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldLatestChapterTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatestChapterTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatestChapterTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatestChapterTitle: %w", err)
+	}
+	return oldValue.LatestChapterTitle, nil
+}
+
+// ResetLatestChapterTitle resets all changes to the "latest_chapter_title" field.
+func (m *LatestSeriesMutation) ResetLatestChapterTitle() {
+	m.latest_chapter_title = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *LatestSeriesMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *LatestSeriesMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *LatestSeriesMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetInLibrary sets the "in_library" field.
+func (m *LatestSeriesMutation) SetInLibrary(i int) {
+	m.in_library = &i
+	m.addin_library = nil
+}
+
+// InLibrary returns the value of the "in_library" field in the mutation.
+func (m *LatestSeriesMutation) InLibrary() (r int, exists bool) {
+	v := m.in_library
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInLibrary returns the old "in_library" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldInLibrary(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInLibrary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInLibrary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInLibrary: %w", err)
+	}
+	return oldValue.InLibrary, nil
+}
+
+// AddInLibrary adds i to the "in_library" field.
+func (m *LatestSeriesMutation) AddInLibrary(i int) {
+	if m.addin_library != nil {
+		*m.addin_library += i
+	} else {
+		m.addin_library = &i
+	}
+}
+
+// AddedInLibrary returns the value that was added to the "in_library" field in this mutation.
+func (m *LatestSeriesMutation) AddedInLibrary() (r int, exists bool) {
+	v := m.addin_library
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInLibrary resets all changes to the "in_library" field.
+func (m *LatestSeriesMutation) ResetInLibrary() {
+	m.in_library = nil
+	m.addin_library = nil
+}
+
+// SetSeriesID sets the "series_id" field.
+func (m *LatestSeriesMutation) SetSeriesID(u uuid.UUID) {
+	m.series = &u
+}
+
+// SeriesID returns the value of the "series_id" field in the mutation.
+func (m *LatestSeriesMutation) SeriesID() (r uuid.UUID, exists bool) {
+	v := m.series
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesID returns the old "series_id" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldSeriesID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesID: %w", err)
+	}
+	return oldValue.SeriesID, nil
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (m *LatestSeriesMutation) ClearSeriesID() {
+	m.series = nil
+	m.clearedFields[latestseries.FieldSeriesID] = struct{}{}
+}
+
+// SeriesIDCleared returns if the "series_id" field was cleared in this mutation.
+func (m *LatestSeriesMutation) SeriesIDCleared() bool {
+	_, ok := m.clearedFields[latestseries.FieldSeriesID]
+	return ok
+}
+
+// ResetSeriesID resets all changes to the "series_id" field.
+func (m *LatestSeriesMutation) ResetSeriesID() {
+	m.series = nil
+	delete(m.clearedFields, latestseries.FieldSeriesID)
+}
+
+// SetChapters sets the "chapters" field.
+func (m *LatestSeriesMutation) SetChapters(tc []types.SuwayomiChapter) {
+	m.chapters = &tc
+	m.appendchapters = nil
+}
+
+// Chapters returns the value of the "chapters" field in the mutation.
+func (m *LatestSeriesMutation) Chapters() (r []types.SuwayomiChapter, exists bool) {
+	v := m.chapters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChapters returns the old "chapters" field's value of the LatestSeries entity.
+// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LatestSeriesMutation) OldChapters(ctx context.Context) (v []types.SuwayomiChapter, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChapters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChapters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChapters: %w", err)
+	}
+	return oldValue.Chapters, nil
+}
+
+// AppendChapters adds tc to the "chapters" field.
+func (m *LatestSeriesMutation) AppendChapters(tc []types.SuwayomiChapter) {
+	m.appendchapters = append(m.appendchapters, tc...)
+}
+
+// AppendedChapters returns the list of values that were appended to the "chapters" field in this mutation.
+func (m *LatestSeriesMutation) AppendedChapters() ([]types.SuwayomiChapter, bool) {
+	if len(m.appendchapters) == 0 {
+		return nil, false
+	}
+	return m.appendchapters, true
+}
+
+// ClearChapters clears the value of the "chapters" field.
+func (m *LatestSeriesMutation) ClearChapters() {
+	m.chapters = nil
+	m.appendchapters = nil
+	m.clearedFields[latestseries.FieldChapters] = struct{}{}
+}
+
+// ChaptersCleared returns if the "chapters" field was cleared in this mutation.
+func (m *LatestSeriesMutation) ChaptersCleared() bool {
+	_, ok := m.clearedFields[latestseries.FieldChapters]
+	return ok
+}
+
+// ResetChapters resets all changes to the "chapters" field.
+func (m *LatestSeriesMutation) ResetChapters() {
+	m.chapters = nil
+	m.appendchapters = nil
+	delete(m.clearedFields, latestseries.FieldChapters)
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (m *LatestSeriesMutation) ClearSeries() {
+	m.clearedseries = true
+	m.clearedFields[latestseries.FieldSeriesID] = struct{}{}
+}
+
+// SeriesCleared reports if the "series" edge to the Series entity was cleared.
+func (m *LatestSeriesMutation) SeriesCleared() bool {
+	return m.SeriesIDCleared() || m.clearedseries
+}
+
+// SeriesIDs returns the "series" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SeriesID instead. It exists only for internal usage by the builders.
+func (m *LatestSeriesMutation) SeriesIDs() (ids []uuid.UUID) {
+	if id := m.series; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSeries resets all changes to the "series" edge.
+func (m *LatestSeriesMutation) ResetSeries() {
+	m.series = nil
+	m.clearedseries = false
+}
+
+// Where appends a list predicates to the LatestSeriesMutation builder.
+func (m *LatestSeriesMutation) Where(ps ...predicate.LatestSeries) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LatestSeriesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LatestSeriesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LatestSeries, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LatestSeriesMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LatestSeriesMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LatestSeries).
+func (m *LatestSeriesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LatestSeriesMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.suwayomi_source_id != nil {
+		fields = append(fields, latestseries.FieldSuwayomiSourceID)
+	}
+	if m.provider != nil {
+		fields = append(fields, latestseries.FieldProvider)
+	}
+	if m.language != nil {
+		fields = append(fields, latestseries.FieldLanguage)
+	}
+	if m.url != nil {
+		fields = append(fields, latestseries.FieldURL)
+	}
+	if m.title != nil {
+		fields = append(fields, latestseries.FieldTitle)
+	}
+	if m.thumbnail_url != nil {
+		fields = append(fields, latestseries.FieldThumbnailURL)
+	}
+	if m.artist != nil {
+		fields = append(fields, latestseries.FieldArtist)
+	}
+	if m.author != nil {
+		fields = append(fields, latestseries.FieldAuthor)
+	}
+	if m.description != nil {
+		fields = append(fields, latestseries.FieldDescription)
+	}
+	if m.genre != nil {
+		fields = append(fields, latestseries.FieldGenre)
+	}
+	if m.fetch_date != nil {
+		fields = append(fields, latestseries.FieldFetchDate)
+	}
+	if m.chapter_count != nil {
+		fields = append(fields, latestseries.FieldChapterCount)
+	}
+	if m.latest_chapter != nil {
+		fields = append(fields, latestseries.FieldLatestChapter)
+	}
+	if m.latest_chapter_title != nil {
+		fields = append(fields, latestseries.FieldLatestChapterTitle)
+	}
+	if m.status != nil {
+		fields = append(fields, latestseries.FieldStatus)
+	}
+	if m.in_library != nil {
+		fields = append(fields, latestseries.FieldInLibrary)
+	}
+	if m.series != nil {
+		fields = append(fields, latestseries.FieldSeriesID)
+	}
+	if m.chapters != nil {
+		fields = append(fields, latestseries.FieldChapters)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LatestSeriesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case latestseries.FieldSuwayomiSourceID:
+		return m.SuwayomiSourceID()
+	case latestseries.FieldProvider:
+		return m.Provider()
+	case latestseries.FieldLanguage:
+		return m.Language()
+	case latestseries.FieldURL:
+		return m.URL()
+	case latestseries.FieldTitle:
+		return m.Title()
+	case latestseries.FieldThumbnailURL:
+		return m.ThumbnailURL()
+	case latestseries.FieldArtist:
+		return m.Artist()
+	case latestseries.FieldAuthor:
+		return m.Author()
+	case latestseries.FieldDescription:
+		return m.Description()
+	case latestseries.FieldGenre:
+		return m.Genre()
+	case latestseries.FieldFetchDate:
+		return m.FetchDate()
+	case latestseries.FieldChapterCount:
+		return m.ChapterCount()
+	case latestseries.FieldLatestChapter:
+		return m.LatestChapter()
+	case latestseries.FieldLatestChapterTitle:
+		return m.LatestChapterTitle()
+	case latestseries.FieldStatus:
+		return m.Status()
+	case latestseries.FieldInLibrary:
+		return m.InLibrary()
+	case latestseries.FieldSeriesID:
+		return m.SeriesID()
+	case latestseries.FieldChapters:
+		return m.Chapters()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LatestSeriesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case latestseries.FieldSuwayomiSourceID:
+		return m.OldSuwayomiSourceID(ctx)
+	case latestseries.FieldProvider:
+		return m.OldProvider(ctx)
+	case latestseries.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case latestseries.FieldURL:
+		return m.OldURL(ctx)
+	case latestseries.FieldTitle:
+		return m.OldTitle(ctx)
+	case latestseries.FieldThumbnailURL:
+		return m.OldThumbnailURL(ctx)
+	case latestseries.FieldArtist:
+		return m.OldArtist(ctx)
+	case latestseries.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case latestseries.FieldDescription:
+		return m.OldDescription(ctx)
+	case latestseries.FieldGenre:
+		return m.OldGenre(ctx)
+	case latestseries.FieldFetchDate:
+		return m.OldFetchDate(ctx)
+	case latestseries.FieldChapterCount:
+		return m.OldChapterCount(ctx)
+	case latestseries.FieldLatestChapter:
+		return m.OldLatestChapter(ctx)
+	case latestseries.FieldLatestChapterTitle:
+		return m.OldLatestChapterTitle(ctx)
+	case latestseries.FieldStatus:
+		return m.OldStatus(ctx)
+	case latestseries.FieldInLibrary:
+		return m.OldInLibrary(ctx)
+	case latestseries.FieldSeriesID:
+		return m.OldSeriesID(ctx)
+	case latestseries.FieldChapters:
+		return m.OldChapters(ctx)
+	}
+	return nil, fmt.Errorf("unknown LatestSeries field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LatestSeriesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case latestseries.FieldSuwayomiSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuwayomiSourceID(v)
+		return nil
+	case latestseries.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case latestseries.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case latestseries.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case latestseries.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case latestseries.FieldThumbnailURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailURL(v)
+		return nil
+	case latestseries.FieldArtist:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtist(v)
+		return nil
+	case latestseries.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case latestseries.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case latestseries.FieldGenre:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenre(v)
+		return nil
+	case latestseries.FieldFetchDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFetchDate(v)
+		return nil
+	case latestseries.FieldChapterCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChapterCount(v)
+		return nil
+	case latestseries.FieldLatestChapter:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatestChapter(v)
+		return nil
+	case latestseries.FieldLatestChapterTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatestChapterTitle(v)
+		return nil
+	case latestseries.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case latestseries.FieldInLibrary:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInLibrary(v)
+		return nil
+	case latestseries.FieldSeriesID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesID(v)
+		return nil
+	case latestseries.FieldChapters:
+		v, ok := value.([]types.SuwayomiChapter)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChapters(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LatestSeries field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LatestSeriesMutation) AddedFields() []string {
+	var fields []string
+	if m.addchapter_count != nil {
+		fields = append(fields, latestseries.FieldChapterCount)
+	}
+	if m.addlatest_chapter != nil {
+		fields = append(fields, latestseries.FieldLatestChapter)
+	}
+	if m.addin_library != nil {
+		fields = append(fields, latestseries.FieldInLibrary)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LatestSeriesMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case latestseries.FieldChapterCount:
+		return m.AddedChapterCount()
+	case latestseries.FieldLatestChapter:
+		return m.AddedLatestChapter()
+	case latestseries.FieldInLibrary:
+		return m.AddedInLibrary()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LatestSeriesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case latestseries.FieldChapterCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChapterCount(v)
+		return nil
+	case latestseries.FieldLatestChapter:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatestChapter(v)
+		return nil
+	case latestseries.FieldInLibrary:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInLibrary(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LatestSeries numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LatestSeriesMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(latestseries.FieldURL) {
+		fields = append(fields, latestseries.FieldURL)
+	}
+	if m.FieldCleared(latestseries.FieldThumbnailURL) {
+		fields = append(fields, latestseries.FieldThumbnailURL)
+	}
+	if m.FieldCleared(latestseries.FieldArtist) {
+		fields = append(fields, latestseries.FieldArtist)
+	}
+	if m.FieldCleared(latestseries.FieldAuthor) {
+		fields = append(fields, latestseries.FieldAuthor)
+	}
+	if m.FieldCleared(latestseries.FieldDescription) {
+		fields = append(fields, latestseries.FieldDescription)
+	}
+	if m.FieldCleared(latestseries.FieldGenre) {
+		fields = append(fields, latestseries.FieldGenre)
+	}
+	if m.FieldCleared(latestseries.FieldChapterCount) {
+		fields = append(fields, latestseries.FieldChapterCount)
+	}
+	if m.FieldCleared(latestseries.FieldLatestChapter) {
+		fields = append(fields, latestseries.FieldLatestChapter)
+	}
+	if m.FieldCleared(latestseries.FieldSeriesID) {
+		fields = append(fields, latestseries.FieldSeriesID)
+	}
+	if m.FieldCleared(latestseries.FieldChapters) {
+		fields = append(fields, latestseries.FieldChapters)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LatestSeriesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LatestSeriesMutation) ClearField(name string) error {
+	switch name {
+	case latestseries.FieldURL:
+		m.ClearURL()
+		return nil
+	case latestseries.FieldThumbnailURL:
+		m.ClearThumbnailURL()
+		return nil
+	case latestseries.FieldArtist:
+		m.ClearArtist()
+		return nil
+	case latestseries.FieldAuthor:
+		m.ClearAuthor()
+		return nil
+	case latestseries.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case latestseries.FieldGenre:
+		m.ClearGenre()
+		return nil
+	case latestseries.FieldChapterCount:
+		m.ClearChapterCount()
+		return nil
+	case latestseries.FieldLatestChapter:
+		m.ClearLatestChapter()
+		return nil
+	case latestseries.FieldSeriesID:
+		m.ClearSeriesID()
+		return nil
+	case latestseries.FieldChapters:
+		m.ClearChapters()
+		return nil
+	}
+	return fmt.Errorf("unknown LatestSeries nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LatestSeriesMutation) ResetField(name string) error {
+	switch name {
+	case latestseries.FieldSuwayomiSourceID:
+		m.ResetSuwayomiSourceID()
+		return nil
+	case latestseries.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case latestseries.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case latestseries.FieldURL:
+		m.ResetURL()
+		return nil
+	case latestseries.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case latestseries.FieldThumbnailURL:
+		m.ResetThumbnailURL()
+		return nil
+	case latestseries.FieldArtist:
+		m.ResetArtist()
+		return nil
+	case latestseries.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case latestseries.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case latestseries.FieldGenre:
+		m.ResetGenre()
+		return nil
+	case latestseries.FieldFetchDate:
+		m.ResetFetchDate()
+		return nil
+	case latestseries.FieldChapterCount:
+		m.ResetChapterCount()
+		return nil
+	case latestseries.FieldLatestChapter:
+		m.ResetLatestChapter()
+		return nil
+	case latestseries.FieldLatestChapterTitle:
+		m.ResetLatestChapterTitle()
+		return nil
+	case latestseries.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case latestseries.FieldInLibrary:
+		m.ResetInLibrary()
+		return nil
+	case latestseries.FieldSeriesID:
+		m.ResetSeriesID()
+		return nil
+	case latestseries.FieldChapters:
+		m.ResetChapters()
+		return nil
+	}
+	return fmt.Errorf("unknown LatestSeries field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LatestSeriesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.series != nil {
+		edges = append(edges, latestseries.EdgeSeries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LatestSeriesMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case latestseries.EdgeSeries:
+		if id := m.series; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LatestSeriesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LatestSeriesMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LatestSeriesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedseries {
+		edges = append(edges, latestseries.EdgeSeries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LatestSeriesMutation) EdgeCleared(name string) bool {
+	switch name {
+	case latestseries.EdgeSeries:
+		return m.clearedseries
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LatestSeriesMutation) ClearEdge(name string) error {
+	switch name {
+	case latestseries.EdgeSeries:
+		m.ClearSeries()
+		return nil
+	}
+	return fmt.Errorf("unknown LatestSeries unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LatestSeriesMutation) ResetEdge(name string) error {
+	switch name {
+	case latestseries.EdgeSeries:
+		m.ResetSeries()
+		return nil
+	}
+	return fmt.Errorf("unknown LatestSeries edge %s", name)
+}
+
+// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
+type NotificationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	notifier_id   *uuid.UUID
+	event_type    *string
+	title         *string
+	message       *string
+	urgent        *bool
+	status        *string
+	error         *string
+	created_at    *time.Time
+	sent_at       *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Notification, error)
+	predicates    []predicate.Notification
+}
+
+var _ ent.Mutation = (*NotificationMutation)(nil)
+
+// notificationOption allows management of the mutation configuration using functional options.
+type notificationOption func(*NotificationMutation)
+
+// newNotificationMutation creates new mutation for the Notification entity.
+func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
+	m := &NotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationID sets the ID field of the mutation.
+func withNotificationID(id uuid.UUID) notificationOption {
+	return func(m *NotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notification
+		)
+		m.oldValue = func(ctx context.Context) (*Notification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotification sets the old Notification of the mutation.
+func withNotification(node *Notification) notificationOption {
+	return func(m *NotificationMutation) {
+		m.oldValue = func(context.Context) (*Notification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Notification entities.
+func (m *NotificationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNotifierID sets the "notifier_id" field.
+func (m *NotificationMutation) SetNotifierID(u uuid.UUID) {
+	m.notifier_id = &u
+}
+
+// NotifierID returns the value of the "notifier_id" field in the mutation.
+func (m *NotificationMutation) NotifierID() (r uuid.UUID, exists bool) {
+	v := m.notifier_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotifierID returns the old "notifier_id" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldNotifierID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotifierID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotifierID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotifierID: %w", err)
+	}
+	return oldValue.NotifierID, nil
+}
+
+// ResetNotifierID resets all changes to the "notifier_id" field.
+func (m *NotificationMutation) ResetNotifierID() {
+	m.notifier_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *NotificationMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *NotificationMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *NotificationMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *NotificationMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *NotificationMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *NotificationMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *NotificationMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *NotificationMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *NotificationMutation) ResetMessage() {
+	m.message = nil
+}
+
+// SetUrgent sets the "urgent" field.
+func (m *NotificationMutation) SetUrgent(b bool) {
+	m.urgent = &b
+}
+
+// Urgent returns the value of the "urgent" field in the mutation.
+func (m *NotificationMutation) Urgent() (r bool, exists bool) {
+	v := m.urgent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUrgent returns the old "urgent" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldUrgent(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUrgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUrgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUrgent: %w", err)
+	}
+	return oldValue.Urgent, nil
+}
+
+// ResetUrgent resets all changes to the "urgent" field.
+func (m *NotificationMutation) ResetUrgent() {
+	m.urgent = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *NotificationMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *NotificationMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *NotificationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *NotificationMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *NotificationMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *NotificationMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[notification.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *NotificationMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[notification.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *NotificationMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, notification.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *NotificationMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *NotificationMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (m *NotificationMutation) ClearSentAt() {
+	m.sent_at = nil
+	m.clearedFields[notification.FieldSentAt] = struct{}{}
+}
+
+// SentAtCleared returns if the "sent_at" field was cleared in this mutation.
+func (m *NotificationMutation) SentAtCleared() bool {
+	_, ok := m.clearedFields[notification.FieldSentAt]
+	return ok
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *NotificationMutation) ResetSentAt() {
+	m.sent_at = nil
+	delete(m.clearedFields, notification.FieldSentAt)
+}
+
+// Where appends a list predicates to the NotificationMutation builder.
+func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Notification).
+func (m *NotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.notifier_id != nil {
+		fields = append(fields, notification.FieldNotifierID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, notification.FieldEventType)
+	}
+	if m.title != nil {
+		fields = append(fields, notification.FieldTitle)
+	}
+	if m.message != nil {
+		fields = append(fields, notification.FieldMessage)
+	}
+	if m.urgent != nil {
+		fields = append(fields, notification.FieldUrgent)
+	}
+	if m.status != nil {
+		fields = append(fields, notification.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, notification.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notification.FieldCreatedAt)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, notification.FieldSentAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldNotifierID:
+		return m.NotifierID()
+	case notification.FieldEventType:
+		return m.EventType()
+	case notification.FieldTitle:
+		return m.Title()
+	case notification.FieldMessage:
+		return m.Message()
+	case notification.FieldUrgent:
+		return m.Urgent()
+	case notification.FieldStatus:
+		return m.Status()
+	case notification.FieldError:
+		return m.Error()
+	case notification.FieldCreatedAt:
+		return m.CreatedAt()
+	case notification.FieldSentAt:
+		return m.SentAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notification.FieldNotifierID:
+		return m.OldNotifierID(ctx)
+	case notification.FieldEventType:
+		return m.OldEventType(ctx)
+	case notification.FieldTitle:
+		return m.OldTitle(ctx)
+	case notification.FieldMessage:
+		return m.OldMessage(ctx)
+	case notification.FieldUrgent:
+		return m.OldUrgent(ctx)
+	case notification.FieldStatus:
+		return m.OldStatus(ctx)
+	case notification.FieldError:
+		return m.OldError(ctx)
+	case notification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notification.FieldSentAt:
+		return m.OldSentAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldNotifierID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotifierID(v)
+		return nil
+	case notification.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case notification.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case notification.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case notification.FieldUrgent:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUrgent(v)
+		return nil
+	case notification.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case notification.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case notification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notification.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Notification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notification.FieldError) {
+		fields = append(fields, notification.FieldError)
+	}
+	if m.FieldCleared(notification.FieldSentAt) {
+		fields = append(fields, notification.FieldSentAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationMutation) ClearField(name string) error {
+	switch name {
+	case notification.FieldError:
+		m.ClearError()
+		return nil
+	case notification.FieldSentAt:
+		m.ClearSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationMutation) ResetField(name string) error {
+	switch name {
+	case notification.FieldNotifierID:
+		m.ResetNotifierID()
+		return nil
+	case notification.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case notification.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case notification.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case notification.FieldUrgent:
+		m.ResetUrgent()
+		return nil
+	case notification.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case notification.FieldError:
+		m.ResetError()
+		return nil
+	case notification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notification.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Notification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Notification edge %s", name)
+}
+
+// NotifierMutation represents an operation that mutates the Notifier nodes in the graph.
+type NotifierMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	kind             *string
+	url              *string
+	topic            *string
+	token            *string
+	events           *[]string
+	appendevents     []string
+	title_template   *string
+	message_template *string
+	quiet_start      *string
+	quiet_end        *string
+	enabled          *bool
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*Notifier, error)
+	predicates       []predicate.Notifier
+}
+
+var _ ent.Mutation = (*NotifierMutation)(nil)
+
+// notifierOption allows management of the mutation configuration using functional options.
+type notifierOption func(*NotifierMutation)
+
+// newNotifierMutation creates new mutation for the Notifier entity.
+func newNotifierMutation(c config, op Op, opts ...notifierOption) *NotifierMutation {
+	m := &NotifierMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotifier,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotifierID sets the ID field of the mutation.
+func withNotifierID(id uuid.UUID) notifierOption {
+	return func(m *NotifierMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notifier
+		)
+		m.oldValue = func(ctx context.Context) (*Notifier, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notifier.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotifier sets the old Notifier of the mutation.
+func withNotifier(node *Notifier) notifierOption {
+	return func(m *NotifierMutation) {
+		m.oldValue = func(context.Context) (*Notifier, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotifierMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotifierMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Notifier entities.
+func (m *NotifierMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotifierMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotifierMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notifier.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *NotifierMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NotifierMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *NotifierMutation) ResetName() {
+	m.name = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *NotifierMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *NotifierMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *NotifierMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetURL sets the "url" field.
+func (m *NotifierMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *NotifierMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *NotifierMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetTopic sets the "topic" field.
+func (m *NotifierMutation) SetTopic(s string) {
+	m.topic = &s
+}
+
+// Topic returns the value of the "topic" field in the mutation.
+func (m *NotifierMutation) Topic() (r string, exists bool) {
+	v := m.topic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopic returns the old "topic" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldTopic(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopic: %w", err)
+	}
+	return oldValue.Topic, nil
+}
+
+// ClearTopic clears the value of the "topic" field.
+func (m *NotifierMutation) ClearTopic() {
+	m.topic = nil
+	m.clearedFields[notifier.FieldTopic] = struct{}{}
+}
+
+// TopicCleared returns if the "topic" field was cleared in this mutation.
+func (m *NotifierMutation) TopicCleared() bool {
+	_, ok := m.clearedFields[notifier.FieldTopic]
+	return ok
+}
+
+// ResetTopic resets all changes to the "topic" field.
+func (m *NotifierMutation) ResetTopic() {
+	m.topic = nil
+	delete(m.clearedFields, notifier.FieldTopic)
+}
+
+// SetToken sets the "token" field.
+func (m *NotifierMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *NotifierMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ClearToken clears the value of the "token" field.
+func (m *NotifierMutation) ClearToken() {
+	m.token = nil
+	m.clearedFields[notifier.FieldToken] = struct{}{}
+}
+
+// TokenCleared returns if the "token" field was cleared in this mutation.
+func (m *NotifierMutation) TokenCleared() bool {
+	_, ok := m.clearedFields[notifier.FieldToken]
+	return ok
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *NotifierMutation) ResetToken() {
+	m.token = nil
+	delete(m.clearedFields, notifier.FieldToken)
+}
+
+// SetEvents sets the "events" field.
+func (m *NotifierMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *NotifierMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// AppendEvents adds s to the "events" field.
+func (m *NotifierMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
+}
+
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *NotifierMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ClearEvents clears the value of the "events" field.
+func (m *NotifierMutation) ClearEvents() {
+	m.events = nil
+	m.appendevents = nil
+	m.clearedFields[notifier.FieldEvents] = struct{}{}
+}
+
+// EventsCleared returns if the "events" field was cleared in this mutation.
+func (m *NotifierMutation) EventsCleared() bool {
+	_, ok := m.clearedFields[notifier.FieldEvents]
+	return ok
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *NotifierMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+	delete(m.clearedFields, notifier.FieldEvents)
+}
+
+// SetTitleTemplate sets the "title_template" field.
+func (m *NotifierMutation) SetTitleTemplate(s string) {
+	m.title_template = &s
+}
+
+// TitleTemplate returns the value of the "title_template" field in the mutation.
+func (m *NotifierMutation) TitleTemplate() (r string, exists bool) {
+	v := m.title_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitleTemplate returns the old "title_template" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldTitleTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitleTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitleTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitleTemplate: %w", err)
+	}
+	return oldValue.TitleTemplate, nil
+}
+
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (m *NotifierMutation) ClearTitleTemplate() {
+	m.title_template = nil
+	m.clearedFields[notifier.FieldTitleTemplate] = struct{}{}
+}
+
+// TitleTemplateCleared returns if the "title_template" field was cleared in this mutation.
+func (m *NotifierMutation) TitleTemplateCleared() bool {
+	_, ok := m.clearedFields[notifier.FieldTitleTemplate]
+	return ok
+}
+
+// ResetTitleTemplate resets all changes to the "title_template" field.
+func (m *NotifierMutation) ResetTitleTemplate() {
+	m.title_template = nil
+	delete(m.clearedFields, notifier.FieldTitleTemplate)
+}
+
+// SetMessageTemplate sets the "message_template" field.
+func (m *NotifierMutation) SetMessageTemplate(s string) {
+	m.message_template = &s
+}
+
+// MessageTemplate returns the value of the "message_template" field in the mutation.
+func (m *NotifierMutation) MessageTemplate() (r string, exists bool) {
+	v := m.message_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageTemplate returns the old "message_template" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldMessageTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageTemplate: %w", err)
+	}
+	return oldValue.MessageTemplate, nil
+}
+
+// ClearMessageTemplate clears the value of the "message_template" field.
+func (m *NotifierMutation) ClearMessageTemplate() {
+	m.message_template = nil
+	m.clearedFields[notifier.FieldMessageTemplate] = struct{}{}
+}
+
+// MessageTemplateCleared returns if the "message_template" field was cleared in this mutation.
+func (m *NotifierMutation) MessageTemplateCleared() bool {
+	_, ok := m.clearedFields[notifier.FieldMessageTemplate]
+	return ok
+}
+
+// ResetMessageTemplate resets all changes to the "message_template" field.
+func (m *NotifierMutation) ResetMessageTemplate() {
+	m.message_template = nil
+	delete(m.clearedFields, notifier.FieldMessageTemplate)
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (m *NotifierMutation) SetQuietStart(s string) {
+	m.quiet_start = &s
+}
+
+// QuietStart returns the value of the "quiet_start" field in the mutation.
+func (m *NotifierMutation) QuietStart() (r string, exists bool) {
+	v := m.quiet_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuietStart returns the old "quiet_start" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldQuietStart(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietStart: %w", err)
+	}
+	return oldValue.QuietStart, nil
+}
+
+// ClearQuietStart clears the value of the "quiet_start" field.
+func (m *NotifierMutation) ClearQuietStart() {
+	m.quiet_start = nil
+	m.clearedFields[notifier.FieldQuietStart] = struct{}{}
+}
+
+// QuietStartCleared returns if the "quiet_start" field was cleared in this mutation.
+func (m *NotifierMutation) QuietStartCleared() bool {
+	_, ok := m.clearedFields[notifier.FieldQuietStart]
+	return ok
+}
+
+// ResetQuietStart resets all changes to the "quiet_start" field.
+func (m *NotifierMutation) ResetQuietStart() {
+	m.quiet_start = nil
+	delete(m.clearedFields, notifier.FieldQuietStart)
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (m *NotifierMutation) SetQuietEnd(s string) {
+	m.quiet_end = &s
+}
+
+// QuietEnd returns the value of the "quiet_end" field in the mutation.
+func (m *NotifierMutation) QuietEnd() (r string, exists bool) {
+	v := m.quiet_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuietEnd returns the old "quiet_end" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldQuietEnd(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuietEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuietEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuietEnd: %w", err)
+	}
+	return oldValue.QuietEnd, nil
+}
+
+// ClearQuietEnd clears the value of the "quiet_end" field.
+func (m *NotifierMutation) ClearQuietEnd() {
+	m.quiet_end = nil
+	m.clearedFields[notifier.FieldQuietEnd] = struct{}{}
+}
+
+// QuietEndCleared returns if the "quiet_end" field was cleared in this mutation.
+func (m *NotifierMutation) QuietEndCleared() bool {
+	_, ok := m.clearedFields[notifier.FieldQuietEnd]
+	return ok
+}
+
+// ResetQuietEnd resets all changes to the "quiet_end" field.
+func (m *NotifierMutation) ResetQuietEnd() {
+	m.quiet_end = nil
+	delete(m.clearedFields, notifier.FieldQuietEnd)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *NotifierMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *NotifierMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *NotifierMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotifierMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotifierMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotifierMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotifierMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotifierMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Notifier entity.
+// If the Notifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotifierMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotifierMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the NotifierMutation builder.
+func (m *NotifierMutation) Where(ps ...predicate.Notifier) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotifierMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotifierMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notifier, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotifierMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotifierMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Notifier).
+func (m *NotifierMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotifierMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.name != nil {
+		fields = append(fields, notifier.FieldName)
+	}
+	if m.kind != nil {
+		fields = append(fields, notifier.FieldKind)
+	}
+	if m.url != nil {
+		fields = append(fields, notifier.FieldURL)
+	}
+	if m.topic != nil {
+		fields = append(fields, notifier.FieldTopic)
+	}
+	if m.token != nil {
+		fields = append(fields, notifier.FieldToken)
+	}
+	if m.events != nil {
+		fields = append(fields, notifier.FieldEvents)
+	}
+	if m.title_template != nil {
+		fields = append(fields, notifier.FieldTitleTemplate)
+	}
+	if m.message_template != nil {
+		fields = append(fields, notifier.FieldMessageTemplate)
+	}
+	if m.quiet_start != nil {
+		fields = append(fields, notifier.FieldQuietStart)
+	}
+	if m.quiet_end != nil {
+		fields = append(fields, notifier.FieldQuietEnd)
+	}
+	if m.enabled != nil {
+		fields = append(fields, notifier.FieldEnabled)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notifier.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notifier.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotifierMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notifier.FieldName:
+		return m.Name()
+	case notifier.FieldKind:
+		return m.Kind()
+	case notifier.FieldURL:
+		return m.URL()
+	case notifier.FieldTopic:
+		return m.Topic()
+	case notifier.FieldToken:
+		return m.Token()
+	case notifier.FieldEvents:
+		return m.Events()
+	case notifier.FieldTitleTemplate:
+		return m.TitleTemplate()
+	case notifier.FieldMessageTemplate:
+		return m.MessageTemplate()
+	case notifier.FieldQuietStart:
+		return m.QuietStart()
+	case notifier.FieldQuietEnd:
+		return m.QuietEnd()
+	case notifier.FieldEnabled:
+		return m.Enabled()
+	case notifier.FieldCreatedAt:
+		return m.CreatedAt()
+	case notifier.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotifierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notifier.FieldName:
+		return m.OldName(ctx)
+	case notifier.FieldKind:
+		return m.OldKind(ctx)
+	case notifier.FieldURL:
+		return m.OldURL(ctx)
+	case notifier.FieldTopic:
+		return m.OldTopic(ctx)
+	case notifier.FieldToken:
+		return m.OldToken(ctx)
+	case notifier.FieldEvents:
+		return m.OldEvents(ctx)
+	case notifier.FieldTitleTemplate:
+		return m.OldTitleTemplate(ctx)
+	case notifier.FieldMessageTemplate:
+		return m.OldMessageTemplate(ctx)
+	case notifier.FieldQuietStart:
+		return m.OldQuietStart(ctx)
+	case notifier.FieldQuietEnd:
+		return m.OldQuietEnd(ctx)
+	case notifier.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case notifier.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notifier.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notifier field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotifierMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notifier.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case notifier.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case notifier.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case notifier.FieldTopic:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopic(v)
+		return nil
+	case notifier.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case notifier.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case notifier.FieldTitleTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitleTemplate(v)
+		return nil
+	case notifier.FieldMessageTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageTemplate(v)
+		return nil
+	case notifier.FieldQuietStart:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietStart(v)
+		return nil
+	case notifier.FieldQuietEnd:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuietEnd(v)
+		return nil
+	case notifier.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case notifier.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notifier.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notifier field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotifierMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotifierMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotifierMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Notifier numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotifierMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notifier.FieldTopic) {
+		fields = append(fields, notifier.FieldTopic)
+	}
+	if m.FieldCleared(notifier.FieldToken) {
+		fields = append(fields, notifier.FieldToken)
+	}
+	if m.FieldCleared(notifier.FieldEvents) {
+		fields = append(fields, notifier.FieldEvents)
+	}
+	if m.FieldCleared(notifier.FieldTitleTemplate) {
+		fields = append(fields, notifier.FieldTitleTemplate)
+	}
+	if m.FieldCleared(notifier.FieldMessageTemplate) {
+		fields = append(fields, notifier.FieldMessageTemplate)
+	}
+	if m.FieldCleared(notifier.FieldQuietStart) {
+		fields = append(fields, notifier.FieldQuietStart)
+	}
+	if m.FieldCleared(notifier.FieldQuietEnd) {
+		fields = append(fields, notifier.FieldQuietEnd)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotifierMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotifierMutation) ClearField(name string) error {
+	switch name {
+	case notifier.FieldTopic:
+		m.ClearTopic()
+		return nil
+	case notifier.FieldToken:
+		m.ClearToken()
+		return nil
+	case notifier.FieldEvents:
+		m.ClearEvents()
+		return nil
+	case notifier.FieldTitleTemplate:
+		m.ClearTitleTemplate()
+		return nil
+	case notifier.FieldMessageTemplate:
+		m.ClearMessageTemplate()
+		return nil
+	case notifier.FieldQuietStart:
+		m.ClearQuietStart()
+		return nil
+	case notifier.FieldQuietEnd:
+		m.ClearQuietEnd()
+		return nil
+	}
+	return fmt.Errorf("unknown Notifier nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotifierMutation) ResetField(name string) error {
+	switch name {
+	case notifier.FieldName:
+		m.ResetName()
+		return nil
+	case notifier.FieldKind:
+		m.ResetKind()
+		return nil
+	case notifier.FieldURL:
+		m.ResetURL()
+		return nil
+	case notifier.FieldTopic:
+		m.ResetTopic()
+		return nil
+	case notifier.FieldToken:
+		m.ResetToken()
+		return nil
+	case notifier.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case notifier.FieldTitleTemplate:
+		m.ResetTitleTemplate()
+		return nil
+	case notifier.FieldMessageTemplate:
+		m.ResetMessageTemplate()
+		return nil
+	case notifier.FieldQuietStart:
+		m.ResetQuietStart()
+		return nil
+	case notifier.FieldQuietEnd:
+		m.ResetQuietEnd()
+		return nil
+	case notifier.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case notifier.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notifier.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notifier field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotifierMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotifierMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotifierMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotifierMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotifierMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotifierMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotifierMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Notifier unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotifierMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Notifier edge %s", name)
+}
+
+// ProviderStorageMutation represents an operation that mutates the ProviderStorage nodes in the graph.
+type ProviderStorageMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	apk_name        *string
+	pkg_name        *string
+	name            *string
+	lang            *string
+	version_code    *int64
+	addversion_code *int64
+	is_storage      *bool
+	is_disabled     *bool
+	is_obsolete     *bool
+	mappings        *[]types.ProviderMapping
+	appendmappings  []types.ProviderMapping
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*ProviderStorage, error)
+	predicates      []predicate.ProviderStorage
+}
+
+var _ ent.Mutation = (*ProviderStorageMutation)(nil)
+
+// providerstorageOption allows management of the mutation configuration using functional options.
+type providerstorageOption func(*ProviderStorageMutation)
+
+// newProviderStorageMutation creates new mutation for the ProviderStorage entity.
+func newProviderStorageMutation(c config, op Op, opts ...providerstorageOption) *ProviderStorageMutation {
+	m := &ProviderStorageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProviderStorage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProviderStorageID sets the ID field of the mutation.
+func withProviderStorageID(id uuid.UUID) providerstorageOption {
+	return func(m *ProviderStorageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProviderStorage
+		)
+		m.oldValue = func(ctx context.Context) (*ProviderStorage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProviderStorage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProviderStorage sets the old ProviderStorage of the mutation.
+func withProviderStorage(node *ProviderStorage) providerstorageOption {
+	return func(m *ProviderStorageMutation) {
+		m.oldValue = func(context.Context) (*ProviderStorage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProviderStorageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProviderStorageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProviderStorage entities.
+func (m *ProviderStorageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProviderStorageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProviderStorageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProviderStorage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetApkName sets the "apk_name" field.
+func (m *ProviderStorageMutation) SetApkName(s string) {
+	m.apk_name = &s
+}
+
+// ApkName returns the value of the "apk_name" field in the mutation.
+func (m *ProviderStorageMutation) ApkName() (r string, exists bool) {
+	v := m.apk_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApkName returns the old "apk_name" field's value of the ProviderStorage entity.
+// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderStorageMutation) OldApkName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApkName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApkName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApkName: %w", err)
+	}
+	return oldValue.ApkName, nil
+}
+
+// ResetApkName resets all changes to the "apk_name" field.
+func (m *ProviderStorageMutation) ResetApkName() {
+	m.apk_name = nil
+}
+
+// SetPkgName sets the "pkg_name" field.
+func (m *ProviderStorageMutation) SetPkgName(s string) {
+	m.pkg_name = &s
+}
+
+// PkgName returns the value of the "pkg_name" field in the mutation.
+func (m *ProviderStorageMutation) PkgName() (r string, exists bool) {
+	v := m.pkg_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPkgName returns the old "pkg_name" field's value of the ProviderStorage entity.
+// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderStorageMutation) OldPkgName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPkgName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPkgName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPkgName: %w", err)
+	}
+	return oldValue.PkgName, nil
+}
+
+// ResetPkgName resets all changes to the "pkg_name" field.
+func (m *ProviderStorageMutation) ResetPkgName() {
+	m.pkg_name = nil
+}
+
+// SetName sets the "name" field.
+func (m *ProviderStorageMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProviderStorageMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ProviderStorage entity.
+// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderStorageMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProviderStorageMutation) ResetName() {
+	m.name = nil
+}
+
+// SetLang sets the "lang" field.
+func (m *ProviderStorageMutation) SetLang(s string) {
+	m.lang = &s
+}
+
+// Lang returns the value of the "lang" field in the mutation.
+func (m *ProviderStorageMutation) Lang() (r string, exists bool) {
+	v := m.lang
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLang returns the old "lang" field's value of the ProviderStorage entity.
+// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderStorageMutation) OldLang(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLang is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLang requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLang: %w", err)
+	}
+	return oldValue.Lang, nil
+}
+
+// ResetLang resets all changes to the "lang" field.
+func (m *ProviderStorageMutation) ResetLang() {
+	m.lang = nil
+}
+
+// SetVersionCode sets the "version_code" field.
+func (m *ProviderStorageMutation) SetVersionCode(i int64) {
+	m.version_code = &i
+	m.addversion_code = nil
+}
+
+// VersionCode returns the value of the "version_code" field in the mutation.
+func (m *ProviderStorageMutation) VersionCode() (r int64, exists bool) {
+	v := m.version_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersionCode returns the old "version_code" field's value of the ProviderStorage entity.
+// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderStorageMutation) OldVersionCode(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersionCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersionCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersionCode: %w", err)
+	}
+	return oldValue.VersionCode, nil
+}
+
+// AddVersionCode adds i to the "version_code" field.
+func (m *ProviderStorageMutation) AddVersionCode(i int64) {
+	if m.addversion_code != nil {
+		*m.addversion_code += i
+	} else {
+		m.addversion_code = &i
+	}
+}
+
+// AddedVersionCode returns the value that was added to the "version_code" field in this mutation.
+func (m *ProviderStorageMutation) AddedVersionCode() (r int64, exists bool) {
+	v := m.addversion_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersionCode resets all changes to the "version_code" field.
+func (m *ProviderStorageMutation) ResetVersionCode() {
+	m.version_code = nil
+	m.addversion_code = nil
+}
+
+// SetIsStorage sets the "is_storage" field.
+func (m *ProviderStorageMutation) SetIsStorage(b bool) {
+	m.is_storage = &b
+}
+
+// IsStorage returns the value of the "is_storage" field in the mutation.
+func (m *ProviderStorageMutation) IsStorage() (r bool, exists bool) {
+	v := m.is_storage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsStorage returns the old "is_storage" field's value of the ProviderStorage entity.
+// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderStorageMutation) OldIsStorage(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsStorage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsStorage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsStorage: %w", err)
+	}
+	return oldValue.IsStorage, nil
+}
+
+// ResetIsStorage resets all changes to the "is_storage" field.
+func (m *ProviderStorageMutation) ResetIsStorage() {
+	m.is_storage = nil
+}
+
+// SetIsDisabled sets the "is_disabled" field.
+func (m *ProviderStorageMutation) SetIsDisabled(b bool) {
+	m.is_disabled = &b
+}
+
+// IsDisabled returns the value of the "is_disabled" field in the mutation.
+func (m *ProviderStorageMutation) IsDisabled() (r bool, exists bool) {
+	v := m.is_disabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDisabled returns the old "is_disabled" field's value of the ProviderStorage entity.
+// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderStorageMutation) OldIsDisabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDisabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDisabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDisabled: %w", err)
+	}
+	return oldValue.IsDisabled, nil
+}
+
+// ResetIsDisabled resets all changes to the "is_disabled" field.
+func (m *ProviderStorageMutation) ResetIsDisabled() {
+	m.is_disabled = nil
+}
+
+// SetIsObsolete sets the "is_obsolete" field.
+func (m *ProviderStorageMutation) SetIsObsolete(b bool) {
+	m.is_obsolete = &b
+}
+
+// IsObsolete returns the value of the "is_obsolete" field in the mutation.
+func (m *ProviderStorageMutation) IsObsolete() (r bool, exists bool) {
+	v := m.is_obsolete
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsObsolete returns the old "is_obsolete" field's value of the ProviderStorage entity.
+// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderStorageMutation) OldIsObsolete(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsObsolete is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsObsolete requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsObsolete: %w", err)
+	}
+	return oldValue.IsObsolete, nil
+}
+
+// ResetIsObsolete resets all changes to the "is_obsolete" field.
+func (m *ProviderStorageMutation) ResetIsObsolete() {
+	m.is_obsolete = nil
+}
+
+// SetMappings sets the "mappings" field.
+func (m *ProviderStorageMutation) SetMappings(tm []types.ProviderMapping) {
+	m.mappings = &tm
+	m.appendmappings = nil
+}
+
+// Mappings returns the value of the "mappings" field in the mutation.
+func (m *ProviderStorageMutation) Mappings() (r []types.ProviderMapping, exists bool) {
+	v := m.mappings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMappings returns the old "mappings" field's value of the ProviderStorage entity.
+// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProviderStorageMutation) OldMappings(ctx context.Context) (v []types.ProviderMapping, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMappings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMappings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMappings: %w", err)
+	}
+	return oldValue.Mappings, nil
+}
+
+// AppendMappings adds tm to the "mappings" field.
+func (m *ProviderStorageMutation) AppendMappings(tm []types.ProviderMapping) {
+	m.appendmappings = append(m.appendmappings, tm...)
+}
+
+// AppendedMappings returns the list of values that were appended to the "mappings" field in this mutation.
+func (m *ProviderStorageMutation) AppendedMappings() ([]types.ProviderMapping, bool) {
+	if len(m.appendmappings) == 0 {
+		return nil, false
+	}
+	return m.appendmappings, true
+}
+
+// ClearMappings clears the value of the "mappings" field.
+func (m *ProviderStorageMutation) ClearMappings() {
+	m.mappings = nil
+	m.appendmappings = nil
+	m.clearedFields[providerstorage.FieldMappings] = struct{}{}
+}
+
+// MappingsCleared returns if the "mappings" field was cleared in this mutation.
+func (m *ProviderStorageMutation) MappingsCleared() bool {
+	_, ok := m.clearedFields[providerstorage.FieldMappings]
+	return ok
+}
+
+// ResetMappings resets all changes to the "mappings" field.
+func (m *ProviderStorageMutation) ResetMappings() {
+	m.mappings = nil
+	m.appendmappings = nil
+	delete(m.clearedFields, providerstorage.FieldMappings)
+}
+
+// Where appends a list predicates to the ProviderStorageMutation builder.
+func (m *ProviderStorageMutation) Where(ps ...predicate.ProviderStorage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProviderStorageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProviderStorageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProviderStorage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProviderStorageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProviderStorageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProviderStorage).
+func (m *ProviderStorageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProviderStorageMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.apk_name != nil {
+		fields = append(fields, providerstorage.FieldApkName)
+	}
+	if m.pkg_name != nil {
+		fields = append(fields, providerstorage.FieldPkgName)
+	}
+	if m.name != nil {
+		fields = append(fields, providerstorage.FieldName)
+	}
+	if m.lang != nil {
+		fields = append(fields, providerstorage.FieldLang)
+	}
+	if m.version_code != nil {
+		fields = append(fields, providerstorage.FieldVersionCode)
+	}
+	if m.is_storage != nil {
+		fields = append(fields, providerstorage.FieldIsStorage)
+	}
+	if m.is_disabled != nil {
+		fields = append(fields, providerstorage.FieldIsDisabled)
+	}
+	if m.is_obsolete != nil {
+		fields = append(fields, providerstorage.FieldIsObsolete)
+	}
+	if m.mappings != nil {
+		fields = append(fields, providerstorage.FieldMappings)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProviderStorageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case providerstorage.FieldApkName:
+		return m.ApkName()
+	case providerstorage.FieldPkgName:
+		return m.PkgName()
+	case providerstorage.FieldName:
+		return m.Name()
+	case providerstorage.FieldLang:
+		return m.Lang()
+	case providerstorage.FieldVersionCode:
+		return m.VersionCode()
+	case providerstorage.FieldIsStorage:
+		return m.IsStorage()
+	case providerstorage.FieldIsDisabled:
+		return m.IsDisabled()
+	case providerstorage.FieldIsObsolete:
+		return m.IsObsolete()
+	case providerstorage.FieldMappings:
+		return m.Mappings()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProviderStorageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case providerstorage.FieldApkName:
+		return m.OldApkName(ctx)
+	case providerstorage.FieldPkgName:
+		return m.OldPkgName(ctx)
+	case providerstorage.FieldName:
+		return m.OldName(ctx)
+	case providerstorage.FieldLang:
+		return m.OldLang(ctx)
+	case providerstorage.FieldVersionCode:
+		return m.OldVersionCode(ctx)
+	case providerstorage.FieldIsStorage:
+		return m.OldIsStorage(ctx)
+	case providerstorage.FieldIsDisabled:
+		return m.OldIsDisabled(ctx)
+	case providerstorage.FieldIsObsolete:
+		return m.OldIsObsolete(ctx)
+	case providerstorage.FieldMappings:
+		return m.OldMappings(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProviderStorage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProviderStorageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case providerstorage.FieldApkName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApkName(v)
+		return nil
+	case providerstorage.FieldPkgName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPkgName(v)
+		return nil
+	case providerstorage.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case providerstorage.FieldLang:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLang(v)
+		return nil
+	case providerstorage.FieldVersionCode:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionCode(v)
+		return nil
+	case providerstorage.FieldIsStorage:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsStorage(v)
+		return nil
+	case providerstorage.FieldIsDisabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDisabled(v)
+		return nil
+	case providerstorage.FieldIsObsolete:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsObsolete(v)
+		return nil
+	case providerstorage.FieldMappings:
+		v, ok := value.([]types.ProviderMapping)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMappings(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProviderStorage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProviderStorageMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion_code != nil {
+		fields = append(fields, providerstorage.FieldVersionCode)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProviderStorageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case providerstorage.FieldVersionCode:
+		return m.AddedVersionCode()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProviderStorageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case providerstorage.FieldVersionCode:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersionCode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProviderStorage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProviderStorageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(providerstorage.FieldMappings) {
+		fields = append(fields, providerstorage.FieldMappings)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProviderStorageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProviderStorageMutation) ClearField(name string) error {
+	switch name {
+	case providerstorage.FieldMappings:
+		m.ClearMappings()
+		return nil
+	}
+	return fmt.Errorf("unknown ProviderStorage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProviderStorageMutation) ResetField(name string) error {
+	switch name {
+	case providerstorage.FieldApkName:
+		m.ResetApkName()
+		return nil
+	case providerstorage.FieldPkgName:
+		m.ResetPkgName()
+		return nil
+	case providerstorage.FieldName:
+		m.ResetName()
+		return nil
+	case providerstorage.FieldLang:
+		m.ResetLang()
+		return nil
+	case providerstorage.FieldVersionCode:
+		m.ResetVersionCode()
+		return nil
+	case providerstorage.FieldIsStorage:
+		m.ResetIsStorage()
+		return nil
+	case providerstorage.FieldIsDisabled:
+		m.ResetIsDisabled()
+		return nil
+	case providerstorage.FieldIsObsolete:
+		m.ResetIsObsolete()
+		return nil
+	case providerstorage.FieldMappings:
+		m.ResetMappings()
+		return nil
+	}
+	return fmt.Errorf("unknown ProviderStorage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProviderStorageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProviderStorageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProviderStorageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProviderStorageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProviderStorageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProviderStorageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProviderStorageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProviderStorage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProviderStorageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProviderStorage edge %s", name)
+}
+
+// ReadProgressMutation represents an operation that mutates the ReadProgress nodes in the graph.
+type ReadProgressMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	user_id           *uuid.UUID
+	series_id         *uuid.UUID
+	chapter_number    *float64
+	addchapter_number *float64
+	page              *int
+	addpage           *int
+	page_count        *int
+	addpage_count     *int
+	completed         *bool
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*ReadProgress, error)
+	predicates        []predicate.ReadProgress
+}
+
+var _ ent.Mutation = (*ReadProgressMutation)(nil)
+
+// readprogressOption allows management of the mutation configuration using functional options.
+type readprogressOption func(*ReadProgressMutation)
+
+// newReadProgressMutation creates new mutation for the ReadProgress entity.
+func newReadProgressMutation(c config, op Op, opts ...readprogressOption) *ReadProgressMutation {
+	m := &ReadProgressMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReadProgress,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReadProgressID sets the ID field of the mutation.
+func withReadProgressID(id uuid.UUID) readprogressOption {
+	return func(m *ReadProgressMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReadProgress
+		)
+		m.oldValue = func(ctx context.Context) (*ReadProgress, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReadProgress.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReadProgress sets the old ReadProgress of the mutation.
+func withReadProgress(node *ReadProgress) readprogressOption {
+	return func(m *ReadProgressMutation) {
+		m.oldValue = func(context.Context) (*ReadProgress, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReadProgressMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReadProgressMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReadProgress entities.
+func (m *ReadProgressMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReadProgressMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReadProgressMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReadProgress.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ReadProgressMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReadProgressMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ReadProgress entity.
+// If the ReadProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadProgressMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReadProgressMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetSeriesID sets the "series_id" field.
+func (m *ReadProgressMutation) SetSeriesID(u uuid.UUID) {
+	m.series_id = &u
+}
+
+// SeriesID returns the value of the "series_id" field in the mutation.
+func (m *ReadProgressMutation) SeriesID() (r uuid.UUID, exists bool) {
+	v := m.series_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesID returns the old "series_id" field's value of the ReadProgress entity.
+// If the ReadProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadProgressMutation) OldSeriesID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesID: %w", err)
+	}
+	return oldValue.SeriesID, nil
+}
+
+// ResetSeriesID resets all changes to the "series_id" field.
+func (m *ReadProgressMutation) ResetSeriesID() {
+	m.series_id = nil
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (m *ReadProgressMutation) SetChapterNumber(f float64) {
+	m.chapter_number = &f
+	m.addchapter_number = nil
+}
+
+// ChapterNumber returns the value of the "chapter_number" field in the mutation.
+func (m *ReadProgressMutation) ChapterNumber() (r float64, exists bool) {
+	v := m.chapter_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChapterNumber returns the old "chapter_number" field's value of the ReadProgress entity.
+// If the ReadProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadProgressMutation) OldChapterNumber(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChapterNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChapterNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChapterNumber: %w", err)
+	}
+	return oldValue.ChapterNumber, nil
+}
+
+// AddChapterNumber adds f to the "chapter_number" field.
+func (m *ReadProgressMutation) AddChapterNumber(f float64) {
+	if m.addchapter_number != nil {
+		*m.addchapter_number += f
+	} else {
+		m.addchapter_number = &f
+	}
+}
+
+// AddedChapterNumber returns the value that was added to the "chapter_number" field in this mutation.
+func (m *ReadProgressMutation) AddedChapterNumber() (r float64, exists bool) {
+	v := m.addchapter_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChapterNumber resets all changes to the "chapter_number" field.
+func (m *ReadProgressMutation) ResetChapterNumber() {
+	m.chapter_number = nil
+	m.addchapter_number = nil
+}
+
+// SetPage sets the "page" field.
+func (m *ReadProgressMutation) SetPage(i int) {
+	m.page = &i
+	m.addpage = nil
+}
+
+// Page returns the value of the "page" field in the mutation.
+func (m *ReadProgressMutation) Page() (r int, exists bool) {
+	v := m.page
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPage returns the old "page" field's value of the ReadProgress entity.
+// If the ReadProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadProgressMutation) OldPage(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPage: %w", err)
+	}
+	return oldValue.Page, nil
+}
+
+// AddPage adds i to the "page" field.
+func (m *ReadProgressMutation) AddPage(i int) {
+	if m.addpage != nil {
+		*m.addpage += i
+	} else {
+		m.addpage = &i
+	}
+}
+
+// AddedPage returns the value that was added to the "page" field in this mutation.
+func (m *ReadProgressMutation) AddedPage() (r int, exists bool) {
+	v := m.addpage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPage resets all changes to the "page" field.
+func (m *ReadProgressMutation) ResetPage() {
+	m.page = nil
+	m.addpage = nil
+}
+
+// SetPageCount sets the "page_count" field.
+func (m *ReadProgressMutation) SetPageCount(i int) {
+	m.page_count = &i
+	m.addpage_count = nil
+}
+
+// PageCount returns the value of the "page_count" field in the mutation.
+func (m *ReadProgressMutation) PageCount() (r int, exists bool) {
+	v := m.page_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPageCount returns the old "page_count" field's value of the ReadProgress entity.
+// If the ReadProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadProgressMutation) OldPageCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPageCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPageCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPageCount: %w", err)
+	}
+	return oldValue.PageCount, nil
+}
+
+// AddPageCount adds i to the "page_count" field.
+func (m *ReadProgressMutation) AddPageCount(i int) {
+	if m.addpage_count != nil {
+		*m.addpage_count += i
+	} else {
+		m.addpage_count = &i
+	}
+}
+
+// AddedPageCount returns the value that was added to the "page_count" field in this mutation.
+func (m *ReadProgressMutation) AddedPageCount() (r int, exists bool) {
+	v := m.addpage_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPageCount resets all changes to the "page_count" field.
+func (m *ReadProgressMutation) ResetPageCount() {
+	m.page_count = nil
+	m.addpage_count = nil
+}
+
+// SetCompleted sets the "completed" field.
+func (m *ReadProgressMutation) SetCompleted(b bool) {
+	m.completed = &b
+}
+
+// Completed returns the value of the "completed" field in the mutation.
+func (m *ReadProgressMutation) Completed() (r bool, exists bool) {
+	v := m.completed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompleted returns the old "completed" field's value of the ReadProgress entity.
+// If the ReadProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadProgressMutation) OldCompleted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompleted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompleted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompleted: %w", err)
+	}
+	return oldValue.Completed, nil
+}
+
+// ResetCompleted resets all changes to the "completed" field.
+func (m *ReadProgressMutation) ResetCompleted() {
+	m.completed = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReadProgressMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReadProgressMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ReadProgress entity.
+// If the ReadProgress object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadProgressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReadProgressMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ReadProgressMutation builder.
+func (m *ReadProgressMutation) Where(ps ...predicate.ReadProgress) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReadProgressMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReadProgressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReadProgress, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReadProgressMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReadProgressMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReadProgress).
+func (m *ReadProgressMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReadProgressMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user_id != nil {
+		fields = append(fields, readprogress.FieldUserID)
+	}
+	if m.series_id != nil {
+		fields = append(fields, readprogress.FieldSeriesID)
+	}
+	if m.chapter_number != nil {
+		fields = append(fields, readprogress.FieldChapterNumber)
+	}
+	if m.page != nil {
+		fields = append(fields, readprogress.FieldPage)
+	}
+	if m.page_count != nil {
+		fields = append(fields, readprogress.FieldPageCount)
+	}
+	if m.completed != nil {
+		fields = append(fields, readprogress.FieldCompleted)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, readprogress.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReadProgressMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case readprogress.FieldUserID:
+		return m.UserID()
+	case readprogress.FieldSeriesID:
+		return m.SeriesID()
+	case readprogress.FieldChapterNumber:
+		return m.ChapterNumber()
+	case readprogress.FieldPage:
+		return m.Page()
+	case readprogress.FieldPageCount:
+		return m.PageCount()
+	case readprogress.FieldCompleted:
+		return m.Completed()
+	case readprogress.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReadProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case readprogress.FieldUserID:
+		return m.OldUserID(ctx)
+	case readprogress.FieldSeriesID:
+		return m.OldSeriesID(ctx)
+	case readprogress.FieldChapterNumber:
+		return m.OldChapterNumber(ctx)
+	case readprogress.FieldPage:
+		return m.OldPage(ctx)
+	case readprogress.FieldPageCount:
+		return m.OldPageCount(ctx)
+	case readprogress.FieldCompleted:
+		return m.OldCompleted(ctx)
+	case readprogress.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReadProgress field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReadProgressMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case readprogress.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case readprogress.FieldSeriesID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesID(v)
+		return nil
+	case readprogress.FieldChapterNumber:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChapterNumber(v)
+		return nil
+	case readprogress.FieldPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPage(v)
+		return nil
+	case readprogress.FieldPageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPageCount(v)
+		return nil
+	case readprogress.FieldCompleted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompleted(v)
+		return nil
+	case readprogress.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReadProgress field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReadProgressMutation) AddedFields() []string {
+	var fields []string
+	if m.addchapter_number != nil {
+		fields = append(fields, readprogress.FieldChapterNumber)
+	}
+	if m.addpage != nil {
+		fields = append(fields, readprogress.FieldPage)
+	}
+	if m.addpage_count != nil {
+		fields = append(fields, readprogress.FieldPageCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReadProgressMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case readprogress.FieldChapterNumber:
+		return m.AddedChapterNumber()
+	case readprogress.FieldPage:
+		return m.AddedPage()
+	case readprogress.FieldPageCount:
+		return m.AddedPageCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReadProgressMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case readprogress.FieldChapterNumber:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChapterNumber(v)
+		return nil
+	case readprogress.FieldPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPage(v)
+		return nil
+	case readprogress.FieldPageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPageCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReadProgress numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReadProgressMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReadProgressMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReadProgressMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReadProgress nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReadProgressMutation) ResetField(name string) error {
+	switch name {
+	case readprogress.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case readprogress.FieldSeriesID:
+		m.ResetSeriesID()
+		return nil
+	case readprogress.FieldChapterNumber:
+		m.ResetChapterNumber()
+		return nil
+	case readprogress.FieldPage:
+		m.ResetPage()
+		return nil
+	case readprogress.FieldPageCount:
+		m.ResetPageCount()
+		return nil
+	case readprogress.FieldCompleted:
+		m.ResetCompleted()
+		return nil
+	case readprogress.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadProgress field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReadProgressMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReadProgressMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReadProgressMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReadProgressMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReadProgressMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReadProgressMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReadProgressMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ReadProgress unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReadProgressMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ReadProgress edge %s", name)
+}
+
+// SeriesMutation represents an operation that mutates the Series nodes in the graph.
+type SeriesMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	title                *string
+	thumbnail_url        *string
+	artist               *string
+	author               *string
+	description          *string
+	genre                *[]string
+	appendgenre          []string
+	status               *string
+	storage_path         *string
+	_type                *string
+	chapter_count        *int
+	addchapter_count     *int
+	pause_downloads      *bool
+	notify               *bool
+	clearedFields        map[string]struct{}
+	providers            map[uuid.UUID]struct{}
+	removedproviders     map[uuid.UUID]struct{}
+	clearedproviders     bool
+	latest_series        map[int]struct{}
+	removedlatest_series map[int]struct{}
+	clearedlatest_series bool
+	done                 bool
+	oldValue             func(context.Context) (*Series, error)
+	predicates           []predicate.Series
+}
+
+var _ ent.Mutation = (*SeriesMutation)(nil)
+
+// seriesOption allows management of the mutation configuration using functional options.
+type seriesOption func(*SeriesMutation)
+
+// newSeriesMutation creates new mutation for the Series entity.
+func newSeriesMutation(c config, op Op, opts ...seriesOption) *SeriesMutation {
+	m := &SeriesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSeries,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSeriesID sets the ID field of the mutation.
+func withSeriesID(id uuid.UUID) seriesOption {
+	return func(m *SeriesMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Series
+		)
+		m.oldValue = func(ctx context.Context) (*Series, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Series.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSeries sets the old Series of the mutation.
+func withSeries(node *Series) seriesOption {
+	return func(m *SeriesMutation) {
+		m.oldValue = func(context.Context) (*Series, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SeriesMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SeriesMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Series entities.
+func (m *SeriesMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SeriesMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SeriesMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Series.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *SeriesMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *SeriesMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *SeriesMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (m *SeriesMutation) SetThumbnailURL(s string) {
+	m.thumbnail_url = &s
+}
+
+// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
+func (m *SeriesMutation) ThumbnailURL() (r string, exists bool) {
+	v := m.thumbnail_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailURL returns the old "thumbnail_url" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+	}
+	return oldValue.ThumbnailURL, nil
+}
+
+// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
+func (m *SeriesMutation) ResetThumbnailURL() {
+	m.thumbnail_url = nil
+}
+
+// SetArtist sets the "artist" field.
+func (m *SeriesMutation) SetArtist(s string) {
+	m.artist = &s
+}
+
+// Artist returns the value of the "artist" field in the mutation.
+func (m *SeriesMutation) Artist() (r string, exists bool) {
+	v := m.artist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtist returns the old "artist" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldArtist(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtist is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtist requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtist: %w", err)
+	}
+	return oldValue.Artist, nil
+}
+
+// ResetArtist resets all changes to the "artist" field.
+func (m *SeriesMutation) ResetArtist() {
+	m.artist = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *SeriesMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *SeriesMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *SeriesMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *SeriesMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *SeriesMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *SeriesMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetGenre sets the "genre" field.
+func (m *SeriesMutation) SetGenre(s []string) {
+	m.genre = &s
+	m.appendgenre = nil
+}
+
+// Genre returns the value of the "genre" field in the mutation.
+func (m *SeriesMutation) Genre() (r []string, exists bool) {
+	v := m.genre
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenre returns the old "genre" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldGenre(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenre requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
+	}
+	return oldValue.Genre, nil
+}
+
+// AppendGenre adds s to the "genre" field.
+func (m *SeriesMutation) AppendGenre(s []string) {
+	m.appendgenre = append(m.appendgenre, s...)
+}
+
+// AppendedGenre returns the list of values that were appended to the "genre" field in this mutation.
+func (m *SeriesMutation) AppendedGenre() ([]string, bool) {
+	if len(m.appendgenre) == 0 {
+		return nil, false
+	}
+	return m.appendgenre, true
+}
+
+// ClearGenre clears the value of the "genre" field.
+func (m *SeriesMutation) ClearGenre() {
+	m.genre = nil
+	m.appendgenre = nil
+	m.clearedFields[series.FieldGenre] = struct{}{}
+}
+
+// GenreCleared returns if the "genre" field was cleared in this mutation.
+func (m *SeriesMutation) GenreCleared() bool {
+	_, ok := m.clearedFields[series.FieldGenre]
+	return ok
+}
+
+// ResetGenre resets all changes to the "genre" field.
+func (m *SeriesMutation) ResetGenre() {
+	m.genre = nil
+	m.appendgenre = nil
+	delete(m.clearedFields, series.FieldGenre)
+}
+
+// SetStatus sets the "status" field.
+func (m *SeriesMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SeriesMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SeriesMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStoragePath sets the "storage_path" field.
+func (m *SeriesMutation) SetStoragePath(s string) {
+	m.storage_path = &s
+}
+
+// StoragePath returns the value of the "storage_path" field in the mutation.
+func (m *SeriesMutation) StoragePath() (r string, exists bool) {
+	v := m.storage_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStoragePath returns the old "storage_path" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldStoragePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoragePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoragePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoragePath: %w", err)
+	}
+	return oldValue.StoragePath, nil
+}
+
+// ClearStoragePath clears the value of the "storage_path" field.
+func (m *SeriesMutation) ClearStoragePath() {
+	m.storage_path = nil
+	m.clearedFields[series.FieldStoragePath] = struct{}{}
+}
+
+// StoragePathCleared returns if the "storage_path" field was cleared in this mutation.
+func (m *SeriesMutation) StoragePathCleared() bool {
+	_, ok := m.clearedFields[series.FieldStoragePath]
+	return ok
+}
+
+// ResetStoragePath resets all changes to the "storage_path" field.
+func (m *SeriesMutation) ResetStoragePath() {
+	m.storage_path = nil
+	delete(m.clearedFields, series.FieldStoragePath)
+}
+
+// SetType sets the "type" field.
+func (m *SeriesMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *SeriesMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldType(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ClearType clears the value of the "type" field.
+func (m *SeriesMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[series.FieldType] = struct{}{}
+}
+
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *SeriesMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[series.FieldType]
+	return ok
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *SeriesMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, series.FieldType)
+}
+
+// SetChapterCount sets the "chapter_count" field.
+func (m *SeriesMutation) SetChapterCount(i int) {
+	m.chapter_count = &i
+	m.addchapter_count = nil
+}
+
+// ChapterCount returns the value of the "chapter_count" field in the mutation.
+func (m *SeriesMutation) ChapterCount() (r int, exists bool) {
+	v := m.chapter_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChapterCount returns the old "chapter_count" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldChapterCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChapterCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChapterCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChapterCount: %w", err)
+	}
+	return oldValue.ChapterCount, nil
+}
+
+// AddChapterCount adds i to the "chapter_count" field.
+func (m *SeriesMutation) AddChapterCount(i int) {
+	if m.addchapter_count != nil {
+		*m.addchapter_count += i
+	} else {
+		m.addchapter_count = &i
+	}
+}
+
+// AddedChapterCount returns the value that was added to the "chapter_count" field in this mutation.
+func (m *SeriesMutation) AddedChapterCount() (r int, exists bool) {
+	v := m.addchapter_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChapterCount resets all changes to the "chapter_count" field.
+func (m *SeriesMutation) ResetChapterCount() {
+	m.chapter_count = nil
+	m.addchapter_count = nil
+}
+
+// SetPauseDownloads sets the "pause_downloads" field.
+func (m *SeriesMutation) SetPauseDownloads(b bool) {
+	m.pause_downloads = &b
+}
+
+// PauseDownloads returns the value of the "pause_downloads" field in the mutation.
+func (m *SeriesMutation) PauseDownloads() (r bool, exists bool) {
+	v := m.pause_downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPauseDownloads returns the old "pause_downloads" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldPauseDownloads(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPauseDownloads is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPauseDownloads requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPauseDownloads: %w", err)
+	}
+	return oldValue.PauseDownloads, nil
+}
+
+// ResetPauseDownloads resets all changes to the "pause_downloads" field.
+func (m *SeriesMutation) ResetPauseDownloads() {
+	m.pause_downloads = nil
+}
+
+// SetNotify sets the "notify" field.
+func (m *SeriesMutation) SetNotify(b bool) {
+	m.notify = &b
+}
+
+// Notify returns the value of the "notify" field in the mutation.
+func (m *SeriesMutation) Notify() (r bool, exists bool) {
+	v := m.notify
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotify returns the old "notify" field's value of the Series entity.
+// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesMutation) OldNotify(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotify is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotify requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotify: %w", err)
+	}
+	return oldValue.Notify, nil
+}
+
+// ResetNotify resets all changes to the "notify" field.
+func (m *SeriesMutation) ResetNotify() {
+	m.notify = nil
+}
+
+// AddProviderIDs adds the "providers" edge to the SeriesProvider entity by ids.
+func (m *SeriesMutation) AddProviderIDs(ids ...uuid.UUID) {
+	if m.providers == nil {
+		m.providers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.providers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProviders clears the "providers" edge to the SeriesProvider entity.
+func (m *SeriesMutation) ClearProviders() {
+	m.clearedproviders = true
+}
+
+// ProvidersCleared reports if the "providers" edge to the SeriesProvider entity was cleared.
+func (m *SeriesMutation) ProvidersCleared() bool {
+	return m.clearedproviders
+}
+
+// RemoveProviderIDs removes the "providers" edge to the SeriesProvider entity by IDs.
+func (m *SeriesMutation) RemoveProviderIDs(ids ...uuid.UUID) {
+	if m.removedproviders == nil {
+		m.removedproviders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.providers, ids[i])
+		m.removedproviders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProviders returns the removed IDs of the "providers" edge to the SeriesProvider entity.
+func (m *SeriesMutation) RemovedProvidersIDs() (ids []uuid.UUID) {
+	for id := range m.removedproviders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProvidersIDs returns the "providers" edge IDs in the mutation.
+func (m *SeriesMutation) ProvidersIDs() (ids []uuid.UUID) {
+	for id := range m.providers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProviders resets all changes to the "providers" edge.
+func (m *SeriesMutation) ResetProviders() {
+	m.providers = nil
+	m.clearedproviders = false
+	m.removedproviders = nil
+}
+
+// AddLatestSeriesIDs adds the "latest_series" edge to the LatestSeries entity by ids.
+func (m *SeriesMutation) AddLatestSeriesIDs(ids ...int) {
+	if m.latest_series == nil {
+		m.latest_series = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.latest_series[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLatestSeries clears the "latest_series" edge to the LatestSeries entity.
+func (m *SeriesMutation) ClearLatestSeries() {
+	m.clearedlatest_series = true
+}
+
+// LatestSeriesCleared reports if the "latest_series" edge to the LatestSeries entity was cleared.
+func (m *SeriesMutation) LatestSeriesCleared() bool {
+	return m.clearedlatest_series
+}
+
+// RemoveLatestSeriesIDs removes the "latest_series" edge to the LatestSeries entity by IDs.
+func (m *SeriesMutation) RemoveLatestSeriesIDs(ids ...int) {
+	if m.removedlatest_series == nil {
+		m.removedlatest_series = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.latest_series, ids[i])
+		m.removedlatest_series[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLatestSeries returns the removed IDs of the "latest_series" edge to the LatestSeries entity.
+func (m *SeriesMutation) RemovedLatestSeriesIDs() (ids []int) {
+	for id := range m.removedlatest_series {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LatestSeriesIDs returns the "latest_series" edge IDs in the mutation.
+func (m *SeriesMutation) LatestSeriesIDs() (ids []int) {
+	for id := range m.latest_series {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLatestSeries resets all changes to the "latest_series" edge.
+func (m *SeriesMutation) ResetLatestSeries() {
+	m.latest_series = nil
+	m.clearedlatest_series = false
+	m.removedlatest_series = nil
+}
+
+// Where appends a list predicates to the SeriesMutation builder.
+func (m *SeriesMutation) Where(ps ...predicate.Series) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SeriesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SeriesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Series, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SeriesMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SeriesMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Series).
+func (m *SeriesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SeriesMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.title != nil {
+		fields = append(fields, series.FieldTitle)
+	}
+	if m.thumbnail_url != nil {
+		fields = append(fields, series.FieldThumbnailURL)
+	}
+	if m.artist != nil {
+		fields = append(fields, series.FieldArtist)
+	}
+	if m.author != nil {
+		fields = append(fields, series.FieldAuthor)
+	}
+	if m.description != nil {
+		fields = append(fields, series.FieldDescription)
+	}
+	if m.genre != nil {
+		fields = append(fields, series.FieldGenre)
+	}
+	if m.status != nil {
+		fields = append(fields, series.FieldStatus)
+	}
+	if m.storage_path != nil {
+		fields = append(fields, series.FieldStoragePath)
+	}
+	if m._type != nil {
+		fields = append(fields, series.FieldType)
+	}
+	if m.chapter_count != nil {
+		fields = append(fields, series.FieldChapterCount)
+	}
+	if m.pause_downloads != nil {
+		fields = append(fields, series.FieldPauseDownloads)
+	}
+	if m.notify != nil {
+		fields = append(fields, series.FieldNotify)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SeriesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case series.FieldTitle:
+		return m.Title()
+	case series.FieldThumbnailURL:
+		return m.ThumbnailURL()
+	case series.FieldArtist:
+		return m.Artist()
+	case series.FieldAuthor:
+		return m.Author()
+	case series.FieldDescription:
+		return m.Description()
+	case series.FieldGenre:
+		return m.Genre()
+	case series.FieldStatus:
+		return m.Status()
+	case series.FieldStoragePath:
+		return m.StoragePath()
+	case series.FieldType:
+		return m.GetType()
+	case series.FieldChapterCount:
+		return m.ChapterCount()
+	case series.FieldPauseDownloads:
+		return m.PauseDownloads()
+	case series.FieldNotify:
+		return m.Notify()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SeriesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case series.FieldTitle:
+		return m.OldTitle(ctx)
+	case series.FieldThumbnailURL:
+		return m.OldThumbnailURL(ctx)
+	case series.FieldArtist:
+		return m.OldArtist(ctx)
+	case series.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case series.FieldDescription:
+		return m.OldDescription(ctx)
+	case series.FieldGenre:
+		return m.OldGenre(ctx)
+	case series.FieldStatus:
+		return m.OldStatus(ctx)
+	case series.FieldStoragePath:
+		return m.OldStoragePath(ctx)
+	case series.FieldType:
+		return m.OldType(ctx)
+	case series.FieldChapterCount:
+		return m.OldChapterCount(ctx)
+	case series.FieldPauseDownloads:
+		return m.OldPauseDownloads(ctx)
+	case series.FieldNotify:
+		return m.OldNotify(ctx)
+	}
+	return nil, fmt.Errorf("unknown Series field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeriesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case series.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case series.FieldThumbnailURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailURL(v)
+		return nil
+	case series.FieldArtist:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtist(v)
+		return nil
+	case series.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case series.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case series.FieldGenre:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenre(v)
+		return nil
+	case series.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case series.FieldStoragePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoragePath(v)
+		return nil
+	case series.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case series.FieldChapterCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChapterCount(v)
+		return nil
+	case series.FieldPauseDownloads:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPauseDownloads(v)
+		return nil
+	case series.FieldNotify:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotify(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Series field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SeriesMutation) AddedFields() []string {
+	var fields []string
+	if m.addchapter_count != nil {
+		fields = append(fields, series.FieldChapterCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SeriesMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case series.FieldChapterCount:
+		return m.AddedChapterCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SeriesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case series.FieldChapterCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChapterCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Series numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SeriesMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(series.FieldGenre) {
+		fields = append(fields, series.FieldGenre)
+	}
+	if m.FieldCleared(series.FieldStoragePath) {
+		fields = append(fields, series.FieldStoragePath)
+	}
+	if m.FieldCleared(series.FieldType) {
+		fields = append(fields, series.FieldType)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SeriesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SeriesMutation) ClearField(name string) error {
+	switch name {
+	case series.FieldGenre:
+		m.ClearGenre()
+		return nil
+	case series.FieldStoragePath:
+		m.ClearStoragePath()
+		return nil
+	case series.FieldType:
+		m.ClearType()
+		return nil
+	}
+	return fmt.Errorf("unknown Series nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SeriesMutation) ResetField(name string) error {
+	switch name {
+	case series.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case series.FieldThumbnailURL:
+		m.ResetThumbnailURL()
+		return nil
+	case series.FieldArtist:
+		m.ResetArtist()
+		return nil
+	case series.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case series.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case series.FieldGenre:
+		m.ResetGenre()
+		return nil
+	case series.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case series.FieldStoragePath:
+		m.ResetStoragePath()
+		return nil
+	case series.FieldType:
+		m.ResetType()
+		return nil
+	case series.FieldChapterCount:
+		m.ResetChapterCount()
+		return nil
+	case series.FieldPauseDownloads:
+		m.ResetPauseDownloads()
+		return nil
+	case series.FieldNotify:
+		m.ResetNotify()
+		return nil
+	}
+	return fmt.Errorf("unknown Series field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SeriesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.providers != nil {
+		edges = append(edges, series.EdgeProviders)
+	}
+	if m.latest_series != nil {
+		edges = append(edges, series.EdgeLatestSeries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SeriesMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case series.EdgeProviders:
+		ids := make([]ent.Value, 0, len(m.providers))
+		for id := range m.providers {
+			ids = append(ids, id)
+		}
+		return ids
+	case series.EdgeLatestSeries:
+		ids := make([]ent.Value, 0, len(m.latest_series))
+		for id := range m.latest_series {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SeriesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedproviders != nil {
+		edges = append(edges, series.EdgeProviders)
+	}
+	if m.removedlatest_series != nil {
+		edges = append(edges, series.EdgeLatestSeries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SeriesMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case series.EdgeProviders:
+		ids := make([]ent.Value, 0, len(m.removedproviders))
+		for id := range m.removedproviders {
+			ids = append(ids, id)
+		}
+		return ids
+	case series.EdgeLatestSeries:
+		ids := make([]ent.Value, 0, len(m.removedlatest_series))
+		for id := range m.removedlatest_series {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SeriesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedproviders {
+		edges = append(edges, series.EdgeProviders)
+	}
+	if m.clearedlatest_series {
+		edges = append(edges, series.EdgeLatestSeries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SeriesMutation) EdgeCleared(name string) bool {
+	switch name {
+	case series.EdgeProviders:
+		return m.clearedproviders
+	case series.EdgeLatestSeries:
+		return m.clearedlatest_series
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SeriesMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Series unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SeriesMutation) ResetEdge(name string) error {
+	switch name {
+	case series.EdgeProviders:
+		m.ResetProviders()
+		return nil
+	case series.EdgeLatestSeries:
+		m.ResetLatestSeries()
+		return nil
+	}
+	return fmt.Errorf("unknown Series edge %s", name)
+}
+
+// SeriesProviderMutation represents an operation that mutates the SeriesProvider nodes in the graph.
+type SeriesProviderMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	suwayomi_id               *int
+	addsuwayomi_id            *int
+	provider                  *string
+	scanlator                 *string
+	url                       *string
+	title                     *string
+	language                  *string
+	thumbnail_url             *string
+	artist                    *string
+	author                    *string
+	description               *string
+	genre                     *[]string
+	appendgenre               []string
+	fetch_date                *time.Time
+	chapter_count             *int64
+	addchapter_count          *int64
+	continue_after_chapter    *float64
+	addcontinue_after_chapter *float64
+	is_title                  *bool
+	is_cover                  *bool
+	is_unknown                *bool
+	importance                *int
+	addimportance             *int
+	is_disabled               *bool
+	is_uninstalled            *bool
+	status                    *string
+	page_count_synced         *bool
+	chapters                  *[]types.Chapter
+	appendchapters            []types.Chapter
+	clearedFields             map[string]struct{}
+	series                    *uuid.UUID
+	clearedseries             bool
+	done                      bool
+	oldValue                  func(context.Context) (*SeriesProvider, error)
+	predicates                []predicate.SeriesProvider
+}
+
+var _ ent.Mutation = (*SeriesProviderMutation)(nil)
+
+// seriesproviderOption allows management of the mutation configuration using functional options.
+type seriesproviderOption func(*SeriesProviderMutation)
+
+// newSeriesProviderMutation creates new mutation for the SeriesProvider entity.
+func newSeriesProviderMutation(c config, op Op, opts ...seriesproviderOption) *SeriesProviderMutation {
+	m := &SeriesProviderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSeriesProvider,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSeriesProviderID sets the ID field of the mutation.
+func withSeriesProviderID(id uuid.UUID) seriesproviderOption {
+	return func(m *SeriesProviderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SeriesProvider
+		)
+		m.oldValue = func(ctx context.Context) (*SeriesProvider, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SeriesProvider.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSeriesProvider sets the old SeriesProvider of the mutation.
+func withSeriesProvider(node *SeriesProvider) seriesproviderOption {
+	return func(m *SeriesProviderMutation) {
+		m.oldValue = func(context.Context) (*SeriesProvider, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SeriesProviderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SeriesProviderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SeriesProvider entities.
+func (m *SeriesProviderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SeriesProviderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SeriesProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SeriesProvider.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSeriesID sets the "series_id" field.
+func (m *SeriesProviderMutation) SetSeriesID(u uuid.UUID) {
+	m.series = &u
+}
+
+// SeriesID returns the value of the "series_id" field in the mutation.
+func (m *SeriesProviderMutation) SeriesID() (r uuid.UUID, exists bool) {
+	v := m.series
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesID returns the old "series_id" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldSeriesID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesID: %w", err)
+	}
+	return oldValue.SeriesID, nil
+}
+
+// ResetSeriesID resets all changes to the "series_id" field.
+func (m *SeriesProviderMutation) ResetSeriesID() {
+	m.series = nil
+}
+
+// SetSuwayomiID sets the "suwayomi_id" field.
+func (m *SeriesProviderMutation) SetSuwayomiID(i int) {
+	m.suwayomi_id = &i
+	m.addsuwayomi_id = nil
+}
+
+// SuwayomiID returns the value of the "suwayomi_id" field in the mutation.
+func (m *SeriesProviderMutation) SuwayomiID() (r int, exists bool) {
+	v := m.suwayomi_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuwayomiID returns the old "suwayomi_id" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldSuwayomiID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuwayomiID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuwayomiID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuwayomiID: %w", err)
+	}
+	return oldValue.SuwayomiID, nil
+}
+
+// AddSuwayomiID adds i to the "suwayomi_id" field.
+func (m *SeriesProviderMutation) AddSuwayomiID(i int) {
+	if m.addsuwayomi_id != nil {
+		*m.addsuwayomi_id += i
+	} else {
+		m.addsuwayomi_id = &i
+	}
+}
+
+// AddedSuwayomiID returns the value that was added to the "suwayomi_id" field in this mutation.
+func (m *SeriesProviderMutation) AddedSuwayomiID() (r int, exists bool) {
+	v := m.addsuwayomi_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSuwayomiID resets all changes to the "suwayomi_id" field.
+func (m *SeriesProviderMutation) ResetSuwayomiID() {
+	m.suwayomi_id = nil
+	m.addsuwayomi_id = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *SeriesProviderMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *SeriesProviderMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *SeriesProviderMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetScanlator sets the "scanlator" field.
+func (m *SeriesProviderMutation) SetScanlator(s string) {
+	m.scanlator = &s
+}
+
+// Scanlator returns the value of the "scanlator" field in the mutation.
+func (m *SeriesProviderMutation) Scanlator() (r string, exists bool) {
+	v := m.scanlator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScanlator returns the old "scanlator" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldScanlator(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanlator is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanlator requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanlator: %w", err)
+	}
+	return oldValue.Scanlator, nil
+}
+
+// ResetScanlator resets all changes to the "scanlator" field.
+func (m *SeriesProviderMutation) ResetScanlator() {
+	m.scanlator = nil
+}
+
+// SetURL sets the "url" field.
+func (m *SeriesProviderMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *SeriesProviderMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *SeriesProviderMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[seriesprovider.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *SeriesProviderMutation) URLCleared() bool {
+	_, ok := m.clearedFields[seriesprovider.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *SeriesProviderMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, seriesprovider.FieldURL)
+}
+
+// SetTitle sets the "title" field.
+func (m *SeriesProviderMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *SeriesProviderMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *SeriesProviderMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *SeriesProviderMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *SeriesProviderMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *SeriesProviderMutation) ResetLanguage() {
+	m.language = nil
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (m *SeriesProviderMutation) SetThumbnailURL(s string) {
+	m.thumbnail_url = &s
+}
+
+// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
+func (m *SeriesProviderMutation) ThumbnailURL() (r string, exists bool) {
+	v := m.thumbnail_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailURL returns the old "thumbnail_url" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldThumbnailURL(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+	}
+	return oldValue.ThumbnailURL, nil
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (m *SeriesProviderMutation) ClearThumbnailURL() {
+	m.thumbnail_url = nil
+	m.clearedFields[seriesprovider.FieldThumbnailURL] = struct{}{}
+}
+
+// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
+func (m *SeriesProviderMutation) ThumbnailURLCleared() bool {
+	_, ok := m.clearedFields[seriesprovider.FieldThumbnailURL]
+	return ok
+}
+
+// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
+func (m *SeriesProviderMutation) ResetThumbnailURL() {
+	m.thumbnail_url = nil
+	delete(m.clearedFields, seriesprovider.FieldThumbnailURL)
+}
+
+// SetArtist sets the "artist" field.
+func (m *SeriesProviderMutation) SetArtist(s string) {
+	m.artist = &s
+}
+
+// Artist returns the value of the "artist" field in the mutation.
+func (m *SeriesProviderMutation) Artist() (r string, exists bool) {
+	v := m.artist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtist returns the old "artist" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldArtist(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtist is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtist requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtist: %w", err)
+	}
+	return oldValue.Artist, nil
+}
+
+// ClearArtist clears the value of the "artist" field.
+func (m *SeriesProviderMutation) ClearArtist() {
+	m.artist = nil
+	m.clearedFields[seriesprovider.FieldArtist] = struct{}{}
+}
+
+// ArtistCleared returns if the "artist" field was cleared in this mutation.
+func (m *SeriesProviderMutation) ArtistCleared() bool {
+	_, ok := m.clearedFields[seriesprovider.FieldArtist]
+	return ok
+}
+
+// ResetArtist resets all changes to the "artist" field.
+func (m *SeriesProviderMutation) ResetArtist() {
+	m.artist = nil
+	delete(m.clearedFields, seriesprovider.FieldArtist)
+}
+
+// SetAuthor sets the "author" field.
+func (m *SeriesProviderMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *SeriesProviderMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldAuthor(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (m *SeriesProviderMutation) ClearAuthor() {
+	m.author = nil
+	m.clearedFields[seriesprovider.FieldAuthor] = struct{}{}
+}
+
+// AuthorCleared returns if the "author" field was cleared in this mutation.
+func (m *SeriesProviderMutation) AuthorCleared() bool {
+	_, ok := m.clearedFields[seriesprovider.FieldAuthor]
+	return ok
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *SeriesProviderMutation) ResetAuthor() {
+	m.author = nil
+	delete(m.clearedFields, seriesprovider.FieldAuthor)
+}
+
+// SetDescription sets the "description" field.
+func (m *SeriesProviderMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *SeriesProviderMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *SeriesProviderMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[seriesprovider.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *SeriesProviderMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[seriesprovider.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *SeriesProviderMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, seriesprovider.FieldDescription)
+}
+
+// SetGenre sets the "genre" field.
+func (m *SeriesProviderMutation) SetGenre(s []string) {
+	m.genre = &s
+	m.appendgenre = nil
+}
+
+// Genre returns the value of the "genre" field in the mutation.
+func (m *SeriesProviderMutation) Genre() (r []string, exists bool) {
+	v := m.genre
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenre returns the old "genre" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldGenre(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenre requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
+	}
+	return oldValue.Genre, nil
+}
+
+// AppendGenre adds s to the "genre" field.
+func (m *SeriesProviderMutation) AppendGenre(s []string) {
+	m.appendgenre = append(m.appendgenre, s...)
+}
+
+// AppendedGenre returns the list of values that were appended to the "genre" field in this mutation.
+func (m *SeriesProviderMutation) AppendedGenre() ([]string, bool) {
+	if len(m.appendgenre) == 0 {
+		return nil, false
+	}
+	return m.appendgenre, true
+}
+
+// ClearGenre clears the value of the "genre" field.
+func (m *SeriesProviderMutation) ClearGenre() {
+	m.genre = nil
+	m.appendgenre = nil
+	m.clearedFields[seriesprovider.FieldGenre] = struct{}{}
+}
+
+// GenreCleared returns if the "genre" field was cleared in this mutation.
+func (m *SeriesProviderMutation) GenreCleared() bool {
+	_, ok := m.clearedFields[seriesprovider.FieldGenre]
+	return ok
+}
+
+// ResetGenre resets all changes to the "genre" field.
+func (m *SeriesProviderMutation) ResetGenre() {
+	m.genre = nil
+	m.appendgenre = nil
+	delete(m.clearedFields, seriesprovider.FieldGenre)
+}
+
+// SetFetchDate sets the "fetch_date" field.
+func (m *SeriesProviderMutation) SetFetchDate(t time.Time) {
+	m.fetch_date = &t
+}
+
+// FetchDate returns the value of the "fetch_date" field in the mutation.
+func (m *SeriesProviderMutation) FetchDate() (r time.Time, exists bool) {
+	v := m.fetch_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFetchDate returns the old "fetch_date" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldFetchDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFetchDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFetchDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFetchDate: %w", err)
+	}
+	return oldValue.FetchDate, nil
 }
 
-// LatestChapterTitle returns the value of the "latest_chapter_title" field in the mutation.
-func (m *LatestSeriesMutation) LatestChapterTitle() (r string, exists bool) {
-	v := m.latest_chapter_title
+// ClearFetchDate clears the value of the "fetch_date" field.
+func (m *SeriesProviderMutation) ClearFetchDate() {
+	m.fetch_date = nil
+	m.clearedFields[seriesprovider.FieldFetchDate] = struct{}{}
+}
+
+// FetchDateCleared returns if the "fetch_date" field was cleared in this mutation.
+func (m *SeriesProviderMutation) FetchDateCleared() bool {
+	_, ok := m.clearedFields[seriesprovider.FieldFetchDate]
+	return ok
+}
+
+// ResetFetchDate resets all changes to the "fetch_date" field.
+func (m *SeriesProviderMutation) ResetFetchDate() {
+	m.fetch_date = nil
+	delete(m.clearedFields, seriesprovider.FieldFetchDate)
+}
+
+// SetChapterCount sets the "chapter_count" field.
+func (m *SeriesProviderMutation) SetChapterCount(i int64) {
+	m.chapter_count = &i
+	m.addchapter_count = nil
+}
+
+// ChapterCount returns the value of the "chapter_count" field in the mutation.
+func (m *SeriesProviderMutation) ChapterCount() (r int64, exists bool) {
+	v := m.chapter_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChapterCount returns the old "chapter_count" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldChapterCount(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChapterCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChapterCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChapterCount: %w", err)
+	}
+	return oldValue.ChapterCount, nil
+}
+
+// AddChapterCount adds i to the "chapter_count" field.
+func (m *SeriesProviderMutation) AddChapterCount(i int64) {
+	if m.addchapter_count != nil {
+		*m.addchapter_count += i
+	} else {
+		m.addchapter_count = &i
+	}
+}
+
+// AddedChapterCount returns the value that was added to the "chapter_count" field in this mutation.
+func (m *SeriesProviderMutation) AddedChapterCount() (r int64, exists bool) {
+	v := m.addchapter_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChapterCount clears the value of the "chapter_count" field.
+func (m *SeriesProviderMutation) ClearChapterCount() {
+	m.chapter_count = nil
+	m.addchapter_count = nil
+	m.clearedFields[seriesprovider.FieldChapterCount] = struct{}{}
+}
+
+// ChapterCountCleared returns if the "chapter_count" field was cleared in this mutation.
+func (m *SeriesProviderMutation) ChapterCountCleared() bool {
+	_, ok := m.clearedFields[seriesprovider.FieldChapterCount]
+	return ok
+}
+
+// ResetChapterCount resets all changes to the "chapter_count" field.
+func (m *SeriesProviderMutation) ResetChapterCount() {
+	m.chapter_count = nil
+	m.addchapter_count = nil
+	delete(m.clearedFields, seriesprovider.FieldChapterCount)
+}
+
+// SetContinueAfterChapter sets the "continue_after_chapter" field.
+func (m *SeriesProviderMutation) SetContinueAfterChapter(f float64) {
+	m.continue_after_chapter = &f
+	m.addcontinue_after_chapter = nil
+}
+
+// ContinueAfterChapter returns the value of the "continue_after_chapter" field in the mutation.
+func (m *SeriesProviderMutation) ContinueAfterChapter() (r float64, exists bool) {
+	v := m.continue_after_chapter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContinueAfterChapter returns the old "continue_after_chapter" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldContinueAfterChapter(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContinueAfterChapter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContinueAfterChapter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContinueAfterChapter: %w", err)
+	}
+	return oldValue.ContinueAfterChapter, nil
+}
+
+// AddContinueAfterChapter adds f to the "continue_after_chapter" field.
+func (m *SeriesProviderMutation) AddContinueAfterChapter(f float64) {
+	if m.addcontinue_after_chapter != nil {
+		*m.addcontinue_after_chapter += f
+	} else {
+		m.addcontinue_after_chapter = &f
+	}
+}
+
+// AddedContinueAfterChapter returns the value that was added to the "continue_after_chapter" field in this mutation.
+func (m *SeriesProviderMutation) AddedContinueAfterChapter() (r float64, exists bool) {
+	v := m.addcontinue_after_chapter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearContinueAfterChapter clears the value of the "continue_after_chapter" field.
+func (m *SeriesProviderMutation) ClearContinueAfterChapter() {
+	m.continue_after_chapter = nil
+	m.addcontinue_after_chapter = nil
+	m.clearedFields[seriesprovider.FieldContinueAfterChapter] = struct{}{}
+}
+
+// ContinueAfterChapterCleared returns if the "continue_after_chapter" field was cleared in this mutation.
+func (m *SeriesProviderMutation) ContinueAfterChapterCleared() bool {
+	_, ok := m.clearedFields[seriesprovider.FieldContinueAfterChapter]
+	return ok
+}
+
+// ResetContinueAfterChapter resets all changes to the "continue_after_chapter" field.
+func (m *SeriesProviderMutation) ResetContinueAfterChapter() {
+	m.continue_after_chapter = nil
+	m.addcontinue_after_chapter = nil
+	delete(m.clearedFields, seriesprovider.FieldContinueAfterChapter)
+}
+
+// SetIsTitle sets the "is_title" field.
+func (m *SeriesProviderMutation) SetIsTitle(b bool) {
+	m.is_title = &b
+}
+
+// IsTitle returns the value of the "is_title" field in the mutation.
+func (m *SeriesProviderMutation) IsTitle() (r bool, exists bool) {
+	v := m.is_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsTitle returns the old "is_title" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldIsTitle(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsTitle: %w", err)
+	}
+	return oldValue.IsTitle, nil
+}
+
+// ResetIsTitle resets all changes to the "is_title" field.
+func (m *SeriesProviderMutation) ResetIsTitle() {
+	m.is_title = nil
+}
+
+// SetIsCover sets the "is_cover" field.
+func (m *SeriesProviderMutation) SetIsCover(b bool) {
+	m.is_cover = &b
+}
+
+// IsCover returns the value of the "is_cover" field in the mutation.
+func (m *SeriesProviderMutation) IsCover() (r bool, exists bool) {
+	v := m.is_cover
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsCover returns the old "is_cover" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldIsCover(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsCover is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsCover requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsCover: %w", err)
+	}
+	return oldValue.IsCover, nil
+}
+
+// ResetIsCover resets all changes to the "is_cover" field.
+func (m *SeriesProviderMutation) ResetIsCover() {
+	m.is_cover = nil
+}
+
+// SetIsUnknown sets the "is_unknown" field.
+func (m *SeriesProviderMutation) SetIsUnknown(b bool) {
+	m.is_unknown = &b
+}
+
+// IsUnknown returns the value of the "is_unknown" field in the mutation.
+func (m *SeriesProviderMutation) IsUnknown() (r bool, exists bool) {
+	v := m.is_unknown
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLatestChapterTitle returns the old "latest_chapter_title" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldIsUnknown returns the old "is_unknown" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldLatestChapterTitle(ctx context.Context) (v string, err error) {
+func (m *SeriesProviderMutation) OldIsUnknown(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLatestChapterTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsUnknown is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLatestChapterTitle requires an ID field in the mutation")
+		return v, errors.New("OldIsUnknown requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLatestChapterTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsUnknown: %w", err)
 	}
-	return oldValue.LatestChapterTitle, nil
+	return oldValue.IsUnknown, nil
 }
 
-// ResetLatestChapterTitle resets all changes to the "latest_chapter_title" field.
-func (m *LatestSeriesMutation) ResetLatestChapterTitle() {
-	m.latest_chapter_title = nil
+// ResetIsUnknown resets all changes to the "is_unknown" field.
+func (m *SeriesProviderMutation) ResetIsUnknown() {
+	m.is_unknown = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *LatestSeriesMutation) SetStatus(s string) {
-	m.status = &s
+// SetImportance sets the "importance" field.
+func (m *SeriesProviderMutation) SetImportance(i int) {
+	m.importance = &i
+	m.addimportance = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *LatestSeriesMutation) Status() (r string, exists bool) {
-	v := m.status
+// Importance returns the value of the "importance" field in the mutation.
+func (m *SeriesProviderMutation) Importance() (r int, exists bool) {
+	v := m.importance
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldImportance returns the old "importance" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *SeriesProviderMutation) OldImportance(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldImportance is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldImportance requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldImportance: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Importance, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *LatestSeriesMutation) ResetStatus() {
-	m.status = nil
+// AddImportance adds i to the "importance" field.
+func (m *SeriesProviderMutation) AddImportance(i int) {
+	if m.addimportance != nil {
+		*m.addimportance += i
+	} else {
+		m.addimportance = &i
+	}
 }
 
-// SetInLibrary sets the "in_library" field.
-func (m *LatestSeriesMutation) SetInLibrary(i int) {
-	m.in_library = &i
-	m.addin_library = nil
+// AddedImportance returns the value that was added to the "importance" field in this mutation.
+func (m *SeriesProviderMutation) AddedImportance() (r int, exists bool) {
+	v := m.addimportance
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// InLibrary returns the value of the "in_library" field in the mutation.
-func (m *LatestSeriesMutation) InLibrary() (r int, exists bool) {
-	v := m.in_library
+// ResetImportance resets all changes to the "importance" field.
+func (m *SeriesProviderMutation) ResetImportance() {
+	m.importance = nil
+	m.addimportance = nil
+}
+
+// SetIsDisabled sets the "is_disabled" field.
+func (m *SeriesProviderMutation) SetIsDisabled(b bool) {
+	m.is_disabled = &b
+}
+
+// IsDisabled returns the value of the "is_disabled" field in the mutation.
+func (m *SeriesProviderMutation) IsDisabled() (r bool, exists bool) {
+	v := m.is_disabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInLibrary returns the old "in_library" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldIsDisabled returns the old "is_disabled" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldInLibrary(ctx context.Context) (v int, err error) {
+func (m *SeriesProviderMutation) OldIsDisabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInLibrary is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsDisabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInLibrary requires an ID field in the mutation")
+		return v, errors.New("OldIsDisabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInLibrary: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsDisabled: %w", err)
 	}
-	return oldValue.InLibrary, nil
+	return oldValue.IsDisabled, nil
 }
 
-// AddInLibrary adds i to the "in_library" field.
-func (m *LatestSeriesMutation) AddInLibrary(i int) {
-	if m.addin_library != nil {
-		*m.addin_library += i
-	} else {
-		m.addin_library = &i
-	}
+// ResetIsDisabled resets all changes to the "is_disabled" field.
+func (m *SeriesProviderMutation) ResetIsDisabled() {
+	m.is_disabled = nil
 }
 
-// AddedInLibrary returns the value that was added to the "in_library" field in this mutation.
-func (m *LatestSeriesMutation) AddedInLibrary() (r int, exists bool) {
-	v := m.addin_library
+// SetIsUninstalled sets the "is_uninstalled" field.
+func (m *SeriesProviderMutation) SetIsUninstalled(b bool) {
+	m.is_uninstalled = &b
+}
+
+// IsUninstalled returns the value of the "is_uninstalled" field in the mutation.
+func (m *SeriesProviderMutation) IsUninstalled() (r bool, exists bool) {
+	v := m.is_uninstalled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetInLibrary resets all changes to the "in_library" field.
-func (m *LatestSeriesMutation) ResetInLibrary() {
-	m.in_library = nil
-	m.addin_library = nil
+// OldIsUninstalled returns the old "is_uninstalled" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldIsUninstalled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsUninstalled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsUninstalled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsUninstalled: %w", err)
+	}
+	return oldValue.IsUninstalled, nil
 }
 
-// SetSeriesID sets the "series_id" field.
-func (m *LatestSeriesMutation) SetSeriesID(u uuid.UUID) {
-	m.series = &u
+// ResetIsUninstalled resets all changes to the "is_uninstalled" field.
+func (m *SeriesProviderMutation) ResetIsUninstalled() {
+	m.is_uninstalled = nil
 }
 
-// SeriesID returns the value of the "series_id" field in the mutation.
-func (m *LatestSeriesMutation) SeriesID() (r uuid.UUID, exists bool) {
-	v := m.series
+// SetStatus sets the "status" field.
+func (m *SeriesProviderMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SeriesProviderMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeriesID returns the old "series_id" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldSeriesID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *SeriesProviderMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeriesID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeriesID requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeriesID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.SeriesID, nil
+	return oldValue.Status, nil
 }
 
-// ClearSeriesID clears the value of the "series_id" field.
-func (m *LatestSeriesMutation) ClearSeriesID() {
-	m.series = nil
-	m.clearedFields[latestseries.FieldSeriesID] = struct{}{}
+// ResetStatus resets all changes to the "status" field.
+func (m *SeriesProviderMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SeriesIDCleared returns if the "series_id" field was cleared in this mutation.
-func (m *LatestSeriesMutation) SeriesIDCleared() bool {
-	_, ok := m.clearedFields[latestseries.FieldSeriesID]
-	return ok
+// SetPageCountSynced sets the "page_count_synced" field.
+func (m *SeriesProviderMutation) SetPageCountSynced(b bool) {
+	m.page_count_synced = &b
 }
 
-// ResetSeriesID resets all changes to the "series_id" field.
-func (m *LatestSeriesMutation) ResetSeriesID() {
-	m.series = nil
-	delete(m.clearedFields, latestseries.FieldSeriesID)
+// PageCountSynced returns the value of the "page_count_synced" field in the mutation.
+func (m *SeriesProviderMutation) PageCountSynced() (r bool, exists bool) {
+	v := m.page_count_synced
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPageCountSynced returns the old "page_count_synced" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SeriesProviderMutation) OldPageCountSynced(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPageCountSynced is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPageCountSynced requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPageCountSynced: %w", err)
+	}
+	return oldValue.PageCountSynced, nil
+}
+
+// ResetPageCountSynced resets all changes to the "page_count_synced" field.
+func (m *SeriesProviderMutation) ResetPageCountSynced() {
+	m.page_count_synced = nil
 }
 
 // SetChapters sets the "chapters" field.
-func (m *LatestSeriesMutation) SetChapters(tc []types.SuwayomiChapter) {
-	m.chapters = &tc
+func (m *SeriesProviderMutation) SetChapters(t []types.Chapter) {
+	m.chapters = &t
 	m.appendchapters = nil
 }
 
 // Chapters returns the value of the "chapters" field in the mutation.
-func (m *LatestSeriesMutation) Chapters() (r []types.SuwayomiChapter, exists bool) {
+func (m *SeriesProviderMutation) Chapters() (r []types.Chapter, exists bool) {
 	v := m.chapters
 	if v == nil {
 		return
@@ -2995,10 +12620,10 @@ func (m *LatestSeriesMutation) Chapters() (r []types.SuwayomiChapter, exists boo
 	return *v, true
 }
 
-// OldChapters returns the old "chapters" field's value of the LatestSeries entity.
-// If the LatestSeries object wasn't provided to the builder, the object is fetched from the database.
+// OldChapters returns the old "chapters" field's value of the SeriesProvider entity.
+// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LatestSeriesMutation) OldChapters(ctx context.Context) (v []types.SuwayomiChapter, err error) {
+func (m *SeriesProviderMutation) OldChapters(ctx context.Context) (v []types.Chapter, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldChapters is only allowed on UpdateOne operations")
 	}
@@ -3012,13 +12637,13 @@ func (m *LatestSeriesMutation) OldChapters(ctx context.Context) (v []types.Suway
 	return oldValue.Chapters, nil
 }
 
-// AppendChapters adds tc to the "chapters" field.
-func (m *LatestSeriesMutation) AppendChapters(tc []types.SuwayomiChapter) {
-	m.appendchapters = append(m.appendchapters, tc...)
+// AppendChapters adds t to the "chapters" field.
+func (m *SeriesProviderMutation) AppendChapters(t []types.Chapter) {
+	m.appendchapters = append(m.appendchapters, t...)
 }
 
 // AppendedChapters returns the list of values that were appended to the "chapters" field in this mutation.
-func (m *LatestSeriesMutation) AppendedChapters() ([]types.SuwayomiChapter, bool) {
+func (m *SeriesProviderMutation) AppendedChapters() ([]types.Chapter, bool) {
 	if len(m.appendchapters) == 0 {
 		return nil, false
 	}
@@ -3026,40 +12651,40 @@ func (m *LatestSeriesMutation) AppendedChapters() ([]types.SuwayomiChapter, bool
 }
 
 // ClearChapters clears the value of the "chapters" field.
-func (m *LatestSeriesMutation) ClearChapters() {
+func (m *SeriesProviderMutation) ClearChapters() {
 	m.chapters = nil
 	m.appendchapters = nil
-	m.clearedFields[latestseries.FieldChapters] = struct{}{}
+	m.clearedFields[seriesprovider.FieldChapters] = struct{}{}
 }
 
 // ChaptersCleared returns if the "chapters" field was cleared in this mutation.
-func (m *LatestSeriesMutation) ChaptersCleared() bool {
-	_, ok := m.clearedFields[latestseries.FieldChapters]
+func (m *SeriesProviderMutation) ChaptersCleared() bool {
+	_, ok := m.clearedFields[seriesprovider.FieldChapters]
 	return ok
 }
 
 // ResetChapters resets all changes to the "chapters" field.
-func (m *LatestSeriesMutation) ResetChapters() {
+func (m *SeriesProviderMutation) ResetChapters() {
 	m.chapters = nil
 	m.appendchapters = nil
-	delete(m.clearedFields, latestseries.FieldChapters)
+	delete(m.clearedFields, seriesprovider.FieldChapters)
 }
 
 // ClearSeries clears the "series" edge to the Series entity.
-func (m *LatestSeriesMutation) ClearSeries() {
+func (m *SeriesProviderMutation) ClearSeries() {
 	m.clearedseries = true
-	m.clearedFields[latestseries.FieldSeriesID] = struct{}{}
+	m.clearedFields[seriesprovider.FieldSeriesID] = struct{}{}
 }
 
 // SeriesCleared reports if the "series" edge to the Series entity was cleared.
-func (m *LatestSeriesMutation) SeriesCleared() bool {
-	return m.SeriesIDCleared() || m.clearedseries
+func (m *SeriesProviderMutation) SeriesCleared() bool {
+	return m.clearedseries
 }
 
 // SeriesIDs returns the "series" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // SeriesID instead. It exists only for internal usage by the builders.
-func (m *LatestSeriesMutation) SeriesIDs() (ids []uuid.UUID) {
+func (m *SeriesProviderMutation) SeriesIDs() (ids []uuid.UUID) {
 	if id := m.series; id != nil {
 		ids = append(ids, *id)
 	}
@@ -3067,20 +12692,20 @@ func (m *LatestSeriesMutation) SeriesIDs() (ids []uuid.UUID) {
 }
 
 // ResetSeries resets all changes to the "series" edge.
-func (m *LatestSeriesMutation) ResetSeries() {
+func (m *SeriesProviderMutation) ResetSeries() {
 	m.series = nil
 	m.clearedseries = false
 }
 
-// Where appends a list predicates to the LatestSeriesMutation builder.
-func (m *LatestSeriesMutation) Where(ps ...predicate.LatestSeries) {
+// Where appends a list predicates to the SeriesProviderMutation builder.
+func (m *SeriesProviderMutation) Where(ps ...predicate.SeriesProvider) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LatestSeriesMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SeriesProviderMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LatestSeriesMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LatestSeries, len(ps))
+func (m *SeriesProviderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SeriesProvider, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3088,78 +12713,96 @@ func (m *LatestSeriesMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LatestSeriesMutation) Op() Op {
+func (m *SeriesProviderMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LatestSeriesMutation) SetOp(op Op) {
+func (m *SeriesProviderMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (LatestSeries).
-func (m *LatestSeriesMutation) Type() string {
+// Type returns the node type of this mutation (SeriesProvider).
+func (m *SeriesProviderMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LatestSeriesMutation) Fields() []string {
-	fields := make([]string, 0, 18)
-	if m.suwayomi_source_id != nil {
-		fields = append(fields, latestseries.FieldSuwayomiSourceID)
+func (m *SeriesProviderMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.series != nil {
+		fields = append(fields, seriesprovider.FieldSeriesID)
+	}
+	if m.suwayomi_id != nil {
+		fields = append(fields, seriesprovider.FieldSuwayomiID)
 	}
 	if m.provider != nil {
-		fields = append(fields, latestseries.FieldProvider)
+		fields = append(fields, seriesprovider.FieldProvider)
 	}
-	if m.language != nil {
-		fields = append(fields, latestseries.FieldLanguage)
+	if m.scanlator != nil {
+		fields = append(fields, seriesprovider.FieldScanlator)
 	}
 	if m.url != nil {
-		fields = append(fields, latestseries.FieldURL)
+		fields = append(fields, seriesprovider.FieldURL)
 	}
 	if m.title != nil {
-		fields = append(fields, latestseries.FieldTitle)
+		fields = append(fields, seriesprovider.FieldTitle)
+	}
+	if m.language != nil {
+		fields = append(fields, seriesprovider.FieldLanguage)
 	}
 	if m.thumbnail_url != nil {
-		fields = append(fields, latestseries.FieldThumbnailURL)
+		fields = append(fields, seriesprovider.FieldThumbnailURL)
 	}
 	if m.artist != nil {
-		fields = append(fields, latestseries.FieldArtist)
+		fields = append(fields, seriesprovider.FieldArtist)
 	}
 	if m.author != nil {
-		fields = append(fields, latestseries.FieldAuthor)
+		fields = append(fields, seriesprovider.FieldAuthor)
 	}
 	if m.description != nil {
-		fields = append(fields, latestseries.FieldDescription)
+		fields = append(fields, seriesprovider.FieldDescription)
 	}
 	if m.genre != nil {
-		fields = append(fields, latestseries.FieldGenre)
+		fields = append(fields, seriesprovider.FieldGenre)
 	}
 	if m.fetch_date != nil {
-		fields = append(fields, latestseries.FieldFetchDate)
+		fields = append(fields, seriesprovider.FieldFetchDate)
 	}
 	if m.chapter_count != nil {
-		fields = append(fields, latestseries.FieldChapterCount)
+		fields = append(fields, seriesprovider.FieldChapterCount)
 	}
-	if m.latest_chapter != nil {
-		fields = append(fields, latestseries.FieldLatestChapter)
+	if m.continue_after_chapter != nil {
+		fields = append(fields, seriesprovider.FieldContinueAfterChapter)
 	}
-	if m.latest_chapter_title != nil {
-		fields = append(fields, latestseries.FieldLatestChapterTitle)
+	if m.is_title != nil {
+		fields = append(fields, seriesprovider.FieldIsTitle)
 	}
-	if m.status != nil {
-		fields = append(fields, latestseries.FieldStatus)
+	if m.is_cover != nil {
+		fields = append(fields, seriesprovider.FieldIsCover)
 	}
-	if m.in_library != nil {
-		fields = append(fields, latestseries.FieldInLibrary)
+	if m.is_unknown != nil {
+		fields = append(fields, seriesprovider.FieldIsUnknown)
 	}
-	if m.series != nil {
-		fields = append(fields, latestseries.FieldSeriesID)
+	if m.importance != nil {
+		fields = append(fields, seriesprovider.FieldImportance)
+	}
+	if m.is_disabled != nil {
+		fields = append(fields, seriesprovider.FieldIsDisabled)
+	}
+	if m.is_uninstalled != nil {
+		fields = append(fields, seriesprovider.FieldIsUninstalled)
+	}
+	if m.status != nil {
+		fields = append(fields, seriesprovider.FieldStatus)
+	}
+	if m.page_count_synced != nil {
+		fields = append(fields, seriesprovider.FieldPageCountSynced)
 	}
 	if m.chapters != nil {
-		fields = append(fields, latestseries.FieldChapters)
+		fields = append(fields, seriesprovider.FieldChapters)
 	}
 	return fields
 }
@@ -3167,43 +12810,55 @@ func (m *LatestSeriesMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LatestSeriesMutation) Field(name string) (ent.Value, bool) {
+func (m *SeriesProviderMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case latestseries.FieldSuwayomiSourceID:
-		return m.SuwayomiSourceID()
-	case latestseries.FieldProvider:
+	case seriesprovider.FieldSeriesID:
+		return m.SeriesID()
+	case seriesprovider.FieldSuwayomiID:
+		return m.SuwayomiID()
+	case seriesprovider.FieldProvider:
 		return m.Provider()
-	case latestseries.FieldLanguage:
-		return m.Language()
-	case latestseries.FieldURL:
+	case seriesprovider.FieldScanlator:
+		return m.Scanlator()
+	case seriesprovider.FieldURL:
 		return m.URL()
-	case latestseries.FieldTitle:
+	case seriesprovider.FieldTitle:
 		return m.Title()
-	case latestseries.FieldThumbnailURL:
+	case seriesprovider.FieldLanguage:
+		return m.Language()
+	case seriesprovider.FieldThumbnailURL:
 		return m.ThumbnailURL()
-	case latestseries.FieldArtist:
+	case seriesprovider.FieldArtist:
 		return m.Artist()
-	case latestseries.FieldAuthor:
+	case seriesprovider.FieldAuthor:
 		return m.Author()
-	case latestseries.FieldDescription:
+	case seriesprovider.FieldDescription:
 		return m.Description()
-	case latestseries.FieldGenre:
+	case seriesprovider.FieldGenre:
 		return m.Genre()
-	case latestseries.FieldFetchDate:
+	case seriesprovider.FieldFetchDate:
 		return m.FetchDate()
-	case latestseries.FieldChapterCount:
+	case seriesprovider.FieldChapterCount:
 		return m.ChapterCount()
-	case latestseries.FieldLatestChapter:
-		return m.LatestChapter()
-	case latestseries.FieldLatestChapterTitle:
-		return m.LatestChapterTitle()
-	case latestseries.FieldStatus:
+	case seriesprovider.FieldContinueAfterChapter:
+		return m.ContinueAfterChapter()
+	case seriesprovider.FieldIsTitle:
+		return m.IsTitle()
+	case seriesprovider.FieldIsCover:
+		return m.IsCover()
+	case seriesprovider.FieldIsUnknown:
+		return m.IsUnknown()
+	case seriesprovider.FieldImportance:
+		return m.Importance()
+	case seriesprovider.FieldIsDisabled:
+		return m.IsDisabled()
+	case seriesprovider.FieldIsUninstalled:
+		return m.IsUninstalled()
+	case seriesprovider.FieldStatus:
 		return m.Status()
-	case latestseries.FieldInLibrary:
-		return m.InLibrary()
-	case latestseries.FieldSeriesID:
-		return m.SeriesID()
-	case latestseries.FieldChapters:
+	case seriesprovider.FieldPageCountSynced:
+		return m.PageCountSynced()
+	case seriesprovider.FieldChapters:
 		return m.Chapters()
 	}
 	return nil, false
@@ -3212,195 +12867,252 @@ func (m *LatestSeriesMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LatestSeriesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SeriesProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case latestseries.FieldSuwayomiSourceID:
-		return m.OldSuwayomiSourceID(ctx)
-	case latestseries.FieldProvider:
+	case seriesprovider.FieldSeriesID:
+		return m.OldSeriesID(ctx)
+	case seriesprovider.FieldSuwayomiID:
+		return m.OldSuwayomiID(ctx)
+	case seriesprovider.FieldProvider:
 		return m.OldProvider(ctx)
-	case latestseries.FieldLanguage:
-		return m.OldLanguage(ctx)
-	case latestseries.FieldURL:
+	case seriesprovider.FieldScanlator:
+		return m.OldScanlator(ctx)
+	case seriesprovider.FieldURL:
 		return m.OldURL(ctx)
-	case latestseries.FieldTitle:
+	case seriesprovider.FieldTitle:
 		return m.OldTitle(ctx)
-	case latestseries.FieldThumbnailURL:
+	case seriesprovider.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case seriesprovider.FieldThumbnailURL:
 		return m.OldThumbnailURL(ctx)
-	case latestseries.FieldArtist:
+	case seriesprovider.FieldArtist:
 		return m.OldArtist(ctx)
-	case latestseries.FieldAuthor:
+	case seriesprovider.FieldAuthor:
 		return m.OldAuthor(ctx)
-	case latestseries.FieldDescription:
+	case seriesprovider.FieldDescription:
 		return m.OldDescription(ctx)
-	case latestseries.FieldGenre:
+	case seriesprovider.FieldGenre:
 		return m.OldGenre(ctx)
-	case latestseries.FieldFetchDate:
+	case seriesprovider.FieldFetchDate:
 		return m.OldFetchDate(ctx)
-	case latestseries.FieldChapterCount:
+	case seriesprovider.FieldChapterCount:
 		return m.OldChapterCount(ctx)
-	case latestseries.FieldLatestChapter:
-		return m.OldLatestChapter(ctx)
-	case latestseries.FieldLatestChapterTitle:
-		return m.OldLatestChapterTitle(ctx)
-	case latestseries.FieldStatus:
+	case seriesprovider.FieldContinueAfterChapter:
+		return m.OldContinueAfterChapter(ctx)
+	case seriesprovider.FieldIsTitle:
+		return m.OldIsTitle(ctx)
+	case seriesprovider.FieldIsCover:
+		return m.OldIsCover(ctx)
+	case seriesprovider.FieldIsUnknown:
+		return m.OldIsUnknown(ctx)
+	case seriesprovider.FieldImportance:
+		return m.OldImportance(ctx)
+	case seriesprovider.FieldIsDisabled:
+		return m.OldIsDisabled(ctx)
+	case seriesprovider.FieldIsUninstalled:
+		return m.OldIsUninstalled(ctx)
+	case seriesprovider.FieldStatus:
 		return m.OldStatus(ctx)
-	case latestseries.FieldInLibrary:
-		return m.OldInLibrary(ctx)
-	case latestseries.FieldSeriesID:
-		return m.OldSeriesID(ctx)
-	case latestseries.FieldChapters:
+	case seriesprovider.FieldPageCountSynced:
+		return m.OldPageCountSynced(ctx)
+	case seriesprovider.FieldChapters:
 		return m.OldChapters(ctx)
 	}
-	return nil, fmt.Errorf("unknown LatestSeries field %s", name)
+	return nil, fmt.Errorf("unknown SeriesProvider field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LatestSeriesMutation) SetField(name string, value ent.Value) error {
+func (m *SeriesProviderMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case latestseries.FieldSuwayomiSourceID:
-		v, ok := value.(string)
+	case seriesprovider.FieldSeriesID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSuwayomiSourceID(v)
+		m.SetSeriesID(v)
 		return nil
-	case latestseries.FieldProvider:
+	case seriesprovider.FieldSuwayomiID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuwayomiID(v)
+		return nil
+	case seriesprovider.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetProvider(v)
 		return nil
-	case latestseries.FieldLanguage:
+	case seriesprovider.FieldScanlator:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLanguage(v)
+		m.SetScanlator(v)
 		return nil
-	case latestseries.FieldURL:
+	case seriesprovider.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetURL(v)
 		return nil
-	case latestseries.FieldTitle:
+	case seriesprovider.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case seriesprovider.FieldLanguage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetLanguage(v)
 		return nil
-	case latestseries.FieldThumbnailURL:
+	case seriesprovider.FieldThumbnailURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetThumbnailURL(v)
 		return nil
-	case latestseries.FieldArtist:
+	case seriesprovider.FieldArtist:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetArtist(v)
 		return nil
-	case latestseries.FieldAuthor:
+	case seriesprovider.FieldAuthor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAuthor(v)
 		return nil
-	case latestseries.FieldDescription:
+	case seriesprovider.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case latestseries.FieldGenre:
+	case seriesprovider.FieldGenre:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetGenre(v)
 		return nil
-	case latestseries.FieldFetchDate:
+	case seriesprovider.FieldFetchDate:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetFetchDate(v)
 		return nil
-	case latestseries.FieldChapterCount:
+	case seriesprovider.FieldChapterCount:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChapterCount(v)
 		return nil
-	case latestseries.FieldLatestChapter:
+	case seriesprovider.FieldContinueAfterChapter:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLatestChapter(v)
+		m.SetContinueAfterChapter(v)
 		return nil
-	case latestseries.FieldLatestChapterTitle:
-		v, ok := value.(string)
+	case seriesprovider.FieldIsTitle:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLatestChapterTitle(v)
+		m.SetIsTitle(v)
 		return nil
-	case latestseries.FieldStatus:
-		v, ok := value.(string)
+	case seriesprovider.FieldIsCover:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetIsCover(v)
 		return nil
-	case latestseries.FieldInLibrary:
+	case seriesprovider.FieldIsUnknown:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsUnknown(v)
+		return nil
+	case seriesprovider.FieldImportance:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInLibrary(v)
+		m.SetImportance(v)
 		return nil
-	case latestseries.FieldSeriesID:
-		v, ok := value.(uuid.UUID)
+	case seriesprovider.FieldIsDisabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSeriesID(v)
+		m.SetIsDisabled(v)
 		return nil
-	case latestseries.FieldChapters:
-		v, ok := value.([]types.SuwayomiChapter)
+	case seriesprovider.FieldIsUninstalled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsUninstalled(v)
+		return nil
+	case seriesprovider.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case seriesprovider.FieldPageCountSynced:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPageCountSynced(v)
+		return nil
+	case seriesprovider.FieldChapters:
+		v, ok := value.([]types.Chapter)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChapters(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LatestSeries field %s", name)
+	return fmt.Errorf("unknown SeriesProvider field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LatestSeriesMutation) AddedFields() []string {
+func (m *SeriesProviderMutation) AddedFields() []string {
 	var fields []string
+	if m.addsuwayomi_id != nil {
+		fields = append(fields, seriesprovider.FieldSuwayomiID)
+	}
 	if m.addchapter_count != nil {
-		fields = append(fields, latestseries.FieldChapterCount)
+		fields = append(fields, seriesprovider.FieldChapterCount)
 	}
-	if m.addlatest_chapter != nil {
-		fields = append(fields, latestseries.FieldLatestChapter)
+	if m.addcontinue_after_chapter != nil {
+		fields = append(fields, seriesprovider.FieldContinueAfterChapter)
 	}
-	if m.addin_library != nil {
-		fields = append(fields, latestseries.FieldInLibrary)
+	if m.addimportance != nil {
+		fields = append(fields, seriesprovider.FieldImportance)
 	}
 	return fields
 }
@@ -3408,14 +13120,16 @@ func (m *LatestSeriesMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LatestSeriesMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SeriesProviderMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case latestseries.FieldChapterCount:
+	case seriesprovider.FieldSuwayomiID:
+		return m.AddedSuwayomiID()
+	case seriesprovider.FieldChapterCount:
 		return m.AddedChapterCount()
-	case latestseries.FieldLatestChapter:
-		return m.AddedLatestChapter()
-	case latestseries.FieldInLibrary:
-		return m.AddedInLibrary()
+	case seriesprovider.FieldContinueAfterChapter:
+		return m.AddedContinueAfterChapter()
+	case seriesprovider.FieldImportance:
+		return m.AddedImportance()
 	}
 	return nil, false
 }
@@ -3423,191 +13137,216 @@ func (m *LatestSeriesMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LatestSeriesMutation) AddField(name string, value ent.Value) error {
+func (m *SeriesProviderMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case latestseries.FieldChapterCount:
+	case seriesprovider.FieldSuwayomiID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSuwayomiID(v)
+		return nil
+	case seriesprovider.FieldChapterCount:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddChapterCount(v)
 		return nil
-	case latestseries.FieldLatestChapter:
+	case seriesprovider.FieldContinueAfterChapter:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLatestChapter(v)
+		m.AddContinueAfterChapter(v)
 		return nil
-	case latestseries.FieldInLibrary:
+	case seriesprovider.FieldImportance:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddInLibrary(v)
+		m.AddImportance(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LatestSeries numeric field %s", name)
+	return fmt.Errorf("unknown SeriesProvider numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LatestSeriesMutation) ClearedFields() []string {
+func (m *SeriesProviderMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(latestseries.FieldURL) {
-		fields = append(fields, latestseries.FieldURL)
+	if m.FieldCleared(seriesprovider.FieldURL) {
+		fields = append(fields, seriesprovider.FieldURL)
 	}
-	if m.FieldCleared(latestseries.FieldThumbnailURL) {
-		fields = append(fields, latestseries.FieldThumbnailURL)
+	if m.FieldCleared(seriesprovider.FieldThumbnailURL) {
+		fields = append(fields, seriesprovider.FieldThumbnailURL)
 	}
-	if m.FieldCleared(latestseries.FieldArtist) {
-		fields = append(fields, latestseries.FieldArtist)
+	if m.FieldCleared(seriesprovider.FieldArtist) {
+		fields = append(fields, seriesprovider.FieldArtist)
 	}
-	if m.FieldCleared(latestseries.FieldAuthor) {
-		fields = append(fields, latestseries.FieldAuthor)
+	if m.FieldCleared(seriesprovider.FieldAuthor) {
+		fields = append(fields, seriesprovider.FieldAuthor)
 	}
-	if m.FieldCleared(latestseries.FieldDescription) {
-		fields = append(fields, latestseries.FieldDescription)
+	if m.FieldCleared(seriesprovider.FieldDescription) {
+		fields = append(fields, seriesprovider.FieldDescription)
 	}
-	if m.FieldCleared(latestseries.FieldGenre) {
-		fields = append(fields, latestseries.FieldGenre)
+	if m.FieldCleared(seriesprovider.FieldGenre) {
+		fields = append(fields, seriesprovider.FieldGenre)
 	}
-	if m.FieldCleared(latestseries.FieldChapterCount) {
-		fields = append(fields, latestseries.FieldChapterCount)
+	if m.FieldCleared(seriesprovider.FieldFetchDate) {
+		fields = append(fields, seriesprovider.FieldFetchDate)
 	}
-	if m.FieldCleared(latestseries.FieldLatestChapter) {
-		fields = append(fields, latestseries.FieldLatestChapter)
+	if m.FieldCleared(seriesprovider.FieldChapterCount) {
+		fields = append(fields, seriesprovider.FieldChapterCount)
 	}
-	if m.FieldCleared(latestseries.FieldSeriesID) {
-		fields = append(fields, latestseries.FieldSeriesID)
+	if m.FieldCleared(seriesprovider.FieldContinueAfterChapter) {
+		fields = append(fields, seriesprovider.FieldContinueAfterChapter)
 	}
-	if m.FieldCleared(latestseries.FieldChapters) {
-		fields = append(fields, latestseries.FieldChapters)
+	if m.FieldCleared(seriesprovider.FieldChapters) {
+		fields = append(fields, seriesprovider.FieldChapters)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LatestSeriesMutation) FieldCleared(name string) bool {
+func (m *SeriesProviderMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LatestSeriesMutation) ClearField(name string) error {
+func (m *SeriesProviderMutation) ClearField(name string) error {
 	switch name {
-	case latestseries.FieldURL:
+	case seriesprovider.FieldURL:
 		m.ClearURL()
 		return nil
-	case latestseries.FieldThumbnailURL:
+	case seriesprovider.FieldThumbnailURL:
 		m.ClearThumbnailURL()
 		return nil
-	case latestseries.FieldArtist:
+	case seriesprovider.FieldArtist:
 		m.ClearArtist()
 		return nil
-	case latestseries.FieldAuthor:
+	case seriesprovider.FieldAuthor:
 		m.ClearAuthor()
 		return nil
-	case latestseries.FieldDescription:
+	case seriesprovider.FieldDescription:
 		m.ClearDescription()
 		return nil
-	case latestseries.FieldGenre:
+	case seriesprovider.FieldGenre:
 		m.ClearGenre()
 		return nil
-	case latestseries.FieldChapterCount:
-		m.ClearChapterCount()
+	case seriesprovider.FieldFetchDate:
+		m.ClearFetchDate()
 		return nil
-	case latestseries.FieldLatestChapter:
-		m.ClearLatestChapter()
+	case seriesprovider.FieldChapterCount:
+		m.ClearChapterCount()
 		return nil
-	case latestseries.FieldSeriesID:
-		m.ClearSeriesID()
+	case seriesprovider.FieldContinueAfterChapter:
+		m.ClearContinueAfterChapter()
 		return nil
-	case latestseries.FieldChapters:
+	case seriesprovider.FieldChapters:
 		m.ClearChapters()
 		return nil
 	}
-	return fmt.Errorf("unknown LatestSeries nullable field %s", name)
+	return fmt.Errorf("unknown SeriesProvider nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LatestSeriesMutation) ResetField(name string) error {
+func (m *SeriesProviderMutation) ResetField(name string) error {
 	switch name {
-	case latestseries.FieldSuwayomiSourceID:
-		m.ResetSuwayomiSourceID()
+	case seriesprovider.FieldSeriesID:
+		m.ResetSeriesID()
 		return nil
-	case latestseries.FieldProvider:
+	case seriesprovider.FieldSuwayomiID:
+		m.ResetSuwayomiID()
+		return nil
+	case seriesprovider.FieldProvider:
 		m.ResetProvider()
 		return nil
-	case latestseries.FieldLanguage:
-		m.ResetLanguage()
+	case seriesprovider.FieldScanlator:
+		m.ResetScanlator()
 		return nil
-	case latestseries.FieldURL:
+	case seriesprovider.FieldURL:
 		m.ResetURL()
 		return nil
-	case latestseries.FieldTitle:
+	case seriesprovider.FieldTitle:
 		m.ResetTitle()
 		return nil
-	case latestseries.FieldThumbnailURL:
+	case seriesprovider.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case seriesprovider.FieldThumbnailURL:
 		m.ResetThumbnailURL()
 		return nil
-	case latestseries.FieldArtist:
+	case seriesprovider.FieldArtist:
 		m.ResetArtist()
 		return nil
-	case latestseries.FieldAuthor:
+	case seriesprovider.FieldAuthor:
 		m.ResetAuthor()
 		return nil
-	case latestseries.FieldDescription:
+	case seriesprovider.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case latestseries.FieldGenre:
+	case seriesprovider.FieldGenre:
 		m.ResetGenre()
 		return nil
-	case latestseries.FieldFetchDate:
+	case seriesprovider.FieldFetchDate:
 		m.ResetFetchDate()
 		return nil
-	case latestseries.FieldChapterCount:
+	case seriesprovider.FieldChapterCount:
 		m.ResetChapterCount()
 		return nil
-	case latestseries.FieldLatestChapter:
-		m.ResetLatestChapter()
+	case seriesprovider.FieldContinueAfterChapter:
+		m.ResetContinueAfterChapter()
+		return nil
+	case seriesprovider.FieldIsTitle:
+		m.ResetIsTitle()
+		return nil
+	case seriesprovider.FieldIsCover:
+		m.ResetIsCover()
+		return nil
+	case seriesprovider.FieldIsUnknown:
+		m.ResetIsUnknown()
+		return nil
+	case seriesprovider.FieldImportance:
+		m.ResetImportance()
+		return nil
+	case seriesprovider.FieldIsDisabled:
+		m.ResetIsDisabled()
 		return nil
-	case latestseries.FieldLatestChapterTitle:
-		m.ResetLatestChapterTitle()
+	case seriesprovider.FieldIsUninstalled:
+		m.ResetIsUninstalled()
 		return nil
-	case latestseries.FieldStatus:
+	case seriesprovider.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case latestseries.FieldInLibrary:
-		m.ResetInLibrary()
-		return nil
-	case latestseries.FieldSeriesID:
-		m.ResetSeriesID()
+	case seriesprovider.FieldPageCountSynced:
+		m.ResetPageCountSynced()
 		return nil
-	case latestseries.FieldChapters:
+	case seriesprovider.FieldChapters:
 		m.ResetChapters()
 		return nil
 	}
-	return fmt.Errorf("unknown LatestSeries field %s", name)
+	return fmt.Errorf("unknown SeriesProvider field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LatestSeriesMutation) AddedEdges() []string {
+func (m *SeriesProviderMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.series != nil {
-		edges = append(edges, latestseries.EdgeSeries)
+		edges = append(edges, seriesprovider.EdgeSeries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LatestSeriesMutation) AddedIDs(name string) []ent.Value {
+func (m *SeriesProviderMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case latestseries.EdgeSeries:
+	case seriesprovider.EdgeSeries:
 		if id := m.series; id != nil {
 			return []ent.Value{*id}
 		}
@@ -3616,31 +13355,31 @@ func (m *LatestSeriesMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LatestSeriesMutation) RemovedEdges() []string {
+func (m *SeriesProviderMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LatestSeriesMutation) RemovedIDs(name string) []ent.Value {
+func (m *SeriesProviderMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LatestSeriesMutation) ClearedEdges() []string {
+func (m *SeriesProviderMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedseries {
-		edges = append(edges, latestseries.EdgeSeries)
+		edges = append(edges, seriesprovider.EdgeSeries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LatestSeriesMutation) EdgeCleared(name string) bool {
+func (m *SeriesProviderMutation) EdgeCleared(name string) bool {
 	switch name {
-	case latestseries.EdgeSeries:
+	case seriesprovider.EdgeSeries:
 		return m.clearedseries
 	}
 	return false
@@ -3648,59 +13387,53 @@ func (m *LatestSeriesMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LatestSeriesMutation) ClearEdge(name string) error {
+func (m *SeriesProviderMutation) ClearEdge(name string) error {
 	switch name {
-	case latestseries.EdgeSeries:
+	case seriesprovider.EdgeSeries:
 		m.ClearSeries()
 		return nil
 	}
-	return fmt.Errorf("unknown LatestSeries unique edge %s", name)
+	return fmt.Errorf("unknown SeriesProvider unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LatestSeriesMutation) ResetEdge(name string) error {
+func (m *SeriesProviderMutation) ResetEdge(name string) error {
 	switch name {
-	case latestseries.EdgeSeries:
+	case seriesprovider.EdgeSeries:
 		m.ResetSeries()
 		return nil
 	}
-	return fmt.Errorf("unknown LatestSeries edge %s", name)
+	return fmt.Errorf("unknown SeriesProvider edge %s", name)
 }
 
-// ProviderStorageMutation represents an operation that mutates the ProviderStorage nodes in the graph.
-type ProviderStorageMutation struct {
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	apk_name        *string
-	pkg_name        *string
-	name            *string
-	lang            *string
-	version_code    *int64
-	addversion_code *int64
-	is_storage      *bool
-	is_disabled     *bool
-	mappings        *[]types.ProviderMapping
-	appendmappings  []types.ProviderMapping
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*ProviderStorage, error)
-	predicates      []predicate.ProviderStorage
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	token_hash    *string
+	user_id       *uuid.UUID
+	created_at    *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Session, error)
+	predicates    []predicate.Session
 }
 
-var _ ent.Mutation = (*ProviderStorageMutation)(nil)
+var _ ent.Mutation = (*SessionMutation)(nil)
 
-// providerstorageOption allows management of the mutation configuration using functional options.
-type providerstorageOption func(*ProviderStorageMutation)
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
 
-// newProviderStorageMutation creates new mutation for the ProviderStorage entity.
-func newProviderStorageMutation(c config, op Op, opts ...providerstorageOption) *ProviderStorageMutation {
-	m := &ProviderStorageMutation{
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProviderStorage,
+		typ:           TypeSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3709,20 +13442,20 @@ func newProviderStorageMutation(c config, op Op, opts ...providerstorageOption)
 	return m
 }
 
-// withProviderStorageID sets the ID field of the mutation.
-func withProviderStorageID(id uuid.UUID) providerstorageOption {
-	return func(m *ProviderStorageMutation) {
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id uuid.UUID) sessionOption {
+	return func(m *SessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ProviderStorage
+			value *Session
 		)
-		m.oldValue = func(ctx context.Context) (*ProviderStorage, error) {
+		m.oldValue = func(ctx context.Context) (*Session, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ProviderStorage.Get(ctx, id)
+					value, err = m.Client().Session.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3731,10 +13464,10 @@ func withProviderStorageID(id uuid.UUID) providerstorageOption {
 	}
 }
 
-// withProviderStorage sets the old ProviderStorage of the mutation.
-func withProviderStorage(node *ProviderStorage) providerstorageOption {
-	return func(m *ProviderStorageMutation) {
-		m.oldValue = func(context.Context) (*ProviderStorage, error) {
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3743,7 +13476,7 @@ func withProviderStorage(node *ProviderStorage) providerstorageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProviderStorageMutation) Client() *Client {
+func (m SessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3751,7 +13484,7 @@ func (m ProviderStorageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProviderStorageMutation) Tx() (*Tx, error) {
+func (m SessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3761,14 +13494,14 @@ func (m ProviderStorageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ProviderStorage entities.
-func (m *ProviderStorageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Session entities.
+func (m *SessionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProviderStorageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SessionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3779,7 +13512,7 @@ func (m *ProviderStorageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProviderStorageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3788,358 +13521,548 @@ func (m *ProviderStorageMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProviderStorage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetApkName sets the "apk_name" field.
-func (m *ProviderStorageMutation) SetApkName(s string) {
-	m.apk_name = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *SessionMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// ApkName returns the value of the "apk_name" field in the mutation.
-func (m *ProviderStorageMutation) ApkName() (r string, exists bool) {
-	v := m.apk_name
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *SessionMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldApkName returns the old "apk_name" field's value of the ProviderStorage entity.
-// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProviderStorageMutation) OldApkName(ctx context.Context) (v string, err error) {
+func (m *SessionMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldApkName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldApkName requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldApkName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.ApkName, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetApkName resets all changes to the "apk_name" field.
-func (m *ProviderStorageMutation) ResetApkName() {
-	m.apk_name = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *SessionMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetPkgName sets the "pkg_name" field.
-func (m *ProviderStorageMutation) SetPkgName(s string) {
-	m.pkg_name = &s
+// SetUserID sets the "user_id" field.
+func (m *SessionMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// PkgName returns the value of the "pkg_name" field in the mutation.
-func (m *ProviderStorageMutation) PkgName() (r string, exists bool) {
-	v := m.pkg_name
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SessionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPkgName returns the old "pkg_name" field's value of the ProviderStorage entity.
-// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProviderStorageMutation) OldPkgName(ctx context.Context) (v string, err error) {
+func (m *SessionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPkgName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPkgName requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPkgName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SessionMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.token_hash != nil {
+		fields = append(fields, session.FieldTokenHash)
+	}
+	if m.user_id != nil {
+		fields = append(fields, session.FieldUserID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, session.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldTokenHash:
+		return m.TokenHash()
+	case session.FieldUserID:
+		return m.UserID()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case session.FieldUserID:
+		return m.OldUserID(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case session.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case session.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
 	}
-	return oldValue.PkgName, nil
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
-// ResetPkgName resets all changes to the "pkg_name" field.
-func (m *ProviderStorageMutation) ResetPkgName() {
-	m.pkg_name = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	return nil
 }
 
-// SetName sets the "name" field.
-func (m *ProviderStorageMutation) SetName(s string) {
-	m.name = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ProviderStorageMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Session numeric field %s", name)
 }
 
-// OldName returns the old "name" field's value of the ProviderStorage entity.
-// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProviderStorageMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ProviderStorageMutation) ResetName() {
-	m.name = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetLang sets the "lang" field.
-func (m *ProviderStorageMutation) SetLang(s string) {
-	m.lang = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Session nullable field %s", name)
 }
 
-// Lang returns the value of the "lang" field in the mutation.
-func (m *ProviderStorageMutation) Lang() (r string, exists bool) {
-	v := m.lang
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case session.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
-// OldLang returns the old "lang" field's value of the ProviderStorage entity.
-// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProviderStorageMutation) OldLang(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLang is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLang requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLang: %w", err)
-	}
-	return oldValue.Lang, nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetLang resets all changes to the "lang" field.
-func (m *ProviderStorageMutation) ResetLang() {
-	m.lang = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetVersionCode sets the "version_code" field.
-func (m *ProviderStorageMutation) SetVersionCode(i int64) {
-	m.version_code = &i
-	m.addversion_code = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// VersionCode returns the value of the "version_code" field in the mutation.
-func (m *ProviderStorageMutation) VersionCode() (r int64, exists bool) {
-	v := m.version_code
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldVersionCode returns the old "version_code" field's value of the ProviderStorage entity.
-// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProviderStorageMutation) OldVersionCode(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersionCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersionCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersionCode: %w", err)
-	}
-	return oldValue.VersionCode, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AddVersionCode adds i to the "version_code" field.
-func (m *ProviderStorageMutation) AddVersionCode(i int64) {
-	if m.addversion_code != nil {
-		*m.addversion_code += i
-	} else {
-		m.addversion_code = &i
-	}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// AddedVersionCode returns the value that was added to the "version_code" field in this mutation.
-func (m *ProviderStorageMutation) AddedVersionCode() (r int64, exists bool) {
-	v := m.addversion_code
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Session unique edge %s", name)
 }
 
-// ResetVersionCode resets all changes to the "version_code" field.
-func (m *ProviderStorageMutation) ResetVersionCode() {
-	m.version_code = nil
-	m.addversion_code = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Session edge %s", name)
 }
 
-// SetIsStorage sets the "is_storage" field.
-func (m *ProviderStorageMutation) SetIsStorage(b bool) {
-	m.is_storage = &b
+// SettingMutation represents an operation that mutates the Setting nodes in the graph.
+type SettingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	value         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Setting, error)
+	predicates    []predicate.Setting
 }
 
-// IsStorage returns the value of the "is_storage" field in the mutation.
-func (m *ProviderStorageMutation) IsStorage() (r bool, exists bool) {
-	v := m.is_storage
-	if v == nil {
-		return
+var _ ent.Mutation = (*SettingMutation)(nil)
+
+// settingOption allows management of the mutation configuration using functional options.
+type settingOption func(*SettingMutation)
+
+// newSettingMutation creates new mutation for the Setting entity.
+func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
+	m := &SettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSetting,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldIsStorage returns the old "is_storage" field's value of the ProviderStorage entity.
-// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProviderStorageMutation) OldIsStorage(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsStorage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsStorage requires an ID field in the mutation")
+// withSettingID sets the ID field of the mutation.
+func withSettingID(id string) settingOption {
+	return func(m *SettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Setting
+		)
+		m.oldValue = func(ctx context.Context) (*Setting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Setting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsStorage: %w", err)
+}
+
+// withSetting sets the old Setting of the mutation.
+func withSetting(node *Setting) settingOption {
+	return func(m *SettingMutation) {
+		m.oldValue = func(context.Context) (*Setting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.IsStorage, nil
 }
 
-// ResetIsStorage resets all changes to the "is_storage" field.
-func (m *ProviderStorageMutation) ResetIsStorage() {
-	m.is_storage = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetIsDisabled sets the "is_disabled" field.
-func (m *ProviderStorageMutation) SetIsDisabled(b bool) {
-	m.is_disabled = &b
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Setting entities.
+func (m *SettingMutation) SetID(id string) {
+	m.id = &id
 }
 
-// IsDisabled returns the value of the "is_disabled" field in the mutation.
-func (m *ProviderStorageMutation) IsDisabled() (r bool, exists bool) {
-	v := m.is_disabled
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettingMutation) ID() (id string, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldIsDisabled returns the old "is_disabled" field's value of the ProviderStorage entity.
-// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProviderStorageMutation) OldIsDisabled(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDisabled is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDisabled requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDisabled: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettingMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.IsDisabled, nil
 }
 
-// ResetIsDisabled resets all changes to the "is_disabled" field.
-func (m *ProviderStorageMutation) ResetIsDisabled() {
-	m.is_disabled = nil
-}
-
-// SetMappings sets the "mappings" field.
-func (m *ProviderStorageMutation) SetMappings(tm []types.ProviderMapping) {
-	m.mappings = &tm
-	m.appendmappings = nil
+// SetValue sets the "value" field.
+func (m *SettingMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// Mappings returns the value of the "mappings" field in the mutation.
-func (m *ProviderStorageMutation) Mappings() (r []types.ProviderMapping, exists bool) {
-	v := m.mappings
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingMutation) Value() (r string, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMappings returns the old "mappings" field's value of the ProviderStorage entity.
-// If the ProviderStorage object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProviderStorageMutation) OldMappings(ctx context.Context) (v []types.ProviderMapping, err error) {
+func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMappings is only allowed on UpdateOne operations")
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMappings requires an ID field in the mutation")
+		return v, errors.New("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMappings: %w", err)
-	}
-	return oldValue.Mappings, nil
-}
-
-// AppendMappings adds tm to the "mappings" field.
-func (m *ProviderStorageMutation) AppendMappings(tm []types.ProviderMapping) {
-	m.appendmappings = append(m.appendmappings, tm...)
-}
-
-// AppendedMappings returns the list of values that were appended to the "mappings" field in this mutation.
-func (m *ProviderStorageMutation) AppendedMappings() ([]types.ProviderMapping, bool) {
-	if len(m.appendmappings) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return m.appendmappings, true
-}
-
-// ClearMappings clears the value of the "mappings" field.
-func (m *ProviderStorageMutation) ClearMappings() {
-	m.mappings = nil
-	m.appendmappings = nil
-	m.clearedFields[providerstorage.FieldMappings] = struct{}{}
-}
-
-// MappingsCleared returns if the "mappings" field was cleared in this mutation.
-func (m *ProviderStorageMutation) MappingsCleared() bool {
-	_, ok := m.clearedFields[providerstorage.FieldMappings]
-	return ok
+	return oldValue.Value, nil
 }
 
-// ResetMappings resets all changes to the "mappings" field.
-func (m *ProviderStorageMutation) ResetMappings() {
-	m.mappings = nil
-	m.appendmappings = nil
-	delete(m.clearedFields, providerstorage.FieldMappings)
+// ResetValue resets all changes to the "value" field.
+func (m *SettingMutation) ResetValue() {
+	m.value = nil
 }
 
-// Where appends a list predicates to the ProviderStorageMutation builder.
-func (m *ProviderStorageMutation) Where(ps ...predicate.ProviderStorage) {
+// Where appends a list predicates to the SettingMutation builder.
+func (m *SettingMutation) Where(ps ...predicate.Setting) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProviderStorageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProviderStorageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProviderStorage, len(ps))
+func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Setting, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4147,48 +14070,27 @@ func (m *ProviderStorageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProviderStorageMutation) Op() Op {
+func (m *SettingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProviderStorageMutation) SetOp(op Op) {
+func (m *SettingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ProviderStorage).
-func (m *ProviderStorageMutation) Type() string {
+// Type returns the node type of this mutation (Setting).
+func (m *SettingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProviderStorageMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.apk_name != nil {
-		fields = append(fields, providerstorage.FieldApkName)
-	}
-	if m.pkg_name != nil {
-		fields = append(fields, providerstorage.FieldPkgName)
-	}
-	if m.name != nil {
-		fields = append(fields, providerstorage.FieldName)
-	}
-	if m.lang != nil {
-		fields = append(fields, providerstorage.FieldLang)
-	}
-	if m.version_code != nil {
-		fields = append(fields, providerstorage.FieldVersionCode)
-	}
-	if m.is_storage != nil {
-		fields = append(fields, providerstorage.FieldIsStorage)
-	}
-	if m.is_disabled != nil {
-		fields = append(fields, providerstorage.FieldIsDisabled)
-	}
-	if m.mappings != nil {
-		fields = append(fields, providerstorage.FieldMappings)
+func (m *SettingMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.value != nil {
+		fields = append(fields, setting.FieldValue)
 	}
 	return fields
 }
@@ -4196,305 +14098,177 @@ func (m *ProviderStorageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProviderStorageMutation) Field(name string) (ent.Value, bool) {
+func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case providerstorage.FieldApkName:
-		return m.ApkName()
-	case providerstorage.FieldPkgName:
-		return m.PkgName()
-	case providerstorage.FieldName:
-		return m.Name()
-	case providerstorage.FieldLang:
-		return m.Lang()
-	case providerstorage.FieldVersionCode:
-		return m.VersionCode()
-	case providerstorage.FieldIsStorage:
-		return m.IsStorage()
-	case providerstorage.FieldIsDisabled:
-		return m.IsDisabled()
-	case providerstorage.FieldMappings:
-		return m.Mappings()
+	case setting.FieldValue:
+		return m.Value()
 	}
 	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ProviderStorageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case providerstorage.FieldApkName:
-		return m.OldApkName(ctx)
-	case providerstorage.FieldPkgName:
-		return m.OldPkgName(ctx)
-	case providerstorage.FieldName:
-		return m.OldName(ctx)
-	case providerstorage.FieldLang:
-		return m.OldLang(ctx)
-	case providerstorage.FieldVersionCode:
-		return m.OldVersionCode(ctx)
-	case providerstorage.FieldIsStorage:
-		return m.OldIsStorage(ctx)
-	case providerstorage.FieldIsDisabled:
-		return m.OldIsDisabled(ctx)
-	case providerstorage.FieldMappings:
-		return m.OldMappings(ctx)
-	}
-	return nil, fmt.Errorf("unknown ProviderStorage field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProviderStorageMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case providerstorage.FieldApkName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetApkName(v)
-		return nil
-	case providerstorage.FieldPkgName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPkgName(v)
-		return nil
-	case providerstorage.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case providerstorage.FieldLang:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLang(v)
-		return nil
-	case providerstorage.FieldVersionCode:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetVersionCode(v)
-		return nil
-	case providerstorage.FieldIsStorage:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsStorage(v)
-		return nil
-	case providerstorage.FieldIsDisabled:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsDisabled(v)
-		return nil
-	case providerstorage.FieldMappings:
-		v, ok := value.([]types.ProviderMapping)
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case setting.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown Setting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case setting.FieldValue:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMappings(v)
+		m.SetValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProviderStorage field %s", name)
+	return fmt.Errorf("unknown Setting field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProviderStorageMutation) AddedFields() []string {
-	var fields []string
-	if m.addversion_code != nil {
-		fields = append(fields, providerstorage.FieldVersionCode)
-	}
-	return fields
+func (m *SettingMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProviderStorageMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case providerstorage.FieldVersionCode:
-		return m.AddedVersionCode()
-	}
+func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProviderStorageMutation) AddField(name string, value ent.Value) error {
+func (m *SettingMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case providerstorage.FieldVersionCode:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddVersionCode(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ProviderStorage numeric field %s", name)
+	return fmt.Errorf("unknown Setting numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProviderStorageMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(providerstorage.FieldMappings) {
-		fields = append(fields, providerstorage.FieldMappings)
-	}
-	return fields
+func (m *SettingMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProviderStorageMutation) FieldCleared(name string) bool {
+func (m *SettingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProviderStorageMutation) ClearField(name string) error {
-	switch name {
-	case providerstorage.FieldMappings:
-		m.ClearMappings()
-		return nil
-	}
-	return fmt.Errorf("unknown ProviderStorage nullable field %s", name)
+func (m *SettingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Setting nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProviderStorageMutation) ResetField(name string) error {
+func (m *SettingMutation) ResetField(name string) error {
 	switch name {
-	case providerstorage.FieldApkName:
-		m.ResetApkName()
-		return nil
-	case providerstorage.FieldPkgName:
-		m.ResetPkgName()
-		return nil
-	case providerstorage.FieldName:
-		m.ResetName()
-		return nil
-	case providerstorage.FieldLang:
-		m.ResetLang()
-		return nil
-	case providerstorage.FieldVersionCode:
-		m.ResetVersionCode()
-		return nil
-	case providerstorage.FieldIsStorage:
-		m.ResetIsStorage()
-		return nil
-	case providerstorage.FieldIsDisabled:
-		m.ResetIsDisabled()
-		return nil
-	case providerstorage.FieldMappings:
-		m.ResetMappings()
+	case setting.FieldValue:
+		m.ResetValue()
 		return nil
 	}
-	return fmt.Errorf("unknown ProviderStorage field %s", name)
+	return fmt.Errorf("unknown Setting field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProviderStorageMutation) AddedEdges() []string {
+func (m *SettingMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProviderStorageMutation) AddedIDs(name string) []ent.Value {
+func (m *SettingMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProviderStorageMutation) RemovedEdges() []string {
+func (m *SettingMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProviderStorageMutation) RemovedIDs(name string) []ent.Value {
+func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProviderStorageMutation) ClearedEdges() []string {
+func (m *SettingMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProviderStorageMutation) EdgeCleared(name string) bool {
+func (m *SettingMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProviderStorageMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ProviderStorage unique edge %s", name)
+func (m *SettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Setting unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProviderStorageMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ProviderStorage edge %s", name)
+func (m *SettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Setting edge %s", name)
 }
 
-// SeriesMutation represents an operation that mutates the Series nodes in the graph.
-type SeriesMutation struct {
+// SourceEventMutation represents an operation that mutates the SourceEvent nodes in the graph.
+type SourceEventMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	title                *string
-	thumbnail_url        *string
-	artist               *string
-	author               *string
-	description          *string
-	genre                *[]string
-	appendgenre          []string
-	status               *string
-	storage_path         *string
-	_type                *string
-	chapter_count        *int
-	addchapter_count     *int
-	pause_downloads      *bool
-	clearedFields        map[string]struct{}
-	providers            map[uuid.UUID]struct{}
-	removedproviders     map[uuid.UUID]struct{}
-	clearedproviders     bool
-	latest_series        map[int]struct{}
-	removedlatest_series map[int]struct{}
-	clearedlatest_series bool
-	done                 bool
-	oldValue             func(context.Context) (*Series, error)
-	predicates           []predicate.Series
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	source_id      *string
+	source_name    *string
+	language       *string
+	event_type     *string
+	status         *string
+	duration_ms    *int64
+	addduration_ms *int64
+	error_message  *string
+	error_category *string
+	items_count    *int
+	additems_count *int
+	metadata       *map[string]string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*SourceEvent, error)
+	predicates     []predicate.SourceEvent
 }
 
-var _ ent.Mutation = (*SeriesMutation)(nil)
+var _ ent.Mutation = (*SourceEventMutation)(nil)
 
-// seriesOption allows management of the mutation configuration using functional options.
-type seriesOption func(*SeriesMutation)
+// sourceeventOption allows management of the mutation configuration using functional options.
+type sourceeventOption func(*SourceEventMutation)
 
-// newSeriesMutation creates new mutation for the Series entity.
-func newSeriesMutation(c config, op Op, opts ...seriesOption) *SeriesMutation {
-	m := &SeriesMutation{
+// newSourceEventMutation creates new mutation for the SourceEvent entity.
+func newSourceEventMutation(c config, op Op, opts ...sourceeventOption) *SourceEventMutation {
+	m := &SourceEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSeries,
+		typ:           TypeSourceEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4503,20 +14277,20 @@ func newSeriesMutation(c config, op Op, opts ...seriesOption) *SeriesMutation {
 	return m
 }
 
-// withSeriesID sets the ID field of the mutation.
-func withSeriesID(id uuid.UUID) seriesOption {
-	return func(m *SeriesMutation) {
+// withSourceEventID sets the ID field of the mutation.
+func withSourceEventID(id uuid.UUID) sourceeventOption {
+	return func(m *SourceEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Series
+			value *SourceEvent
 		)
-		m.oldValue = func(ctx context.Context) (*Series, error) {
+		m.oldValue = func(ctx context.Context) (*SourceEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Series.Get(ctx, id)
+					value, err = m.Client().SourceEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4525,10 +14299,10 @@ func withSeriesID(id uuid.UUID) seriesOption {
 	}
 }
 
-// withSeries sets the old Series of the mutation.
-func withSeries(node *Series) seriesOption {
-	return func(m *SeriesMutation) {
-		m.oldValue = func(context.Context) (*Series, error) {
+// withSourceEvent sets the old SourceEvent of the mutation.
+func withSourceEvent(node *SourceEvent) sourceeventOption {
+	return func(m *SourceEventMutation) {
+		m.oldValue = func(context.Context) (*SourceEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4537,7 +14311,7 @@ func withSeries(node *Series) seriesOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SeriesMutation) Client() *Client {
+func (m SourceEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4545,7 +14319,7 @@ func (m SeriesMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SeriesMutation) Tx() (*Tx, error) {
+func (m SourceEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4555,14 +14329,14 @@ func (m SeriesMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Series entities.
-func (m *SeriesMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SourceEvent entities.
+func (m *SourceEventMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SeriesMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SourceEventMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4573,7 +14347,7 @@ func (m *SeriesMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SeriesMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SourceEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4582,600 +14356,510 @@ func (m *SeriesMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Series.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SourceEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *SeriesMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *SeriesMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *SeriesMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetThumbnailURL sets the "thumbnail_url" field.
-func (m *SeriesMutation) SetThumbnailURL(s string) {
-	m.thumbnail_url = &s
-}
-
-// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
-func (m *SeriesMutation) ThumbnailURL() (r string, exists bool) {
-	v := m.thumbnail_url
+// SetSourceID sets the "source_id" field.
+func (m *SourceEventMutation) SetSourceID(s string) {
+	m.source_id = &s
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *SourceEventMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnailURL returns the old "thumbnail_url" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceID returns the old "source_id" field's value of the SourceEvent entity.
+// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
+func (m *SourceEventMutation) OldSourceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
 	}
-	return oldValue.ThumbnailURL, nil
+	return oldValue.SourceID, nil
 }
 
-// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
-func (m *SeriesMutation) ResetThumbnailURL() {
-	m.thumbnail_url = nil
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *SourceEventMutation) ResetSourceID() {
+	m.source_id = nil
 }
 
-// SetArtist sets the "artist" field.
-func (m *SeriesMutation) SetArtist(s string) {
-	m.artist = &s
+// SetSourceName sets the "source_name" field.
+func (m *SourceEventMutation) SetSourceName(s string) {
+	m.source_name = &s
 }
 
-// Artist returns the value of the "artist" field in the mutation.
-func (m *SeriesMutation) Artist() (r string, exists bool) {
-	v := m.artist
+// SourceName returns the value of the "source_name" field in the mutation.
+func (m *SourceEventMutation) SourceName() (r string, exists bool) {
+	v := m.source_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldArtist returns the old "artist" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceName returns the old "source_name" field's value of the SourceEvent entity.
+// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldArtist(ctx context.Context) (v string, err error) {
+func (m *SourceEventMutation) OldSourceName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldArtist is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldArtist requires an ID field in the mutation")
+		return v, errors.New("OldSourceName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldArtist: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceName: %w", err)
 	}
-	return oldValue.Artist, nil
+	return oldValue.SourceName, nil
 }
 
-// ResetArtist resets all changes to the "artist" field.
-func (m *SeriesMutation) ResetArtist() {
-	m.artist = nil
+// ResetSourceName resets all changes to the "source_name" field.
+func (m *SourceEventMutation) ResetSourceName() {
+	m.source_name = nil
 }
 
-// SetAuthor sets the "author" field.
-func (m *SeriesMutation) SetAuthor(s string) {
-	m.author = &s
+// SetLanguage sets the "language" field.
+func (m *SourceEventMutation) SetLanguage(s string) {
+	m.language = &s
 }
 
-// Author returns the value of the "author" field in the mutation.
-func (m *SeriesMutation) Author() (r string, exists bool) {
-	v := m.author
+// Language returns the value of the "language" field in the mutation.
+func (m *SourceEventMutation) Language() (r string, exists bool) {
+	v := m.language
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthor returns the old "author" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldLanguage returns the old "language" field's value of the SourceEvent entity.
+// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldAuthor(ctx context.Context) (v string, err error) {
+func (m *SourceEventMutation) OldLanguage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthor requires an ID field in the mutation")
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
 	}
-	return oldValue.Author, nil
+	return oldValue.Language, nil
 }
 
-// ResetAuthor resets all changes to the "author" field.
-func (m *SeriesMutation) ResetAuthor() {
-	m.author = nil
+// ResetLanguage resets all changes to the "language" field.
+func (m *SourceEventMutation) ResetLanguage() {
+	m.language = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *SeriesMutation) SetDescription(s string) {
-	m.description = &s
+// SetEventType sets the "event_type" field.
+func (m *SourceEventMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *SeriesMutation) Description() (r string, exists bool) {
-	v := m.description
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *SourceEventMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the SourceEvent entity.
+// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *SourceEventMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.EventType, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *SeriesMutation) ResetDescription() {
-	m.description = nil
+// ResetEventType resets all changes to the "event_type" field.
+func (m *SourceEventMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// SetGenre sets the "genre" field.
-func (m *SeriesMutation) SetGenre(s []string) {
-	m.genre = &s
-	m.appendgenre = nil
+// SetStatus sets the "status" field.
+func (m *SourceEventMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// Genre returns the value of the "genre" field in the mutation.
-func (m *SeriesMutation) Genre() (r []string, exists bool) {
-	v := m.genre
+// Status returns the value of the "status" field in the mutation.
+func (m *SourceEventMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGenre returns the old "genre" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the SourceEvent entity.
+// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldGenre(ctx context.Context) (v []string, err error) {
+func (m *SourceEventMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGenre requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
-	}
-	return oldValue.Genre, nil
-}
-
-// AppendGenre adds s to the "genre" field.
-func (m *SeriesMutation) AppendGenre(s []string) {
-	m.appendgenre = append(m.appendgenre, s...)
-}
-
-// AppendedGenre returns the list of values that were appended to the "genre" field in this mutation.
-func (m *SeriesMutation) AppendedGenre() ([]string, bool) {
-	if len(m.appendgenre) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return m.appendgenre, true
-}
-
-// ClearGenre clears the value of the "genre" field.
-func (m *SeriesMutation) ClearGenre() {
-	m.genre = nil
-	m.appendgenre = nil
-	m.clearedFields[series.FieldGenre] = struct{}{}
-}
-
-// GenreCleared returns if the "genre" field was cleared in this mutation.
-func (m *SeriesMutation) GenreCleared() bool {
-	_, ok := m.clearedFields[series.FieldGenre]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetGenre resets all changes to the "genre" field.
-func (m *SeriesMutation) ResetGenre() {
-	m.genre = nil
-	m.appendgenre = nil
-	delete(m.clearedFields, series.FieldGenre)
+// ResetStatus resets all changes to the "status" field.
+func (m *SourceEventMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *SeriesMutation) SetStatus(s string) {
-	m.status = &s
+// SetDurationMs sets the "duration_ms" field.
+func (m *SourceEventMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *SeriesMutation) Status() (r string, exists bool) {
-	v := m.status
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *SourceEventMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMs returns the old "duration_ms" field's value of the SourceEvent entity.
+// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *SourceEventMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.DurationMs, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SeriesMutation) ResetStatus() {
-	m.status = nil
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *SourceEventMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
 }
 
-// SetStoragePath sets the "storage_path" field.
-func (m *SeriesMutation) SetStoragePath(s string) {
-	m.storage_path = &s
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *SourceEventMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// StoragePath returns the value of the "storage_path" field in the mutation.
-func (m *SeriesMutation) StoragePath() (r string, exists bool) {
-	v := m.storage_path
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *SourceEventMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *SourceEventMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *SourceEventMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStoragePath returns the old "storage_path" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldErrorMessage returns the old "error_message" field's value of the SourceEvent entity.
+// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldStoragePath(ctx context.Context) (v string, err error) {
+func (m *SourceEventMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStoragePath is only allowed on UpdateOne operations")
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStoragePath requires an ID field in the mutation")
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStoragePath: %w", err)
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
 	}
-	return oldValue.StoragePath, nil
+	return oldValue.ErrorMessage, nil
 }
 
-// ClearStoragePath clears the value of the "storage_path" field.
-func (m *SeriesMutation) ClearStoragePath() {
-	m.storage_path = nil
-	m.clearedFields[series.FieldStoragePath] = struct{}{}
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *SourceEventMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[sourceevent.FieldErrorMessage] = struct{}{}
 }
 
-// StoragePathCleared returns if the "storage_path" field was cleared in this mutation.
-func (m *SeriesMutation) StoragePathCleared() bool {
-	_, ok := m.clearedFields[series.FieldStoragePath]
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *SourceEventMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[sourceevent.FieldErrorMessage]
 	return ok
 }
 
-// ResetStoragePath resets all changes to the "storage_path" field.
-func (m *SeriesMutation) ResetStoragePath() {
-	m.storage_path = nil
-	delete(m.clearedFields, series.FieldStoragePath)
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *SourceEventMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, sourceevent.FieldErrorMessage)
 }
 
-// SetType sets the "type" field.
-func (m *SeriesMutation) SetType(s string) {
-	m._type = &s
+// SetErrorCategory sets the "error_category" field.
+func (m *SourceEventMutation) SetErrorCategory(s string) {
+	m.error_category = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *SeriesMutation) GetType() (r string, exists bool) {
-	v := m._type
+// ErrorCategory returns the value of the "error_category" field in the mutation.
+func (m *SourceEventMutation) ErrorCategory() (r string, exists bool) {
+	v := m.error_category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldErrorCategory returns the old "error_category" field's value of the SourceEvent entity.
+// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldType(ctx context.Context) (v *string, err error) {
+func (m *SourceEventMutation) OldErrorCategory(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldErrorCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldErrorCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldErrorCategory: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.ErrorCategory, nil
 }
 
-// ClearType clears the value of the "type" field.
-func (m *SeriesMutation) ClearType() {
-	m._type = nil
-	m.clearedFields[series.FieldType] = struct{}{}
+// ClearErrorCategory clears the value of the "error_category" field.
+func (m *SourceEventMutation) ClearErrorCategory() {
+	m.error_category = nil
+	m.clearedFields[sourceevent.FieldErrorCategory] = struct{}{}
 }
 
-// TypeCleared returns if the "type" field was cleared in this mutation.
-func (m *SeriesMutation) TypeCleared() bool {
-	_, ok := m.clearedFields[series.FieldType]
+// ErrorCategoryCleared returns if the "error_category" field was cleared in this mutation.
+func (m *SourceEventMutation) ErrorCategoryCleared() bool {
+	_, ok := m.clearedFields[sourceevent.FieldErrorCategory]
 	return ok
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *SeriesMutation) ResetType() {
-	m._type = nil
-	delete(m.clearedFields, series.FieldType)
+// ResetErrorCategory resets all changes to the "error_category" field.
+func (m *SourceEventMutation) ResetErrorCategory() {
+	m.error_category = nil
+	delete(m.clearedFields, sourceevent.FieldErrorCategory)
 }
 
-// SetChapterCount sets the "chapter_count" field.
-func (m *SeriesMutation) SetChapterCount(i int) {
-	m.chapter_count = &i
-	m.addchapter_count = nil
+// SetItemsCount sets the "items_count" field.
+func (m *SourceEventMutation) SetItemsCount(i int) {
+	m.items_count = &i
+	m.additems_count = nil
 }
 
-// ChapterCount returns the value of the "chapter_count" field in the mutation.
-func (m *SeriesMutation) ChapterCount() (r int, exists bool) {
-	v := m.chapter_count
+// ItemsCount returns the value of the "items_count" field in the mutation.
+func (m *SourceEventMutation) ItemsCount() (r int, exists bool) {
+	v := m.items_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChapterCount returns the old "chapter_count" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldItemsCount returns the old "items_count" field's value of the SourceEvent entity.
+// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldChapterCount(ctx context.Context) (v int, err error) {
+func (m *SourceEventMutation) OldItemsCount(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChapterCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldItemsCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChapterCount requires an ID field in the mutation")
+		return v, errors.New("OldItemsCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChapterCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldItemsCount: %w", err)
 	}
-	return oldValue.ChapterCount, nil
+	return oldValue.ItemsCount, nil
 }
 
-// AddChapterCount adds i to the "chapter_count" field.
-func (m *SeriesMutation) AddChapterCount(i int) {
-	if m.addchapter_count != nil {
-		*m.addchapter_count += i
+// AddItemsCount adds i to the "items_count" field.
+func (m *SourceEventMutation) AddItemsCount(i int) {
+	if m.additems_count != nil {
+		*m.additems_count += i
 	} else {
-		m.addchapter_count = &i
+		m.additems_count = &i
 	}
 }
 
-// AddedChapterCount returns the value that was added to the "chapter_count" field in this mutation.
-func (m *SeriesMutation) AddedChapterCount() (r int, exists bool) {
-	v := m.addchapter_count
+// AddedItemsCount returns the value that was added to the "items_count" field in this mutation.
+func (m *SourceEventMutation) AddedItemsCount() (r int, exists bool) {
+	v := m.additems_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetChapterCount resets all changes to the "chapter_count" field.
-func (m *SeriesMutation) ResetChapterCount() {
-	m.chapter_count = nil
-	m.addchapter_count = nil
+// ClearItemsCount clears the value of the "items_count" field.
+func (m *SourceEventMutation) ClearItemsCount() {
+	m.items_count = nil
+	m.additems_count = nil
+	m.clearedFields[sourceevent.FieldItemsCount] = struct{}{}
 }
 
-// SetPauseDownloads sets the "pause_downloads" field.
-func (m *SeriesMutation) SetPauseDownloads(b bool) {
-	m.pause_downloads = &b
+// ItemsCountCleared returns if the "items_count" field was cleared in this mutation.
+func (m *SourceEventMutation) ItemsCountCleared() bool {
+	_, ok := m.clearedFields[sourceevent.FieldItemsCount]
+	return ok
 }
 
-// PauseDownloads returns the value of the "pause_downloads" field in the mutation.
-func (m *SeriesMutation) PauseDownloads() (r bool, exists bool) {
-	v := m.pause_downloads
+// ResetItemsCount resets all changes to the "items_count" field.
+func (m *SourceEventMutation) ResetItemsCount() {
+	m.items_count = nil
+	m.additems_count = nil
+	delete(m.clearedFields, sourceevent.FieldItemsCount)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *SourceEventMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SourceEventMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPauseDownloads returns the old "pause_downloads" field's value of the Series entity.
-// If the Series object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the SourceEvent entity.
+// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesMutation) OldPauseDownloads(ctx context.Context) (v bool, err error) {
+func (m *SourceEventMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPauseDownloads is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPauseDownloads requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPauseDownloads: %w", err)
-	}
-	return oldValue.PauseDownloads, nil
-}
-
-// ResetPauseDownloads resets all changes to the "pause_downloads" field.
-func (m *SeriesMutation) ResetPauseDownloads() {
-	m.pause_downloads = nil
-}
-
-// AddProviderIDs adds the "providers" edge to the SeriesProvider entity by ids.
-func (m *SeriesMutation) AddProviderIDs(ids ...uuid.UUID) {
-	if m.providers == nil {
-		m.providers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.providers[ids[i]] = struct{}{}
-	}
-}
-
-// ClearProviders clears the "providers" edge to the SeriesProvider entity.
-func (m *SeriesMutation) ClearProviders() {
-	m.clearedproviders = true
-}
-
-// ProvidersCleared reports if the "providers" edge to the SeriesProvider entity was cleared.
-func (m *SeriesMutation) ProvidersCleared() bool {
-	return m.clearedproviders
-}
-
-// RemoveProviderIDs removes the "providers" edge to the SeriesProvider entity by IDs.
-func (m *SeriesMutation) RemoveProviderIDs(ids ...uuid.UUID) {
-	if m.removedproviders == nil {
-		m.removedproviders = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.providers, ids[i])
-		m.removedproviders[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedProviders returns the removed IDs of the "providers" edge to the SeriesProvider entity.
-func (m *SeriesMutation) RemovedProvidersIDs() (ids []uuid.UUID) {
-	for id := range m.removedproviders {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ProvidersIDs returns the "providers" edge IDs in the mutation.
-func (m *SeriesMutation) ProvidersIDs() (ids []uuid.UUID) {
-	for id := range m.providers {
-		ids = append(ids, id)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return
-}
-
-// ResetProviders resets all changes to the "providers" edge.
-func (m *SeriesMutation) ResetProviders() {
-	m.providers = nil
-	m.clearedproviders = false
-	m.removedproviders = nil
+	return oldValue.Metadata, nil
 }
 
-// AddLatestSeriesIDs adds the "latest_series" edge to the LatestSeries entity by ids.
-func (m *SeriesMutation) AddLatestSeriesIDs(ids ...int) {
-	if m.latest_series == nil {
-		m.latest_series = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.latest_series[ids[i]] = struct{}{}
-	}
+// ClearMetadata clears the value of the "metadata" field.
+func (m *SourceEventMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[sourceevent.FieldMetadata] = struct{}{}
 }
 
-// ClearLatestSeries clears the "latest_series" edge to the LatestSeries entity.
-func (m *SeriesMutation) ClearLatestSeries() {
-	m.clearedlatest_series = true
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *SourceEventMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[sourceevent.FieldMetadata]
+	return ok
 }
 
-// LatestSeriesCleared reports if the "latest_series" edge to the LatestSeries entity was cleared.
-func (m *SeriesMutation) LatestSeriesCleared() bool {
-	return m.clearedlatest_series
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SourceEventMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, sourceevent.FieldMetadata)
 }
 
-// RemoveLatestSeriesIDs removes the "latest_series" edge to the LatestSeries entity by IDs.
-func (m *SeriesMutation) RemoveLatestSeriesIDs(ids ...int) {
-	if m.removedlatest_series == nil {
-		m.removedlatest_series = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.latest_series, ids[i])
-		m.removedlatest_series[ids[i]] = struct{}{}
-	}
+// SetCreatedAt sets the "created_at" field.
+func (m *SourceEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemovedLatestSeries returns the removed IDs of the "latest_series" edge to the LatestSeries entity.
-func (m *SeriesMutation) RemovedLatestSeriesIDs() (ids []int) {
-	for id := range m.removedlatest_series {
-		ids = append(ids, id)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SourceEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// LatestSeriesIDs returns the "latest_series" edge IDs in the mutation.
-func (m *SeriesMutation) LatestSeriesIDs() (ids []int) {
-	for id := range m.latest_series {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the SourceEvent entity.
+// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetLatestSeries resets all changes to the "latest_series" edge.
-func (m *SeriesMutation) ResetLatestSeries() {
-	m.latest_series = nil
-	m.clearedlatest_series = false
-	m.removedlatest_series = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SourceEventMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the SeriesMutation builder.
-func (m *SeriesMutation) Where(ps ...predicate.Series) {
+// Where appends a list predicates to the SourceEventMutation builder.
+func (m *SourceEventMutation) Where(ps ...predicate.SourceEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SeriesMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SourceEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SeriesMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Series, len(ps))
+func (m *SourceEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SourceEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5183,57 +14867,57 @@ func (m *SeriesMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SeriesMutation) Op() Op {
+func (m *SourceEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SeriesMutation) SetOp(op Op) {
+func (m *SourceEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Series).
-func (m *SeriesMutation) Type() string {
+// Type returns the node type of this mutation (SourceEvent).
+func (m *SourceEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SeriesMutation) Fields() []string {
+func (m *SourceEventMutation) Fields() []string {
 	fields := make([]string, 0, 11)
-	if m.title != nil {
-		fields = append(fields, series.FieldTitle)
+	if m.source_id != nil {
+		fields = append(fields, sourceevent.FieldSourceID)
 	}
-	if m.thumbnail_url != nil {
-		fields = append(fields, series.FieldThumbnailURL)
+	if m.source_name != nil {
+		fields = append(fields, sourceevent.FieldSourceName)
 	}
-	if m.artist != nil {
-		fields = append(fields, series.FieldArtist)
+	if m.language != nil {
+		fields = append(fields, sourceevent.FieldLanguage)
 	}
-	if m.author != nil {
-		fields = append(fields, series.FieldAuthor)
+	if m.event_type != nil {
+		fields = append(fields, sourceevent.FieldEventType)
 	}
-	if m.description != nil {
-		fields = append(fields, series.FieldDescription)
+	if m.status != nil {
+		fields = append(fields, sourceevent.FieldStatus)
 	}
-	if m.genre != nil {
-		fields = append(fields, series.FieldGenre)
+	if m.duration_ms != nil {
+		fields = append(fields, sourceevent.FieldDurationMs)
 	}
-	if m.status != nil {
-		fields = append(fields, series.FieldStatus)
+	if m.error_message != nil {
+		fields = append(fields, sourceevent.FieldErrorMessage)
 	}
-	if m.storage_path != nil {
-		fields = append(fields, series.FieldStoragePath)
+	if m.error_category != nil {
+		fields = append(fields, sourceevent.FieldErrorCategory)
 	}
-	if m._type != nil {
-		fields = append(fields, series.FieldType)
+	if m.items_count != nil {
+		fields = append(fields, sourceevent.FieldItemsCount)
 	}
-	if m.chapter_count != nil {
-		fields = append(fields, series.FieldChapterCount)
+	if m.metadata != nil {
+		fields = append(fields, sourceevent.FieldMetadata)
 	}
-	if m.pause_downloads != nil {
-		fields = append(fields, series.FieldPauseDownloads)
+	if m.created_at != nil {
+		fields = append(fields, sourceevent.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5241,30 +14925,30 @@ func (m *SeriesMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SeriesMutation) Field(name string) (ent.Value, bool) {
+func (m *SourceEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case series.FieldTitle:
-		return m.Title()
-	case series.FieldThumbnailURL:
-		return m.ThumbnailURL()
-	case series.FieldArtist:
-		return m.Artist()
-	case series.FieldAuthor:
-		return m.Author()
-	case series.FieldDescription:
-		return m.Description()
-	case series.FieldGenre:
-		return m.Genre()
-	case series.FieldStatus:
+	case sourceevent.FieldSourceID:
+		return m.SourceID()
+	case sourceevent.FieldSourceName:
+		return m.SourceName()
+	case sourceevent.FieldLanguage:
+		return m.Language()
+	case sourceevent.FieldEventType:
+		return m.EventType()
+	case sourceevent.FieldStatus:
 		return m.Status()
-	case series.FieldStoragePath:
-		return m.StoragePath()
-	case series.FieldType:
-		return m.GetType()
-	case series.FieldChapterCount:
-		return m.ChapterCount()
-	case series.FieldPauseDownloads:
-		return m.PauseDownloads()
+	case sourceevent.FieldDurationMs:
+		return m.DurationMs()
+	case sourceevent.FieldErrorMessage:
+		return m.ErrorMessage()
+	case sourceevent.FieldErrorCategory:
+		return m.ErrorCategory()
+	case sourceevent.FieldItemsCount:
+		return m.ItemsCount()
+	case sourceevent.FieldMetadata:
+		return m.Metadata()
+	case sourceevent.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -5272,126 +14956,129 @@ func (m *SeriesMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SeriesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SourceEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case series.FieldTitle:
-		return m.OldTitle(ctx)
-	case series.FieldThumbnailURL:
-		return m.OldThumbnailURL(ctx)
-	case series.FieldArtist:
-		return m.OldArtist(ctx)
-	case series.FieldAuthor:
-		return m.OldAuthor(ctx)
-	case series.FieldDescription:
-		return m.OldDescription(ctx)
-	case series.FieldGenre:
-		return m.OldGenre(ctx)
-	case series.FieldStatus:
+	case sourceevent.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case sourceevent.FieldSourceName:
+		return m.OldSourceName(ctx)
+	case sourceevent.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case sourceevent.FieldEventType:
+		return m.OldEventType(ctx)
+	case sourceevent.FieldStatus:
 		return m.OldStatus(ctx)
-	case series.FieldStoragePath:
-		return m.OldStoragePath(ctx)
-	case series.FieldType:
-		return m.OldType(ctx)
-	case series.FieldChapterCount:
-		return m.OldChapterCount(ctx)
-	case series.FieldPauseDownloads:
-		return m.OldPauseDownloads(ctx)
+	case sourceevent.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case sourceevent.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case sourceevent.FieldErrorCategory:
+		return m.OldErrorCategory(ctx)
+	case sourceevent.FieldItemsCount:
+		return m.OldItemsCount(ctx)
+	case sourceevent.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case sourceevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Series field %s", name)
+	return nil, fmt.Errorf("unknown SourceEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SeriesMutation) SetField(name string, value ent.Value) error {
+func (m *SourceEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case series.FieldTitle:
+	case sourceevent.FieldSourceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetSourceID(v)
 		return nil
-	case series.FieldThumbnailURL:
+	case sourceevent.FieldSourceName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetThumbnailURL(v)
+		m.SetSourceName(v)
 		return nil
-	case series.FieldArtist:
+	case sourceevent.FieldLanguage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetArtist(v)
+		m.SetLanguage(v)
 		return nil
-	case series.FieldAuthor:
+	case sourceevent.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthor(v)
+		m.SetEventType(v)
 		return nil
-	case series.FieldDescription:
+	case sourceevent.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetStatus(v)
 		return nil
-	case series.FieldGenre:
-		v, ok := value.([]string)
+	case sourceevent.FieldDurationMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGenre(v)
+		m.SetDurationMs(v)
 		return nil
-	case series.FieldStatus:
+	case sourceevent.FieldErrorMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetErrorMessage(v)
 		return nil
-	case series.FieldStoragePath:
+	case sourceevent.FieldErrorCategory:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStoragePath(v)
+		m.SetErrorCategory(v)
 		return nil
-	case series.FieldType:
-		v, ok := value.(string)
+	case sourceevent.FieldItemsCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetItemsCount(v)
 		return nil
-	case series.FieldChapterCount:
-		v, ok := value.(int)
+	case sourceevent.FieldMetadata:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChapterCount(v)
+		m.SetMetadata(v)
 		return nil
-	case series.FieldPauseDownloads:
-		v, ok := value.(bool)
+	case sourceevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPauseDownloads(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Series field %s", name)
+	return fmt.Errorf("unknown SourceEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SeriesMutation) AddedFields() []string {
+func (m *SourceEventMutation) AddedFields() []string {
 	var fields []string
-	if m.addchapter_count != nil {
-		fields = append(fields, series.FieldChapterCount)
+	if m.addduration_ms != nil {
+		fields = append(fields, sourceevent.FieldDurationMs)
+	}
+	if m.additems_count != nil {
+		fields = append(fields, sourceevent.FieldItemsCount)
 	}
 	return fields
 }
@@ -5399,10 +15086,12 @@ func (m *SeriesMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SeriesMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SourceEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case series.FieldChapterCount:
-		return m.AddedChapterCount()
+	case sourceevent.FieldDurationMs:
+		return m.AddedDurationMs()
+	case sourceevent.FieldItemsCount:
+		return m.AddedItemsCount()
 	}
 	return nil, false
 }
@@ -5410,264 +15099,192 @@ func (m *SeriesMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SeriesMutation) AddField(name string, value ent.Value) error {
+func (m *SourceEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case series.FieldChapterCount:
+	case sourceevent.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	case sourceevent.FieldItemsCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddChapterCount(v)
+		m.AddItemsCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Series numeric field %s", name)
+	return fmt.Errorf("unknown SourceEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SeriesMutation) ClearedFields() []string {
+func (m *SourceEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(series.FieldGenre) {
-		fields = append(fields, series.FieldGenre)
+	if m.FieldCleared(sourceevent.FieldErrorMessage) {
+		fields = append(fields, sourceevent.FieldErrorMessage)
 	}
-	if m.FieldCleared(series.FieldStoragePath) {
-		fields = append(fields, series.FieldStoragePath)
+	if m.FieldCleared(sourceevent.FieldErrorCategory) {
+		fields = append(fields, sourceevent.FieldErrorCategory)
 	}
-	if m.FieldCleared(series.FieldType) {
-		fields = append(fields, series.FieldType)
+	if m.FieldCleared(sourceevent.FieldItemsCount) {
+		fields = append(fields, sourceevent.FieldItemsCount)
+	}
+	if m.FieldCleared(sourceevent.FieldMetadata) {
+		fields = append(fields, sourceevent.FieldMetadata)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SeriesMutation) FieldCleared(name string) bool {
+func (m *SourceEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SeriesMutation) ClearField(name string) error {
+func (m *SourceEventMutation) ClearField(name string) error {
 	switch name {
-	case series.FieldGenre:
-		m.ClearGenre()
+	case sourceevent.FieldErrorMessage:
+		m.ClearErrorMessage()
 		return nil
-	case series.FieldStoragePath:
-		m.ClearStoragePath()
+	case sourceevent.FieldErrorCategory:
+		m.ClearErrorCategory()
 		return nil
-	case series.FieldType:
-		m.ClearType()
+	case sourceevent.FieldItemsCount:
+		m.ClearItemsCount()
+		return nil
+	case sourceevent.FieldMetadata:
+		m.ClearMetadata()
 		return nil
 	}
-	return fmt.Errorf("unknown Series nullable field %s", name)
+	return fmt.Errorf("unknown SourceEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SeriesMutation) ResetField(name string) error {
+func (m *SourceEventMutation) ResetField(name string) error {
 	switch name {
-	case series.FieldTitle:
-		m.ResetTitle()
+	case sourceevent.FieldSourceID:
+		m.ResetSourceID()
 		return nil
-	case series.FieldThumbnailURL:
-		m.ResetThumbnailURL()
+	case sourceevent.FieldSourceName:
+		m.ResetSourceName()
 		return nil
-	case series.FieldArtist:
-		m.ResetArtist()
+	case sourceevent.FieldLanguage:
+		m.ResetLanguage()
 		return nil
-	case series.FieldAuthor:
-		m.ResetAuthor()
+	case sourceevent.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case series.FieldDescription:
-		m.ResetDescription()
+	case sourceevent.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case series.FieldGenre:
-		m.ResetGenre()
+	case sourceevent.FieldDurationMs:
+		m.ResetDurationMs()
 		return nil
-	case series.FieldStatus:
-		m.ResetStatus()
+	case sourceevent.FieldErrorMessage:
+		m.ResetErrorMessage()
 		return nil
-	case series.FieldStoragePath:
-		m.ResetStoragePath()
+	case sourceevent.FieldErrorCategory:
+		m.ResetErrorCategory()
 		return nil
-	case series.FieldType:
-		m.ResetType()
+	case sourceevent.FieldItemsCount:
+		m.ResetItemsCount()
 		return nil
-	case series.FieldChapterCount:
-		m.ResetChapterCount()
+	case sourceevent.FieldMetadata:
+		m.ResetMetadata()
 		return nil
-	case series.FieldPauseDownloads:
-		m.ResetPauseDownloads()
+	case sourceevent.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Series field %s", name)
+	return fmt.Errorf("unknown SourceEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SeriesMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.providers != nil {
-		edges = append(edges, series.EdgeProviders)
-	}
-	if m.latest_series != nil {
-		edges = append(edges, series.EdgeLatestSeries)
-	}
+func (m *SourceEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SeriesMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case series.EdgeProviders:
-		ids := make([]ent.Value, 0, len(m.providers))
-		for id := range m.providers {
-			ids = append(ids, id)
-		}
-		return ids
-	case series.EdgeLatestSeries:
-		ids := make([]ent.Value, 0, len(m.latest_series))
-		for id := range m.latest_series {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SourceEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SeriesMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedproviders != nil {
-		edges = append(edges, series.EdgeProviders)
-	}
-	if m.removedlatest_series != nil {
-		edges = append(edges, series.EdgeLatestSeries)
-	}
+func (m *SourceEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SeriesMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case series.EdgeProviders:
-		ids := make([]ent.Value, 0, len(m.removedproviders))
-		for id := range m.removedproviders {
-			ids = append(ids, id)
-		}
-		return ids
-	case series.EdgeLatestSeries:
-		ids := make([]ent.Value, 0, len(m.removedlatest_series))
-		for id := range m.removedlatest_series {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SourceEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SeriesMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedproviders {
-		edges = append(edges, series.EdgeProviders)
-	}
-	if m.clearedlatest_series {
-		edges = append(edges, series.EdgeLatestSeries)
-	}
+func (m *SourceEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SeriesMutation) EdgeCleared(name string) bool {
-	switch name {
-	case series.EdgeProviders:
-		return m.clearedproviders
-	case series.EdgeLatestSeries:
-		return m.clearedlatest_series
-	}
+func (m *SourceEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SeriesMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Series unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *SeriesMutation) ResetEdge(name string) error {
-	switch name {
-	case series.EdgeProviders:
-		m.ResetProviders()
-		return nil
-	case series.EdgeLatestSeries:
-		m.ResetLatestSeries()
-		return nil
-	}
-	return fmt.Errorf("unknown Series edge %s", name)
-}
-
-// SeriesProviderMutation represents an operation that mutates the SeriesProvider nodes in the graph.
-type SeriesProviderMutation struct {
-	config
-	op                        Op
-	typ                       string
-	id                        *uuid.UUID
-	suwayomi_id               *int
-	addsuwayomi_id            *int
-	provider                  *string
-	scanlator                 *string
-	url                       *string
-	title                     *string
-	language                  *string
-	thumbnail_url             *string
-	artist                    *string
-	author                    *string
-	description               *string
-	genre                     *[]string
-	appendgenre               []string
-	fetch_date                *time.Time
-	chapter_count             *int64
-	addchapter_count          *int64
-	continue_after_chapter    *float64
-	addcontinue_after_chapter *float64
-	is_title                  *bool
-	is_cover                  *bool
-	is_unknown                *bool
-	importance                *int
-	addimportance             *int
-	is_disabled               *bool
-	is_uninstalled            *bool
-	status                    *string
-	page_count_synced         *bool
-	chapters                  *[]types.Chapter
-	appendchapters            []types.Chapter
-	clearedFields             map[string]struct{}
-	series                    *uuid.UUID
-	clearedseries             bool
-	done                      bool
-	oldValue                  func(context.Context) (*SeriesProvider, error)
-	predicates                []predicate.SeriesProvider
+func (m *SourceEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SourceEvent unique edge %s", name)
 }
 
-var _ ent.Mutation = (*SeriesProviderMutation)(nil)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SourceEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SourceEvent edge %s", name)
+}
 
-// seriesproviderOption allows management of the mutation configuration using functional options.
-type seriesproviderOption func(*SeriesProviderMutation)
+// SyncProgressMutation represents an operation that mutates the SyncProgress nodes in the graph.
+type SyncProgressMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	document      *string
+	progress      *string
+	percentage    *float64
+	addpercentage *float64
+	device        *string
+	device_id     *string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SyncProgress, error)
+	predicates    []predicate.SyncProgress
+}
 
-// newSeriesProviderMutation creates new mutation for the SeriesProvider entity.
-func newSeriesProviderMutation(c config, op Op, opts ...seriesproviderOption) *SeriesProviderMutation {
-	m := &SeriesProviderMutation{
+var _ ent.Mutation = (*SyncProgressMutation)(nil)
+
+// syncprogressOption allows management of the mutation configuration using functional options.
+type syncprogressOption func(*SyncProgressMutation)
+
+// newSyncProgressMutation creates new mutation for the SyncProgress entity.
+func newSyncProgressMutation(c config, op Op, opts ...syncprogressOption) *SyncProgressMutation {
+	m := &SyncProgressMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSeriesProvider,
+		typ:           TypeSyncProgress,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5676,20 +15293,20 @@ func newSeriesProviderMutation(c config, op Op, opts ...seriesproviderOption) *S
 	return m
 }
 
-// withSeriesProviderID sets the ID field of the mutation.
-func withSeriesProviderID(id uuid.UUID) seriesproviderOption {
-	return func(m *SeriesProviderMutation) {
+// withSyncProgressID sets the ID field of the mutation.
+func withSyncProgressID(id uuid.UUID) syncprogressOption {
+	return func(m *SyncProgressMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SeriesProvider
+			value *SyncProgress
 		)
-		m.oldValue = func(ctx context.Context) (*SeriesProvider, error) {
+		m.oldValue = func(ctx context.Context) (*SyncProgress, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SeriesProvider.Get(ctx, id)
+					value, err = m.Client().SyncProgress.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5698,10 +15315,10 @@ func withSeriesProviderID(id uuid.UUID) seriesproviderOption {
 	}
 }
 
-// withSeriesProvider sets the old SeriesProvider of the mutation.
-func withSeriesProvider(node *SeriesProvider) seriesproviderOption {
-	return func(m *SeriesProviderMutation) {
-		m.oldValue = func(context.Context) (*SeriesProvider, error) {
+// withSyncProgress sets the old SyncProgress of the mutation.
+func withSyncProgress(node *SyncProgress) syncprogressOption {
+	return func(m *SyncProgressMutation) {
+		m.oldValue = func(context.Context) (*SyncProgress, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5710,7 +15327,7 @@ func withSeriesProvider(node *SeriesProvider) seriesproviderOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SeriesProviderMutation) Client() *Client {
+func (m SyncProgressMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5718,7 +15335,7 @@ func (m SeriesProviderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SeriesProviderMutation) Tx() (*Tx, error) {
+func (m SyncProgressMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5728,14 +15345,14 @@ func (m SeriesProviderMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SeriesProvider entities.
-func (m *SeriesProviderMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SyncProgress entities.
+func (m *SyncProgressMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SeriesProviderMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SyncProgressMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5746,1165 +15363,1149 @@ func (m *SeriesProviderMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SeriesProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SyncProgressMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
 			return []uuid.UUID{id}, nil
 		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SeriesProvider.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetSeriesID sets the "series_id" field.
-func (m *SeriesProviderMutation) SetSeriesID(u uuid.UUID) {
-	m.series = &u
-}
-
-// SeriesID returns the value of the "series_id" field in the mutation.
-func (m *SeriesProviderMutation) SeriesID() (r uuid.UUID, exists bool) {
-	v := m.series
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSeriesID returns the old "series_id" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldSeriesID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeriesID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeriesID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeriesID: %w", err)
-	}
-	return oldValue.SeriesID, nil
-}
-
-// ResetSeriesID resets all changes to the "series_id" field.
-func (m *SeriesProviderMutation) ResetSeriesID() {
-	m.series = nil
-}
-
-// SetSuwayomiID sets the "suwayomi_id" field.
-func (m *SeriesProviderMutation) SetSuwayomiID(i int) {
-	m.suwayomi_id = &i
-	m.addsuwayomi_id = nil
-}
-
-// SuwayomiID returns the value of the "suwayomi_id" field in the mutation.
-func (m *SeriesProviderMutation) SuwayomiID() (r int, exists bool) {
-	v := m.suwayomi_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSuwayomiID returns the old "suwayomi_id" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldSuwayomiID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuwayomiID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuwayomiID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuwayomiID: %w", err)
-	}
-	return oldValue.SuwayomiID, nil
-}
-
-// AddSuwayomiID adds i to the "suwayomi_id" field.
-func (m *SeriesProviderMutation) AddSuwayomiID(i int) {
-	if m.addsuwayomi_id != nil {
-		*m.addsuwayomi_id += i
-	} else {
-		m.addsuwayomi_id = &i
-	}
-}
-
-// AddedSuwayomiID returns the value that was added to the "suwayomi_id" field in this mutation.
-func (m *SeriesProviderMutation) AddedSuwayomiID() (r int, exists bool) {
-	v := m.addsuwayomi_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetSuwayomiID resets all changes to the "suwayomi_id" field.
-func (m *SeriesProviderMutation) ResetSuwayomiID() {
-	m.suwayomi_id = nil
-	m.addsuwayomi_id = nil
-}
-
-// SetProvider sets the "provider" field.
-func (m *SeriesProviderMutation) SetProvider(s string) {
-	m.provider = &s
-}
-
-// Provider returns the value of the "provider" field in the mutation.
-func (m *SeriesProviderMutation) Provider() (r string, exists bool) {
-	v := m.provider
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldProvider returns the old "provider" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldProvider(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
-	}
-	return oldValue.Provider, nil
-}
-
-// ResetProvider resets all changes to the "provider" field.
-func (m *SeriesProviderMutation) ResetProvider() {
-	m.provider = nil
-}
-
-// SetScanlator sets the "scanlator" field.
-func (m *SeriesProviderMutation) SetScanlator(s string) {
-	m.scanlator = &s
-}
-
-// Scanlator returns the value of the "scanlator" field in the mutation.
-func (m *SeriesProviderMutation) Scanlator() (r string, exists bool) {
-	v := m.scanlator
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldScanlator returns the old "scanlator" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldScanlator(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScanlator is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScanlator requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScanlator: %w", err)
-	}
-	return oldValue.Scanlator, nil
-}
-
-// ResetScanlator resets all changes to the "scanlator" field.
-func (m *SeriesProviderMutation) ResetScanlator() {
-	m.scanlator = nil
-}
-
-// SetURL sets the "url" field.
-func (m *SeriesProviderMutation) SetURL(s string) {
-	m.url = &s
-}
-
-// URL returns the value of the "url" field in the mutation.
-func (m *SeriesProviderMutation) URL() (r string, exists bool) {
-	v := m.url
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldURL returns the old "url" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldURL(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
-	}
-	return oldValue.URL, nil
-}
-
-// ClearURL clears the value of the "url" field.
-func (m *SeriesProviderMutation) ClearURL() {
-	m.url = nil
-	m.clearedFields[seriesprovider.FieldURL] = struct{}{}
-}
-
-// URLCleared returns if the "url" field was cleared in this mutation.
-func (m *SeriesProviderMutation) URLCleared() bool {
-	_, ok := m.clearedFields[seriesprovider.FieldURL]
-	return ok
-}
-
-// ResetURL resets all changes to the "url" field.
-func (m *SeriesProviderMutation) ResetURL() {
-	m.url = nil
-	delete(m.clearedFields, seriesprovider.FieldURL)
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SyncProgress.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetTitle sets the "title" field.
-func (m *SeriesProviderMutation) SetTitle(s string) {
-	m.title = &s
+// SetUserID sets the "user_id" field.
+func (m *SyncProgressMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *SeriesProviderMutation) Title() (r string, exists bool) {
-	v := m.title
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SyncProgressMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the SyncProgress entity.
+// If the SyncProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *SyncProgressMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.UserID, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *SeriesProviderMutation) ResetTitle() {
-	m.title = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SyncProgressMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetLanguage sets the "language" field.
-func (m *SeriesProviderMutation) SetLanguage(s string) {
-	m.language = &s
+// SetDocument sets the "document" field.
+func (m *SyncProgressMutation) SetDocument(s string) {
+	m.document = &s
 }
 
-// Language returns the value of the "language" field in the mutation.
-func (m *SeriesProviderMutation) Language() (r string, exists bool) {
-	v := m.language
+// Document returns the value of the "document" field in the mutation.
+func (m *SyncProgressMutation) Document() (r string, exists bool) {
+	v := m.document
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLanguage returns the old "language" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldDocument returns the old "document" field's value of the SyncProgress entity.
+// If the SyncProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldLanguage(ctx context.Context) (v string, err error) {
+func (m *SyncProgressMutation) OldDocument(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+		return v, errors.New("OldDocument is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLanguage requires an ID field in the mutation")
+		return v, errors.New("OldDocument requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+		return v, fmt.Errorf("querying old value for OldDocument: %w", err)
 	}
-	return oldValue.Language, nil
+	return oldValue.Document, nil
 }
 
-// ResetLanguage resets all changes to the "language" field.
-func (m *SeriesProviderMutation) ResetLanguage() {
-	m.language = nil
+// ResetDocument resets all changes to the "document" field.
+func (m *SyncProgressMutation) ResetDocument() {
+	m.document = nil
 }
 
-// SetThumbnailURL sets the "thumbnail_url" field.
-func (m *SeriesProviderMutation) SetThumbnailURL(s string) {
-	m.thumbnail_url = &s
+// SetProgress sets the "progress" field.
+func (m *SyncProgressMutation) SetProgress(s string) {
+	m.progress = &s
 }
 
-// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
-func (m *SeriesProviderMutation) ThumbnailURL() (r string, exists bool) {
-	v := m.thumbnail_url
+// Progress returns the value of the "progress" field in the mutation.
+func (m *SyncProgressMutation) Progress() (r string, exists bool) {
+	v := m.progress
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnailURL returns the old "thumbnail_url" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldProgress returns the old "progress" field's value of the SyncProgress entity.
+// If the SyncProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldThumbnailURL(ctx context.Context) (v *string, err error) {
+func (m *SyncProgressMutation) OldProgress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+		return v, errors.New("OldProgress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
 	}
-	return oldValue.ThumbnailURL, nil
-}
-
-// ClearThumbnailURL clears the value of the "thumbnail_url" field.
-func (m *SeriesProviderMutation) ClearThumbnailURL() {
-	m.thumbnail_url = nil
-	m.clearedFields[seriesprovider.FieldThumbnailURL] = struct{}{}
+	return oldValue.Progress, nil
 }
 
-// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
-func (m *SeriesProviderMutation) ThumbnailURLCleared() bool {
-	_, ok := m.clearedFields[seriesprovider.FieldThumbnailURL]
-	return ok
-}
-
-// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
-func (m *SeriesProviderMutation) ResetThumbnailURL() {
-	m.thumbnail_url = nil
-	delete(m.clearedFields, seriesprovider.FieldThumbnailURL)
+// ResetProgress resets all changes to the "progress" field.
+func (m *SyncProgressMutation) ResetProgress() {
+	m.progress = nil
 }
 
-// SetArtist sets the "artist" field.
-func (m *SeriesProviderMutation) SetArtist(s string) {
-	m.artist = &s
+// SetPercentage sets the "percentage" field.
+func (m *SyncProgressMutation) SetPercentage(f float64) {
+	m.percentage = &f
+	m.addpercentage = nil
 }
 
-// Artist returns the value of the "artist" field in the mutation.
-func (m *SeriesProviderMutation) Artist() (r string, exists bool) {
-	v := m.artist
+// Percentage returns the value of the "percentage" field in the mutation.
+func (m *SyncProgressMutation) Percentage() (r float64, exists bool) {
+	v := m.percentage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldArtist returns the old "artist" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldPercentage returns the old "percentage" field's value of the SyncProgress entity.
+// If the SyncProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldArtist(ctx context.Context) (v *string, err error) {
+func (m *SyncProgressMutation) OldPercentage(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldArtist is only allowed on UpdateOne operations")
+		return v, errors.New("OldPercentage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldArtist requires an ID field in the mutation")
+		return v, errors.New("OldPercentage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldArtist: %w", err)
+		return v, fmt.Errorf("querying old value for OldPercentage: %w", err)
 	}
-	return oldValue.Artist, nil
+	return oldValue.Percentage, nil
 }
 
-// ClearArtist clears the value of the "artist" field.
-func (m *SeriesProviderMutation) ClearArtist() {
-	m.artist = nil
-	m.clearedFields[seriesprovider.FieldArtist] = struct{}{}
+// AddPercentage adds f to the "percentage" field.
+func (m *SyncProgressMutation) AddPercentage(f float64) {
+	if m.addpercentage != nil {
+		*m.addpercentage += f
+	} else {
+		m.addpercentage = &f
+	}
 }
 
-// ArtistCleared returns if the "artist" field was cleared in this mutation.
-func (m *SeriesProviderMutation) ArtistCleared() bool {
-	_, ok := m.clearedFields[seriesprovider.FieldArtist]
-	return ok
+// AddedPercentage returns the value that was added to the "percentage" field in this mutation.
+func (m *SyncProgressMutation) AddedPercentage() (r float64, exists bool) {
+	v := m.addpercentage
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetArtist resets all changes to the "artist" field.
-func (m *SeriesProviderMutation) ResetArtist() {
-	m.artist = nil
-	delete(m.clearedFields, seriesprovider.FieldArtist)
+// ResetPercentage resets all changes to the "percentage" field.
+func (m *SyncProgressMutation) ResetPercentage() {
+	m.percentage = nil
+	m.addpercentage = nil
 }
 
-// SetAuthor sets the "author" field.
-func (m *SeriesProviderMutation) SetAuthor(s string) {
-	m.author = &s
+// SetDevice sets the "device" field.
+func (m *SyncProgressMutation) SetDevice(s string) {
+	m.device = &s
 }
 
-// Author returns the value of the "author" field in the mutation.
-func (m *SeriesProviderMutation) Author() (r string, exists bool) {
-	v := m.author
+// Device returns the value of the "device" field in the mutation.
+func (m *SyncProgressMutation) Device() (r string, exists bool) {
+	v := m.device
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthor returns the old "author" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldDevice returns the old "device" field's value of the SyncProgress entity.
+// If the SyncProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldAuthor(ctx context.Context) (v *string, err error) {
+func (m *SyncProgressMutation) OldDevice(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthor requires an ID field in the mutation")
+		return v, errors.New("OldDevice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
 	}
-	return oldValue.Author, nil
-}
-
-// ClearAuthor clears the value of the "author" field.
-func (m *SeriesProviderMutation) ClearAuthor() {
-	m.author = nil
-	m.clearedFields[seriesprovider.FieldAuthor] = struct{}{}
-}
-
-// AuthorCleared returns if the "author" field was cleared in this mutation.
-func (m *SeriesProviderMutation) AuthorCleared() bool {
-	_, ok := m.clearedFields[seriesprovider.FieldAuthor]
-	return ok
+	return oldValue.Device, nil
 }
 
-// ResetAuthor resets all changes to the "author" field.
-func (m *SeriesProviderMutation) ResetAuthor() {
-	m.author = nil
-	delete(m.clearedFields, seriesprovider.FieldAuthor)
+// ResetDevice resets all changes to the "device" field.
+func (m *SyncProgressMutation) ResetDevice() {
+	m.device = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *SeriesProviderMutation) SetDescription(s string) {
-	m.description = &s
+// SetDeviceID sets the "device_id" field.
+func (m *SyncProgressMutation) SetDeviceID(s string) {
+	m.device_id = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *SeriesProviderMutation) Description() (r string, exists bool) {
-	v := m.description
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *SyncProgressMutation) DeviceID() (r string, exists bool) {
+	v := m.device_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldDeviceID returns the old "device_id" field's value of the SyncProgress entity.
+// If the SyncProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *SyncProgressMutation) OldDeviceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *SeriesProviderMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[seriesprovider.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *SeriesProviderMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[seriesprovider.FieldDescription]
-	return ok
+	return oldValue.DeviceID, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *SeriesProviderMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, seriesprovider.FieldDescription)
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *SyncProgressMutation) ResetDeviceID() {
+	m.device_id = nil
 }
 
-// SetGenre sets the "genre" field.
-func (m *SeriesProviderMutation) SetGenre(s []string) {
-	m.genre = &s
-	m.appendgenre = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SyncProgressMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Genre returns the value of the "genre" field in the mutation.
-func (m *SeriesProviderMutation) Genre() (r []string, exists bool) {
-	v := m.genre
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SyncProgressMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGenre returns the old "genre" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SyncProgress entity.
+// If the SyncProgress object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldGenre(ctx context.Context) (v []string, err error) {
+func (m *SyncProgressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGenre requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SyncProgressMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the SyncProgressMutation builder.
+func (m *SyncProgressMutation) Where(ps ...predicate.SyncProgress) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SyncProgressMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SyncProgressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SyncProgress, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SyncProgressMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SyncProgressMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SyncProgress).
+func (m *SyncProgressMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SyncProgressMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user_id != nil {
+		fields = append(fields, syncprogress.FieldUserID)
+	}
+	if m.document != nil {
+		fields = append(fields, syncprogress.FieldDocument)
+	}
+	if m.progress != nil {
+		fields = append(fields, syncprogress.FieldProgress)
+	}
+	if m.percentage != nil {
+		fields = append(fields, syncprogress.FieldPercentage)
+	}
+	if m.device != nil {
+		fields = append(fields, syncprogress.FieldDevice)
+	}
+	if m.device_id != nil {
+		fields = append(fields, syncprogress.FieldDeviceID)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, syncprogress.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SyncProgressMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case syncprogress.FieldUserID:
+		return m.UserID()
+	case syncprogress.FieldDocument:
+		return m.Document()
+	case syncprogress.FieldProgress:
+		return m.Progress()
+	case syncprogress.FieldPercentage:
+		return m.Percentage()
+	case syncprogress.FieldDevice:
+		return m.Device()
+	case syncprogress.FieldDeviceID:
+		return m.DeviceID()
+	case syncprogress.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SyncProgressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case syncprogress.FieldUserID:
+		return m.OldUserID(ctx)
+	case syncprogress.FieldDocument:
+		return m.OldDocument(ctx)
+	case syncprogress.FieldProgress:
+		return m.OldProgress(ctx)
+	case syncprogress.FieldPercentage:
+		return m.OldPercentage(ctx)
+	case syncprogress.FieldDevice:
+		return m.OldDevice(ctx)
+	case syncprogress.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case syncprogress.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SyncProgress field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SyncProgressMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case syncprogress.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case syncprogress.FieldDocument:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocument(v)
+		return nil
+	case syncprogress.FieldProgress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgress(v)
+		return nil
+	case syncprogress.FieldPercentage:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPercentage(v)
+		return nil
+	case syncprogress.FieldDevice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDevice(v)
+		return nil
+	case syncprogress.FieldDeviceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case syncprogress.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return oldValue.Genre, nil
-}
-
-// AppendGenre adds s to the "genre" field.
-func (m *SeriesProviderMutation) AppendGenre(s []string) {
-	m.appendgenre = append(m.appendgenre, s...)
+	return fmt.Errorf("unknown SyncProgress field %s", name)
 }
 
-// AppendedGenre returns the list of values that were appended to the "genre" field in this mutation.
-func (m *SeriesProviderMutation) AppendedGenre() ([]string, bool) {
-	if len(m.appendgenre) == 0 {
-		return nil, false
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SyncProgressMutation) AddedFields() []string {
+	var fields []string
+	if m.addpercentage != nil {
+		fields = append(fields, syncprogress.FieldPercentage)
 	}
-	return m.appendgenre, true
+	return fields
 }
 
-// ClearGenre clears the value of the "genre" field.
-func (m *SeriesProviderMutation) ClearGenre() {
-	m.genre = nil
-	m.appendgenre = nil
-	m.clearedFields[seriesprovider.FieldGenre] = struct{}{}
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SyncProgressMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case syncprogress.FieldPercentage:
+		return m.AddedPercentage()
+	}
+	return nil, false
 }
 
-// GenreCleared returns if the "genre" field was cleared in this mutation.
-func (m *SeriesProviderMutation) GenreCleared() bool {
-	_, ok := m.clearedFields[seriesprovider.FieldGenre]
-	return ok
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SyncProgressMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case syncprogress.FieldPercentage:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPercentage(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SyncProgress numeric field %s", name)
 }
 
-// ResetGenre resets all changes to the "genre" field.
-func (m *SeriesProviderMutation) ResetGenre() {
-	m.genre = nil
-	m.appendgenre = nil
-	delete(m.clearedFields, seriesprovider.FieldGenre)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SyncProgressMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetFetchDate sets the "fetch_date" field.
-func (m *SeriesProviderMutation) SetFetchDate(t time.Time) {
-	m.fetch_date = &t
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SyncProgressMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// FetchDate returns the value of the "fetch_date" field in the mutation.
-func (m *SeriesProviderMutation) FetchDate() (r time.Time, exists bool) {
-	v := m.fetch_date
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SyncProgressMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SyncProgress nullable field %s", name)
 }
 
-// OldFetchDate returns the old "fetch_date" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldFetchDate(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFetchDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFetchDate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFetchDate: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SyncProgressMutation) ResetField(name string) error {
+	switch name {
+	case syncprogress.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case syncprogress.FieldDocument:
+		m.ResetDocument()
+		return nil
+	case syncprogress.FieldProgress:
+		m.ResetProgress()
+		return nil
+	case syncprogress.FieldPercentage:
+		m.ResetPercentage()
+		return nil
+	case syncprogress.FieldDevice:
+		m.ResetDevice()
+		return nil
+	case syncprogress.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case syncprogress.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.FetchDate, nil
+	return fmt.Errorf("unknown SyncProgress field %s", name)
 }
 
-// ClearFetchDate clears the value of the "fetch_date" field.
-func (m *SeriesProviderMutation) ClearFetchDate() {
-	m.fetch_date = nil
-	m.clearedFields[seriesprovider.FieldFetchDate] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SyncProgressMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// FetchDateCleared returns if the "fetch_date" field was cleared in this mutation.
-func (m *SeriesProviderMutation) FetchDateCleared() bool {
-	_, ok := m.clearedFields[seriesprovider.FieldFetchDate]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SyncProgressMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetFetchDate resets all changes to the "fetch_date" field.
-func (m *SeriesProviderMutation) ResetFetchDate() {
-	m.fetch_date = nil
-	delete(m.clearedFields, seriesprovider.FieldFetchDate)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SyncProgressMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetChapterCount sets the "chapter_count" field.
-func (m *SeriesProviderMutation) SetChapterCount(i int64) {
-	m.chapter_count = &i
-	m.addchapter_count = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SyncProgressMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ChapterCount returns the value of the "chapter_count" field in the mutation.
-func (m *SeriesProviderMutation) ChapterCount() (r int64, exists bool) {
-	v := m.chapter_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SyncProgressMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldChapterCount returns the old "chapter_count" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldChapterCount(ctx context.Context) (v *int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChapterCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChapterCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChapterCount: %w", err)
-	}
-	return oldValue.ChapterCount, nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SyncProgressMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// AddChapterCount adds i to the "chapter_count" field.
-func (m *SeriesProviderMutation) AddChapterCount(i int64) {
-	if m.addchapter_count != nil {
-		*m.addchapter_count += i
-	} else {
-		m.addchapter_count = &i
-	}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SyncProgressMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SyncProgress unique edge %s", name)
 }
 
-// AddedChapterCount returns the value that was added to the "chapter_count" field in this mutation.
-func (m *SeriesProviderMutation) AddedChapterCount() (r int64, exists bool) {
-	v := m.addchapter_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SyncProgressMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SyncProgress edge %s", name)
 }
 
-// ClearChapterCount clears the value of the "chapter_count" field.
-func (m *SeriesProviderMutation) ClearChapterCount() {
-	m.chapter_count = nil
-	m.addchapter_count = nil
-	m.clearedFields[seriesprovider.FieldChapterCount] = struct{}{}
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	username        *string
+	password_hash   *string
+	kosync_hash     *string
+	role            *string
+	source          *string
+	external_id     *string
+	feed_token_hash *string
+	disabled        *bool
+	created_at      *time.Time
+	last_login_at   *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*User, error)
+	predicates      []predicate.User
 }
 
-// ChapterCountCleared returns if the "chapter_count" field was cleared in this mutation.
-func (m *SeriesProviderMutation) ChapterCountCleared() bool {
-	_, ok := m.clearedFields[seriesprovider.FieldChapterCount]
-	return ok
-}
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// ResetChapterCount resets all changes to the "chapter_count" field.
-func (m *SeriesProviderMutation) ResetChapterCount() {
-	m.chapter_count = nil
-	m.addchapter_count = nil
-	delete(m.clearedFields, seriesprovider.FieldChapterCount)
-}
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// SetContinueAfterChapter sets the "continue_after_chapter" field.
-func (m *SeriesProviderMutation) SetContinueAfterChapter(f float64) {
-	m.continue_after_chapter = &f
-	m.addcontinue_after_chapter = nil
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ContinueAfterChapter returns the value of the "continue_after_chapter" field in the mutation.
-func (m *SeriesProviderMutation) ContinueAfterChapter() (r float64, exists bool) {
-	v := m.continue_after_chapter
-	if v == nil {
-		return
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldContinueAfterChapter returns the old "continue_after_chapter" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldContinueAfterChapter(ctx context.Context) (v *float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContinueAfterChapter is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContinueAfterChapter requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContinueAfterChapter: %w", err)
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.ContinueAfterChapter, nil
 }
 
-// AddContinueAfterChapter adds f to the "continue_after_chapter" field.
-func (m *SeriesProviderMutation) AddContinueAfterChapter(f float64) {
-	if m.addcontinue_after_chapter != nil {
-		*m.addcontinue_after_chapter += f
-	} else {
-		m.addcontinue_after_chapter = &f
-	}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddedContinueAfterChapter returns the value that was added to the "continue_after_chapter" field in this mutation.
-func (m *SeriesProviderMutation) AddedContinueAfterChapter() (r float64, exists bool) {
-	v := m.addcontinue_after_chapter
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearContinueAfterChapter clears the value of the "continue_after_chapter" field.
-func (m *SeriesProviderMutation) ClearContinueAfterChapter() {
-	m.continue_after_chapter = nil
-	m.addcontinue_after_chapter = nil
-	m.clearedFields[seriesprovider.FieldContinueAfterChapter] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// ContinueAfterChapterCleared returns if the "continue_after_chapter" field was cleared in this mutation.
-func (m *SeriesProviderMutation) ContinueAfterChapterCleared() bool {
-	_, ok := m.clearedFields[seriesprovider.FieldContinueAfterChapter]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetContinueAfterChapter resets all changes to the "continue_after_chapter" field.
-func (m *SeriesProviderMutation) ResetContinueAfterChapter() {
-	m.continue_after_chapter = nil
-	m.addcontinue_after_chapter = nil
-	delete(m.clearedFields, seriesprovider.FieldContinueAfterChapter)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetIsTitle sets the "is_title" field.
-func (m *SeriesProviderMutation) SetIsTitle(b bool) {
-	m.is_title = &b
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
 }
 
-// IsTitle returns the value of the "is_title" field in the mutation.
-func (m *SeriesProviderMutation) IsTitle() (r bool, exists bool) {
-	v := m.is_title
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsTitle returns the old "is_title" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldIsTitle(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsTitle requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.IsTitle, nil
+	return oldValue.Username, nil
 }
 
-// ResetIsTitle resets all changes to the "is_title" field.
-func (m *SeriesProviderMutation) ResetIsTitle() {
-	m.is_title = nil
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
 }
 
-// SetIsCover sets the "is_cover" field.
-func (m *SeriesProviderMutation) SetIsCover(b bool) {
-	m.is_cover = &b
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// IsCover returns the value of the "is_cover" field in the mutation.
-func (m *SeriesProviderMutation) IsCover() (r bool, exists bool) {
-	v := m.is_cover
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsCover returns the old "is_cover" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldIsCover(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsCover is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsCover requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsCover: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return oldValue.IsCover, nil
+	return oldValue.PasswordHash, nil
 }
 
-// ResetIsCover resets all changes to the "is_cover" field.
-func (m *SeriesProviderMutation) ResetIsCover() {
-	m.is_cover = nil
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (m *UserMutation) ClearPasswordHash() {
+	m.password_hash = nil
+	m.clearedFields[user.FieldPasswordHash] = struct{}{}
 }
 
-// SetIsUnknown sets the "is_unknown" field.
-func (m *SeriesProviderMutation) SetIsUnknown(b bool) {
-	m.is_unknown = &b
+// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
+func (m *UserMutation) PasswordHashCleared() bool {
+	_, ok := m.clearedFields[user.FieldPasswordHash]
+	return ok
 }
 
-// IsUnknown returns the value of the "is_unknown" field in the mutation.
-func (m *SeriesProviderMutation) IsUnknown() (r bool, exists bool) {
-	v := m.is_unknown
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
+	delete(m.clearedFields, user.FieldPasswordHash)
+}
+
+// SetKosyncHash sets the "kosync_hash" field.
+func (m *UserMutation) SetKosyncHash(s string) {
+	m.kosync_hash = &s
+}
+
+// KosyncHash returns the value of the "kosync_hash" field in the mutation.
+func (m *UserMutation) KosyncHash() (r string, exists bool) {
+	v := m.kosync_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsUnknown returns the old "is_unknown" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldKosyncHash returns the old "kosync_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldIsUnknown(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldKosyncHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsUnknown is only allowed on UpdateOne operations")
+		return v, errors.New("OldKosyncHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsUnknown requires an ID field in the mutation")
+		return v, errors.New("OldKosyncHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsUnknown: %w", err)
+		return v, fmt.Errorf("querying old value for OldKosyncHash: %w", err)
 	}
-	return oldValue.IsUnknown, nil
+	return oldValue.KosyncHash, nil
 }
 
-// ResetIsUnknown resets all changes to the "is_unknown" field.
-func (m *SeriesProviderMutation) ResetIsUnknown() {
-	m.is_unknown = nil
+// ClearKosyncHash clears the value of the "kosync_hash" field.
+func (m *UserMutation) ClearKosyncHash() {
+	m.kosync_hash = nil
+	m.clearedFields[user.FieldKosyncHash] = struct{}{}
 }
 
-// SetImportance sets the "importance" field.
-func (m *SeriesProviderMutation) SetImportance(i int) {
-	m.importance = &i
-	m.addimportance = nil
+// KosyncHashCleared returns if the "kosync_hash" field was cleared in this mutation.
+func (m *UserMutation) KosyncHashCleared() bool {
+	_, ok := m.clearedFields[user.FieldKosyncHash]
+	return ok
 }
 
-// Importance returns the value of the "importance" field in the mutation.
-func (m *SeriesProviderMutation) Importance() (r int, exists bool) {
-	v := m.importance
+// ResetKosyncHash resets all changes to the "kosync_hash" field.
+func (m *UserMutation) ResetKosyncHash() {
+	m.kosync_hash = nil
+	delete(m.clearedFields, user.FieldKosyncHash)
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r string, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImportance returns the old "importance" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldImportance(ctx context.Context) (v int, err error) {
+func (m *UserMutation) OldRole(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImportance is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImportance requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImportance: %w", err)
-	}
-	return oldValue.Importance, nil
-}
-
-// AddImportance adds i to the "importance" field.
-func (m *SeriesProviderMutation) AddImportance(i int) {
-	if m.addimportance != nil {
-		*m.addimportance += i
-	} else {
-		m.addimportance = &i
-	}
-}
-
-// AddedImportance returns the value that was added to the "importance" field in this mutation.
-func (m *SeriesProviderMutation) AddedImportance() (r int, exists bool) {
-	v := m.addimportance
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return *v, true
+	return oldValue.Role, nil
 }
 
-// ResetImportance resets all changes to the "importance" field.
-func (m *SeriesProviderMutation) ResetImportance() {
-	m.importance = nil
-	m.addimportance = nil
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetIsDisabled sets the "is_disabled" field.
-func (m *SeriesProviderMutation) SetIsDisabled(b bool) {
-	m.is_disabled = &b
+// SetSource sets the "source" field.
+func (m *UserMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// IsDisabled returns the value of the "is_disabled" field in the mutation.
-func (m *SeriesProviderMutation) IsDisabled() (r bool, exists bool) {
-	v := m.is_disabled
+// Source returns the value of the "source" field in the mutation.
+func (m *UserMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsDisabled returns the old "is_disabled" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldIsDisabled(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDisabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDisabled requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDisabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.IsDisabled, nil
+	return oldValue.Source, nil
 }
 
-// ResetIsDisabled resets all changes to the "is_disabled" field.
-func (m *SeriesProviderMutation) ResetIsDisabled() {
-	m.is_disabled = nil
+// ResetSource resets all changes to the "source" field.
+func (m *UserMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetIsUninstalled sets the "is_uninstalled" field.
-func (m *SeriesProviderMutation) SetIsUninstalled(b bool) {
-	m.is_uninstalled = &b
+// SetExternalID sets the "external_id" field.
+func (m *UserMutation) SetExternalID(s string) {
+	m.external_id = &s
 }
 
-// IsUninstalled returns the value of the "is_uninstalled" field in the mutation.
-func (m *SeriesProviderMutation) IsUninstalled() (r bool, exists bool) {
-	v := m.is_uninstalled
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *UserMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsUninstalled returns the old "is_uninstalled" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldExternalID returns the old "external_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldIsUninstalled(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldExternalID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsUninstalled is only allowed on UpdateOne operations")
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsUninstalled requires an ID field in the mutation")
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsUninstalled: %w", err)
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
 	}
-	return oldValue.IsUninstalled, nil
+	return oldValue.ExternalID, nil
 }
 
-// ResetIsUninstalled resets all changes to the "is_uninstalled" field.
-func (m *SeriesProviderMutation) ResetIsUninstalled() {
-	m.is_uninstalled = nil
+// ClearExternalID clears the value of the "external_id" field.
+func (m *UserMutation) ClearExternalID() {
+	m.external_id = nil
+	m.clearedFields[user.FieldExternalID] = struct{}{}
 }
 
-// SetStatus sets the "status" field.
-func (m *SeriesProviderMutation) SetStatus(s string) {
-	m.status = &s
+// ExternalIDCleared returns if the "external_id" field was cleared in this mutation.
+func (m *UserMutation) ExternalIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldExternalID]
+	return ok
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *SeriesProviderMutation) Status() (r string, exists bool) {
-	v := m.status
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *UserMutation) ResetExternalID() {
+	m.external_id = nil
+	delete(m.clearedFields, user.FieldExternalID)
+}
+
+// SetFeedTokenHash sets the "feed_token_hash" field.
+func (m *UserMutation) SetFeedTokenHash(s string) {
+	m.feed_token_hash = &s
+}
+
+// FeedTokenHash returns the value of the "feed_token_hash" field in the mutation.
+func (m *UserMutation) FeedTokenHash() (r string, exists bool) {
+	v := m.feed_token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldFeedTokenHash returns the old "feed_token_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldFeedTokenHash(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldFeedTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldFeedTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldFeedTokenHash: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.FeedTokenHash, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SeriesProviderMutation) ResetStatus() {
-	m.status = nil
+// ClearFeedTokenHash clears the value of the "feed_token_hash" field.
+func (m *UserMutation) ClearFeedTokenHash() {
+	m.feed_token_hash = nil
+	m.clearedFields[user.FieldFeedTokenHash] = struct{}{}
 }
 
-// SetPageCountSynced sets the "page_count_synced" field.
-func (m *SeriesProviderMutation) SetPageCountSynced(b bool) {
-	m.page_count_synced = &b
+// FeedTokenHashCleared returns if the "feed_token_hash" field was cleared in this mutation.
+func (m *UserMutation) FeedTokenHashCleared() bool {
+	_, ok := m.clearedFields[user.FieldFeedTokenHash]
+	return ok
 }
 
-// PageCountSynced returns the value of the "page_count_synced" field in the mutation.
-func (m *SeriesProviderMutation) PageCountSynced() (r bool, exists bool) {
-	v := m.page_count_synced
+// ResetFeedTokenHash resets all changes to the "feed_token_hash" field.
+func (m *UserMutation) ResetFeedTokenHash() {
+	m.feed_token_hash = nil
+	delete(m.clearedFields, user.FieldFeedTokenHash)
+}
+
+// SetDisabled sets the "disabled" field.
+func (m *UserMutation) SetDisabled(b bool) {
+	m.disabled = &b
+}
+
+// Disabled returns the value of the "disabled" field in the mutation.
+func (m *UserMutation) Disabled() (r bool, exists bool) {
+	v := m.disabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPageCountSynced returns the old "page_count_synced" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldDisabled returns the old "disabled" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldPageCountSynced(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldDisabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPageCountSynced is only allowed on UpdateOne operations")
+		return v, errors.New("OldDisabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPageCountSynced requires an ID field in the mutation")
+		return v, errors.New("OldDisabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPageCountSynced: %w", err)
+		return v, fmt.Errorf("querying old value for OldDisabled: %w", err)
 	}
-	return oldValue.PageCountSynced, nil
+	return oldValue.Disabled, nil
 }
 
-// ResetPageCountSynced resets all changes to the "page_count_synced" field.
-func (m *SeriesProviderMutation) ResetPageCountSynced() {
-	m.page_count_synced = nil
+// ResetDisabled resets all changes to the "disabled" field.
+func (m *UserMutation) ResetDisabled() {
+	m.disabled = nil
 }
 
-// SetChapters sets the "chapters" field.
-func (m *SeriesProviderMutation) SetChapters(t []types.Chapter) {
-	m.chapters = &t
-	m.appendchapters = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Chapters returns the value of the "chapters" field in the mutation.
-func (m *SeriesProviderMutation) Chapters() (r []types.Chapter, exists bool) {
-	v := m.chapters
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChapters returns the old "chapters" field's value of the SeriesProvider entity.
-// If the SeriesProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SeriesProviderMutation) OldChapters(ctx context.Context) (v []types.Chapter, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChapters is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChapters requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChapters: %w", err)
-	}
-	return oldValue.Chapters, nil
-}
-
-// AppendChapters adds t to the "chapters" field.
-func (m *SeriesProviderMutation) AppendChapters(t []types.Chapter) {
-	m.appendchapters = append(m.appendchapters, t...)
-}
-
-// AppendedChapters returns the list of values that were appended to the "chapters" field in this mutation.
-func (m *SeriesProviderMutation) AppendedChapters() ([]types.Chapter, bool) {
-	if len(m.appendchapters) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return m.appendchapters, true
+	return oldValue.CreatedAt, nil
 }
 
-// ClearChapters clears the value of the "chapters" field.
-func (m *SeriesProviderMutation) ClearChapters() {
-	m.chapters = nil
-	m.appendchapters = nil
-	m.clearedFields[seriesprovider.FieldChapters] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ChaptersCleared returns if the "chapters" field was cleared in this mutation.
-func (m *SeriesProviderMutation) ChaptersCleared() bool {
-	_, ok := m.clearedFields[seriesprovider.FieldChapters]
-	return ok
+// SetLastLoginAt sets the "last_login_at" field.
+func (m *UserMutation) SetLastLoginAt(t time.Time) {
+	m.last_login_at = &t
 }
 
-// ResetChapters resets all changes to the "chapters" field.
-func (m *SeriesProviderMutation) ResetChapters() {
-	m.chapters = nil
-	m.appendchapters = nil
-	delete(m.clearedFields, seriesprovider.FieldChapters)
+// LastLoginAt returns the value of the "last_login_at" field in the mutation.
+func (m *UserMutation) LastLoginAt() (r time.Time, exists bool) {
+	v := m.last_login_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearSeries clears the "series" edge to the Series entity.
-func (m *SeriesProviderMutation) ClearSeries() {
-	m.clearedseries = true
-	m.clearedFields[seriesprovider.FieldSeriesID] = struct{}{}
+// OldLastLoginAt returns the old "last_login_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastLoginAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
+	}
+	return oldValue.LastLoginAt, nil
 }
 
-// SeriesCleared reports if the "series" edge to the Series entity was cleared.
-func (m *SeriesProviderMutation) SeriesCleared() bool {
-	return m.clearedseries
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (m *UserMutation) ClearLastLoginAt() {
+	m.last_login_at = nil
+	m.clearedFields[user.FieldLastLoginAt] = struct{}{}
 }
 
-// SeriesIDs returns the "series" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SeriesID instead. It exists only for internal usage by the builders.
-func (m *SeriesProviderMutation) SeriesIDs() (ids []uuid.UUID) {
-	if id := m.series; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
+func (m *UserMutation) LastLoginAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastLoginAt]
+	return ok
 }
 
-// ResetSeries resets all changes to the "series" edge.
-func (m *SeriesProviderMutation) ResetSeries() {
-	m.series = nil
-	m.clearedseries = false
+// ResetLastLoginAt resets all changes to the "last_login_at" field.
+func (m *UserMutation) ResetLastLoginAt() {
+	m.last_login_at = nil
+	delete(m.clearedFields, user.FieldLastLoginAt)
 }
 
-// Where appends a list predicates to the SeriesProviderMutation builder.
-func (m *SeriesProviderMutation) Where(ps ...predicate.SeriesProvider) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SeriesProviderMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SeriesProviderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SeriesProvider, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6912,96 +16513,54 @@ func (m *SeriesProviderMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SeriesProviderMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SeriesProviderMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SeriesProvider).
-func (m *SeriesProviderMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SeriesProviderMutation) Fields() []string {
-	fields := make([]string, 0, 24)
-	if m.series != nil {
-		fields = append(fields, seriesprovider.FieldSeriesID)
-	}
-	if m.suwayomi_id != nil {
-		fields = append(fields, seriesprovider.FieldSuwayomiID)
-	}
-	if m.provider != nil {
-		fields = append(fields, seriesprovider.FieldProvider)
-	}
-	if m.scanlator != nil {
-		fields = append(fields, seriesprovider.FieldScanlator)
-	}
-	if m.url != nil {
-		fields = append(fields, seriesprovider.FieldURL)
-	}
-	if m.title != nil {
-		fields = append(fields, seriesprovider.FieldTitle)
-	}
-	if m.language != nil {
-		fields = append(fields, seriesprovider.FieldLanguage)
-	}
-	if m.thumbnail_url != nil {
-		fields = append(fields, seriesprovider.FieldThumbnailURL)
-	}
-	if m.artist != nil {
-		fields = append(fields, seriesprovider.FieldArtist)
-	}
-	if m.author != nil {
-		fields = append(fields, seriesprovider.FieldAuthor)
-	}
-	if m.description != nil {
-		fields = append(fields, seriesprovider.FieldDescription)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
 	}
-	if m.genre != nil {
-		fields = append(fields, seriesprovider.FieldGenre)
-	}
-	if m.fetch_date != nil {
-		fields = append(fields, seriesprovider.FieldFetchDate)
-	}
-	if m.chapter_count != nil {
-		fields = append(fields, seriesprovider.FieldChapterCount)
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
 	}
-	if m.continue_after_chapter != nil {
-		fields = append(fields, seriesprovider.FieldContinueAfterChapter)
-	}
-	if m.is_title != nil {
-		fields = append(fields, seriesprovider.FieldIsTitle)
-	}
-	if m.is_cover != nil {
-		fields = append(fields, seriesprovider.FieldIsCover)
+	if m.kosync_hash != nil {
+		fields = append(fields, user.FieldKosyncHash)
 	}
-	if m.is_unknown != nil {
-		fields = append(fields, seriesprovider.FieldIsUnknown)
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
 	}
-	if m.importance != nil {
-		fields = append(fields, seriesprovider.FieldImportance)
+	if m.source != nil {
+		fields = append(fields, user.FieldSource)
 	}
-	if m.is_disabled != nil {
-		fields = append(fields, seriesprovider.FieldIsDisabled)
+	if m.external_id != nil {
+		fields = append(fields, user.FieldExternalID)
 	}
-	if m.is_uninstalled != nil {
-		fields = append(fields, seriesprovider.FieldIsUninstalled)
+	if m.feed_token_hash != nil {
+		fields = append(fields, user.FieldFeedTokenHash)
 	}
-	if m.status != nil {
-		fields = append(fields, seriesprovider.FieldStatus)
+	if m.disabled != nil {
+		fields = append(fields, user.FieldDisabled)
 	}
-	if m.page_count_synced != nil {
-		fields = append(fields, seriesprovider.FieldPageCountSynced)
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
 	}
-	if m.chapters != nil {
-		fields = append(fields, seriesprovider.FieldChapters)
+	if m.last_login_at != nil {
+		fields = append(fields, user.FieldLastLoginAt)
 	}
 	return fields
 }
@@ -7009,56 +16568,28 @@ func (m *SeriesProviderMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SeriesProviderMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case seriesprovider.FieldSeriesID:
-		return m.SeriesID()
-	case seriesprovider.FieldSuwayomiID:
-		return m.SuwayomiID()
-	case seriesprovider.FieldProvider:
-		return m.Provider()
-	case seriesprovider.FieldScanlator:
-		return m.Scanlator()
-	case seriesprovider.FieldURL:
-		return m.URL()
-	case seriesprovider.FieldTitle:
-		return m.Title()
-	case seriesprovider.FieldLanguage:
-		return m.Language()
-	case seriesprovider.FieldThumbnailURL:
-		return m.ThumbnailURL()
-	case seriesprovider.FieldArtist:
-		return m.Artist()
-	case seriesprovider.FieldAuthor:
-		return m.Author()
-	case seriesprovider.FieldDescription:
-		return m.Description()
-	case seriesprovider.FieldGenre:
-		return m.Genre()
-	case seriesprovider.FieldFetchDate:
-		return m.FetchDate()
-	case seriesprovider.FieldChapterCount:
-		return m.ChapterCount()
-	case seriesprovider.FieldContinueAfterChapter:
-		return m.ContinueAfterChapter()
-	case seriesprovider.FieldIsTitle:
-		return m.IsTitle()
-	case seriesprovider.FieldIsCover:
-		return m.IsCover()
-	case seriesprovider.FieldIsUnknown:
-		return m.IsUnknown()
-	case seriesprovider.FieldImportance:
-		return m.Importance()
-	case seriesprovider.FieldIsDisabled:
-		return m.IsDisabled()
-	case seriesprovider.FieldIsUninstalled:
-		return m.IsUninstalled()
-	case seriesprovider.FieldStatus:
-		return m.Status()
-	case seriesprovider.FieldPageCountSynced:
-		return m.PageCountSynced()
-	case seriesprovider.FieldChapters:
-		return m.Chapters()
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldKosyncHash:
+		return m.KosyncHash()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldSource:
+		return m.Source()
+	case user.FieldExternalID:
+		return m.ExternalID()
+	case user.FieldFeedTokenHash:
+		return m.FeedTokenHash()
+	case user.FieldDisabled:
+		return m.Disabled()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldLastLoginAt:
+		return m.LastLoginAt()
 	}
 	return nil, false
 }
@@ -7066,570 +16597,303 @@ func (m *SeriesProviderMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SeriesProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case seriesprovider.FieldSeriesID:
-		return m.OldSeriesID(ctx)
-	case seriesprovider.FieldSuwayomiID:
-		return m.OldSuwayomiID(ctx)
-	case seriesprovider.FieldProvider:
-		return m.OldProvider(ctx)
-	case seriesprovider.FieldScanlator:
-		return m.OldScanlator(ctx)
-	case seriesprovider.FieldURL:
-		return m.OldURL(ctx)
-	case seriesprovider.FieldTitle:
-		return m.OldTitle(ctx)
-	case seriesprovider.FieldLanguage:
-		return m.OldLanguage(ctx)
-	case seriesprovider.FieldThumbnailURL:
-		return m.OldThumbnailURL(ctx)
-	case seriesprovider.FieldArtist:
-		return m.OldArtist(ctx)
-	case seriesprovider.FieldAuthor:
-		return m.OldAuthor(ctx)
-	case seriesprovider.FieldDescription:
-		return m.OldDescription(ctx)
-	case seriesprovider.FieldGenre:
-		return m.OldGenre(ctx)
-	case seriesprovider.FieldFetchDate:
-		return m.OldFetchDate(ctx)
-	case seriesprovider.FieldChapterCount:
-		return m.OldChapterCount(ctx)
-	case seriesprovider.FieldContinueAfterChapter:
-		return m.OldContinueAfterChapter(ctx)
-	case seriesprovider.FieldIsTitle:
-		return m.OldIsTitle(ctx)
-	case seriesprovider.FieldIsCover:
-		return m.OldIsCover(ctx)
-	case seriesprovider.FieldIsUnknown:
-		return m.OldIsUnknown(ctx)
-	case seriesprovider.FieldImportance:
-		return m.OldImportance(ctx)
-	case seriesprovider.FieldIsDisabled:
-		return m.OldIsDisabled(ctx)
-	case seriesprovider.FieldIsUninstalled:
-		return m.OldIsUninstalled(ctx)
-	case seriesprovider.FieldStatus:
-		return m.OldStatus(ctx)
-	case seriesprovider.FieldPageCountSynced:
-		return m.OldPageCountSynced(ctx)
-	case seriesprovider.FieldChapters:
-		return m.OldChapters(ctx)
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldKosyncHash:
+		return m.OldKosyncHash(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldSource:
+		return m.OldSource(ctx)
+	case user.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case user.FieldFeedTokenHash:
+		return m.OldFeedTokenHash(ctx)
+	case user.FieldDisabled:
+		return m.OldDisabled(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldLastLoginAt:
+		return m.OldLastLoginAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SeriesProvider field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SeriesProviderMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case seriesprovider.FieldSeriesID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSeriesID(v)
-		return nil
-	case seriesprovider.FieldSuwayomiID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuwayomiID(v)
-		return nil
-	case seriesprovider.FieldProvider:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProvider(v)
-		return nil
-	case seriesprovider.FieldScanlator:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScanlator(v)
-		return nil
-	case seriesprovider.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case seriesprovider.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case seriesprovider.FieldLanguage:
+	case user.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLanguage(v)
+		m.SetUsername(v)
 		return nil
-	case seriesprovider.FieldThumbnailURL:
+	case user.FieldPasswordHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetThumbnailURL(v)
+		m.SetPasswordHash(v)
 		return nil
-	case seriesprovider.FieldArtist:
+	case user.FieldKosyncHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetArtist(v)
+		m.SetKosyncHash(v)
 		return nil
-	case seriesprovider.FieldAuthor:
+	case user.FieldRole:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthor(v)
+		m.SetRole(v)
 		return nil
-	case seriesprovider.FieldDescription:
+	case user.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
-		return nil
-	case seriesprovider.FieldGenre:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGenre(v)
-		return nil
-	case seriesprovider.FieldFetchDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFetchDate(v)
-		return nil
-	case seriesprovider.FieldChapterCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChapterCount(v)
-		return nil
-	case seriesprovider.FieldContinueAfterChapter:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContinueAfterChapter(v)
-		return nil
-	case seriesprovider.FieldIsTitle:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsTitle(v)
-		return nil
-	case seriesprovider.FieldIsCover:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsCover(v)
-		return nil
-	case seriesprovider.FieldIsUnknown:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsUnknown(v)
+		m.SetSource(v)
 		return nil
-	case seriesprovider.FieldImportance:
-		v, ok := value.(int)
+	case user.FieldExternalID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImportance(v)
+		m.SetExternalID(v)
 		return nil
-	case seriesprovider.FieldIsDisabled:
-		v, ok := value.(bool)
+	case user.FieldFeedTokenHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDisabled(v)
+		m.SetFeedTokenHash(v)
 		return nil
-	case seriesprovider.FieldIsUninstalled:
+	case user.FieldDisabled:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsUninstalled(v)
-		return nil
-	case seriesprovider.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+		m.SetDisabled(v)
 		return nil
-	case seriesprovider.FieldPageCountSynced:
-		v, ok := value.(bool)
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPageCountSynced(v)
+		m.SetCreatedAt(v)
 		return nil
-	case seriesprovider.FieldChapters:
-		v, ok := value.([]types.Chapter)
+	case user.FieldLastLoginAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChapters(v)
+		m.SetLastLoginAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SeriesProvider field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SeriesProviderMutation) AddedFields() []string {
-	var fields []string
-	if m.addsuwayomi_id != nil {
-		fields = append(fields, seriesprovider.FieldSuwayomiID)
-	}
-	if m.addchapter_count != nil {
-		fields = append(fields, seriesprovider.FieldChapterCount)
-	}
-	if m.addcontinue_after_chapter != nil {
-		fields = append(fields, seriesprovider.FieldContinueAfterChapter)
-	}
-	if m.addimportance != nil {
-		fields = append(fields, seriesprovider.FieldImportance)
-	}
-	return fields
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SeriesProviderMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case seriesprovider.FieldSuwayomiID:
-		return m.AddedSuwayomiID()
-	case seriesprovider.FieldChapterCount:
-		return m.AddedChapterCount()
-	case seriesprovider.FieldContinueAfterChapter:
-		return m.AddedContinueAfterChapter()
-	case seriesprovider.FieldImportance:
-		return m.AddedImportance()
-	}
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SeriesProviderMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case seriesprovider.FieldSuwayomiID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSuwayomiID(v)
-		return nil
-	case seriesprovider.FieldChapterCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddChapterCount(v)
-		return nil
-	case seriesprovider.FieldContinueAfterChapter:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddContinueAfterChapter(v)
-		return nil
-	case seriesprovider.FieldImportance:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddImportance(v)
-		return nil
 	}
-	return fmt.Errorf("unknown SeriesProvider numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SeriesProviderMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(seriesprovider.FieldURL) {
-		fields = append(fields, seriesprovider.FieldURL)
-	}
-	if m.FieldCleared(seriesprovider.FieldThumbnailURL) {
-		fields = append(fields, seriesprovider.FieldThumbnailURL)
-	}
-	if m.FieldCleared(seriesprovider.FieldArtist) {
-		fields = append(fields, seriesprovider.FieldArtist)
-	}
-	if m.FieldCleared(seriesprovider.FieldAuthor) {
-		fields = append(fields, seriesprovider.FieldAuthor)
-	}
-	if m.FieldCleared(seriesprovider.FieldDescription) {
-		fields = append(fields, seriesprovider.FieldDescription)
-	}
-	if m.FieldCleared(seriesprovider.FieldGenre) {
-		fields = append(fields, seriesprovider.FieldGenre)
+	if m.FieldCleared(user.FieldPasswordHash) {
+		fields = append(fields, user.FieldPasswordHash)
 	}
-	if m.FieldCleared(seriesprovider.FieldFetchDate) {
-		fields = append(fields, seriesprovider.FieldFetchDate)
+	if m.FieldCleared(user.FieldKosyncHash) {
+		fields = append(fields, user.FieldKosyncHash)
 	}
-	if m.FieldCleared(seriesprovider.FieldChapterCount) {
-		fields = append(fields, seriesprovider.FieldChapterCount)
+	if m.FieldCleared(user.FieldExternalID) {
+		fields = append(fields, user.FieldExternalID)
 	}
-	if m.FieldCleared(seriesprovider.FieldContinueAfterChapter) {
-		fields = append(fields, seriesprovider.FieldContinueAfterChapter)
+	if m.FieldCleared(user.FieldFeedTokenHash) {
+		fields = append(fields, user.FieldFeedTokenHash)
 	}
-	if m.FieldCleared(seriesprovider.FieldChapters) {
-		fields = append(fields, seriesprovider.FieldChapters)
+	if m.FieldCleared(user.FieldLastLoginAt) {
+		fields = append(fields, user.FieldLastLoginAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SeriesProviderMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SeriesProviderMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case seriesprovider.FieldURL:
-		m.ClearURL()
-		return nil
-	case seriesprovider.FieldThumbnailURL:
-		m.ClearThumbnailURL()
-		return nil
-	case seriesprovider.FieldArtist:
-		m.ClearArtist()
-		return nil
-	case seriesprovider.FieldAuthor:
-		m.ClearAuthor()
-		return nil
-	case seriesprovider.FieldDescription:
-		m.ClearDescription()
+	case user.FieldPasswordHash:
+		m.ClearPasswordHash()
 		return nil
-	case seriesprovider.FieldGenre:
-		m.ClearGenre()
-		return nil
-	case seriesprovider.FieldFetchDate:
-		m.ClearFetchDate()
+	case user.FieldKosyncHash:
+		m.ClearKosyncHash()
 		return nil
-	case seriesprovider.FieldChapterCount:
-		m.ClearChapterCount()
+	case user.FieldExternalID:
+		m.ClearExternalID()
 		return nil
-	case seriesprovider.FieldContinueAfterChapter:
-		m.ClearContinueAfterChapter()
+	case user.FieldFeedTokenHash:
+		m.ClearFeedTokenHash()
 		return nil
-	case seriesprovider.FieldChapters:
-		m.ClearChapters()
+	case user.FieldLastLoginAt:
+		m.ClearLastLoginAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SeriesProvider nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SeriesProviderMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case seriesprovider.FieldSeriesID:
-		m.ResetSeriesID()
-		return nil
-	case seriesprovider.FieldSuwayomiID:
-		m.ResetSuwayomiID()
-		return nil
-	case seriesprovider.FieldProvider:
-		m.ResetProvider()
-		return nil
-	case seriesprovider.FieldScanlator:
-		m.ResetScanlator()
-		return nil
-	case seriesprovider.FieldURL:
-		m.ResetURL()
-		return nil
-	case seriesprovider.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case seriesprovider.FieldLanguage:
-		m.ResetLanguage()
-		return nil
-	case seriesprovider.FieldThumbnailURL:
-		m.ResetThumbnailURL()
-		return nil
-	case seriesprovider.FieldArtist:
-		m.ResetArtist()
-		return nil
-	case seriesprovider.FieldAuthor:
-		m.ResetAuthor()
-		return nil
-	case seriesprovider.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case seriesprovider.FieldGenre:
-		m.ResetGenre()
-		return nil
-	case seriesprovider.FieldFetchDate:
-		m.ResetFetchDate()
-		return nil
-	case seriesprovider.FieldChapterCount:
-		m.ResetChapterCount()
-		return nil
-	case seriesprovider.FieldContinueAfterChapter:
-		m.ResetContinueAfterChapter()
-		return nil
-	case seriesprovider.FieldIsTitle:
-		m.ResetIsTitle()
+	case user.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case seriesprovider.FieldIsCover:
-		m.ResetIsCover()
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
 		return nil
-	case seriesprovider.FieldIsUnknown:
-		m.ResetIsUnknown()
+	case user.FieldKosyncHash:
+		m.ResetKosyncHash()
 		return nil
-	case seriesprovider.FieldImportance:
-		m.ResetImportance()
+	case user.FieldRole:
+		m.ResetRole()
 		return nil
-	case seriesprovider.FieldIsDisabled:
-		m.ResetIsDisabled()
+	case user.FieldSource:
+		m.ResetSource()
 		return nil
-	case seriesprovider.FieldIsUninstalled:
-		m.ResetIsUninstalled()
+	case user.FieldExternalID:
+		m.ResetExternalID()
 		return nil
-	case seriesprovider.FieldStatus:
-		m.ResetStatus()
+	case user.FieldFeedTokenHash:
+		m.ResetFeedTokenHash()
 		return nil
-	case seriesprovider.FieldPageCountSynced:
-		m.ResetPageCountSynced()
+	case user.FieldDisabled:
+		m.ResetDisabled()
 		return nil
-	case seriesprovider.FieldChapters:
-		m.ResetChapters()
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldLastLoginAt:
+		m.ResetLastLoginAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SeriesProvider field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SeriesProviderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.series != nil {
-		edges = append(edges, seriesprovider.EdgeSeries)
-	}
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SeriesProviderMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case seriesprovider.EdgeSeries:
-		if id := m.series; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SeriesProviderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SeriesProviderMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SeriesProviderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedseries {
-		edges = append(edges, seriesprovider.EdgeSeries)
-	}
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SeriesProviderMutation) EdgeCleared(name string) bool {
-	switch name {
-	case seriesprovider.EdgeSeries:
-		return m.clearedseries
-	}
+func (m *UserMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SeriesProviderMutation) ClearEdge(name string) error {
-	switch name {
-	case seriesprovider.EdgeSeries:
-		m.ClearSeries()
-		return nil
-	}
-	return fmt.Errorf("unknown SeriesProvider unique edge %s", name)
+func (m *UserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SeriesProviderMutation) ResetEdge(name string) error {
-	switch name {
-	case seriesprovider.EdgeSeries:
-		m.ResetSeries()
-		return nil
-	}
-	return fmt.Errorf("unknown SeriesProvider edge %s", name)
+func (m *UserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// SettingMutation represents an operation that mutates the Setting nodes in the graph.
-type SettingMutation struct {
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *string
-	value         *string
+	id            *uuid.UUID
+	name          *string
+	url           *string
+	events        *[]string
+	appendevents  []string
+	secret        *string
+	body_template *string
+	enabled       *bool
+	created_at    *time.Time
+	updated_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Setting, error)
-	predicates    []predicate.Setting
+	oldValue      func(context.Context) (*Webhook, error)
+	predicates    []predicate.Webhook
 }
 
-var _ ent.Mutation = (*SettingMutation)(nil)
+var _ ent.Mutation = (*WebhookMutation)(nil)
 
-// settingOption allows management of the mutation configuration using functional options.
-type settingOption func(*SettingMutation)
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
 
-// newSettingMutation creates new mutation for the Setting entity.
-func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
-	m := &SettingMutation{
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSetting,
+		typ:           TypeWebhook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7638,20 +16902,20 @@ func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation
 	return m
 }
 
-// withSettingID sets the ID field of the mutation.
-func withSettingID(id string) settingOption {
-	return func(m *SettingMutation) {
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id uuid.UUID) webhookOption {
+	return func(m *WebhookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Setting
+			value *Webhook
 		)
-		m.oldValue = func(ctx context.Context) (*Setting, error) {
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Setting.Get(ctx, id)
+					value, err = m.Client().Webhook.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7660,114 +16924,421 @@ func withSettingID(id string) settingOption {
 	}
 }
 
-// withSetting sets the old Setting of the mutation.
-func withSetting(node *Setting) settingOption {
-	return func(m *SettingMutation) {
-		m.oldValue = func(context.Context) (*Setting, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Webhook entities.
+func (m *WebhookMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *WebhookMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WebhookMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WebhookMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetEvents sets the "events" field.
+func (m *WebhookMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// AppendEvents adds s to the "events" field.
+func (m *WebhookMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
+}
+
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *WebhookMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ClearEvents clears the value of the "events" field.
+func (m *WebhookMutation) ClearEvents() {
+	m.events = nil
+	m.appendevents = nil
+	m.clearedFields[webhook.FieldEvents] = struct{}{}
+}
+
+// EventsCleared returns if the "events" field was cleared in this mutation.
+func (m *WebhookMutation) EventsCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldEvents]
+	return ok
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+	delete(m.clearedFields, webhook.FieldEvents)
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (m *WebhookMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[webhook.FieldSecret] = struct{}{}
+}
+
+// SecretCleared returns if the "secret" field was cleared in this mutation.
+func (m *WebhookMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldSecret]
+	return ok
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, webhook.FieldSecret)
+}
+
+// SetBodyTemplate sets the "body_template" field.
+func (m *WebhookMutation) SetBodyTemplate(s string) {
+	m.body_template = &s
+}
+
+// BodyTemplate returns the value of the "body_template" field in the mutation.
+func (m *WebhookMutation) BodyTemplate() (r string, exists bool) {
+	v := m.body_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBodyTemplate returns the old "body_template" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldBodyTemplate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBodyTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBodyTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBodyTemplate: %w", err)
+	}
+	return oldValue.BodyTemplate, nil
+}
+
+// ClearBodyTemplate clears the value of the "body_template" field.
+func (m *WebhookMutation) ClearBodyTemplate() {
+	m.body_template = nil
+	m.clearedFields[webhook.FieldBodyTemplate] = struct{}{}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// BodyTemplateCleared returns if the "body_template" field was cleared in this mutation.
+func (m *WebhookMutation) BodyTemplateCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldBodyTemplate]
+	return ok
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SettingMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// ResetBodyTemplate resets all changes to the "body_template" field.
+func (m *WebhookMutation) ResetBodyTemplate() {
+	m.body_template = nil
+	delete(m.clearedFields, webhook.FieldBodyTemplate)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *WebhookMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Setting entities.
-func (m *SettingMutation) SetID(id string) {
-	m.id = &id
+// OldEnabled returns the old "enabled" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SettingMutation) ID() (id string, exists bool) {
-	if m.id == nil {
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SettingMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldCreatedAt returns the old "created_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// SetValue sets the "value" field.
-func (m *SettingMutation) SetValue(s string) {
-	m.value = &s
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SettingMutation) Value() (r string, exists bool) {
-	v := m.value
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SettingMutation) ResetValue() {
-	m.value = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the SettingMutation builder.
-func (m *SettingMutation) Where(ps ...predicate.Setting) {
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Setting, len(ps))
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7775,27 +17346,48 @@ func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SettingMutation) Op() Op {
+func (m *WebhookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SettingMutation) SetOp(op Op) {
+func (m *WebhookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Setting).
-func (m *SettingMutation) Type() string {
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SettingMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.value != nil {
-		fields = append(fields, setting.FieldValue)
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, webhook.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
+	}
+	if m.events != nil {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	if m.body_template != nil {
+		fields = append(fields, webhook.FieldBodyTemplate)
+	}
+	if m.enabled != nil {
+		fields = append(fields, webhook.FieldEnabled)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhook.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, webhook.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -7803,10 +17395,24 @@ func (m *SettingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case setting.FieldValue:
-		return m.Value()
+	case webhook.FieldName:
+		return m.Name()
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldEvents:
+		return m.Events()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldBodyTemplate:
+		return m.BodyTemplate()
+	case webhook.FieldEnabled:
+		return m.Enabled()
+	case webhook.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhook.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -7814,166 +17420,274 @@ func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case setting.FieldValue:
-		return m.OldValue(ctx)
+	case webhook.FieldName:
+		return m.OldName(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldBodyTemplate:
+		return m.OldBodyTemplate(ctx)
+	case webhook.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case webhook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhook.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Setting field %s", name)
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case setting.FieldValue:
+	case webhook.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetName(v)
+		return nil
+	case webhook.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhook.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case webhook.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhook.FieldBodyTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodyTemplate(v)
+		return nil
+	case webhook.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case webhook.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhook.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SettingMutation) AddedFields() []string {
+func (m *WebhookMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Setting numeric field %s", name)
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SettingMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhook.FieldEvents) {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	if m.FieldCleared(webhook.FieldSecret) {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	if m.FieldCleared(webhook.FieldBodyTemplate) {
+		fields = append(fields, webhook.FieldBodyTemplate)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SettingMutation) FieldCleared(name string) bool {
+func (m *WebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SettingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Setting nullable field %s", name)
+func (m *WebhookMutation) ClearField(name string) error {
+	switch name {
+	case webhook.FieldEvents:
+		m.ClearEvents()
+		return nil
+	case webhook.FieldSecret:
+		m.ClearSecret()
+		return nil
+	case webhook.FieldBodyTemplate:
+		m.ClearBodyTemplate()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SettingMutation) ResetField(name string) error {
+func (m *WebhookMutation) ResetField(name string) error {
 	switch name {
-	case setting.FieldValue:
-		m.ResetValue()
+	case webhook.FieldName:
+		m.ResetName()
+		return nil
+	case webhook.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhook.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case webhook.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhook.FieldBodyTemplate:
+		m.ResetBodyTemplate()
+		return nil
+	case webhook.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case webhook.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhook.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingMutation) AddedEdges() []string {
+func (m *WebhookMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingMutation) RemovedEdges() []string {
+func (m *WebhookMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingMutation) ClearedEdges() []string {
+func (m *WebhookMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SettingMutation) EdgeCleared(name string) bool {
+func (m *WebhookMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SettingMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Setting unique edge %s", name)
+func (m *WebhookMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SettingMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Setting edge %s", name)
-}
-
-// SourceEventMutation represents an operation that mutates the SourceEvent nodes in the graph.
-type SourceEventMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	source_id      *string
-	source_name    *string
-	language       *string
-	event_type     *string
-	status         *string
-	duration_ms    *int64
-	addduration_ms *int64
-	error_message  *string
-	error_category *string
-	items_count    *int
-	additems_count *int
-	metadata       *map[string]string
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*SourceEvent, error)
-	predicates     []predicate.SourceEvent
+func (m *WebhookMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-var _ ent.Mutation = (*SourceEventMutation)(nil)
-
-// sourceeventOption allows management of the mutation configuration using functional options.
-type sourceeventOption func(*SourceEventMutation)
-
-// newSourceEventMutation creates new mutation for the SourceEvent entity.
-func newSourceEventMutation(c config, op Op, opts ...sourceeventOption) *SourceEventMutation {
-	m := &SourceEventMutation{
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	webhook_id         *uuid.UUID
+	event_id           *string
+	event_type         *string
+	payload            *string
+	status             *string
+	attempts           *int
+	addattempts        *int
+	response_status    *int
+	addresponse_status *int
+	response_body      *string
+	error              *string
+	duration_ms        *int64
+	addduration_ms     *int64
+	next_attempt_at    *time.Time
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*WebhookDelivery, error)
+	predicates         []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSourceEvent,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7982,20 +17696,20 @@ func newSourceEventMutation(c config, op Op, opts ...sourceeventOption) *SourceE
 	return m
 }
 
-// withSourceEventID sets the ID field of the mutation.
-func withSourceEventID(id uuid.UUID) sourceeventOption {
-	return func(m *SourceEventMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uuid.UUID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SourceEvent
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*SourceEvent, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SourceEvent.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8004,10 +17718,10 @@ func withSourceEventID(id uuid.UUID) sourceeventOption {
 	}
 }
 
-// withSourceEvent sets the old SourceEvent of the mutation.
-func withSourceEvent(node *SourceEvent) sourceeventOption {
-	return func(m *SourceEventMutation) {
-		m.oldValue = func(context.Context) (*SourceEvent, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8016,7 +17730,7 @@ func withSourceEvent(node *SourceEvent) sourceeventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SourceEventMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8024,7 +17738,7 @@ func (m SourceEventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SourceEventMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8034,14 +17748,14 @@ func (m SourceEventMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SourceEvent entities.
-func (m *SourceEventMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SourceEventMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8052,7 +17766,7 @@ func (m *SourceEventMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SourceEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8061,163 +17775,163 @@ func (m *SourceEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SourceEvent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSourceID sets the "source_id" field.
-func (m *SourceEventMutation) SetSourceID(s string) {
-	m.source_id = &s
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliveryMutation) SetWebhookID(u uuid.UUID) {
+	m.webhook_id = &u
 }
 
-// SourceID returns the value of the "source_id" field in the mutation.
-func (m *SourceEventMutation) SourceID() (r string, exists bool) {
-	v := m.source_id
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (r uuid.UUID, exists bool) {
+	v := m.webhook_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceID returns the old "source_id" field's value of the SourceEvent entity.
-// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceEventMutation) OldSourceID(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldWebhookID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceID requires an ID field in the mutation")
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
 	}
-	return oldValue.SourceID, nil
+	return oldValue.WebhookID, nil
 }
 
-// ResetSourceID resets all changes to the "source_id" field.
-func (m *SourceEventMutation) ResetSourceID() {
-	m.source_id = nil
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) ResetWebhookID() {
+	m.webhook_id = nil
 }
 
-// SetSourceName sets the "source_name" field.
-func (m *SourceEventMutation) SetSourceName(s string) {
-	m.source_name = &s
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(s string) {
+	m.event_id = &s
 }
 
-// SourceName returns the value of the "source_name" field in the mutation.
-func (m *SourceEventMutation) SourceName() (r string, exists bool) {
-	v := m.source_name
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r string, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceName returns the old "source_name" field's value of the SourceEvent entity.
-// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceEventMutation) OldSourceName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceName is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceName requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceName: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.SourceName, nil
+	return oldValue.EventID, nil
 }
 
-// ResetSourceName resets all changes to the "source_name" field.
-func (m *SourceEventMutation) ResetSourceName() {
-	m.source_name = nil
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// SetLanguage sets the "language" field.
-func (m *SourceEventMutation) SetLanguage(s string) {
-	m.language = &s
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// Language returns the value of the "language" field in the mutation.
-func (m *SourceEventMutation) Language() (r string, exists bool) {
-	v := m.language
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLanguage returns the old "language" field's value of the SourceEvent entity.
-// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceEventMutation) OldLanguage(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLanguage requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.Language, nil
+	return oldValue.EventType, nil
 }
 
-// ResetLanguage resets all changes to the "language" field.
-func (m *SourceEventMutation) ResetLanguage() {
-	m.language = nil
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// SetEventType sets the "event_type" field.
-func (m *SourceEventMutation) SetEventType(s string) {
-	m.event_type = &s
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// EventType returns the value of the "event_type" field in the mutation.
-func (m *SourceEventMutation) EventType() (r string, exists bool) {
-	v := m.event_type
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEventType returns the old "event_type" field's value of the SourceEvent entity.
-// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceEventMutation) OldEventType(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventType requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.EventType, nil
+	return oldValue.Payload, nil
 }
 
-// ResetEventType resets all changes to the "event_type" field.
-func (m *SourceEventMutation) ResetEventType() {
-	m.event_type = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
 // SetStatus sets the "status" field.
-func (m *SourceEventMutation) SetStatus(s string) {
+func (m *WebhookDeliveryMutation) SetStatus(s string) {
 	m.status = &s
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *SourceEventMutation) Status() (r string, exists bool) {
+func (m *WebhookDeliveryMutation) Status() (r string, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -8225,10 +17939,10 @@ func (m *SourceEventMutation) Status() (r string, exists bool) {
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the SourceEvent entity.
-// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceEventMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -8243,290 +17957,346 @@ func (m *SourceEventMutation) OldStatus(ctx context.Context) (v string, err erro
 }
 
 // ResetStatus resets all changes to the "status" field.
-func (m *SourceEventMutation) ResetStatus() {
+func (m *WebhookDeliveryMutation) ResetStatus() {
 	m.status = nil
 }
 
-// SetDurationMs sets the "duration_ms" field.
-func (m *SourceEventMutation) SetDurationMs(i int64) {
-	m.duration_ms = &i
-	m.addduration_ms = nil
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// DurationMs returns the value of the "duration_ms" field in the mutation.
-func (m *SourceEventMutation) DurationMs() (r int64, exists bool) {
-	v := m.duration_ms
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDurationMs returns the old "duration_ms" field's value of the SourceEvent entity.
-// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceEventMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.DurationMs, nil
+	return oldValue.Attempts, nil
 }
 
-// AddDurationMs adds i to the "duration_ms" field.
-func (m *SourceEventMutation) AddDurationMs(i int64) {
-	if m.addduration_ms != nil {
-		*m.addduration_ms += i
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
 	} else {
-		m.addduration_ms = &i
+		m.addattempts = &i
 	}
 }
 
-// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
-func (m *SourceEventMutation) AddedDurationMs() (r int64, exists bool) {
-	v := m.addduration_ms
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDurationMs resets all changes to the "duration_ms" field.
-func (m *SourceEventMutation) ResetDurationMs() {
-	m.duration_ms = nil
-	m.addduration_ms = nil
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *SourceEventMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *SourceEventMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the SourceEvent entity.
-// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceEventMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
+	return oldValue.ResponseStatus, nil
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *SourceEventMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[sourceevent.FieldErrorMessage] = struct{}{}
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *SourceEventMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[sourceevent.FieldErrorMessage]
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
 	return ok
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *SourceEventMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, sourceevent.FieldErrorMessage)
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
 }
 
-// SetErrorCategory sets the "error_category" field.
-func (m *SourceEventMutation) SetErrorCategory(s string) {
-	m.error_category = &s
+// SetResponseBody sets the "response_body" field.
+func (m *WebhookDeliveryMutation) SetResponseBody(s string) {
+	m.response_body = &s
 }
 
-// ErrorCategory returns the value of the "error_category" field in the mutation.
-func (m *SourceEventMutation) ErrorCategory() (r string, exists bool) {
-	v := m.error_category
+// ResponseBody returns the value of the "response_body" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseBody() (r string, exists bool) {
+	v := m.response_body
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorCategory returns the old "error_category" field's value of the SourceEvent entity.
-// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseBody returns the old "response_body" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceEventMutation) OldErrorCategory(ctx context.Context) (v *string, err error) {
+func (m *WebhookDeliveryMutation) OldResponseBody(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseBody is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorCategory requires an ID field in the mutation")
+		return v, errors.New("OldResponseBody requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseBody: %w", err)
 	}
-	return oldValue.ErrorCategory, nil
+	return oldValue.ResponseBody, nil
 }
 
-// ClearErrorCategory clears the value of the "error_category" field.
-func (m *SourceEventMutation) ClearErrorCategory() {
-	m.error_category = nil
-	m.clearedFields[sourceevent.FieldErrorCategory] = struct{}{}
+// ClearResponseBody clears the value of the "response_body" field.
+func (m *WebhookDeliveryMutation) ClearResponseBody() {
+	m.response_body = nil
+	m.clearedFields[webhookdelivery.FieldResponseBody] = struct{}{}
 }
 
-// ErrorCategoryCleared returns if the "error_category" field was cleared in this mutation.
-func (m *SourceEventMutation) ErrorCategoryCleared() bool {
-	_, ok := m.clearedFields[sourceevent.FieldErrorCategory]
+// ResponseBodyCleared returns if the "response_body" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseBodyCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseBody]
 	return ok
 }
 
-// ResetErrorCategory resets all changes to the "error_category" field.
-func (m *SourceEventMutation) ResetErrorCategory() {
-	m.error_category = nil
-	delete(m.clearedFields, sourceevent.FieldErrorCategory)
+// ResetResponseBody resets all changes to the "response_body" field.
+func (m *WebhookDeliveryMutation) ResetResponseBody() {
+	m.response_body = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseBody)
 }
 
-// SetItemsCount sets the "items_count" field.
-func (m *SourceEventMutation) SetItemsCount(i int) {
-	m.items_count = &i
-	m.additems_count = nil
+// SetError sets the "error" field.
+func (m *WebhookDeliveryMutation) SetError(s string) {
+	m.error = &s
 }
 
-// ItemsCount returns the value of the "items_count" field in the mutation.
-func (m *SourceEventMutation) ItemsCount() (r int, exists bool) {
-	v := m.items_count
+// Error returns the value of the "error" field in the mutation.
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldItemsCount returns the old "items_count" field's value of the SourceEvent entity.
-// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceEventMutation) OldItemsCount(ctx context.Context) (v *int, err error) {
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldItemsCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldItemsCount requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldItemsCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.ItemsCount, nil
+	return oldValue.Error, nil
 }
 
-// AddItemsCount adds i to the "items_count" field.
-func (m *SourceEventMutation) AddItemsCount(i int) {
-	if m.additems_count != nil {
-		*m.additems_count += i
-	} else {
-		m.additems_count = &i
-	}
+// ClearError clears the value of the "error" field.
+func (m *WebhookDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
 }
 
-// AddedItemsCount returns the value that was added to the "items_count" field in this mutation.
-func (m *SourceEventMutation) AddedItemsCount() (r int, exists bool) {
-	v := m.additems_count
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *WebhookDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, webhookdelivery.FieldError)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *WebhookDeliveryMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *WebhookDeliveryMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearItemsCount clears the value of the "items_count" field.
-func (m *SourceEventMutation) ClearItemsCount() {
-	m.items_count = nil
-	m.additems_count = nil
-	m.clearedFields[sourceevent.FieldItemsCount] = struct{}{}
+// OldDurationMs returns the old "duration_ms" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
 }
 
-// ItemsCountCleared returns if the "items_count" field was cleared in this mutation.
-func (m *SourceEventMutation) ItemsCountCleared() bool {
-	_, ok := m.clearedFields[sourceevent.FieldItemsCount]
-	return ok
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *WebhookDeliveryMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetItemsCount resets all changes to the "items_count" field.
-func (m *SourceEventMutation) ResetItemsCount() {
-	m.items_count = nil
-	m.additems_count = nil
-	delete(m.clearedFields, sourceevent.FieldItemsCount)
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *WebhookDeliveryMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *SourceEventMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *SourceEventMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the SourceEvent entity.
-// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceEventMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.NextAttemptAt, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *SourceEventMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[sourceevent.FieldMetadata] = struct{}{}
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[webhookdelivery.FieldNextAttemptAt] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *SourceEventMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[sourceevent.FieldMetadata]
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldNextAttemptAt]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *SourceEventMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, sourceevent.FieldMetadata)
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldNextAttemptAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SourceEventMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SourceEventMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8534,10 +18304,10 @@ func (m *SourceEventMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the SourceEvent entity.
-// If the SourceEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SourceEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8552,19 +18322,55 @@ func (m *SourceEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SourceEventMutation) ResetCreatedAt() {
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the SourceEventMutation builder.
-func (m *SourceEventMutation) Where(ps ...predicate.SourceEvent) {
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookDeliveryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookDeliveryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SourceEventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SourceEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SourceEvent, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8572,57 +18378,63 @@ func (m *SourceEventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SourceEventMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SourceEventMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SourceEvent).
-func (m *SourceEventMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SourceEventMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.source_id != nil {
-		fields = append(fields, sourceevent.FieldSourceID)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.webhook_id != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
 	}
-	if m.source_name != nil {
-		fields = append(fields, sourceevent.FieldSourceName)
-	}
-	if m.language != nil {
-		fields = append(fields, sourceevent.FieldLanguage)
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
 	}
 	if m.event_type != nil {
-		fields = append(fields, sourceevent.FieldEventType)
+		fields = append(fields, webhookdelivery.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
 	if m.status != nil {
-		fields = append(fields, sourceevent.FieldStatus)
+		fields = append(fields, webhookdelivery.FieldStatus)
 	}
-	if m.duration_ms != nil {
-		fields = append(fields, sourceevent.FieldDurationMs)
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
 	}
-	if m.error_message != nil {
-		fields = append(fields, sourceevent.FieldErrorMessage)
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
 	}
-	if m.error_category != nil {
-		fields = append(fields, sourceevent.FieldErrorCategory)
+	if m.response_body != nil {
+		fields = append(fields, webhookdelivery.FieldResponseBody)
 	}
-	if m.items_count != nil {
-		fields = append(fields, sourceevent.FieldItemsCount)
+	if m.error != nil {
+		fields = append(fields, webhookdelivery.FieldError)
 	}
-	if m.metadata != nil {
-		fields = append(fields, sourceevent.FieldMetadata)
+	if m.duration_ms != nil {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, sourceevent.FieldCreatedAt)
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -8630,30 +18442,34 @@ func (m *SourceEventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SourceEventMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case sourceevent.FieldSourceID:
-		return m.SourceID()
-	case sourceevent.FieldSourceName:
-		return m.SourceName()
-	case sourceevent.FieldLanguage:
-		return m.Language()
-	case sourceevent.FieldEventType:
+	case webhookdelivery.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEventType:
 		return m.EventType()
-	case sourceevent.FieldStatus:
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
 		return m.Status()
-	case sourceevent.FieldDurationMs:
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldResponseBody:
+		return m.ResponseBody()
+	case webhookdelivery.FieldError:
+		return m.Error()
+	case webhookdelivery.FieldDurationMs:
 		return m.DurationMs()
-	case sourceevent.FieldErrorMessage:
-		return m.ErrorMessage()
-	case sourceevent.FieldErrorCategory:
-		return m.ErrorCategory()
-	case sourceevent.FieldItemsCount:
-		return m.ItemsCount()
-	case sourceevent.FieldMetadata:
-		return m.Metadata()
-	case sourceevent.FieldCreatedAt:
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
+	case webhookdelivery.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -8661,129 +18477,150 @@ func (m *SourceEventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SourceEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case sourceevent.FieldSourceID:
-		return m.OldSourceID(ctx)
-	case sourceevent.FieldSourceName:
-		return m.OldSourceName(ctx)
-	case sourceevent.FieldLanguage:
-		return m.OldLanguage(ctx)
-	case sourceevent.FieldEventType:
+	case webhookdelivery.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEventType:
 		return m.OldEventType(ctx)
-	case sourceevent.FieldStatus:
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
 		return m.OldStatus(ctx)
-	case sourceevent.FieldDurationMs:
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldResponseBody:
+		return m.OldResponseBody(ctx)
+	case webhookdelivery.FieldError:
+		return m.OldError(ctx)
+	case webhookdelivery.FieldDurationMs:
 		return m.OldDurationMs(ctx)
-	case sourceevent.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case sourceevent.FieldErrorCategory:
-		return m.OldErrorCategory(ctx)
-	case sourceevent.FieldItemsCount:
-		return m.OldItemsCount(ctx)
-	case sourceevent.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case sourceevent.FieldCreatedAt:
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case webhookdelivery.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SourceEvent field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SourceEventMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case sourceevent.FieldSourceID:
-		v, ok := value.(string)
+	case webhookdelivery.FieldWebhookID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceID(v)
+		m.SetWebhookID(v)
 		return nil
-	case sourceevent.FieldSourceName:
+	case webhookdelivery.FieldEventID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceName(v)
+		m.SetEventID(v)
 		return nil
-	case sourceevent.FieldLanguage:
+	case webhookdelivery.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLanguage(v)
+		m.SetEventType(v)
 		return nil
-	case sourceevent.FieldEventType:
+	case webhookdelivery.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEventType(v)
+		m.SetPayload(v)
 		return nil
-	case sourceevent.FieldStatus:
+	case webhookdelivery.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case sourceevent.FieldDurationMs:
-		v, ok := value.(int64)
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDurationMs(v)
+		m.SetAttempts(v)
 		return nil
-	case sourceevent.FieldErrorMessage:
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldResponseBody:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetResponseBody(v)
 		return nil
-	case sourceevent.FieldErrorCategory:
+	case webhookdelivery.FieldError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorCategory(v)
+		m.SetError(v)
 		return nil
-	case sourceevent.FieldItemsCount:
-		v, ok := value.(int)
+	case webhookdelivery.FieldDurationMs:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetItemsCount(v)
+		m.SetDurationMs(v)
 		return nil
-	case sourceevent.FieldMetadata:
-		v, ok := value.(map[string]string)
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetNextAttemptAt(v)
 		return nil
-	case sourceevent.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case webhookdelivery.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown SourceEvent field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SourceEventMutation) AddedFields() []string {
+func (m *WebhookDeliveryMutation) AddedFields() []string {
 	var fields []string
-	if m.addduration_ms != nil {
-		fields = append(fields, sourceevent.FieldDurationMs)
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
 	}
-	if m.additems_count != nil {
-		fields = append(fields, sourceevent.FieldItemsCount)
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
 	}
 	return fields
 }
@@ -8791,12 +18628,14 @@ func (m *SourceEventMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SourceEventMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case sourceevent.FieldDurationMs:
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	case webhookdelivery.FieldDurationMs:
 		return m.AddedDurationMs()
-	case sourceevent.FieldItemsCount:
-		return m.AddedItemsCount()
 	}
 	return nil, false
 }
@@ -8804,157 +18643,170 @@ func (m *SourceEventMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SourceEventMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case sourceevent.FieldDurationMs:
-		v, ok := value.(int64)
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDurationMs(v)
+		m.AddAttempts(v)
 		return nil
-	case sourceevent.FieldItemsCount:
+	case webhookdelivery.FieldResponseStatus:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddItemsCount(v)
+		m.AddResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SourceEvent numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SourceEventMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(sourceevent.FieldErrorMessage) {
-		fields = append(fields, sourceevent.FieldErrorMessage)
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
 	}
-	if m.FieldCleared(sourceevent.FieldErrorCategory) {
-		fields = append(fields, sourceevent.FieldErrorCategory)
+	if m.FieldCleared(webhookdelivery.FieldResponseBody) {
+		fields = append(fields, webhookdelivery.FieldResponseBody)
 	}
-	if m.FieldCleared(sourceevent.FieldItemsCount) {
-		fields = append(fields, sourceevent.FieldItemsCount)
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
 	}
-	if m.FieldCleared(sourceevent.FieldMetadata) {
-		fields = append(fields, sourceevent.FieldMetadata)
+	if m.FieldCleared(webhookdelivery.FieldNextAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SourceEventMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SourceEventMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case sourceevent.FieldErrorMessage:
-		m.ClearErrorMessage()
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
 		return nil
-	case sourceevent.FieldErrorCategory:
-		m.ClearErrorCategory()
+	case webhookdelivery.FieldResponseBody:
+		m.ClearResponseBody()
 		return nil
-	case sourceevent.FieldItemsCount:
-		m.ClearItemsCount()
+	case webhookdelivery.FieldError:
+		m.ClearError()
 		return nil
-	case sourceevent.FieldMetadata:
-		m.ClearMetadata()
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SourceEvent nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SourceEventMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case sourceevent.FieldSourceID:
-		m.ResetSourceID()
-		return nil
-	case sourceevent.FieldSourceName:
-		m.ResetSourceName()
+	case webhookdelivery.FieldWebhookID:
+		m.ResetWebhookID()
 		return nil
-	case sourceevent.FieldLanguage:
-		m.ResetLanguage()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
 		return nil
-	case sourceevent.FieldEventType:
+	case webhookdelivery.FieldEventType:
 		m.ResetEventType()
 		return nil
-	case sourceevent.FieldStatus:
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdelivery.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case sourceevent.FieldDurationMs:
-		m.ResetDurationMs()
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case sourceevent.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
 		return nil
-	case sourceevent.FieldErrorCategory:
-		m.ResetErrorCategory()
+	case webhookdelivery.FieldResponseBody:
+		m.ResetResponseBody()
 		return nil
-	case sourceevent.FieldItemsCount:
-		m.ResetItemsCount()
+	case webhookdelivery.FieldError:
+		m.ResetError()
 		return nil
-	case sourceevent.FieldMetadata:
-		m.ResetMetadata()
+	case webhookdelivery.FieldDurationMs:
+		m.ResetDurationMs()
 		return nil
-	case sourceevent.FieldCreatedAt:
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case webhookdelivery.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown SourceEvent field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SourceEventMutation) AddedEdges() []string {
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SourceEventMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SourceEventMutation) RemovedEdges() []string {
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SourceEventMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SourceEventMutation) ClearedEdges() []string {
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SourceEventMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SourceEventMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown SourceEvent unique edge %s", name)
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SourceEventMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown SourceEvent edge %s", name)
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
@@ -0,0 +1,245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/notifier"
+)
+
+// Notifier is the model entity for the Notifier schema.
+type Notifier struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// ntfy, gotify or apprise
+	Kind string `json:"kind,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// ntfy topic or Apprise tag
+	Topic string `json:"topic,omitempty"`
+	// ntfy access token, Gotify application token or Apprise bearer token
+	Token string `json:"-"`
+	// Event types to notify about; empty notifies about every event
+	Events []string `json:"events,omitempty"`
+	// Go text/template for the title; empty uses the built-in title
+	TitleTemplate string `json:"title_template,omitempty"`
+	// Go text/template for the message; empty uses the built-in message
+	MessageTemplate string `json:"message_template,omitempty"`
+	// Start of quiet hours (HH:MM, server time); empty disables quiet hours
+	QuietStart string `json:"quiet_start,omitempty"`
+	// End of quiet hours (HH:MM, server time)
+	QuietEnd string `json:"quiet_end,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Notifier) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notifier.FieldEvents:
+			values[i] = new([]byte)
+		case notifier.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case notifier.FieldName, notifier.FieldKind, notifier.FieldURL, notifier.FieldTopic, notifier.FieldToken, notifier.FieldTitleTemplate, notifier.FieldMessageTemplate, notifier.FieldQuietStart, notifier.FieldQuietEnd:
+			values[i] = new(sql.NullString)
+		case notifier.FieldCreatedAt, notifier.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case notifier.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Notifier fields.
+func (_m *Notifier) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notifier.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case notifier.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case notifier.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case notifier.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case notifier.FieldTopic:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field topic", values[i])
+			} else if value.Valid {
+				_m.Topic = value.String
+			}
+		case notifier.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case notifier.FieldEvents:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field events", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Events); err != nil {
+					return fmt.Errorf("unmarshal field events: %w", err)
+				}
+			}
+		case notifier.FieldTitleTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title_template", values[i])
+			} else if value.Valid {
+				_m.TitleTemplate = value.String
+			}
+		case notifier.FieldMessageTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_template", values[i])
+			} else if value.Valid {
+				_m.MessageTemplate = value.String
+			}
+		case notifier.FieldQuietStart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quiet_start", values[i])
+			} else if value.Valid {
+				_m.QuietStart = value.String
+			}
+		case notifier.FieldQuietEnd:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quiet_end", values[i])
+			} else if value.Valid {
+				_m.QuietEnd = value.String
+			}
+		case notifier.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case notifier.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case notifier.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Notifier.
+// This includes values selected through modifiers, order, etc.
+func (_m *Notifier) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Notifier.
+// Note that you need to call Notifier.Unwrap() before calling this method if this Notifier
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Notifier) Update() *NotifierUpdateOne {
+	return NewNotifierClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Notifier entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Notifier) Unwrap() *Notifier {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Notifier is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Notifier) String() string {
+	var builder strings.Builder
+	builder.WriteString("Notifier(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("topic=")
+	builder.WriteString(_m.Topic)
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("events=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Events))
+	builder.WriteString(", ")
+	builder.WriteString("title_template=")
+	builder.WriteString(_m.TitleTemplate)
+	builder.WriteString(", ")
+	builder.WriteString("message_template=")
+	builder.WriteString(_m.MessageTemplate)
+	builder.WriteString(", ")
+	builder.WriteString("quiet_start=")
+	builder.WriteString(_m.QuietStart)
+	builder.WriteString(", ")
+	builder.WriteString("quiet_end=")
+	builder.WriteString(_m.QuietEnd)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Notifiers is a parsable slice of Notifier.
+type Notifiers []*Notifier
@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notifier
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the notifier type in the database.
+	Label = "notifier"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldTopic holds the string denoting the topic field in the database.
+	FieldTopic = "topic"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldEvents holds the string denoting the events field in the database.
+	FieldEvents = "events"
+	// FieldTitleTemplate holds the string denoting the title_template field in the database.
+	FieldTitleTemplate = "title_template"
+	// FieldMessageTemplate holds the string denoting the message_template field in the database.
+	FieldMessageTemplate = "message_template"
+	// FieldQuietStart holds the string denoting the quiet_start field in the database.
+	FieldQuietStart = "quiet_start"
+	// FieldQuietEnd holds the string denoting the quiet_end field in the database.
+	FieldQuietEnd = "quiet_end"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the notifier in the database.
+	Table = "notifiers"
+)
+
+// Columns holds all SQL columns for notifier fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldKind,
+	FieldURL,
+	FieldTopic,
+	FieldToken,
+	FieldEvents,
+	FieldTitleTemplate,
+	FieldMessageTemplate,
+	FieldQuietStart,
+	FieldQuietEnd,
+	FieldEnabled,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Notifier queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByTopic orders the results by the topic field.
+func ByTopic(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTopic, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// ByTitleTemplate orders the results by the title_template field.
+func ByTitleTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitleTemplate, opts...).ToFunc()
+}
+
+// ByMessageTemplate orders the results by the message_template field.
+func ByMessageTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageTemplate, opts...).ToFunc()
+}
+
+// ByQuietStart orders the results by the quiet_start field.
+func ByQuietStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietStart, opts...).ToFunc()
+}
+
+// ByQuietEnd orders the results by the quiet_end field.
+func ByQuietEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuietEnd, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,876 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notifier
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldName, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldKind, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldURL, v))
+}
+
+// Topic applies equality check predicate on the "topic" field. It's identical to TopicEQ.
+func Topic(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldTopic, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldToken, v))
+}
+
+// TitleTemplate applies equality check predicate on the "title_template" field. It's identical to TitleTemplateEQ.
+func TitleTemplate(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldTitleTemplate, v))
+}
+
+// MessageTemplate applies equality check predicate on the "message_template" field. It's identical to MessageTemplateEQ.
+func MessageTemplate(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldMessageTemplate, v))
+}
+
+// QuietStart applies equality check predicate on the "quiet_start" field. It's identical to QuietStartEQ.
+func QuietStart(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldQuietStart, v))
+}
+
+// QuietEnd applies equality check predicate on the "quiet_end" field. It's identical to QuietEndEQ.
+func QuietEnd(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldQuietEnd, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContainsFold(FieldName, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContainsFold(FieldKind, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContainsFold(FieldURL, v))
+}
+
+// TopicEQ applies the EQ predicate on the "topic" field.
+func TopicEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldTopic, v))
+}
+
+// TopicNEQ applies the NEQ predicate on the "topic" field.
+func TopicNEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldTopic, v))
+}
+
+// TopicIn applies the In predicate on the "topic" field.
+func TopicIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldTopic, vs...))
+}
+
+// TopicNotIn applies the NotIn predicate on the "topic" field.
+func TopicNotIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldTopic, vs...))
+}
+
+// TopicGT applies the GT predicate on the "topic" field.
+func TopicGT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldTopic, v))
+}
+
+// TopicGTE applies the GTE predicate on the "topic" field.
+func TopicGTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldTopic, v))
+}
+
+// TopicLT applies the LT predicate on the "topic" field.
+func TopicLT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldTopic, v))
+}
+
+// TopicLTE applies the LTE predicate on the "topic" field.
+func TopicLTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldTopic, v))
+}
+
+// TopicContains applies the Contains predicate on the "topic" field.
+func TopicContains(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContains(FieldTopic, v))
+}
+
+// TopicHasPrefix applies the HasPrefix predicate on the "topic" field.
+func TopicHasPrefix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasPrefix(FieldTopic, v))
+}
+
+// TopicHasSuffix applies the HasSuffix predicate on the "topic" field.
+func TopicHasSuffix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasSuffix(FieldTopic, v))
+}
+
+// TopicIsNil applies the IsNil predicate on the "topic" field.
+func TopicIsNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldIsNull(FieldTopic))
+}
+
+// TopicNotNil applies the NotNil predicate on the "topic" field.
+func TopicNotNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotNull(FieldTopic))
+}
+
+// TopicEqualFold applies the EqualFold predicate on the "topic" field.
+func TopicEqualFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEqualFold(FieldTopic, v))
+}
+
+// TopicContainsFold applies the ContainsFold predicate on the "topic" field.
+func TopicContainsFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContainsFold(FieldTopic, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenIsNil applies the IsNil predicate on the "token" field.
+func TokenIsNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldIsNull(FieldToken))
+}
+
+// TokenNotNil applies the NotNil predicate on the "token" field.
+func TokenNotNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotNull(FieldToken))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContainsFold(FieldToken, v))
+}
+
+// EventsIsNil applies the IsNil predicate on the "events" field.
+func EventsIsNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldIsNull(FieldEvents))
+}
+
+// EventsNotNil applies the NotNil predicate on the "events" field.
+func EventsNotNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotNull(FieldEvents))
+}
+
+// TitleTemplateEQ applies the EQ predicate on the "title_template" field.
+func TitleTemplateEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldTitleTemplate, v))
+}
+
+// TitleTemplateNEQ applies the NEQ predicate on the "title_template" field.
+func TitleTemplateNEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldTitleTemplate, v))
+}
+
+// TitleTemplateIn applies the In predicate on the "title_template" field.
+func TitleTemplateIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldTitleTemplate, vs...))
+}
+
+// TitleTemplateNotIn applies the NotIn predicate on the "title_template" field.
+func TitleTemplateNotIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldTitleTemplate, vs...))
+}
+
+// TitleTemplateGT applies the GT predicate on the "title_template" field.
+func TitleTemplateGT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldTitleTemplate, v))
+}
+
+// TitleTemplateGTE applies the GTE predicate on the "title_template" field.
+func TitleTemplateGTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldTitleTemplate, v))
+}
+
+// TitleTemplateLT applies the LT predicate on the "title_template" field.
+func TitleTemplateLT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldTitleTemplate, v))
+}
+
+// TitleTemplateLTE applies the LTE predicate on the "title_template" field.
+func TitleTemplateLTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldTitleTemplate, v))
+}
+
+// TitleTemplateContains applies the Contains predicate on the "title_template" field.
+func TitleTemplateContains(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContains(FieldTitleTemplate, v))
+}
+
+// TitleTemplateHasPrefix applies the HasPrefix predicate on the "title_template" field.
+func TitleTemplateHasPrefix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasPrefix(FieldTitleTemplate, v))
+}
+
+// TitleTemplateHasSuffix applies the HasSuffix predicate on the "title_template" field.
+func TitleTemplateHasSuffix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasSuffix(FieldTitleTemplate, v))
+}
+
+// TitleTemplateIsNil applies the IsNil predicate on the "title_template" field.
+func TitleTemplateIsNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldIsNull(FieldTitleTemplate))
+}
+
+// TitleTemplateNotNil applies the NotNil predicate on the "title_template" field.
+func TitleTemplateNotNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotNull(FieldTitleTemplate))
+}
+
+// TitleTemplateEqualFold applies the EqualFold predicate on the "title_template" field.
+func TitleTemplateEqualFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEqualFold(FieldTitleTemplate, v))
+}
+
+// TitleTemplateContainsFold applies the ContainsFold predicate on the "title_template" field.
+func TitleTemplateContainsFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContainsFold(FieldTitleTemplate, v))
+}
+
+// MessageTemplateEQ applies the EQ predicate on the "message_template" field.
+func MessageTemplateEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldMessageTemplate, v))
+}
+
+// MessageTemplateNEQ applies the NEQ predicate on the "message_template" field.
+func MessageTemplateNEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldMessageTemplate, v))
+}
+
+// MessageTemplateIn applies the In predicate on the "message_template" field.
+func MessageTemplateIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldMessageTemplate, vs...))
+}
+
+// MessageTemplateNotIn applies the NotIn predicate on the "message_template" field.
+func MessageTemplateNotIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldMessageTemplate, vs...))
+}
+
+// MessageTemplateGT applies the GT predicate on the "message_template" field.
+func MessageTemplateGT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldMessageTemplate, v))
+}
+
+// MessageTemplateGTE applies the GTE predicate on the "message_template" field.
+func MessageTemplateGTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldMessageTemplate, v))
+}
+
+// MessageTemplateLT applies the LT predicate on the "message_template" field.
+func MessageTemplateLT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldMessageTemplate, v))
+}
+
+// MessageTemplateLTE applies the LTE predicate on the "message_template" field.
+func MessageTemplateLTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldMessageTemplate, v))
+}
+
+// MessageTemplateContains applies the Contains predicate on the "message_template" field.
+func MessageTemplateContains(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContains(FieldMessageTemplate, v))
+}
+
+// MessageTemplateHasPrefix applies the HasPrefix predicate on the "message_template" field.
+func MessageTemplateHasPrefix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasPrefix(FieldMessageTemplate, v))
+}
+
+// MessageTemplateHasSuffix applies the HasSuffix predicate on the "message_template" field.
+func MessageTemplateHasSuffix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasSuffix(FieldMessageTemplate, v))
+}
+
+// MessageTemplateIsNil applies the IsNil predicate on the "message_template" field.
+func MessageTemplateIsNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldIsNull(FieldMessageTemplate))
+}
+
+// MessageTemplateNotNil applies the NotNil predicate on the "message_template" field.
+func MessageTemplateNotNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotNull(FieldMessageTemplate))
+}
+
+// MessageTemplateEqualFold applies the EqualFold predicate on the "message_template" field.
+func MessageTemplateEqualFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEqualFold(FieldMessageTemplate, v))
+}
+
+// MessageTemplateContainsFold applies the ContainsFold predicate on the "message_template" field.
+func MessageTemplateContainsFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContainsFold(FieldMessageTemplate, v))
+}
+
+// QuietStartEQ applies the EQ predicate on the "quiet_start" field.
+func QuietStartEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldQuietStart, v))
+}
+
+// QuietStartNEQ applies the NEQ predicate on the "quiet_start" field.
+func QuietStartNEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldQuietStart, v))
+}
+
+// QuietStartIn applies the In predicate on the "quiet_start" field.
+func QuietStartIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldQuietStart, vs...))
+}
+
+// QuietStartNotIn applies the NotIn predicate on the "quiet_start" field.
+func QuietStartNotIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldQuietStart, vs...))
+}
+
+// QuietStartGT applies the GT predicate on the "quiet_start" field.
+func QuietStartGT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldQuietStart, v))
+}
+
+// QuietStartGTE applies the GTE predicate on the "quiet_start" field.
+func QuietStartGTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldQuietStart, v))
+}
+
+// QuietStartLT applies the LT predicate on the "quiet_start" field.
+func QuietStartLT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldQuietStart, v))
+}
+
+// QuietStartLTE applies the LTE predicate on the "quiet_start" field.
+func QuietStartLTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldQuietStart, v))
+}
+
+// QuietStartContains applies the Contains predicate on the "quiet_start" field.
+func QuietStartContains(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContains(FieldQuietStart, v))
+}
+
+// QuietStartHasPrefix applies the HasPrefix predicate on the "quiet_start" field.
+func QuietStartHasPrefix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasPrefix(FieldQuietStart, v))
+}
+
+// QuietStartHasSuffix applies the HasSuffix predicate on the "quiet_start" field.
+func QuietStartHasSuffix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasSuffix(FieldQuietStart, v))
+}
+
+// QuietStartIsNil applies the IsNil predicate on the "quiet_start" field.
+func QuietStartIsNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldIsNull(FieldQuietStart))
+}
+
+// QuietStartNotNil applies the NotNil predicate on the "quiet_start" field.
+func QuietStartNotNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotNull(FieldQuietStart))
+}
+
+// QuietStartEqualFold applies the EqualFold predicate on the "quiet_start" field.
+func QuietStartEqualFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEqualFold(FieldQuietStart, v))
+}
+
+// QuietStartContainsFold applies the ContainsFold predicate on the "quiet_start" field.
+func QuietStartContainsFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContainsFold(FieldQuietStart, v))
+}
+
+// QuietEndEQ applies the EQ predicate on the "quiet_end" field.
+func QuietEndEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldQuietEnd, v))
+}
+
+// QuietEndNEQ applies the NEQ predicate on the "quiet_end" field.
+func QuietEndNEQ(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldQuietEnd, v))
+}
+
+// QuietEndIn applies the In predicate on the "quiet_end" field.
+func QuietEndIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldQuietEnd, vs...))
+}
+
+// QuietEndNotIn applies the NotIn predicate on the "quiet_end" field.
+func QuietEndNotIn(vs ...string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldQuietEnd, vs...))
+}
+
+// QuietEndGT applies the GT predicate on the "quiet_end" field.
+func QuietEndGT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldQuietEnd, v))
+}
+
+// QuietEndGTE applies the GTE predicate on the "quiet_end" field.
+func QuietEndGTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldQuietEnd, v))
+}
+
+// QuietEndLT applies the LT predicate on the "quiet_end" field.
+func QuietEndLT(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldQuietEnd, v))
+}
+
+// QuietEndLTE applies the LTE predicate on the "quiet_end" field.
+func QuietEndLTE(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldQuietEnd, v))
+}
+
+// QuietEndContains applies the Contains predicate on the "quiet_end" field.
+func QuietEndContains(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContains(FieldQuietEnd, v))
+}
+
+// QuietEndHasPrefix applies the HasPrefix predicate on the "quiet_end" field.
+func QuietEndHasPrefix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasPrefix(FieldQuietEnd, v))
+}
+
+// QuietEndHasSuffix applies the HasSuffix predicate on the "quiet_end" field.
+func QuietEndHasSuffix(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldHasSuffix(FieldQuietEnd, v))
+}
+
+// QuietEndIsNil applies the IsNil predicate on the "quiet_end" field.
+func QuietEndIsNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldIsNull(FieldQuietEnd))
+}
+
+// QuietEndNotNil applies the NotNil predicate on the "quiet_end" field.
+func QuietEndNotNil() predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotNull(FieldQuietEnd))
+}
+
+// QuietEndEqualFold applies the EqualFold predicate on the "quiet_end" field.
+func QuietEndEqualFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEqualFold(FieldQuietEnd, v))
+}
+
+// QuietEndContainsFold applies the ContainsFold predicate on the "quiet_end" field.
+func QuietEndContainsFold(v string) predicate.Notifier {
+	return predicate.Notifier(sql.FieldContainsFold(FieldQuietEnd, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Notifier {
+	return predicate.Notifier(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Notifier) predicate.Notifier {
+	return predicate.Notifier(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Notifier) predicate.Notifier {
+	return predicate.Notifier(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Notifier) predicate.Notifier {
+	return predicate.Notifier(sql.NotPredicates(p))
+}
@@ -0,0 +1,1314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/notifier"
+)
+
+// NotifierCreate is the builder for creating a Notifier entity.
+type NotifierCreate struct {
+	config
+	mutation *NotifierMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *NotifierCreate) SetName(v string) *NotifierCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *NotifierCreate) SetKind(v string) *NotifierCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *NotifierCreate) SetURL(v string) *NotifierCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetTopic sets the "topic" field.
+func (_c *NotifierCreate) SetTopic(v string) *NotifierCreate {
+	_c.mutation.SetTopic(v)
+	return _c
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (_c *NotifierCreate) SetNillableTopic(v *string) *NotifierCreate {
+	if v != nil {
+		_c.SetTopic(*v)
+	}
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *NotifierCreate) SetToken(v string) *NotifierCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_c *NotifierCreate) SetNillableToken(v *string) *NotifierCreate {
+	if v != nil {
+		_c.SetToken(*v)
+	}
+	return _c
+}
+
+// SetEvents sets the "events" field.
+func (_c *NotifierCreate) SetEvents(v []string) *NotifierCreate {
+	_c.mutation.SetEvents(v)
+	return _c
+}
+
+// SetTitleTemplate sets the "title_template" field.
+func (_c *NotifierCreate) SetTitleTemplate(v string) *NotifierCreate {
+	_c.mutation.SetTitleTemplate(v)
+	return _c
+}
+
+// SetNillableTitleTemplate sets the "title_template" field if the given value is not nil.
+func (_c *NotifierCreate) SetNillableTitleTemplate(v *string) *NotifierCreate {
+	if v != nil {
+		_c.SetTitleTemplate(*v)
+	}
+	return _c
+}
+
+// SetMessageTemplate sets the "message_template" field.
+func (_c *NotifierCreate) SetMessageTemplate(v string) *NotifierCreate {
+	_c.mutation.SetMessageTemplate(v)
+	return _c
+}
+
+// SetNillableMessageTemplate sets the "message_template" field if the given value is not nil.
+func (_c *NotifierCreate) SetNillableMessageTemplate(v *string) *NotifierCreate {
+	if v != nil {
+		_c.SetMessageTemplate(*v)
+	}
+	return _c
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (_c *NotifierCreate) SetQuietStart(v string) *NotifierCreate {
+	_c.mutation.SetQuietStart(v)
+	return _c
+}
+
+// SetNillableQuietStart sets the "quiet_start" field if the given value is not nil.
+func (_c *NotifierCreate) SetNillableQuietStart(v *string) *NotifierCreate {
+	if v != nil {
+		_c.SetQuietStart(*v)
+	}
+	return _c
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (_c *NotifierCreate) SetQuietEnd(v string) *NotifierCreate {
+	_c.mutation.SetQuietEnd(v)
+	return _c
+}
+
+// SetNillableQuietEnd sets the "quiet_end" field if the given value is not nil.
+func (_c *NotifierCreate) SetNillableQuietEnd(v *string) *NotifierCreate {
+	if v != nil {
+		_c.SetQuietEnd(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *NotifierCreate) SetEnabled(v bool) *NotifierCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *NotifierCreate) SetNillableEnabled(v *bool) *NotifierCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *NotifierCreate) SetCreatedAt(v time.Time) *NotifierCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *NotifierCreate) SetNillableCreatedAt(v *time.Time) *NotifierCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *NotifierCreate) SetUpdatedAt(v time.Time) *NotifierCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *NotifierCreate) SetNillableUpdatedAt(v *time.Time) *NotifierCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NotifierCreate) SetID(v uuid.UUID) *NotifierCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NotifierCreate) SetNillableID(v *uuid.UUID) *NotifierCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the NotifierMutation object of the builder.
+func (_c *NotifierCreate) Mutation() *NotifierMutation {
+	return _c.mutation
+}
+
+// Save creates the Notifier in the database.
+func (_c *NotifierCreate) Save(ctx context.Context) (*Notifier, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotifierCreate) SaveX(ctx context.Context) *Notifier {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotifierCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotifierCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotifierCreate) defaults() {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := notifier.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := notifier.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := notifier.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := notifier.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotifierCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Notifier.name"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Notifier.kind"`)}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "Notifier.url"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Notifier.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Notifier.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Notifier.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *NotifierCreate) sqlSave(ctx context.Context) (*Notifier, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotifierCreate) createSpec() (*Notifier, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Notifier{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notifier.Table, sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(notifier.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(notifier.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(notifier.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Topic(); ok {
+		_spec.SetField(notifier.FieldTopic, field.TypeString, value)
+		_node.Topic = value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(notifier.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.Events(); ok {
+		_spec.SetField(notifier.FieldEvents, field.TypeJSON, value)
+		_node.Events = value
+	}
+	if value, ok := _c.mutation.TitleTemplate(); ok {
+		_spec.SetField(notifier.FieldTitleTemplate, field.TypeString, value)
+		_node.TitleTemplate = value
+	}
+	if value, ok := _c.mutation.MessageTemplate(); ok {
+		_spec.SetField(notifier.FieldMessageTemplate, field.TypeString, value)
+		_node.MessageTemplate = value
+	}
+	if value, ok := _c.mutation.QuietStart(); ok {
+		_spec.SetField(notifier.FieldQuietStart, field.TypeString, value)
+		_node.QuietStart = value
+	}
+	if value, ok := _c.mutation.QuietEnd(); ok {
+		_spec.SetField(notifier.FieldQuietEnd, field.TypeString, value)
+		_node.QuietEnd = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(notifier.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(notifier.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(notifier.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Notifier.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotifierUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotifierCreate) OnConflict(opts ...sql.ConflictOption) *NotifierUpsertOne {
+	_c.conflict = opts
+	return &NotifierUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Notifier.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotifierCreate) OnConflictColumns(columns ...string) *NotifierUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotifierUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NotifierUpsertOne is the builder for "upsert"-ing
+	//  one Notifier node.
+	NotifierUpsertOne struct {
+		create *NotifierCreate
+	}
+
+	// NotifierUpsert is the "OnConflict" setter.
+	NotifierUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *NotifierUpsert) SetName(v string) *NotifierUpsert {
+	u.Set(notifier.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateName() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldName)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *NotifierUpsert) SetKind(v string) *NotifierUpsert {
+	u.Set(notifier.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateKind() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldKind)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *NotifierUpsert) SetURL(v string) *NotifierUpsert {
+	u.Set(notifier.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateURL() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldURL)
+	return u
+}
+
+// SetTopic sets the "topic" field.
+func (u *NotifierUpsert) SetTopic(v string) *NotifierUpsert {
+	u.Set(notifier.FieldTopic, v)
+	return u
+}
+
+// UpdateTopic sets the "topic" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateTopic() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldTopic)
+	return u
+}
+
+// ClearTopic clears the value of the "topic" field.
+func (u *NotifierUpsert) ClearTopic() *NotifierUpsert {
+	u.SetNull(notifier.FieldTopic)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *NotifierUpsert) SetToken(v string) *NotifierUpsert {
+	u.Set(notifier.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateToken() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldToken)
+	return u
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *NotifierUpsert) ClearToken() *NotifierUpsert {
+	u.SetNull(notifier.FieldToken)
+	return u
+}
+
+// SetEvents sets the "events" field.
+func (u *NotifierUpsert) SetEvents(v []string) *NotifierUpsert {
+	u.Set(notifier.FieldEvents, v)
+	return u
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateEvents() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldEvents)
+	return u
+}
+
+// ClearEvents clears the value of the "events" field.
+func (u *NotifierUpsert) ClearEvents() *NotifierUpsert {
+	u.SetNull(notifier.FieldEvents)
+	return u
+}
+
+// SetTitleTemplate sets the "title_template" field.
+func (u *NotifierUpsert) SetTitleTemplate(v string) *NotifierUpsert {
+	u.Set(notifier.FieldTitleTemplate, v)
+	return u
+}
+
+// UpdateTitleTemplate sets the "title_template" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateTitleTemplate() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldTitleTemplate)
+	return u
+}
+
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (u *NotifierUpsert) ClearTitleTemplate() *NotifierUpsert {
+	u.SetNull(notifier.FieldTitleTemplate)
+	return u
+}
+
+// SetMessageTemplate sets the "message_template" field.
+func (u *NotifierUpsert) SetMessageTemplate(v string) *NotifierUpsert {
+	u.Set(notifier.FieldMessageTemplate, v)
+	return u
+}
+
+// UpdateMessageTemplate sets the "message_template" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateMessageTemplate() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldMessageTemplate)
+	return u
+}
+
+// ClearMessageTemplate clears the value of the "message_template" field.
+func (u *NotifierUpsert) ClearMessageTemplate() *NotifierUpsert {
+	u.SetNull(notifier.FieldMessageTemplate)
+	return u
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (u *NotifierUpsert) SetQuietStart(v string) *NotifierUpsert {
+	u.Set(notifier.FieldQuietStart, v)
+	return u
+}
+
+// UpdateQuietStart sets the "quiet_start" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateQuietStart() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldQuietStart)
+	return u
+}
+
+// ClearQuietStart clears the value of the "quiet_start" field.
+func (u *NotifierUpsert) ClearQuietStart() *NotifierUpsert {
+	u.SetNull(notifier.FieldQuietStart)
+	return u
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (u *NotifierUpsert) SetQuietEnd(v string) *NotifierUpsert {
+	u.Set(notifier.FieldQuietEnd, v)
+	return u
+}
+
+// UpdateQuietEnd sets the "quiet_end" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateQuietEnd() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldQuietEnd)
+	return u
+}
+
+// ClearQuietEnd clears the value of the "quiet_end" field.
+func (u *NotifierUpsert) ClearQuietEnd() *NotifierUpsert {
+	u.SetNull(notifier.FieldQuietEnd)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *NotifierUpsert) SetEnabled(v bool) *NotifierUpsert {
+	u.Set(notifier.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateEnabled() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldEnabled)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotifierUpsert) SetUpdatedAt(v time.Time) *NotifierUpsert {
+	u.Set(notifier.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotifierUpsert) UpdateUpdatedAt() *NotifierUpsert {
+	u.SetExcluded(notifier.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Notifier.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notifier.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotifierUpsertOne) UpdateNewValues() *NotifierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notifier.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(notifier.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Notifier.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotifierUpsertOne) Ignore() *NotifierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotifierUpsertOne) DoNothing() *NotifierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotifierCreate.OnConflict
+// documentation for more info.
+func (u *NotifierUpsertOne) Update(set func(*NotifierUpsert)) *NotifierUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotifierUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NotifierUpsertOne) SetName(v string) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateName() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *NotifierUpsertOne) SetKind(v string) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateKind() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *NotifierUpsertOne) SetURL(v string) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateURL() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetTopic sets the "topic" field.
+func (u *NotifierUpsertOne) SetTopic(v string) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetTopic(v)
+	})
+}
+
+// UpdateTopic sets the "topic" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateTopic() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateTopic()
+	})
+}
+
+// ClearTopic clears the value of the "topic" field.
+func (u *NotifierUpsertOne) ClearTopic() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearTopic()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *NotifierUpsertOne) SetToken(v string) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateToken() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *NotifierUpsertOne) ClearToken() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearToken()
+	})
+}
+
+// SetEvents sets the "events" field.
+func (u *NotifierUpsertOne) SetEvents(v []string) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateEvents() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// ClearEvents clears the value of the "events" field.
+func (u *NotifierUpsertOne) ClearEvents() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearEvents()
+	})
+}
+
+// SetTitleTemplate sets the "title_template" field.
+func (u *NotifierUpsertOne) SetTitleTemplate(v string) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetTitleTemplate(v)
+	})
+}
+
+// UpdateTitleTemplate sets the "title_template" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateTitleTemplate() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateTitleTemplate()
+	})
+}
+
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (u *NotifierUpsertOne) ClearTitleTemplate() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearTitleTemplate()
+	})
+}
+
+// SetMessageTemplate sets the "message_template" field.
+func (u *NotifierUpsertOne) SetMessageTemplate(v string) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetMessageTemplate(v)
+	})
+}
+
+// UpdateMessageTemplate sets the "message_template" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateMessageTemplate() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateMessageTemplate()
+	})
+}
+
+// ClearMessageTemplate clears the value of the "message_template" field.
+func (u *NotifierUpsertOne) ClearMessageTemplate() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearMessageTemplate()
+	})
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (u *NotifierUpsertOne) SetQuietStart(v string) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetQuietStart(v)
+	})
+}
+
+// UpdateQuietStart sets the "quiet_start" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateQuietStart() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateQuietStart()
+	})
+}
+
+// ClearQuietStart clears the value of the "quiet_start" field.
+func (u *NotifierUpsertOne) ClearQuietStart() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearQuietStart()
+	})
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (u *NotifierUpsertOne) SetQuietEnd(v string) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetQuietEnd(v)
+	})
+}
+
+// UpdateQuietEnd sets the "quiet_end" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateQuietEnd() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateQuietEnd()
+	})
+}
+
+// ClearQuietEnd clears the value of the "quiet_end" field.
+func (u *NotifierUpsertOne) ClearQuietEnd() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearQuietEnd()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *NotifierUpsertOne) SetEnabled(v bool) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateEnabled() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotifierUpsertOne) SetUpdatedAt(v time.Time) *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotifierUpsertOne) UpdateUpdatedAt() *NotifierUpsertOne {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotifierUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotifierCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotifierUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotifierUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NotifierUpsertOne.ID is not supported by MySQL driver. Use NotifierUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotifierUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotifierCreateBulk is the builder for creating many Notifier entities in bulk.
+type NotifierCreateBulk struct {
+	config
+	err      error
+	builders []*NotifierCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Notifier entities in the database.
+func (_c *NotifierCreateBulk) Save(ctx context.Context) ([]*Notifier, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Notifier, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotifierMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotifierCreateBulk) SaveX(ctx context.Context) []*Notifier {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotifierCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotifierCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Notifier.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotifierUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotifierCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotifierUpsertBulk {
+	_c.conflict = opts
+	return &NotifierUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Notifier.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotifierCreateBulk) OnConflictColumns(columns ...string) *NotifierUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotifierUpsertBulk{
+		create: _c,
+	}
+}
+
+// NotifierUpsertBulk is the builder for "upsert"-ing
+// a bulk of Notifier nodes.
+type NotifierUpsertBulk struct {
+	create *NotifierCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Notifier.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notifier.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotifierUpsertBulk) UpdateNewValues() *NotifierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notifier.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(notifier.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Notifier.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotifierUpsertBulk) Ignore() *NotifierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotifierUpsertBulk) DoNothing() *NotifierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotifierCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotifierUpsertBulk) Update(set func(*NotifierUpsert)) *NotifierUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotifierUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NotifierUpsertBulk) SetName(v string) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateName() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *NotifierUpsertBulk) SetKind(v string) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateKind() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *NotifierUpsertBulk) SetURL(v string) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateURL() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetTopic sets the "topic" field.
+func (u *NotifierUpsertBulk) SetTopic(v string) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetTopic(v)
+	})
+}
+
+// UpdateTopic sets the "topic" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateTopic() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateTopic()
+	})
+}
+
+// ClearTopic clears the value of the "topic" field.
+func (u *NotifierUpsertBulk) ClearTopic() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearTopic()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *NotifierUpsertBulk) SetToken(v string) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateToken() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *NotifierUpsertBulk) ClearToken() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearToken()
+	})
+}
+
+// SetEvents sets the "events" field.
+func (u *NotifierUpsertBulk) SetEvents(v []string) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateEvents() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// ClearEvents clears the value of the "events" field.
+func (u *NotifierUpsertBulk) ClearEvents() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearEvents()
+	})
+}
+
+// SetTitleTemplate sets the "title_template" field.
+func (u *NotifierUpsertBulk) SetTitleTemplate(v string) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetTitleTemplate(v)
+	})
+}
+
+// UpdateTitleTemplate sets the "title_template" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateTitleTemplate() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateTitleTemplate()
+	})
+}
+
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (u *NotifierUpsertBulk) ClearTitleTemplate() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearTitleTemplate()
+	})
+}
+
+// SetMessageTemplate sets the "message_template" field.
+func (u *NotifierUpsertBulk) SetMessageTemplate(v string) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetMessageTemplate(v)
+	})
+}
+
+// UpdateMessageTemplate sets the "message_template" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateMessageTemplate() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateMessageTemplate()
+	})
+}
+
+// ClearMessageTemplate clears the value of the "message_template" field.
+func (u *NotifierUpsertBulk) ClearMessageTemplate() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearMessageTemplate()
+	})
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (u *NotifierUpsertBulk) SetQuietStart(v string) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetQuietStart(v)
+	})
+}
+
+// UpdateQuietStart sets the "quiet_start" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateQuietStart() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateQuietStart()
+	})
+}
+
+// ClearQuietStart clears the value of the "quiet_start" field.
+func (u *NotifierUpsertBulk) ClearQuietStart() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearQuietStart()
+	})
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (u *NotifierUpsertBulk) SetQuietEnd(v string) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetQuietEnd(v)
+	})
+}
+
+// UpdateQuietEnd sets the "quiet_end" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateQuietEnd() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateQuietEnd()
+	})
+}
+
+// ClearQuietEnd clears the value of the "quiet_end" field.
+func (u *NotifierUpsertBulk) ClearQuietEnd() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.ClearQuietEnd()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *NotifierUpsertBulk) SetEnabled(v bool) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateEnabled() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotifierUpsertBulk) SetUpdatedAt(v time.Time) *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotifierUpsertBulk) UpdateUpdatedAt() *NotifierUpsertBulk {
+	return u.Update(func(s *NotifierUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotifierUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotifierCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotifierCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotifierUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,719 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/technobecet/kaizoku-go/internal/ent/notifier"
+	"github.com/technobecet/kaizoku-go/internal/ent/predicate"
+)
+
+// NotifierUpdate is the builder for updating Notifier entities.
+type NotifierUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotifierMutation
+}
+
+// Where appends a list predicates to the NotifierUpdate builder.
+func (_u *NotifierUpdate) Where(ps ...predicate.Notifier) *NotifierUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *NotifierUpdate) SetName(v string) *NotifierUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *NotifierUpdate) SetNillableName(v *string) *NotifierUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *NotifierUpdate) SetKind(v string) *NotifierUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *NotifierUpdate) SetNillableKind(v *string) *NotifierUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *NotifierUpdate) SetURL(v string) *NotifierUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *NotifierUpdate) SetNillableURL(v *string) *NotifierUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetTopic sets the "topic" field.
+func (_u *NotifierUpdate) SetTopic(v string) *NotifierUpdate {
+	_u.mutation.SetTopic(v)
+	return _u
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (_u *NotifierUpdate) SetNillableTopic(v *string) *NotifierUpdate {
+	if v != nil {
+		_u.SetTopic(*v)
+	}
+	return _u
+}
+
+// ClearTopic clears the value of the "topic" field.
+func (_u *NotifierUpdate) ClearTopic() *NotifierUpdate {
+	_u.mutation.ClearTopic()
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *NotifierUpdate) SetToken(v string) *NotifierUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *NotifierUpdate) SetNillableToken(v *string) *NotifierUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// ClearToken clears the value of the "token" field.
+func (_u *NotifierUpdate) ClearToken() *NotifierUpdate {
+	_u.mutation.ClearToken()
+	return _u
+}
+
+// SetEvents sets the "events" field.
+func (_u *NotifierUpdate) SetEvents(v []string) *NotifierUpdate {
+	_u.mutation.SetEvents(v)
+	return _u
+}
+
+// AppendEvents appends value to the "events" field.
+func (_u *NotifierUpdate) AppendEvents(v []string) *NotifierUpdate {
+	_u.mutation.AppendEvents(v)
+	return _u
+}
+
+// ClearEvents clears the value of the "events" field.
+func (_u *NotifierUpdate) ClearEvents() *NotifierUpdate {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// SetTitleTemplate sets the "title_template" field.
+func (_u *NotifierUpdate) SetTitleTemplate(v string) *NotifierUpdate {
+	_u.mutation.SetTitleTemplate(v)
+	return _u
+}
+
+// SetNillableTitleTemplate sets the "title_template" field if the given value is not nil.
+func (_u *NotifierUpdate) SetNillableTitleTemplate(v *string) *NotifierUpdate {
+	if v != nil {
+		_u.SetTitleTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (_u *NotifierUpdate) ClearTitleTemplate() *NotifierUpdate {
+	_u.mutation.ClearTitleTemplate()
+	return _u
+}
+
+// SetMessageTemplate sets the "message_template" field.
+func (_u *NotifierUpdate) SetMessageTemplate(v string) *NotifierUpdate {
+	_u.mutation.SetMessageTemplate(v)
+	return _u
+}
+
+// SetNillableMessageTemplate sets the "message_template" field if the given value is not nil.
+func (_u *NotifierUpdate) SetNillableMessageTemplate(v *string) *NotifierUpdate {
+	if v != nil {
+		_u.SetMessageTemplate(*v)
+	}
+	return _u
+}
+
+// ClearMessageTemplate clears the value of the "message_template" field.
+func (_u *NotifierUpdate) ClearMessageTemplate() *NotifierUpdate {
+	_u.mutation.ClearMessageTemplate()
+	return _u
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (_u *NotifierUpdate) SetQuietStart(v string) *NotifierUpdate {
+	_u.mutation.SetQuietStart(v)
+	return _u
+}
+
+// SetNillableQuietStart sets the "quiet_start" field if the given value is not nil.
+func (_u *NotifierUpdate) SetNillableQuietStart(v *string) *NotifierUpdate {
+	if v != nil {
+		_u.SetQuietStart(*v)
+	}
+	return _u
+}
+
+// ClearQuietStart clears the value of the "quiet_start" field.
+func (_u *NotifierUpdate) ClearQuietStart() *NotifierUpdate {
+	_u.mutation.ClearQuietStart()
+	return _u
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (_u *NotifierUpdate) SetQuietEnd(v string) *NotifierUpdate {
+	_u.mutation.SetQuietEnd(v)
+	return _u
+}
+
+// SetNillableQuietEnd sets the "quiet_end" field if the given value is not nil.
+func (_u *NotifierUpdate) SetNillableQuietEnd(v *string) *NotifierUpdate {
+	if v != nil {
+		_u.SetQuietEnd(*v)
+	}
+	return _u
+}
+
+// ClearQuietEnd clears the value of the "quiet_end" field.
+func (_u *NotifierUpdate) ClearQuietEnd() *NotifierUpdate {
+	_u.mutation.ClearQuietEnd()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *NotifierUpdate) SetEnabled(v bool) *NotifierUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *NotifierUpdate) SetNillableEnabled(v *bool) *NotifierUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotifierUpdate) SetUpdatedAt(v time.Time) *NotifierUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the NotifierMutation object of the builder.
+func (_u *NotifierUpdate) Mutation() *NotifierMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotifierUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotifierUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotifierUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotifierUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotifierUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notifier.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *NotifierUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(notifier.Table, notifier.Columns, sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(notifier.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(notifier.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(notifier.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Topic(); ok {
+		_spec.SetField(notifier.FieldTopic, field.TypeString, value)
+	}
+	if _u.mutation.TopicCleared() {
+		_spec.ClearField(notifier.FieldTopic, field.TypeString)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(notifier.FieldToken, field.TypeString, value)
+	}
+	if _u.mutation.TokenCleared() {
+		_spec.ClearField(notifier.FieldToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Events(); ok {
+		_spec.SetField(notifier.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notifier.FieldEvents, value)
+		})
+	}
+	if _u.mutation.EventsCleared() {
+		_spec.ClearField(notifier.FieldEvents, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TitleTemplate(); ok {
+		_spec.SetField(notifier.FieldTitleTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TitleTemplateCleared() {
+		_spec.ClearField(notifier.FieldTitleTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.MessageTemplate(); ok {
+		_spec.SetField(notifier.FieldMessageTemplate, field.TypeString, value)
+	}
+	if _u.mutation.MessageTemplateCleared() {
+		_spec.ClearField(notifier.FieldMessageTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.QuietStart(); ok {
+		_spec.SetField(notifier.FieldQuietStart, field.TypeString, value)
+	}
+	if _u.mutation.QuietStartCleared() {
+		_spec.ClearField(notifier.FieldQuietStart, field.TypeString)
+	}
+	if value, ok := _u.mutation.QuietEnd(); ok {
+		_spec.SetField(notifier.FieldQuietEnd, field.TypeString, value)
+	}
+	if _u.mutation.QuietEndCleared() {
+		_spec.ClearField(notifier.FieldQuietEnd, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(notifier.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notifier.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notifier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotifierUpdateOne is the builder for updating a single Notifier entity.
+type NotifierUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotifierMutation
+}
+
+// SetName sets the "name" field.
+func (_u *NotifierUpdateOne) SetName(v string) *NotifierUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *NotifierUpdateOne) SetNillableName(v *string) *NotifierUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *NotifierUpdateOne) SetKind(v string) *NotifierUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *NotifierUpdateOne) SetNillableKind(v *string) *NotifierUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *NotifierUpdateOne) SetURL(v string) *NotifierUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *NotifierUpdateOne) SetNillableURL(v *string) *NotifierUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetTopic sets the "topic" field.
+func (_u *NotifierUpdateOne) SetTopic(v string) *NotifierUpdateOne {
+	_u.mutation.SetTopic(v)
+	return _u
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (_u *NotifierUpdateOne) SetNillableTopic(v *string) *NotifierUpdateOne {
+	if v != nil {
+		_u.SetTopic(*v)
+	}
+	return _u
+}
+
+// ClearTopic clears the value of the "topic" field.
+func (_u *NotifierUpdateOne) ClearTopic() *NotifierUpdateOne {
+	_u.mutation.ClearTopic()
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *NotifierUpdateOne) SetToken(v string) *NotifierUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *NotifierUpdateOne) SetNillableToken(v *string) *NotifierUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// ClearToken clears the value of the "token" field.
+func (_u *NotifierUpdateOne) ClearToken() *NotifierUpdateOne {
+	_u.mutation.ClearToken()
+	return _u
+}
+
+// SetEvents sets the "events" field.
+func (_u *NotifierUpdateOne) SetEvents(v []string) *NotifierUpdateOne {
+	_u.mutation.SetEvents(v)
+	return _u
+}
+
+// AppendEvents appends value to the "events" field.
+func (_u *NotifierUpdateOne) AppendEvents(v []string) *NotifierUpdateOne {
+	_u.mutation.AppendEvents(v)
+	return _u
+}
+
+// ClearEvents clears the value of the "events" field.
+func (_u *NotifierUpdateOne) ClearEvents() *NotifierUpdateOne {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// SetTitleTemplate sets the "title_template" field.
+func (_u *NotifierUpdateOne) SetTitleTemplate(v string) *NotifierUpdateOne {
+	_u.mutation.SetTitleTemplate(v)
+	return _u
+}
+
+// SetNillableTitleTemplate sets the "title_template" field if the given value is not nil.
+func (_u *NotifierUpdateOne) SetNillableTitleTemplate(v *string) *NotifierUpdateOne {
+	if v != nil {
+		_u.SetTitleTemplate(*v)
+	}
+	return _u
+}
+
+// ClearTitleTemplate clears the value of the "title_template" field.
+func (_u *NotifierUpdateOne) ClearTitleTemplate() *NotifierUpdateOne {
+	_u.mutation.ClearTitleTemplate()
+	return _u
+}
+
+// SetMessageTemplate sets the "message_template" field.
+func (_u *NotifierUpdateOne) SetMessageTemplate(v string) *NotifierUpdateOne {
+	_u.mutation.SetMessageTemplate(v)
+	return _u
+}
+
+// SetNillableMessageTemplate sets the "message_template" field if the given value is not nil.
+func (_u *NotifierUpdateOne) SetNillableMessageTemplate(v *string) *NotifierUpdateOne {
+	if v != nil {
+		_u.SetMessageTemplate(*v)
+	}
+	return _u
+}
+
+// ClearMessageTemplate clears the value of the "message_template" field.
+func (_u *NotifierUpdateOne) ClearMessageTemplate() *NotifierUpdateOne {
+	_u.mutation.ClearMessageTemplate()
+	return _u
+}
+
+// SetQuietStart sets the "quiet_start" field.
+func (_u *NotifierUpdateOne) SetQuietStart(v string) *NotifierUpdateOne {
+	_u.mutation.SetQuietStart(v)
+	return _u
+}
+
+// SetNillableQuietStart sets the "quiet_start" field if the given value is not nil.
+func (_u *NotifierUpdateOne) SetNillableQuietStart(v *string) *NotifierUpdateOne {
+	if v != nil {
+		_u.SetQuietStart(*v)
+	}
+	return _u
+}
+
+// ClearQuietStart clears the value of the "quiet_start" field.
+func (_u *NotifierUpdateOne) ClearQuietStart() *NotifierUpdateOne {
+	_u.mutation.ClearQuietStart()
+	return _u
+}
+
+// SetQuietEnd sets the "quiet_end" field.
+func (_u *NotifierUpdateOne) SetQuietEnd(v string) *NotifierUpdateOne {
+	_u.mutation.SetQuietEnd(v)
+	return _u
+}
+
+// SetNillableQuietEnd sets the "quiet_end" field if the given value is not nil.
+func (_u *NotifierUpdateOne) SetNillableQuietEnd(v *string) *NotifierUpdateOne {
+	if v != nil {
+		_u.SetQuietEnd(*v)
+	}
+	return _u
+}
+
+// ClearQuietEnd clears the value of the "quiet_end" field.
+func (_u *NotifierUpdateOne) ClearQuietEnd() *NotifierUpdateOne {
+	_u.mutation.ClearQuietEnd()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *NotifierUpdateOne) SetEnabled(v bool) *NotifierUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *NotifierUpdateOne) SetNillableEnabled(v *bool) *NotifierUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *NotifierUpdateOne) SetUpdatedAt(v time.Time) *NotifierUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the NotifierMutation object of the builder.
+func (_u *NotifierUpdateOne) Mutation() *NotifierMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NotifierUpdate builder.
+func (_u *NotifierUpdateOne) Where(ps ...predicate.Notifier) *NotifierUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotifierUpdateOne) Select(field string, fields ...string) *NotifierUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Notifier entity.
+func (_u *NotifierUpdateOne) Save(ctx context.Context) (*Notifier, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotifierUpdateOne) SaveX(ctx context.Context) *Notifier {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotifierUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotifierUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotifierUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := notifier.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *NotifierUpdateOne) sqlSave(ctx context.Context) (_node *Notifier, err error) {
+	_spec := sqlgraph.NewUpdateSpec(notifier.Table, notifier.Columns, sqlgraph.NewFieldSpec(notifier.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notifier.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notifier.FieldID)
+		for _, f := range fields {
+			if !notifier.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notifier.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(notifier.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(notifier.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(notifier.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Topic(); ok {
+		_spec.SetField(notifier.FieldTopic, field.TypeString, value)
+	}
+	if _u.mutation.TopicCleared() {
+		_spec.ClearField(notifier.FieldTopic, field.TypeString)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(notifier.FieldToken, field.TypeString, value)
+	}
+	if _u.mutation.TokenCleared() {
+		_spec.ClearField(notifier.FieldToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Events(); ok {
+		_spec.SetField(notifier.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notifier.FieldEvents, value)
+		})
+	}
+	if _u.mutation.EventsCleared() {
+		_spec.ClearField(notifier.FieldEvents, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TitleTemplate(); ok {
+		_spec.SetField(notifier.FieldTitleTemplate, field.TypeString, value)
+	}
+	if _u.mutation.TitleTemplateCleared() {
+		_spec.ClearField(notifier.FieldTitleTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.MessageTemplate(); ok {
+		_spec.SetField(notifier.FieldMessageTemplate, field.TypeString, value)
+	}
+	if _u.mutation.MessageTemplateCleared() {
+		_spec.ClearField(notifier.FieldMessageTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.QuietStart(); ok {
+		_spec.SetField(notifier.FieldQuietStart, field.TypeString, value)
+	}
+	if _u.mutation.QuietStartCleared() {
+		_spec.ClearField(notifier.FieldQuietStart, field.TypeString)
+	}
+	if value, ok := _u.mutation.QuietEnd(); ok {
+		_spec.SetField(notifier.FieldQuietEnd, field.TypeString, value)
+	}
+	if _u.mutation.QuietEndCleared() {
+		_spec.ClearField(notifier.FieldQuietEnd, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(notifier.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(notifier.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Notifier{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notifier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,29 +6,62 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
 // DownloadQueueItem is the predicate function for downloadqueueitem builders.
 type DownloadQueueItem func(*sql.Selector)
 
 // EtagCache is the predicate function for etagcache builders.
 type EtagCache func(*sql.Selector)
 
+// HookRun is the predicate function for hookrun builders.
+type HookRun func(*sql.Selector)
+
 // ImportEntry is the predicate function for importentry builders.
 type ImportEntry func(*sql.Selector)
 
 // LatestSeries is the predicate function for latestseries builders.
 type LatestSeries func(*sql.Selector)
 
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// Notifier is the predicate function for notifier builders.
+type Notifier func(*sql.Selector)
+
 // ProviderStorage is the predicate function for providerstorage builders.
 type ProviderStorage func(*sql.Selector)
 
+// ReadProgress is the predicate function for readprogress builders.
+type ReadProgress func(*sql.Selector)
+
 // Series is the predicate function for series builders.
 type Series func(*sql.Selector)
 
 // SeriesProvider is the predicate function for seriesprovider builders.
 type SeriesProvider func(*sql.Selector)
 
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
 // SourceEvent is the predicate function for sourceevent builders.
 type SourceEvent func(*sql.Selector)
+
+// SyncProgress is the predicate function for syncprogress builders.
+type SyncProgress func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
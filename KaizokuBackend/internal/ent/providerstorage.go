@@ -33,6 +33,8 @@ type ProviderStorage struct {
 	IsStorage bool `json:"is_storage,omitempty"`
 	// IsDisabled holds the value of the "is_disabled" field.
 	IsDisabled bool `json:"is_disabled,omitempty"`
+	// Extension was removed from its repository
+	IsObsolete bool `json:"is_obsolete,omitempty"`
 	// Mappings holds the value of the "mappings" field.
 	Mappings     []types.ProviderMapping `json:"mappings,omitempty"`
 	selectValues sql.SelectValues
@@ -45,7 +47,7 @@ func (*ProviderStorage) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case providerstorage.FieldMappings:
 			values[i] = new([]byte)
-		case providerstorage.FieldIsStorage, providerstorage.FieldIsDisabled:
+		case providerstorage.FieldIsStorage, providerstorage.FieldIsDisabled, providerstorage.FieldIsObsolete:
 			values[i] = new(sql.NullBool)
 		case providerstorage.FieldVersionCode:
 			values[i] = new(sql.NullInt64)
@@ -116,6 +118,12 @@ func (_m *ProviderStorage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsDisabled = value.Bool
 			}
+		case providerstorage.FieldIsObsolete:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_obsolete", values[i])
+			} else if value.Valid {
+				_m.IsObsolete = value.Bool
+			}
 		case providerstorage.FieldMappings:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field mappings", values[i])
@@ -181,6 +189,9 @@ func (_m *ProviderStorage) String() string {
 	builder.WriteString("is_disabled=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsDisabled))
 	builder.WriteString(", ")
+	builder.WriteString("is_obsolete=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsObsolete))
+	builder.WriteString(", ")
 	builder.WriteString("mappings=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Mappings))
 	builder.WriteByte(')')
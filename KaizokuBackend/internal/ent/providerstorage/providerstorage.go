@@ -26,6 +26,8 @@ const (
 	FieldIsStorage = "is_storage"
 	// FieldIsDisabled holds the string denoting the is_disabled field in the database.
 	FieldIsDisabled = "is_disabled"
+	// FieldIsObsolete holds the string denoting the is_obsolete field in the database.
+	FieldIsObsolete = "is_obsolete"
 	// FieldMappings holds the string denoting the mappings field in the database.
 	FieldMappings = "mappings"
 	// Table holds the table name of the providerstorage in the database.
@@ -42,6 +44,7 @@ var Columns = []string{
 	FieldVersionCode,
 	FieldIsStorage,
 	FieldIsDisabled,
+	FieldIsObsolete,
 	FieldMappings,
 }
 
@@ -66,6 +69,8 @@ var (
 	DefaultIsStorage bool
 	// DefaultIsDisabled holds the default value on creation for the "is_disabled" field.
 	DefaultIsDisabled bool
+	// DefaultIsObsolete holds the default value on creation for the "is_obsolete" field.
+	DefaultIsObsolete bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -112,3 +117,8 @@ func ByIsStorage(opts ...sql.OrderTermOption) OrderOption {
 func ByIsDisabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsDisabled, opts...).ToFunc()
 }
+
+// ByIsObsolete orders the results by the is_obsolete field.
+func ByIsObsolete(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsObsolete, opts...).ToFunc()
+}
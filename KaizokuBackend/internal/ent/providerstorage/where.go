@@ -88,6 +88,11 @@ func IsDisabled(v bool) predicate.ProviderStorage {
 	return predicate.ProviderStorage(sql.FieldEQ(FieldIsDisabled, v))
 }
 
+// IsObsolete applies equality check predicate on the "is_obsolete" field. It's identical to IsObsoleteEQ.
+func IsObsolete(v bool) predicate.ProviderStorage {
+	return predicate.ProviderStorage(sql.FieldEQ(FieldIsObsolete, v))
+}
+
 // ApkNameEQ applies the EQ predicate on the "apk_name" field.
 func ApkNameEQ(v string) predicate.ProviderStorage {
 	return predicate.ProviderStorage(sql.FieldEQ(FieldApkName, v))
@@ -408,6 +413,16 @@ func IsDisabledNEQ(v bool) predicate.ProviderStorage {
 	return predicate.ProviderStorage(sql.FieldNEQ(FieldIsDisabled, v))
 }
 
+// IsObsoleteEQ applies the EQ predicate on the "is_obsolete" field.
+func IsObsoleteEQ(v bool) predicate.ProviderStorage {
+	return predicate.ProviderStorage(sql.FieldEQ(FieldIsObsolete, v))
+}
+
+// IsObsoleteNEQ applies the NEQ predicate on the "is_obsolete" field.
+func IsObsoleteNEQ(v bool) predicate.ProviderStorage {
+	return predicate.ProviderStorage(sql.FieldNEQ(FieldIsObsolete, v))
+}
+
 // MappingsIsNil applies the IsNil predicate on the "mappings" field.
 func MappingsIsNil() predicate.ProviderStorage {
 	return predicate.ProviderStorage(sql.FieldIsNull(FieldMappings))
@@ -106,6 +106,20 @@ func (_c *ProviderStorageCreate) SetNillableIsDisabled(v *bool) *ProviderStorage
 	return _c
 }
 
+// SetIsObsolete sets the "is_obsolete" field.
+func (_c *ProviderStorageCreate) SetIsObsolete(v bool) *ProviderStorageCreate {
+	_c.mutation.SetIsObsolete(v)
+	return _c
+}
+
+// SetNillableIsObsolete sets the "is_obsolete" field if the given value is not nil.
+func (_c *ProviderStorageCreate) SetNillableIsObsolete(v *bool) *ProviderStorageCreate {
+	if v != nil {
+		_c.SetIsObsolete(*v)
+	}
+	return _c
+}
+
 // SetMappings sets the "mappings" field.
 func (_c *ProviderStorageCreate) SetMappings(v []types.ProviderMapping) *ProviderStorageCreate {
 	_c.mutation.SetMappings(v)
@@ -181,6 +195,10 @@ func (_c *ProviderStorageCreate) defaults() {
 		v := providerstorage.DefaultIsDisabled
 		_c.mutation.SetIsDisabled(v)
 	}
+	if _, ok := _c.mutation.IsObsolete(); !ok {
+		v := providerstorage.DefaultIsObsolete
+		_c.mutation.SetIsObsolete(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := providerstorage.DefaultID()
 		_c.mutation.SetID(v)
@@ -210,6 +228,9 @@ func (_c *ProviderStorageCreate) check() error {
 	if _, ok := _c.mutation.IsDisabled(); !ok {
 		return &ValidationError{Name: "is_disabled", err: errors.New(`ent: missing required field "ProviderStorage.is_disabled"`)}
 	}
+	if _, ok := _c.mutation.IsObsolete(); !ok {
+		return &ValidationError{Name: "is_obsolete", err: errors.New(`ent: missing required field "ProviderStorage.is_obsolete"`)}
+	}
 	return nil
 }
 
@@ -274,6 +295,10 @@ func (_c *ProviderStorageCreate) createSpec() (*ProviderStorage, *sqlgraph.Creat
 		_spec.SetField(providerstorage.FieldIsDisabled, field.TypeBool, value)
 		_node.IsDisabled = value
 	}
+	if value, ok := _c.mutation.IsObsolete(); ok {
+		_spec.SetField(providerstorage.FieldIsObsolete, field.TypeBool, value)
+		_node.IsObsolete = value
+	}
 	if value, ok := _c.mutation.Mappings(); ok {
 		_spec.SetField(providerstorage.FieldMappings, field.TypeJSON, value)
 		_node.Mappings = value
@@ -420,6 +445,18 @@ func (u *ProviderStorageUpsert) UpdateIsDisabled() *ProviderStorageUpsert {
 	return u
 }
 
+// SetIsObsolete sets the "is_obsolete" field.
+func (u *ProviderStorageUpsert) SetIsObsolete(v bool) *ProviderStorageUpsert {
+	u.Set(providerstorage.FieldIsObsolete, v)
+	return u
+}
+
+// UpdateIsObsolete sets the "is_obsolete" field to the value that was provided on create.
+func (u *ProviderStorageUpsert) UpdateIsObsolete() *ProviderStorageUpsert {
+	u.SetExcluded(providerstorage.FieldIsObsolete)
+	return u
+}
+
 // SetMappings sets the "mappings" field.
 func (u *ProviderStorageUpsert) SetMappings(v []types.ProviderMapping) *ProviderStorageUpsert {
 	u.Set(providerstorage.FieldMappings, v)
@@ -591,6 +628,20 @@ func (u *ProviderStorageUpsertOne) UpdateIsDisabled() *ProviderStorageUpsertOne
 	})
 }
 
+// SetIsObsolete sets the "is_obsolete" field.
+func (u *ProviderStorageUpsertOne) SetIsObsolete(v bool) *ProviderStorageUpsertOne {
+	return u.Update(func(s *ProviderStorageUpsert) {
+		s.SetIsObsolete(v)
+	})
+}
+
+// UpdateIsObsolete sets the "is_obsolete" field to the value that was provided on create.
+func (u *ProviderStorageUpsertOne) UpdateIsObsolete() *ProviderStorageUpsertOne {
+	return u.Update(func(s *ProviderStorageUpsert) {
+		s.UpdateIsObsolete()
+	})
+}
+
 // SetMappings sets the "mappings" field.
 func (u *ProviderStorageUpsertOne) SetMappings(v []types.ProviderMapping) *ProviderStorageUpsertOne {
 	return u.Update(func(s *ProviderStorageUpsert) {
@@ -932,6 +983,20 @@ func (u *ProviderStorageUpsertBulk) UpdateIsDisabled() *ProviderStorageUpsertBul
 	})
 }
 
+// SetIsObsolete sets the "is_obsolete" field.
+func (u *ProviderStorageUpsertBulk) SetIsObsolete(v bool) *ProviderStorageUpsertBulk {
+	return u.Update(func(s *ProviderStorageUpsert) {
+		s.SetIsObsolete(v)
+	})
+}
+
+// UpdateIsObsolete sets the "is_obsolete" field to the value that was provided on create.
+func (u *ProviderStorageUpsertBulk) UpdateIsObsolete() *ProviderStorageUpsertBulk {
+	return u.Update(func(s *ProviderStorageUpsert) {
+		s.UpdateIsObsolete()
+	})
+}
+
 // SetMappings sets the "mappings" field.
 func (u *ProviderStorageUpsertBulk) SetMappings(v []types.ProviderMapping) *ProviderStorageUpsertBulk {
 	return u.Update(func(s *ProviderStorageUpsert) {
@@ -134,6 +134,20 @@ func (_u *ProviderStorageUpdate) SetNillableIsDisabled(v *bool) *ProviderStorage
 	return _u
 }
 
+// SetIsObsolete sets the "is_obsolete" field.
+func (_u *ProviderStorageUpdate) SetIsObsolete(v bool) *ProviderStorageUpdate {
+	_u.mutation.SetIsObsolete(v)
+	return _u
+}
+
+// SetNillableIsObsolete sets the "is_obsolete" field if the given value is not nil.
+func (_u *ProviderStorageUpdate) SetNillableIsObsolete(v *bool) *ProviderStorageUpdate {
+	if v != nil {
+		_u.SetIsObsolete(*v)
+	}
+	return _u
+}
+
 // SetMappings sets the "mappings" field.
 func (_u *ProviderStorageUpdate) SetMappings(v []types.ProviderMapping) *ProviderStorageUpdate {
 	_u.mutation.SetMappings(v)
@@ -217,6 +231,9 @@ func (_u *ProviderStorageUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.IsDisabled(); ok {
 		_spec.SetField(providerstorage.FieldIsDisabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.IsObsolete(); ok {
+		_spec.SetField(providerstorage.FieldIsObsolete, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Mappings(); ok {
 		_spec.SetField(providerstorage.FieldMappings, field.TypeJSON, value)
 	}
@@ -353,6 +370,20 @@ func (_u *ProviderStorageUpdateOne) SetNillableIsDisabled(v *bool) *ProviderStor
 	return _u
 }
 
+// SetIsObsolete sets the "is_obsolete" field.
+func (_u *ProviderStorageUpdateOne) SetIsObsolete(v bool) *ProviderStorageUpdateOne {
+	_u.mutation.SetIsObsolete(v)
+	return _u
+}
+
+// SetNillableIsObsolete sets the "is_obsolete" field if the given value is not nil.
+func (_u *ProviderStorageUpdateOne) SetNillableIsObsolete(v *bool) *ProviderStorageUpdateOne {
+	if v != nil {
+		_u.SetIsObsolete(*v)
+	}
+	return _u
+}
+
 // SetMappings sets the "mappings" field.
 func (_u *ProviderStorageUpdateOne) SetMappings(v []types.ProviderMapping) *ProviderStorageUpdateOne {
 	_u.mutation.SetMappings(v)
@@ -466,6 +497,9 @@ func (_u *ProviderStorageUpdateOne) sqlSave(ctx context.Context) (_node *Provide
 	if value, ok := _u.mutation.IsDisabled(); ok {
 		_spec.SetField(providerstorage.FieldIsDisabled, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.IsObsolete(); ok {
+		_spec.SetField(providerstorage.FieldIsObsolete, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Mappings(); ok {
 		_spec.SetField(providerstorage.FieldMappings, field.TypeJSON, value)
 	}
@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/readprogress"
+)
+
+// ReadProgress is the model entity for the ReadProgress schema.
+type ReadProgress struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Reading user; uuid.Nil when authentication is disabled
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// SeriesID holds the value of the "series_id" field.
+	SeriesID uuid.UUID `json:"series_id,omitempty"`
+	// ChapterNumber holds the value of the "chapter_number" field.
+	ChapterNumber float64 `json:"chapter_number,omitempty"`
+	// Zero-based last page viewed
+	Page int `json:"page,omitempty"`
+	// Pages in the chapter when last read, 0 if unknown
+	PageCount int `json:"page_count,omitempty"`
+	// Completed holds the value of the "completed" field.
+	Completed bool `json:"completed,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReadProgress) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case readprogress.FieldCompleted:
+			values[i] = new(sql.NullBool)
+		case readprogress.FieldChapterNumber:
+			values[i] = new(sql.NullFloat64)
+		case readprogress.FieldPage, readprogress.FieldPageCount:
+			values[i] = new(sql.NullInt64)
+		case readprogress.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case readprogress.FieldID, readprogress.FieldUserID, readprogress.FieldSeriesID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReadProgress fields.
+func (_m *ReadProgress) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case readprogress.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case readprogress.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case readprogress.FieldSeriesID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value != nil {
+				_m.SeriesID = *value
+			}
+		case readprogress.FieldChapterNumber:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field chapter_number", values[i])
+			} else if value.Valid {
+				_m.ChapterNumber = value.Float64
+			}
+		case readprogress.FieldPage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field page", values[i])
+			} else if value.Valid {
+				_m.Page = int(value.Int64)
+			}
+		case readprogress.FieldPageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field page_count", values[i])
+			} else if value.Valid {
+				_m.PageCount = int(value.Int64)
+			}
+		case readprogress.FieldCompleted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field completed", values[i])
+			} else if value.Valid {
+				_m.Completed = value.Bool
+			}
+		case readprogress.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReadProgress.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReadProgress) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ReadProgress.
+// Note that you need to call ReadProgress.Unwrap() before calling this method if this ReadProgress
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReadProgress) Update() *ReadProgressUpdateOne {
+	return NewReadProgressClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReadProgress entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReadProgress) Unwrap() *ReadProgress {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReadProgress is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReadProgress) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReadProgress(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("series_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SeriesID))
+	builder.WriteString(", ")
+	builder.WriteString("chapter_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChapterNumber))
+	builder.WriteString(", ")
+	builder.WriteString("page=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Page))
+	builder.WriteString(", ")
+	builder.WriteString("page_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PageCount))
+	builder.WriteString(", ")
+	builder.WriteString("completed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Completed))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReadProgresses is a parsable slice of ReadProgress.
+type ReadProgresses []*ReadProgress
@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package readprogress
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the readprogress type in the database.
+	Label = "read_progress"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldSeriesID holds the string denoting the series_id field in the database.
+	FieldSeriesID = "series_id"
+	// FieldChapterNumber holds the string denoting the chapter_number field in the database.
+	FieldChapterNumber = "chapter_number"
+	// FieldPage holds the string denoting the page field in the database.
+	FieldPage = "page"
+	// FieldPageCount holds the string denoting the page_count field in the database.
+	FieldPageCount = "page_count"
+	// FieldCompleted holds the string denoting the completed field in the database.
+	FieldCompleted = "completed"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the readprogress in the database.
+	Table = "read_progresses"
+)
+
+// Columns holds all SQL columns for readprogress fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldSeriesID,
+	FieldChapterNumber,
+	FieldPage,
+	FieldPageCount,
+	FieldCompleted,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPage holds the default value on creation for the "page" field.
+	DefaultPage int
+	// DefaultPageCount holds the default value on creation for the "page_count" field.
+	DefaultPageCount int
+	// DefaultCompleted holds the default value on creation for the "completed" field.
+	DefaultCompleted bool
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ReadProgress queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// BySeriesID orders the results by the series_id field.
+func BySeriesID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesID, opts...).ToFunc()
+}
+
+// ByChapterNumber orders the results by the chapter_number field.
+func ByChapterNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChapterNumber, opts...).ToFunc()
+}
+
+// ByPage orders the results by the page field.
+func ByPage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPage, opts...).ToFunc()
+}
+
+// ByPageCount orders the results by the page_count field.
+func ByPageCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPageCount, opts...).ToFunc()
+}
+
+// ByCompleted orders the results by the completed field.
+func ByCompleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompleted, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
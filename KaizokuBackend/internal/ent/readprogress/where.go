@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package readprogress
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldUserID, v))
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// ChapterNumber applies equality check predicate on the "chapter_number" field. It's identical to ChapterNumberEQ.
+func ChapterNumber(v float64) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldChapterNumber, v))
+}
+
+// Page applies equality check predicate on the "page" field. It's identical to PageEQ.
+func Page(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldPage, v))
+}
+
+// PageCount applies equality check predicate on the "page_count" field. It's identical to PageCountEQ.
+func PageCount(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldPageCount, v))
+}
+
+// Completed applies equality check predicate on the "completed" field. It's identical to CompletedEQ.
+func Completed(v bool) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldCompleted, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLTE(FieldUserID, v))
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNEQ(FieldSeriesID, v))
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNotIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDGT applies the GT predicate on the "series_id" field.
+func SeriesIDGT(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGT(FieldSeriesID, v))
+}
+
+// SeriesIDGTE applies the GTE predicate on the "series_id" field.
+func SeriesIDGTE(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGTE(FieldSeriesID, v))
+}
+
+// SeriesIDLT applies the LT predicate on the "series_id" field.
+func SeriesIDLT(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLT(FieldSeriesID, v))
+}
+
+// SeriesIDLTE applies the LTE predicate on the "series_id" field.
+func SeriesIDLTE(v uuid.UUID) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLTE(FieldSeriesID, v))
+}
+
+// ChapterNumberEQ applies the EQ predicate on the "chapter_number" field.
+func ChapterNumberEQ(v float64) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldChapterNumber, v))
+}
+
+// ChapterNumberNEQ applies the NEQ predicate on the "chapter_number" field.
+func ChapterNumberNEQ(v float64) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNEQ(FieldChapterNumber, v))
+}
+
+// ChapterNumberIn applies the In predicate on the "chapter_number" field.
+func ChapterNumberIn(vs ...float64) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldIn(FieldChapterNumber, vs...))
+}
+
+// ChapterNumberNotIn applies the NotIn predicate on the "chapter_number" field.
+func ChapterNumberNotIn(vs ...float64) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNotIn(FieldChapterNumber, vs...))
+}
+
+// ChapterNumberGT applies the GT predicate on the "chapter_number" field.
+func ChapterNumberGT(v float64) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGT(FieldChapterNumber, v))
+}
+
+// ChapterNumberGTE applies the GTE predicate on the "chapter_number" field.
+func ChapterNumberGTE(v float64) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGTE(FieldChapterNumber, v))
+}
+
+// ChapterNumberLT applies the LT predicate on the "chapter_number" field.
+func ChapterNumberLT(v float64) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLT(FieldChapterNumber, v))
+}
+
+// ChapterNumberLTE applies the LTE predicate on the "chapter_number" field.
+func ChapterNumberLTE(v float64) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLTE(FieldChapterNumber, v))
+}
+
+// PageEQ applies the EQ predicate on the "page" field.
+func PageEQ(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldPage, v))
+}
+
+// PageNEQ applies the NEQ predicate on the "page" field.
+func PageNEQ(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNEQ(FieldPage, v))
+}
+
+// PageIn applies the In predicate on the "page" field.
+func PageIn(vs ...int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldIn(FieldPage, vs...))
+}
+
+// PageNotIn applies the NotIn predicate on the "page" field.
+func PageNotIn(vs ...int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNotIn(FieldPage, vs...))
+}
+
+// PageGT applies the GT predicate on the "page" field.
+func PageGT(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGT(FieldPage, v))
+}
+
+// PageGTE applies the GTE predicate on the "page" field.
+func PageGTE(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGTE(FieldPage, v))
+}
+
+// PageLT applies the LT predicate on the "page" field.
+func PageLT(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLT(FieldPage, v))
+}
+
+// PageLTE applies the LTE predicate on the "page" field.
+func PageLTE(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLTE(FieldPage, v))
+}
+
+// PageCountEQ applies the EQ predicate on the "page_count" field.
+func PageCountEQ(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldPageCount, v))
+}
+
+// PageCountNEQ applies the NEQ predicate on the "page_count" field.
+func PageCountNEQ(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNEQ(FieldPageCount, v))
+}
+
+// PageCountIn applies the In predicate on the "page_count" field.
+func PageCountIn(vs ...int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldIn(FieldPageCount, vs...))
+}
+
+// PageCountNotIn applies the NotIn predicate on the "page_count" field.
+func PageCountNotIn(vs ...int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNotIn(FieldPageCount, vs...))
+}
+
+// PageCountGT applies the GT predicate on the "page_count" field.
+func PageCountGT(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGT(FieldPageCount, v))
+}
+
+// PageCountGTE applies the GTE predicate on the "page_count" field.
+func PageCountGTE(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGTE(FieldPageCount, v))
+}
+
+// PageCountLT applies the LT predicate on the "page_count" field.
+func PageCountLT(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLT(FieldPageCount, v))
+}
+
+// PageCountLTE applies the LTE predicate on the "page_count" field.
+func PageCountLTE(v int) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLTE(FieldPageCount, v))
+}
+
+// CompletedEQ applies the EQ predicate on the "completed" field.
+func CompletedEQ(v bool) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldCompleted, v))
+}
+
+// CompletedNEQ applies the NEQ predicate on the "completed" field.
+func CompletedNEQ(v bool) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNEQ(FieldCompleted, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReadProgress) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReadProgress) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReadProgress) predicate.ReadProgress {
+	return predicate.ReadProgress(sql.NotPredicates(p))
+}
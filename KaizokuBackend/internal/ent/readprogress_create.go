@@ -0,0 +1,935 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/readprogress"
+)
+
+// ReadProgressCreate is the builder for creating a ReadProgress entity.
+type ReadProgressCreate struct {
+	config
+	mutation *ReadProgressMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ReadProgressCreate) SetUserID(v uuid.UUID) *ReadProgressCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_c *ReadProgressCreate) SetSeriesID(v uuid.UUID) *ReadProgressCreate {
+	_c.mutation.SetSeriesID(v)
+	return _c
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (_c *ReadProgressCreate) SetChapterNumber(v float64) *ReadProgressCreate {
+	_c.mutation.SetChapterNumber(v)
+	return _c
+}
+
+// SetPage sets the "page" field.
+func (_c *ReadProgressCreate) SetPage(v int) *ReadProgressCreate {
+	_c.mutation.SetPage(v)
+	return _c
+}
+
+// SetNillablePage sets the "page" field if the given value is not nil.
+func (_c *ReadProgressCreate) SetNillablePage(v *int) *ReadProgressCreate {
+	if v != nil {
+		_c.SetPage(*v)
+	}
+	return _c
+}
+
+// SetPageCount sets the "page_count" field.
+func (_c *ReadProgressCreate) SetPageCount(v int) *ReadProgressCreate {
+	_c.mutation.SetPageCount(v)
+	return _c
+}
+
+// SetNillablePageCount sets the "page_count" field if the given value is not nil.
+func (_c *ReadProgressCreate) SetNillablePageCount(v *int) *ReadProgressCreate {
+	if v != nil {
+		_c.SetPageCount(*v)
+	}
+	return _c
+}
+
+// SetCompleted sets the "completed" field.
+func (_c *ReadProgressCreate) SetCompleted(v bool) *ReadProgressCreate {
+	_c.mutation.SetCompleted(v)
+	return _c
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (_c *ReadProgressCreate) SetNillableCompleted(v *bool) *ReadProgressCreate {
+	if v != nil {
+		_c.SetCompleted(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReadProgressCreate) SetUpdatedAt(v time.Time) *ReadProgressCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReadProgressCreate) SetNillableUpdatedAt(v *time.Time) *ReadProgressCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReadProgressCreate) SetID(v uuid.UUID) *ReadProgressCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReadProgressCreate) SetNillableID(v *uuid.UUID) *ReadProgressCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ReadProgressMutation object of the builder.
+func (_c *ReadProgressCreate) Mutation() *ReadProgressMutation {
+	return _c.mutation
+}
+
+// Save creates the ReadProgress in the database.
+func (_c *ReadProgressCreate) Save(ctx context.Context) (*ReadProgress, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReadProgressCreate) SaveX(ctx context.Context) *ReadProgress {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReadProgressCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReadProgressCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReadProgressCreate) defaults() {
+	if _, ok := _c.mutation.Page(); !ok {
+		v := readprogress.DefaultPage
+		_c.mutation.SetPage(v)
+	}
+	if _, ok := _c.mutation.PageCount(); !ok {
+		v := readprogress.DefaultPageCount
+		_c.mutation.SetPageCount(v)
+	}
+	if _, ok := _c.mutation.Completed(); !ok {
+		v := readprogress.DefaultCompleted
+		_c.mutation.SetCompleted(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := readprogress.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := readprogress.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReadProgressCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ReadProgress.user_id"`)}
+	}
+	if _, ok := _c.mutation.SeriesID(); !ok {
+		return &ValidationError{Name: "series_id", err: errors.New(`ent: missing required field "ReadProgress.series_id"`)}
+	}
+	if _, ok := _c.mutation.ChapterNumber(); !ok {
+		return &ValidationError{Name: "chapter_number", err: errors.New(`ent: missing required field "ReadProgress.chapter_number"`)}
+	}
+	if _, ok := _c.mutation.Page(); !ok {
+		return &ValidationError{Name: "page", err: errors.New(`ent: missing required field "ReadProgress.page"`)}
+	}
+	if _, ok := _c.mutation.PageCount(); !ok {
+		return &ValidationError{Name: "page_count", err: errors.New(`ent: missing required field "ReadProgress.page_count"`)}
+	}
+	if _, ok := _c.mutation.Completed(); !ok {
+		return &ValidationError{Name: "completed", err: errors.New(`ent: missing required field "ReadProgress.completed"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReadProgress.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReadProgressCreate) sqlSave(ctx context.Context) (*ReadProgress, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReadProgressCreate) createSpec() (*ReadProgress, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReadProgress{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(readprogress.Table, sqlgraph.NewFieldSpec(readprogress.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(readprogress.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.SeriesID(); ok {
+		_spec.SetField(readprogress.FieldSeriesID, field.TypeUUID, value)
+		_node.SeriesID = value
+	}
+	if value, ok := _c.mutation.ChapterNumber(); ok {
+		_spec.SetField(readprogress.FieldChapterNumber, field.TypeFloat64, value)
+		_node.ChapterNumber = value
+	}
+	if value, ok := _c.mutation.Page(); ok {
+		_spec.SetField(readprogress.FieldPage, field.TypeInt, value)
+		_node.Page = value
+	}
+	if value, ok := _c.mutation.PageCount(); ok {
+		_spec.SetField(readprogress.FieldPageCount, field.TypeInt, value)
+		_node.PageCount = value
+	}
+	if value, ok := _c.mutation.Completed(); ok {
+		_spec.SetField(readprogress.FieldCompleted, field.TypeBool, value)
+		_node.Completed = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(readprogress.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReadProgress.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReadProgressUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReadProgressCreate) OnConflict(opts ...sql.ConflictOption) *ReadProgressUpsertOne {
+	_c.conflict = opts
+	return &ReadProgressUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReadProgress.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReadProgressCreate) OnConflictColumns(columns ...string) *ReadProgressUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReadProgressUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReadProgressUpsertOne is the builder for "upsert"-ing
+	//  one ReadProgress node.
+	ReadProgressUpsertOne struct {
+		create *ReadProgressCreate
+	}
+
+	// ReadProgressUpsert is the "OnConflict" setter.
+	ReadProgressUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *ReadProgressUpsert) SetUserID(v uuid.UUID) *ReadProgressUpsert {
+	u.Set(readprogress.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReadProgressUpsert) UpdateUserID() *ReadProgressUpsert {
+	u.SetExcluded(readprogress.FieldUserID)
+	return u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (u *ReadProgressUpsert) SetSeriesID(v uuid.UUID) *ReadProgressUpsert {
+	u.Set(readprogress.FieldSeriesID, v)
+	return u
+}
+
+// UpdateSeriesID sets the "series_id" field to the value that was provided on create.
+func (u *ReadProgressUpsert) UpdateSeriesID() *ReadProgressUpsert {
+	u.SetExcluded(readprogress.FieldSeriesID)
+	return u
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (u *ReadProgressUpsert) SetChapterNumber(v float64) *ReadProgressUpsert {
+	u.Set(readprogress.FieldChapterNumber, v)
+	return u
+}
+
+// UpdateChapterNumber sets the "chapter_number" field to the value that was provided on create.
+func (u *ReadProgressUpsert) UpdateChapterNumber() *ReadProgressUpsert {
+	u.SetExcluded(readprogress.FieldChapterNumber)
+	return u
+}
+
+// AddChapterNumber adds v to the "chapter_number" field.
+func (u *ReadProgressUpsert) AddChapterNumber(v float64) *ReadProgressUpsert {
+	u.Add(readprogress.FieldChapterNumber, v)
+	return u
+}
+
+// SetPage sets the "page" field.
+func (u *ReadProgressUpsert) SetPage(v int) *ReadProgressUpsert {
+	u.Set(readprogress.FieldPage, v)
+	return u
+}
+
+// UpdatePage sets the "page" field to the value that was provided on create.
+func (u *ReadProgressUpsert) UpdatePage() *ReadProgressUpsert {
+	u.SetExcluded(readprogress.FieldPage)
+	return u
+}
+
+// AddPage adds v to the "page" field.
+func (u *ReadProgressUpsert) AddPage(v int) *ReadProgressUpsert {
+	u.Add(readprogress.FieldPage, v)
+	return u
+}
+
+// SetPageCount sets the "page_count" field.
+func (u *ReadProgressUpsert) SetPageCount(v int) *ReadProgressUpsert {
+	u.Set(readprogress.FieldPageCount, v)
+	return u
+}
+
+// UpdatePageCount sets the "page_count" field to the value that was provided on create.
+func (u *ReadProgressUpsert) UpdatePageCount() *ReadProgressUpsert {
+	u.SetExcluded(readprogress.FieldPageCount)
+	return u
+}
+
+// AddPageCount adds v to the "page_count" field.
+func (u *ReadProgressUpsert) AddPageCount(v int) *ReadProgressUpsert {
+	u.Add(readprogress.FieldPageCount, v)
+	return u
+}
+
+// SetCompleted sets the "completed" field.
+func (u *ReadProgressUpsert) SetCompleted(v bool) *ReadProgressUpsert {
+	u.Set(readprogress.FieldCompleted, v)
+	return u
+}
+
+// UpdateCompleted sets the "completed" field to the value that was provided on create.
+func (u *ReadProgressUpsert) UpdateCompleted() *ReadProgressUpsert {
+	u.SetExcluded(readprogress.FieldCompleted)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReadProgressUpsert) SetUpdatedAt(v time.Time) *ReadProgressUpsert {
+	u.Set(readprogress.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReadProgressUpsert) UpdateUpdatedAt() *ReadProgressUpsert {
+	u.SetExcluded(readprogress.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReadProgress.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(readprogress.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReadProgressUpsertOne) UpdateNewValues() *ReadProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(readprogress.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReadProgress.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReadProgressUpsertOne) Ignore() *ReadProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReadProgressUpsertOne) DoNothing() *ReadProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReadProgressCreate.OnConflict
+// documentation for more info.
+func (u *ReadProgressUpsertOne) Update(set func(*ReadProgressUpsert)) *ReadProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReadProgressUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReadProgressUpsertOne) SetUserID(v uuid.UUID) *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReadProgressUpsertOne) UpdateUserID() *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSeriesID sets the "series_id" field.
+func (u *ReadProgressUpsertOne) SetSeriesID(v uuid.UUID) *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetSeriesID(v)
+	})
+}
+
+// UpdateSeriesID sets the "series_id" field to the value that was provided on create.
+func (u *ReadProgressUpsertOne) UpdateSeriesID() *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdateSeriesID()
+	})
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (u *ReadProgressUpsertOne) SetChapterNumber(v float64) *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetChapterNumber(v)
+	})
+}
+
+// AddChapterNumber adds v to the "chapter_number" field.
+func (u *ReadProgressUpsertOne) AddChapterNumber(v float64) *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.AddChapterNumber(v)
+	})
+}
+
+// UpdateChapterNumber sets the "chapter_number" field to the value that was provided on create.
+func (u *ReadProgressUpsertOne) UpdateChapterNumber() *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdateChapterNumber()
+	})
+}
+
+// SetPage sets the "page" field.
+func (u *ReadProgressUpsertOne) SetPage(v int) *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetPage(v)
+	})
+}
+
+// AddPage adds v to the "page" field.
+func (u *ReadProgressUpsertOne) AddPage(v int) *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.AddPage(v)
+	})
+}
+
+// UpdatePage sets the "page" field to the value that was provided on create.
+func (u *ReadProgressUpsertOne) UpdatePage() *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdatePage()
+	})
+}
+
+// SetPageCount sets the "page_count" field.
+func (u *ReadProgressUpsertOne) SetPageCount(v int) *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetPageCount(v)
+	})
+}
+
+// AddPageCount adds v to the "page_count" field.
+func (u *ReadProgressUpsertOne) AddPageCount(v int) *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.AddPageCount(v)
+	})
+}
+
+// UpdatePageCount sets the "page_count" field to the value that was provided on create.
+func (u *ReadProgressUpsertOne) UpdatePageCount() *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdatePageCount()
+	})
+}
+
+// SetCompleted sets the "completed" field.
+func (u *ReadProgressUpsertOne) SetCompleted(v bool) *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetCompleted(v)
+	})
+}
+
+// UpdateCompleted sets the "completed" field to the value that was provided on create.
+func (u *ReadProgressUpsertOne) UpdateCompleted() *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdateCompleted()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReadProgressUpsertOne) SetUpdatedAt(v time.Time) *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReadProgressUpsertOne) UpdateUpdatedAt() *ReadProgressUpsertOne {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReadProgressUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReadProgressCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReadProgressUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReadProgressUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReadProgressUpsertOne.ID is not supported by MySQL driver. Use ReadProgressUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReadProgressUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReadProgressCreateBulk is the builder for creating many ReadProgress entities in bulk.
+type ReadProgressCreateBulk struct {
+	config
+	err      error
+	builders []*ReadProgressCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReadProgress entities in the database.
+func (_c *ReadProgressCreateBulk) Save(ctx context.Context) ([]*ReadProgress, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReadProgress, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReadProgressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReadProgressCreateBulk) SaveX(ctx context.Context) []*ReadProgress {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReadProgressCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReadProgressCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReadProgress.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReadProgressUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReadProgressCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReadProgressUpsertBulk {
+	_c.conflict = opts
+	return &ReadProgressUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReadProgress.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReadProgressCreateBulk) OnConflictColumns(columns ...string) *ReadProgressUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReadProgressUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReadProgressUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReadProgress nodes.
+type ReadProgressUpsertBulk struct {
+	create *ReadProgressCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReadProgress.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(readprogress.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReadProgressUpsertBulk) UpdateNewValues() *ReadProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(readprogress.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReadProgress.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReadProgressUpsertBulk) Ignore() *ReadProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReadProgressUpsertBulk) DoNothing() *ReadProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReadProgressCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReadProgressUpsertBulk) Update(set func(*ReadProgressUpsert)) *ReadProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReadProgressUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ReadProgressUpsertBulk) SetUserID(v uuid.UUID) *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ReadProgressUpsertBulk) UpdateUserID() *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSeriesID sets the "series_id" field.
+func (u *ReadProgressUpsertBulk) SetSeriesID(v uuid.UUID) *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetSeriesID(v)
+	})
+}
+
+// UpdateSeriesID sets the "series_id" field to the value that was provided on create.
+func (u *ReadProgressUpsertBulk) UpdateSeriesID() *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdateSeriesID()
+	})
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (u *ReadProgressUpsertBulk) SetChapterNumber(v float64) *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetChapterNumber(v)
+	})
+}
+
+// AddChapterNumber adds v to the "chapter_number" field.
+func (u *ReadProgressUpsertBulk) AddChapterNumber(v float64) *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.AddChapterNumber(v)
+	})
+}
+
+// UpdateChapterNumber sets the "chapter_number" field to the value that was provided on create.
+func (u *ReadProgressUpsertBulk) UpdateChapterNumber() *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdateChapterNumber()
+	})
+}
+
+// SetPage sets the "page" field.
+func (u *ReadProgressUpsertBulk) SetPage(v int) *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetPage(v)
+	})
+}
+
+// AddPage adds v to the "page" field.
+func (u *ReadProgressUpsertBulk) AddPage(v int) *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.AddPage(v)
+	})
+}
+
+// UpdatePage sets the "page" field to the value that was provided on create.
+func (u *ReadProgressUpsertBulk) UpdatePage() *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdatePage()
+	})
+}
+
+// SetPageCount sets the "page_count" field.
+func (u *ReadProgressUpsertBulk) SetPageCount(v int) *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetPageCount(v)
+	})
+}
+
+// AddPageCount adds v to the "page_count" field.
+func (u *ReadProgressUpsertBulk) AddPageCount(v int) *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.AddPageCount(v)
+	})
+}
+
+// UpdatePageCount sets the "page_count" field to the value that was provided on create.
+func (u *ReadProgressUpsertBulk) UpdatePageCount() *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdatePageCount()
+	})
+}
+
+// SetCompleted sets the "completed" field.
+func (u *ReadProgressUpsertBulk) SetCompleted(v bool) *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetCompleted(v)
+	})
+}
+
+// UpdateCompleted sets the "completed" field to the value that was provided on create.
+func (u *ReadProgressUpsertBulk) UpdateCompleted() *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdateCompleted()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReadProgressUpsertBulk) SetUpdatedAt(v time.Time) *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReadProgressUpsertBulk) UpdateUpdatedAt() *ReadProgressUpsertBulk {
+	return u.Update(func(s *ReadProgressUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReadProgressUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReadProgressCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReadProgressCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReadProgressUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
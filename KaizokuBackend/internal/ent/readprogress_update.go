@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/predicate"
+	"github.com/technobecet/kaizoku-go/internal/ent/readprogress"
+)
+
+// ReadProgressUpdate is the builder for updating ReadProgress entities.
+type ReadProgressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReadProgressMutation
+}
+
+// Where appends a list predicates to the ReadProgressUpdate builder.
+func (_u *ReadProgressUpdate) Where(ps ...predicate.ReadProgress) *ReadProgressUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ReadProgressUpdate) SetUserID(v uuid.UUID) *ReadProgressUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ReadProgressUpdate) SetNillableUserID(v *uuid.UUID) *ReadProgressUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *ReadProgressUpdate) SetSeriesID(v uuid.UUID) *ReadProgressUpdate {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *ReadProgressUpdate) SetNillableSeriesID(v *uuid.UUID) *ReadProgressUpdate {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (_u *ReadProgressUpdate) SetChapterNumber(v float64) *ReadProgressUpdate {
+	_u.mutation.ResetChapterNumber()
+	_u.mutation.SetChapterNumber(v)
+	return _u
+}
+
+// SetNillableChapterNumber sets the "chapter_number" field if the given value is not nil.
+func (_u *ReadProgressUpdate) SetNillableChapterNumber(v *float64) *ReadProgressUpdate {
+	if v != nil {
+		_u.SetChapterNumber(*v)
+	}
+	return _u
+}
+
+// AddChapterNumber adds value to the "chapter_number" field.
+func (_u *ReadProgressUpdate) AddChapterNumber(v float64) *ReadProgressUpdate {
+	_u.mutation.AddChapterNumber(v)
+	return _u
+}
+
+// SetPage sets the "page" field.
+func (_u *ReadProgressUpdate) SetPage(v int) *ReadProgressUpdate {
+	_u.mutation.ResetPage()
+	_u.mutation.SetPage(v)
+	return _u
+}
+
+// SetNillablePage sets the "page" field if the given value is not nil.
+func (_u *ReadProgressUpdate) SetNillablePage(v *int) *ReadProgressUpdate {
+	if v != nil {
+		_u.SetPage(*v)
+	}
+	return _u
+}
+
+// AddPage adds value to the "page" field.
+func (_u *ReadProgressUpdate) AddPage(v int) *ReadProgressUpdate {
+	_u.mutation.AddPage(v)
+	return _u
+}
+
+// SetPageCount sets the "page_count" field.
+func (_u *ReadProgressUpdate) SetPageCount(v int) *ReadProgressUpdate {
+	_u.mutation.ResetPageCount()
+	_u.mutation.SetPageCount(v)
+	return _u
+}
+
+// SetNillablePageCount sets the "page_count" field if the given value is not nil.
+func (_u *ReadProgressUpdate) SetNillablePageCount(v *int) *ReadProgressUpdate {
+	if v != nil {
+		_u.SetPageCount(*v)
+	}
+	return _u
+}
+
+// AddPageCount adds value to the "page_count" field.
+func (_u *ReadProgressUpdate) AddPageCount(v int) *ReadProgressUpdate {
+	_u.mutation.AddPageCount(v)
+	return _u
+}
+
+// SetCompleted sets the "completed" field.
+func (_u *ReadProgressUpdate) SetCompleted(v bool) *ReadProgressUpdate {
+	_u.mutation.SetCompleted(v)
+	return _u
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (_u *ReadProgressUpdate) SetNillableCompleted(v *bool) *ReadProgressUpdate {
+	if v != nil {
+		_u.SetCompleted(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReadProgressUpdate) SetUpdatedAt(v time.Time) *ReadProgressUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ReadProgressMutation object of the builder.
+func (_u *ReadProgressUpdate) Mutation() *ReadProgressMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReadProgressUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReadProgressUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReadProgressUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReadProgressUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReadProgressUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := readprogress.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ReadProgressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(readprogress.Table, readprogress.Columns, sqlgraph.NewFieldSpec(readprogress.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(readprogress.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(readprogress.FieldSeriesID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ChapterNumber(); ok {
+		_spec.SetField(readprogress.FieldChapterNumber, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChapterNumber(); ok {
+		_spec.AddField(readprogress.FieldChapterNumber, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Page(); ok {
+		_spec.SetField(readprogress.FieldPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPage(); ok {
+		_spec.AddField(readprogress.FieldPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PageCount(); ok {
+		_spec.SetField(readprogress.FieldPageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPageCount(); ok {
+		_spec.AddField(readprogress.FieldPageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Completed(); ok {
+		_spec.SetField(readprogress.FieldCompleted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(readprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReadProgressUpdateOne is the builder for updating a single ReadProgress entity.
+type ReadProgressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReadProgressMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ReadProgressUpdateOne) SetUserID(v uuid.UUID) *ReadProgressUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ReadProgressUpdateOne) SetNillableUserID(v *uuid.UUID) *ReadProgressUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *ReadProgressUpdateOne) SetSeriesID(v uuid.UUID) *ReadProgressUpdateOne {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *ReadProgressUpdateOne) SetNillableSeriesID(v *uuid.UUID) *ReadProgressUpdateOne {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// SetChapterNumber sets the "chapter_number" field.
+func (_u *ReadProgressUpdateOne) SetChapterNumber(v float64) *ReadProgressUpdateOne {
+	_u.mutation.ResetChapterNumber()
+	_u.mutation.SetChapterNumber(v)
+	return _u
+}
+
+// SetNillableChapterNumber sets the "chapter_number" field if the given value is not nil.
+func (_u *ReadProgressUpdateOne) SetNillableChapterNumber(v *float64) *ReadProgressUpdateOne {
+	if v != nil {
+		_u.SetChapterNumber(*v)
+	}
+	return _u
+}
+
+// AddChapterNumber adds value to the "chapter_number" field.
+func (_u *ReadProgressUpdateOne) AddChapterNumber(v float64) *ReadProgressUpdateOne {
+	_u.mutation.AddChapterNumber(v)
+	return _u
+}
+
+// SetPage sets the "page" field.
+func (_u *ReadProgressUpdateOne) SetPage(v int) *ReadProgressUpdateOne {
+	_u.mutation.ResetPage()
+	_u.mutation.SetPage(v)
+	return _u
+}
+
+// SetNillablePage sets the "page" field if the given value is not nil.
+func (_u *ReadProgressUpdateOne) SetNillablePage(v *int) *ReadProgressUpdateOne {
+	if v != nil {
+		_u.SetPage(*v)
+	}
+	return _u
+}
+
+// AddPage adds value to the "page" field.
+func (_u *ReadProgressUpdateOne) AddPage(v int) *ReadProgressUpdateOne {
+	_u.mutation.AddPage(v)
+	return _u
+}
+
+// SetPageCount sets the "page_count" field.
+func (_u *ReadProgressUpdateOne) SetPageCount(v int) *ReadProgressUpdateOne {
+	_u.mutation.ResetPageCount()
+	_u.mutation.SetPageCount(v)
+	return _u
+}
+
+// SetNillablePageCount sets the "page_count" field if the given value is not nil.
+func (_u *ReadProgressUpdateOne) SetNillablePageCount(v *int) *ReadProgressUpdateOne {
+	if v != nil {
+		_u.SetPageCount(*v)
+	}
+	return _u
+}
+
+// AddPageCount adds value to the "page_count" field.
+func (_u *ReadProgressUpdateOne) AddPageCount(v int) *ReadProgressUpdateOne {
+	_u.mutation.AddPageCount(v)
+	return _u
+}
+
+// SetCompleted sets the "completed" field.
+func (_u *ReadProgressUpdateOne) SetCompleted(v bool) *ReadProgressUpdateOne {
+	_u.mutation.SetCompleted(v)
+	return _u
+}
+
+// SetNillableCompleted sets the "completed" field if the given value is not nil.
+func (_u *ReadProgressUpdateOne) SetNillableCompleted(v *bool) *ReadProgressUpdateOne {
+	if v != nil {
+		_u.SetCompleted(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReadProgressUpdateOne) SetUpdatedAt(v time.Time) *ReadProgressUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ReadProgressMutation object of the builder.
+func (_u *ReadProgressUpdateOne) Mutation() *ReadProgressMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReadProgressUpdate builder.
+func (_u *ReadProgressUpdateOne) Where(ps ...predicate.ReadProgress) *ReadProgressUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReadProgressUpdateOne) Select(field string, fields ...string) *ReadProgressUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReadProgress entity.
+func (_u *ReadProgressUpdateOne) Save(ctx context.Context) (*ReadProgress, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReadProgressUpdateOne) SaveX(ctx context.Context) *ReadProgress {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReadProgressUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReadProgressUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReadProgressUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := readprogress.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ReadProgressUpdateOne) sqlSave(ctx context.Context) (_node *ReadProgress, err error) {
+	_spec := sqlgraph.NewUpdateSpec(readprogress.Table, readprogress.Columns, sqlgraph.NewFieldSpec(readprogress.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReadProgress.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, readprogress.FieldID)
+		for _, f := range fields {
+			if !readprogress.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != readprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(readprogress.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(readprogress.FieldSeriesID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ChapterNumber(); ok {
+		_spec.SetField(readprogress.FieldChapterNumber, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChapterNumber(); ok {
+		_spec.AddField(readprogress.FieldChapterNumber, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Page(); ok {
+		_spec.SetField(readprogress.FieldPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPage(); ok {
+		_spec.AddField(readprogress.FieldPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PageCount(); ok {
+		_spec.SetField(readprogress.FieldPageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPageCount(); ok {
+		_spec.AddField(readprogress.FieldPageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Completed(); ok {
+		_spec.SetField(readprogress.FieldCompleted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(readprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ReadProgress{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
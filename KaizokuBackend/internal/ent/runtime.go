@@ -6,21 +6,52 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/apikey"
+	"github.com/technobecet/kaizoku-go/internal/ent/auditevent"
 	"github.com/technobecet/kaizoku-go/internal/ent/downloadqueueitem"
 	"github.com/technobecet/kaizoku-go/internal/ent/etagcache"
+	"github.com/technobecet/kaizoku-go/internal/ent/hookrun"
 	"github.com/technobecet/kaizoku-go/internal/ent/importentry"
 	"github.com/technobecet/kaizoku-go/internal/ent/latestseries"
+	"github.com/technobecet/kaizoku-go/internal/ent/notification"
+	"github.com/technobecet/kaizoku-go/internal/ent/notifier"
 	"github.com/technobecet/kaizoku-go/internal/ent/providerstorage"
+	"github.com/technobecet/kaizoku-go/internal/ent/readprogress"
 	"github.com/technobecet/kaizoku-go/internal/ent/schema"
 	"github.com/technobecet/kaizoku-go/internal/ent/series"
 	"github.com/technobecet/kaizoku-go/internal/ent/seriesprovider"
+	"github.com/technobecet/kaizoku-go/internal/ent/session"
 	"github.com/technobecet/kaizoku-go/internal/ent/sourceevent"
+	"github.com/technobecet/kaizoku-go/internal/ent/syncprogress"
+	"github.com/technobecet/kaizoku-go/internal/ent/user"
+	"github.com/technobecet/kaizoku-go/internal/ent/webhook"
+	"github.com/technobecet/kaizoku-go/internal/ent/webhookdelivery"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[5].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[10].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() uuid.UUID)
 	downloadqueueitemFields := schema.DownloadQueueItem{}.Fields()
 	_ = downloadqueueitemFields
 	// downloadqueueitemDescStatus is the schema descriptor for status field.
@@ -49,6 +80,24 @@ func init() {
 	etagcacheDescLastUpdated := etagcacheFields[2].Descriptor()
 	// etagcache.DefaultLastUpdated holds the default value on creation for the last_updated field.
 	etagcache.DefaultLastUpdated = etagcacheDescLastUpdated.Default.(func() time.Time)
+	hookrunFields := schema.HookRun{}.Fields()
+	_ = hookrunFields
+	// hookrunDescAttempt is the schema descriptor for attempt field.
+	hookrunDescAttempt := hookrunFields[6].Descriptor()
+	// hookrun.DefaultAttempt holds the default value on creation for the attempt field.
+	hookrun.DefaultAttempt = hookrunDescAttempt.Default.(int)
+	// hookrunDescDurationMs is the schema descriptor for duration_ms field.
+	hookrunDescDurationMs := hookrunFields[11].Descriptor()
+	// hookrun.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	hookrun.DefaultDurationMs = hookrunDescDurationMs.Default.(int64)
+	// hookrunDescCreatedAt is the schema descriptor for created_at field.
+	hookrunDescCreatedAt := hookrunFields[12].Descriptor()
+	// hookrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	hookrun.DefaultCreatedAt = hookrunDescCreatedAt.Default.(func() time.Time)
+	// hookrunDescID is the schema descriptor for id field.
+	hookrunDescID := hookrunFields[0].Descriptor()
+	// hookrun.DefaultID holds the default value on creation for the id field.
+	hookrun.DefaultID = hookrunDescID.Default.(func() uuid.UUID)
 	importentryFields := schema.ImportEntry{}.Fields()
 	_ = importentryFields
 	// importentryDescStatus is the schema descriptor for status field.
@@ -81,6 +130,44 @@ func init() {
 	latestseriesDescInLibrary := latestseriesFields[16].Descriptor()
 	// latestseries.DefaultInLibrary holds the default value on creation for the in_library field.
 	latestseries.DefaultInLibrary = latestseriesDescInLibrary.Default.(int)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescUrgent is the schema descriptor for urgent field.
+	notificationDescUrgent := notificationFields[5].Descriptor()
+	// notification.DefaultUrgent holds the default value on creation for the urgent field.
+	notification.DefaultUrgent = notificationDescUrgent.Default.(bool)
+	// notificationDescStatus is the schema descriptor for status field.
+	notificationDescStatus := notificationFields[6].Descriptor()
+	// notification.DefaultStatus holds the default value on creation for the status field.
+	notification.DefaultStatus = notificationDescStatus.Default.(string)
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[8].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() uuid.UUID)
+	notifierFields := schema.Notifier{}.Fields()
+	_ = notifierFields
+	// notifierDescEnabled is the schema descriptor for enabled field.
+	notifierDescEnabled := notifierFields[11].Descriptor()
+	// notifier.DefaultEnabled holds the default value on creation for the enabled field.
+	notifier.DefaultEnabled = notifierDescEnabled.Default.(bool)
+	// notifierDescCreatedAt is the schema descriptor for created_at field.
+	notifierDescCreatedAt := notifierFields[12].Descriptor()
+	// notifier.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notifier.DefaultCreatedAt = notifierDescCreatedAt.Default.(func() time.Time)
+	// notifierDescUpdatedAt is the schema descriptor for updated_at field.
+	notifierDescUpdatedAt := notifierFields[13].Descriptor()
+	// notifier.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notifier.DefaultUpdatedAt = notifierDescUpdatedAt.Default.(func() time.Time)
+	// notifier.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notifier.UpdateDefaultUpdatedAt = notifierDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notifierDescID is the schema descriptor for id field.
+	notifierDescID := notifierFields[0].Descriptor()
+	// notifier.DefaultID holds the default value on creation for the id field.
+	notifier.DefaultID = notifierDescID.Default.(func() uuid.UUID)
 	providerstorageFields := schema.ProviderStorage{}.Fields()
 	_ = providerstorageFields
 	// providerstorageDescName is the schema descriptor for name field.
@@ -103,10 +190,38 @@ func init() {
 	providerstorageDescIsDisabled := providerstorageFields[7].Descriptor()
 	// providerstorage.DefaultIsDisabled holds the default value on creation for the is_disabled field.
 	providerstorage.DefaultIsDisabled = providerstorageDescIsDisabled.Default.(bool)
+	// providerstorageDescIsObsolete is the schema descriptor for is_obsolete field.
+	providerstorageDescIsObsolete := providerstorageFields[8].Descriptor()
+	// providerstorage.DefaultIsObsolete holds the default value on creation for the is_obsolete field.
+	providerstorage.DefaultIsObsolete = providerstorageDescIsObsolete.Default.(bool)
 	// providerstorageDescID is the schema descriptor for id field.
 	providerstorageDescID := providerstorageFields[0].Descriptor()
 	// providerstorage.DefaultID holds the default value on creation for the id field.
 	providerstorage.DefaultID = providerstorageDescID.Default.(func() uuid.UUID)
+	readprogressFields := schema.ReadProgress{}.Fields()
+	_ = readprogressFields
+	// readprogressDescPage is the schema descriptor for page field.
+	readprogressDescPage := readprogressFields[4].Descriptor()
+	// readprogress.DefaultPage holds the default value on creation for the page field.
+	readprogress.DefaultPage = readprogressDescPage.Default.(int)
+	// readprogressDescPageCount is the schema descriptor for page_count field.
+	readprogressDescPageCount := readprogressFields[5].Descriptor()
+	// readprogress.DefaultPageCount holds the default value on creation for the page_count field.
+	readprogress.DefaultPageCount = readprogressDescPageCount.Default.(int)
+	// readprogressDescCompleted is the schema descriptor for completed field.
+	readprogressDescCompleted := readprogressFields[6].Descriptor()
+	// readprogress.DefaultCompleted holds the default value on creation for the completed field.
+	readprogress.DefaultCompleted = readprogressDescCompleted.Default.(bool)
+	// readprogressDescUpdatedAt is the schema descriptor for updated_at field.
+	readprogressDescUpdatedAt := readprogressFields[7].Descriptor()
+	// readprogress.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	readprogress.DefaultUpdatedAt = readprogressDescUpdatedAt.Default.(func() time.Time)
+	// readprogress.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	readprogress.UpdateDefaultUpdatedAt = readprogressDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// readprogressDescID is the schema descriptor for id field.
+	readprogressDescID := readprogressFields[0].Descriptor()
+	// readprogress.DefaultID holds the default value on creation for the id field.
+	readprogress.DefaultID = readprogressDescID.Default.(func() uuid.UUID)
 	seriesFields := schema.Series{}.Fields()
 	_ = seriesFields
 	// seriesDescThumbnailURL is the schema descriptor for thumbnail_url field.
@@ -137,6 +252,10 @@ func init() {
 	seriesDescPauseDownloads := seriesFields[11].Descriptor()
 	// series.DefaultPauseDownloads holds the default value on creation for the pause_downloads field.
 	series.DefaultPauseDownloads = seriesDescPauseDownloads.Default.(bool)
+	// seriesDescNotify is the schema descriptor for notify field.
+	seriesDescNotify := seriesFields[12].Descriptor()
+	// series.DefaultNotify holds the default value on creation for the notify field.
+	series.DefaultNotify = seriesDescNotify.Default.(bool)
 	// seriesDescID is the schema descriptor for id field.
 	seriesDescID := seriesFields[0].Descriptor()
 	// series.DefaultID holds the default value on creation for the id field.
@@ -195,6 +314,16 @@ func init() {
 	seriesproviderDescID := seriesproviderFields[0].Descriptor()
 	// seriesprovider.DefaultID holds the default value on creation for the id field.
 	seriesprovider.DefaultID = seriesproviderDescID.Default.(func() uuid.UUID)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[3].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
 	sourceeventFields := schema.SourceEvent{}.Fields()
 	_ = sourceeventFields
 	// sourceeventDescCreatedAt is the schema descriptor for created_at field.
@@ -205,4 +334,94 @@ func init() {
 	sourceeventDescID := sourceeventFields[0].Descriptor()
 	// sourceevent.DefaultID holds the default value on creation for the id field.
 	sourceevent.DefaultID = sourceeventDescID.Default.(func() uuid.UUID)
+	syncprogressFields := schema.SyncProgress{}.Fields()
+	_ = syncprogressFields
+	// syncprogressDescDevice is the schema descriptor for device field.
+	syncprogressDescDevice := syncprogressFields[5].Descriptor()
+	// syncprogress.DefaultDevice holds the default value on creation for the device field.
+	syncprogress.DefaultDevice = syncprogressDescDevice.Default.(string)
+	// syncprogressDescDeviceID is the schema descriptor for device_id field.
+	syncprogressDescDeviceID := syncprogressFields[6].Descriptor()
+	// syncprogress.DefaultDeviceID holds the default value on creation for the device_id field.
+	syncprogress.DefaultDeviceID = syncprogressDescDeviceID.Default.(string)
+	// syncprogressDescUpdatedAt is the schema descriptor for updated_at field.
+	syncprogressDescUpdatedAt := syncprogressFields[7].Descriptor()
+	// syncprogress.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	syncprogress.DefaultUpdatedAt = syncprogressDescUpdatedAt.Default.(func() time.Time)
+	// syncprogress.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	syncprogress.UpdateDefaultUpdatedAt = syncprogressDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// syncprogressDescID is the schema descriptor for id field.
+	syncprogressDescID := syncprogressFields[0].Descriptor()
+	// syncprogress.DefaultID holds the default value on creation for the id field.
+	syncprogress.DefaultID = syncprogressDescID.Default.(func() uuid.UUID)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescRole is the schema descriptor for role field.
+	userDescRole := userFields[4].Descriptor()
+	// user.DefaultRole holds the default value on creation for the role field.
+	user.DefaultRole = userDescRole.Default.(string)
+	// userDescSource is the schema descriptor for source field.
+	userDescSource := userFields[5].Descriptor()
+	// user.DefaultSource holds the default value on creation for the source field.
+	user.DefaultSource = userDescSource.Default.(string)
+	// userDescDisabled is the schema descriptor for disabled field.
+	userDescDisabled := userFields[8].Descriptor()
+	// user.DefaultDisabled holds the default value on creation for the disabled field.
+	user.DefaultDisabled = userDescDisabled.Default.(bool)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[9].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[6].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[7].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[8].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() uuid.UUID)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescStatus is the schema descriptor for status field.
+	webhookdeliveryDescStatus := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultStatus holds the default value on creation for the status field.
+	webhookdelivery.DefaultStatus = webhookdeliveryDescStatus.Default.(string)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescDurationMs is the schema descriptor for duration_ms field.
+	webhookdeliveryDescDurationMs := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	webhookdelivery.DefaultDurationMs = webhookdeliveryDescDurationMs.Default.(int64)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[12].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[13].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
 }
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// APIKey holds the schema definition for an API key used to authenticate
+// requests to the /api routes. Only a SHA-256 hash of the key is stored;
+// the plaintext is shown once at creation time.
+type APIKey struct {
+	ent.Schema
+}
+
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.String("name").Comment("Human-readable label for the key"),
+		field.UUID("user_id", uuid.UUID{}).Optional().Nillable().Comment("Owning user; the key acts with this user's role. Nil for the bootstrap key, which acts as admin"),
+		field.String("prefix").Immutable().Comment("First characters of the key, shown in listings to identify it"),
+		field.String("key_hash").Unique().Immutable().Sensitive().Comment("Hex-encoded SHA-256 of the full key"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+func (APIKey) Edges() []ent.Edge {
+	return nil
+}
+
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("revoked_at"),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// AuditEvent records a significant user or system action: who did it, when,
+// what it targeted and which fields changed.
+type AuditEvent struct {
+	ent.Schema
+}
+
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.String("actor_kind").Comment("user, api_key, proxy, session, system or anonymous"),
+		field.String("actor_id").Optional().Comment("User ID, API key ID or job component"),
+		field.String("actor_name").Comment("Username, key name or system component"),
+		field.String("action").Comment("Dotted action name, e.g. series.delete, settings.update"),
+		field.String("target_type").Comment("series, provider, settings, download, chapter, import"),
+		field.JSON("target_ids", []string{}).Optional().Comment("IDs of the affected entities"),
+		field.JSON("diff", map[string]types.AuditChange{}).Optional().Comment("Changed fields: old and new values"),
+		field.JSON("details", map[string]interface{}{}).Optional().Comment("Extra context (titles, file names, counts)"),
+		field.String("ip").Optional().Comment("Client IP for HTTP-triggered actions"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (AuditEvent) Edges() []ent.Edge {
+	return nil
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("action", "created_at"),
+		index.Fields("actor_name", "created_at"),
+		index.Fields("target_type", "created_at"),
+	}
+}
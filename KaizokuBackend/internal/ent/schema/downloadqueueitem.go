@@ -28,6 +28,8 @@ func (DownloadQueueItem) Fields() []ent.Field {
 		field.Time("started_at").Optional().Nillable(),
 		field.Time("completed_at").Optional().Nillable(),
 		field.JSON("args", types.DownloadChapterArgs{}),
+		field.String("worker_id").Optional().Nillable().Comment("Dispatcher instance that claimed the running item"),
+		field.Time("lease_expires_at").Optional().Nillable().Comment("Running items whose lease expired are reclaimed by any instance"),
 	}
 }
 
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// HookRun records one attempt of a script hook, with the command's output.
+type HookRun struct {
+	ent.Schema
+}
+
+func (HookRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.String("hook").Comment("Name of the configured hook"),
+		field.String("event"),
+		field.UUID("series_id", uuid.UUID{}).Optional().Nillable(),
+		field.String("series_title").Optional(),
+		field.Float("chapter_number").Optional().Nillable(),
+		field.Int("attempt").Default(1),
+		field.String("status").Comment("succeeded, failed or timed_out"),
+		field.Int("exit_code").Optional().Nillable(),
+		field.Text("output").Optional().Comment("Combined stdout and stderr, truncated"),
+		field.String("error").Optional(),
+		field.Int64("duration_ms").Default(0),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (HookRun) Edges() []ent.Edge {
+	return nil
+}
+
+func (HookRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hook", "created_at"),
+		index.Fields("status", "created_at"),
+		index.Fields("created_at"),
+	}
+}
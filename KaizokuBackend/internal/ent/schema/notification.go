@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Notification is one rendered message for a notifier. Messages raised
+// during the notifier's quiet hours are held and sent later as a digest.
+type Notification struct {
+	ent.Schema
+}
+
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("notifier_id", uuid.UUID{}),
+		field.String("event_type"),
+		field.String("title"),
+		field.Text("message"),
+		field.Bool("urgent").Default(false).Comment("Sent with high priority (failures that need attention)"),
+		field.String("status").Default("pending").Comment("pending, held, sent or failed"),
+		field.String("error").Optional().Comment("Error of the last failed attempt"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("sent_at").Optional().Nillable(),
+	}
+}
+
+func (Notification) Edges() []ent.Edge {
+	return nil
+}
+
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("notifier_id", "status"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Notifier is a push-notification target (ntfy, Gotify or Apprise API).
+type Notifier struct {
+	ent.Schema
+}
+
+func (Notifier) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.String("name"),
+		field.String("kind").Comment("ntfy, gotify or apprise"),
+		field.String("url"),
+		field.String("topic").Optional().Comment("ntfy topic or Apprise tag"),
+		field.String("token").Optional().Sensitive().Comment("ntfy access token, Gotify application token or Apprise bearer token"),
+		field.JSON("events", []string{}).Optional().Comment("Event types to notify about; empty notifies about every event"),
+		field.Text("title_template").Optional().Comment("Go text/template for the title; empty uses the built-in title"),
+		field.Text("message_template").Optional().Comment("Go text/template for the message; empty uses the built-in message"),
+		field.String("quiet_start").Optional().Comment("Start of quiet hours (HH:MM, server time); empty disables quiet hours"),
+		field.String("quiet_end").Optional().Comment("End of quiet hours (HH:MM, server time)"),
+		field.Bool("enabled").Default(true),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Notifier) Edges() []ent.Edge {
+	return nil
+}
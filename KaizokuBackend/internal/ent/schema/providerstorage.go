@@ -23,6 +23,7 @@ func (ProviderStorage) Fields() []ent.Field {
 		field.Int64("version_code").Default(0),
 		field.Bool("is_storage").Default(true),
 		field.Bool("is_disabled").Default(false),
+		field.Bool("is_obsolete").Default(false).Comment("Extension was removed from its repository"),
 		field.JSON("mappings", []types.ProviderMapping{}).Optional(),
 	}
 }
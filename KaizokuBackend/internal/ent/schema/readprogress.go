@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ReadProgress records how far a user has read a chapter of a series.
+type ReadProgress struct {
+	ent.Schema
+}
+
+func (ReadProgress) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Comment("Reading user; uuid.Nil when authentication is disabled"),
+		field.UUID("series_id", uuid.UUID{}),
+		field.Float("chapter_number"),
+		field.Int("page").Default(0).Comment("Zero-based last page viewed"),
+		field.Int("page_count").Default(0).Comment("Pages in the chapter when last read, 0 if unknown"),
+		field.Bool("completed").Default(false),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (ReadProgress) Edges() []ent.Edge {
+	return nil
+}
+
+func (ReadProgress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "series_id", "chapter_number").Unique(),
+		index.Fields("user_id", "updated_at"),
+		index.Fields("series_id"),
+	}
+}
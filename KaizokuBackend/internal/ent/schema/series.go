@@ -26,6 +26,7 @@ func (Series) Fields() []ent.Field {
 		field.String("type").Optional().Nillable(),
 		field.Int("chapter_count").Default(0),
 		field.Bool("pause_downloads").Default(false),
+		field.Bool("notify").Default(false).Comment("Send push notifications for new chapters"),
 	}
 }
 
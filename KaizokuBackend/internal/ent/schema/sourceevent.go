@@ -23,7 +23,7 @@ func (SourceEvent) Fields() []ent.Field {
 		field.String("source_name").Comment("Human-readable source display name"),
 		field.String("language").Comment("Source language code (e.g. en, ko)"),
 		field.String("event_type").Comment("get_latest, get_chapters, download, search, get_popular"),
-		field.String("status").Comment("success, failed, partial"),
+		field.String("status").Comment("success, failed, partial, cancelled"),
 		field.Int64("duration_ms").Comment("Operation wall-clock time in milliseconds"),
 		field.String("error_message").Optional().Nillable().Comment("Error details on failure"),
 		field.String("error_category").Optional().Nillable().Comment("network, timeout, rate_limit, server_error, not_found, parse, cancelled, unknown"),
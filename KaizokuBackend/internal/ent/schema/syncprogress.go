@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SyncProgress stores the last position a KOReader device reported for a
+// document, as sent over the kosync protocol.
+type SyncProgress struct {
+	ent.Schema
+}
+
+func (SyncProgress) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("user_id", uuid.UUID{}).Comment("Syncing user; uuid.Nil when authentication is disabled"),
+		field.String("document").Comment("KOReader document hash (partial MD5 of the file or MD5 of its name)"),
+		field.String("progress").Comment("Opaque position; the page number for CBZ files"),
+		field.Float("percentage"),
+		field.String("device").Default(""),
+		field.String("device_id").Default(""),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (SyncProgress) Edges() []ent.Edge {
+	return nil
+}
+
+func (SyncProgress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "document").Unique(),
+	}
+}
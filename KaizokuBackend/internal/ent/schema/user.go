@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// User holds the schema definition for a Kaizoku user account.
+type User struct {
+	ent.Schema
+}
+
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.String("username").Unique(),
+		field.String("password_hash").Optional().Sensitive().Comment("bcrypt hash; empty for externally authenticated users"),
+		field.String("kosync_hash").Optional().Sensitive().Comment("bcrypt hash of the MD5 password digest KOReader sends; set alongside password_hash"),
+		field.String("role").Default("viewer").Comment("admin, manager or viewer"),
+		field.String("source").Default("local").Comment("How the account was created: local, proxy or oidc"),
+		field.String("external_id").Optional().Comment("Identity provider subject (OIDC sub) for external accounts"),
+		field.String("feed_token_hash").Optional().Nillable().Unique().Sensitive().Comment("Hex-encoded SHA-256 of the read-only token for the Atom and calendar feeds"),
+		field.Bool("disabled").Default(false),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("last_login_at").Optional().Nillable(),
+	}
+}
+
+func (User) Edges() []ent.Edge {
+	return nil
+}
+
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("role"),
+		index.Fields("source", "external_id"),
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Webhook is an HTTP endpoint that receives library and download events.
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.String("name"),
+		field.String("url"),
+		field.JSON("events", []string{}).Optional().Comment("Event types to deliver; empty delivers every event"),
+		field.String("secret").Optional().Sensitive().Comment("HMAC-SHA256 signing key; empty sends unsigned deliveries"),
+		field.Text("body_template").Optional().Comment("Go text/template rendering the JSON body; empty sends the event as JSON"),
+		field.Bool("enabled").Default(true),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return nil
+}
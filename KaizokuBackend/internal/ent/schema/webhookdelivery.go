@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery records one event sent to a webhook, including every
+// retry attempt's outcome.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("webhook_id", uuid.UUID{}),
+		field.String("event_id"),
+		field.String("event_type"),
+		field.Text("payload").Comment("Rendered request body"),
+		field.String("status").Default("pending").Comment("pending, succeeded or failed"),
+		field.Int("attempts").Default(0),
+		field.Int("response_status").Optional().Nillable().Comment("HTTP status of the last attempt"),
+		field.Text("response_body").Optional().Comment("Start of the last response body"),
+		field.String("error").Optional().Comment("Error of the last failed attempt"),
+		field.Int64("duration_ms").Default(0).Comment("Duration of the last attempt"),
+		field.Time("next_attempt_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return nil
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "created_at"),
+		index.Fields("status", "created_at"),
+		index.Fields("created_at"),
+	}
+}
@@ -40,6 +40,8 @@ type Series struct {
 	ChapterCount int `json:"chapter_count,omitempty"`
 	// PauseDownloads holds the value of the "pause_downloads" field.
 	PauseDownloads bool `json:"pause_downloads,omitempty"`
+	// Send push notifications for new chapters
+	Notify bool `json:"notify,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SeriesQuery when eager-loading is set.
 	Edges        SeriesEdges `json:"edges"`
@@ -82,7 +84,7 @@ func (*Series) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case series.FieldGenre:
 			values[i] = new([]byte)
-		case series.FieldPauseDownloads:
+		case series.FieldPauseDownloads, series.FieldNotify:
 			values[i] = new(sql.NullBool)
 		case series.FieldChapterCount:
 			values[i] = new(sql.NullInt64)
@@ -180,6 +182,12 @@ func (_m *Series) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PauseDownloads = value.Bool
 			}
+		case series.FieldNotify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notify", values[i])
+			} else if value.Valid {
+				_m.Notify = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -260,6 +268,9 @@ func (_m *Series) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("pause_downloads=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PauseDownloads))
+	builder.WriteString(", ")
+	builder.WriteString("notify=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Notify))
 	builder.WriteByte(')')
 	return builder.String()
 }
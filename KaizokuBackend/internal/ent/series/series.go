@@ -35,6 +35,8 @@ const (
 	FieldChapterCount = "chapter_count"
 	// FieldPauseDownloads holds the string denoting the pause_downloads field in the database.
 	FieldPauseDownloads = "pause_downloads"
+	// FieldNotify holds the string denoting the notify field in the database.
+	FieldNotify = "notify"
 	// EdgeProviders holds the string denoting the providers edge name in mutations.
 	EdgeProviders = "providers"
 	// EdgeLatestSeries holds the string denoting the latest_series edge name in mutations.
@@ -71,6 +73,7 @@ var Columns = []string{
 	FieldType,
 	FieldChapterCount,
 	FieldPauseDownloads,
+	FieldNotify,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -98,6 +101,8 @@ var (
 	DefaultChapterCount int
 	// DefaultPauseDownloads holds the default value on creation for the "pause_downloads" field.
 	DefaultPauseDownloads bool
+	// DefaultNotify holds the default value on creation for the "notify" field.
+	DefaultNotify bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -160,6 +165,11 @@ func ByPauseDownloads(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPauseDownloads, opts...).ToFunc()
 }
 
+// ByNotify orders the results by the notify field.
+func ByNotify(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotify, opts...).ToFunc()
+}
+
 // ByProvidersCount orders the results by providers count.
 func ByProvidersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
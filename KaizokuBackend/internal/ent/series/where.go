@@ -104,6 +104,11 @@ func PauseDownloads(v bool) predicate.Series {
 	return predicate.Series(sql.FieldEQ(FieldPauseDownloads, v))
 }
 
+// Notify applies equality check predicate on the "notify" field. It's identical to NotifyEQ.
+func Notify(v bool) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldNotify, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Series {
 	return predicate.Series(sql.FieldEQ(FieldTitle, v))
@@ -704,6 +709,16 @@ func PauseDownloadsNEQ(v bool) predicate.Series {
 	return predicate.Series(sql.FieldNEQ(FieldPauseDownloads, v))
 }
 
+// NotifyEQ applies the EQ predicate on the "notify" field.
+func NotifyEQ(v bool) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldNotify, v))
+}
+
+// NotifyNEQ applies the NEQ predicate on the "notify" field.
+func NotifyNEQ(v bool) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldNotify, v))
+}
+
 // HasProviders applies the HasEdge predicate on the "providers" edge.
 func HasProviders() predicate.Series {
 	return predicate.Series(func(s *sql.Selector) {
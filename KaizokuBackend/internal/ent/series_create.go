@@ -163,6 +163,20 @@ func (_c *SeriesCreate) SetNillablePauseDownloads(v *bool) *SeriesCreate {
 	return _c
 }
 
+// SetNotify sets the "notify" field.
+func (_c *SeriesCreate) SetNotify(v bool) *SeriesCreate {
+	_c.mutation.SetNotify(v)
+	return _c
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (_c *SeriesCreate) SetNillableNotify(v *bool) *SeriesCreate {
+	if v != nil {
+		_c.SetNotify(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *SeriesCreate) SetID(v uuid.UUID) *SeriesCreate {
 	_c.mutation.SetID(v)
@@ -270,6 +284,10 @@ func (_c *SeriesCreate) defaults() {
 		v := series.DefaultPauseDownloads
 		_c.mutation.SetPauseDownloads(v)
 	}
+	if _, ok := _c.mutation.Notify(); !ok {
+		v := series.DefaultNotify
+		_c.mutation.SetNotify(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := series.DefaultID()
 		_c.mutation.SetID(v)
@@ -302,6 +320,9 @@ func (_c *SeriesCreate) check() error {
 	if _, ok := _c.mutation.PauseDownloads(); !ok {
 		return &ValidationError{Name: "pause_downloads", err: errors.New(`ent: missing required field "Series.pause_downloads"`)}
 	}
+	if _, ok := _c.mutation.Notify(); !ok {
+		return &ValidationError{Name: "notify", err: errors.New(`ent: missing required field "Series.notify"`)}
+	}
 	return nil
 }
 
@@ -382,6 +403,10 @@ func (_c *SeriesCreate) createSpec() (*Series, *sqlgraph.CreateSpec) {
 		_spec.SetField(series.FieldPauseDownloads, field.TypeBool, value)
 		_node.PauseDownloads = value
 	}
+	if value, ok := _c.mutation.Notify(); ok {
+		_spec.SetField(series.FieldNotify, field.TypeBool, value)
+		_node.Notify = value
+	}
 	if nodes := _c.mutation.ProvidersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -622,6 +647,18 @@ func (u *SeriesUpsert) UpdatePauseDownloads() *SeriesUpsert {
 	return u
 }
 
+// SetNotify sets the "notify" field.
+func (u *SeriesUpsert) SetNotify(v bool) *SeriesUpsert {
+	u.Set(series.FieldNotify, v)
+	return u
+}
+
+// UpdateNotify sets the "notify" field to the value that was provided on create.
+func (u *SeriesUpsert) UpdateNotify() *SeriesUpsert {
+	u.SetExcluded(series.FieldNotify)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -852,6 +889,20 @@ func (u *SeriesUpsertOne) UpdatePauseDownloads() *SeriesUpsertOne {
 	})
 }
 
+// SetNotify sets the "notify" field.
+func (u *SeriesUpsertOne) SetNotify(v bool) *SeriesUpsertOne {
+	return u.Update(func(s *SeriesUpsert) {
+		s.SetNotify(v)
+	})
+}
+
+// UpdateNotify sets the "notify" field to the value that was provided on create.
+func (u *SeriesUpsertOne) UpdateNotify() *SeriesUpsertOne {
+	return u.Update(func(s *SeriesUpsert) {
+		s.UpdateNotify()
+	})
+}
+
 // Exec executes the query.
 func (u *SeriesUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1249,6 +1300,20 @@ func (u *SeriesUpsertBulk) UpdatePauseDownloads() *SeriesUpsertBulk {
 	})
 }
 
+// SetNotify sets the "notify" field.
+func (u *SeriesUpsertBulk) SetNotify(v bool) *SeriesUpsertBulk {
+	return u.Update(func(s *SeriesUpsert) {
+		s.SetNotify(v)
+	})
+}
+
+// UpdateNotify sets the "notify" field to the value that was provided on create.
+func (u *SeriesUpsertBulk) UpdateNotify() *SeriesUpsertBulk {
+	return u.Update(func(s *SeriesUpsert) {
+		s.UpdateNotify()
+	})
+}
+
 // Exec executes the query.
 func (u *SeriesUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
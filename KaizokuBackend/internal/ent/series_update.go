@@ -208,6 +208,20 @@ func (_u *SeriesUpdate) SetNillablePauseDownloads(v *bool) *SeriesUpdate {
 	return _u
 }
 
+// SetNotify sets the "notify" field.
+func (_u *SeriesUpdate) SetNotify(v bool) *SeriesUpdate {
+	_u.mutation.SetNotify(v)
+	return _u
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (_u *SeriesUpdate) SetNillableNotify(v *bool) *SeriesUpdate {
+	if v != nil {
+		_u.SetNotify(*v)
+	}
+	return _u
+}
+
 // AddProviderIDs adds the "providers" edge to the SeriesProvider entity by IDs.
 func (_u *SeriesUpdate) AddProviderIDs(ids ...uuid.UUID) *SeriesUpdate {
 	_u.mutation.AddProviderIDs(ids...)
@@ -371,6 +385,9 @@ func (_u *SeriesUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.PauseDownloads(); ok {
 		_spec.SetField(series.FieldPauseDownloads, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Notify(); ok {
+		_spec.SetField(series.FieldNotify, field.TypeBool, value)
+	}
 	if _u.mutation.ProvidersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -658,6 +675,20 @@ func (_u *SeriesUpdateOne) SetNillablePauseDownloads(v *bool) *SeriesUpdateOne {
 	return _u
 }
 
+// SetNotify sets the "notify" field.
+func (_u *SeriesUpdateOne) SetNotify(v bool) *SeriesUpdateOne {
+	_u.mutation.SetNotify(v)
+	return _u
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (_u *SeriesUpdateOne) SetNillableNotify(v *bool) *SeriesUpdateOne {
+	if v != nil {
+		_u.SetNotify(*v)
+	}
+	return _u
+}
+
 // AddProviderIDs adds the "providers" edge to the SeriesProvider entity by IDs.
 func (_u *SeriesUpdateOne) AddProviderIDs(ids ...uuid.UUID) *SeriesUpdateOne {
 	_u.mutation.AddProviderIDs(ids...)
@@ -851,6 +882,9 @@ func (_u *SeriesUpdateOne) sqlSave(ctx context.Context) (_node *Series, err erro
 	if value, ok := _u.mutation.PauseDownloads(); ok {
 		_spec.SetField(series.FieldPauseDownloads, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Notify(); ok {
+		_spec.SetField(series.FieldNotify, field.TypeBool, value)
+	}
 	if _u.mutation.ProvidersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
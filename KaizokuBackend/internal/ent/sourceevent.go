@@ -27,7 +27,7 @@ type SourceEvent struct {
 	Language string `json:"language,omitempty"`
 	// get_latest, get_chapters, download, search, get_popular
 	EventType string `json:"event_type,omitempty"`
-	// success, failed, partial
+	// success, failed, partial, cancelled
 	Status string `json:"status,omitempty"`
 	// Operation wall-clock time in milliseconds
 	DurationMs int64 `json:"duration_ms,omitempty"`
@@ -0,0 +1,883 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/syncprogress"
+)
+
+// SyncProgressCreate is the builder for creating a SyncProgress entity.
+type SyncProgressCreate struct {
+	config
+	mutation *SyncProgressMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *SyncProgressCreate) SetUserID(v uuid.UUID) *SyncProgressCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDocument sets the "document" field.
+func (_c *SyncProgressCreate) SetDocument(v string) *SyncProgressCreate {
+	_c.mutation.SetDocument(v)
+	return _c
+}
+
+// SetProgress sets the "progress" field.
+func (_c *SyncProgressCreate) SetProgress(v string) *SyncProgressCreate {
+	_c.mutation.SetProgress(v)
+	return _c
+}
+
+// SetPercentage sets the "percentage" field.
+func (_c *SyncProgressCreate) SetPercentage(v float64) *SyncProgressCreate {
+	_c.mutation.SetPercentage(v)
+	return _c
+}
+
+// SetDevice sets the "device" field.
+func (_c *SyncProgressCreate) SetDevice(v string) *SyncProgressCreate {
+	_c.mutation.SetDevice(v)
+	return _c
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_c *SyncProgressCreate) SetNillableDevice(v *string) *SyncProgressCreate {
+	if v != nil {
+		_c.SetDevice(*v)
+	}
+	return _c
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *SyncProgressCreate) SetDeviceID(v string) *SyncProgressCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_c *SyncProgressCreate) SetNillableDeviceID(v *string) *SyncProgressCreate {
+	if v != nil {
+		_c.SetDeviceID(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SyncProgressCreate) SetUpdatedAt(v time.Time) *SyncProgressCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SyncProgressCreate) SetNillableUpdatedAt(v *time.Time) *SyncProgressCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SyncProgressCreate) SetID(v uuid.UUID) *SyncProgressCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SyncProgressCreate) SetNillableID(v *uuid.UUID) *SyncProgressCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SyncProgressMutation object of the builder.
+func (_c *SyncProgressCreate) Mutation() *SyncProgressMutation {
+	return _c.mutation
+}
+
+// Save creates the SyncProgress in the database.
+func (_c *SyncProgressCreate) Save(ctx context.Context) (*SyncProgress, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SyncProgressCreate) SaveX(ctx context.Context) *SyncProgress {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SyncProgressCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SyncProgressCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SyncProgressCreate) defaults() {
+	if _, ok := _c.mutation.Device(); !ok {
+		v := syncprogress.DefaultDevice
+		_c.mutation.SetDevice(v)
+	}
+	if _, ok := _c.mutation.DeviceID(); !ok {
+		v := syncprogress.DefaultDeviceID
+		_c.mutation.SetDeviceID(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := syncprogress.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := syncprogress.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SyncProgressCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SyncProgress.user_id"`)}
+	}
+	if _, ok := _c.mutation.Document(); !ok {
+		return &ValidationError{Name: "document", err: errors.New(`ent: missing required field "SyncProgress.document"`)}
+	}
+	if _, ok := _c.mutation.Progress(); !ok {
+		return &ValidationError{Name: "progress", err: errors.New(`ent: missing required field "SyncProgress.progress"`)}
+	}
+	if _, ok := _c.mutation.Percentage(); !ok {
+		return &ValidationError{Name: "percentage", err: errors.New(`ent: missing required field "SyncProgress.percentage"`)}
+	}
+	if _, ok := _c.mutation.Device(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`ent: missing required field "SyncProgress.device"`)}
+	}
+	if _, ok := _c.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "SyncProgress.device_id"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SyncProgress.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SyncProgressCreate) sqlSave(ctx context.Context) (*SyncProgress, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SyncProgressCreate) createSpec() (*SyncProgress, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SyncProgress{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(syncprogress.Table, sqlgraph.NewFieldSpec(syncprogress.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(syncprogress.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Document(); ok {
+		_spec.SetField(syncprogress.FieldDocument, field.TypeString, value)
+		_node.Document = value
+	}
+	if value, ok := _c.mutation.Progress(); ok {
+		_spec.SetField(syncprogress.FieldProgress, field.TypeString, value)
+		_node.Progress = value
+	}
+	if value, ok := _c.mutation.Percentage(); ok {
+		_spec.SetField(syncprogress.FieldPercentage, field.TypeFloat64, value)
+		_node.Percentage = value
+	}
+	if value, ok := _c.mutation.Device(); ok {
+		_spec.SetField(syncprogress.FieldDevice, field.TypeString, value)
+		_node.Device = value
+	}
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(syncprogress.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(syncprogress.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SyncProgress.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SyncProgressUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SyncProgressCreate) OnConflict(opts ...sql.ConflictOption) *SyncProgressUpsertOne {
+	_c.conflict = opts
+	return &SyncProgressUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SyncProgress.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SyncProgressCreate) OnConflictColumns(columns ...string) *SyncProgressUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SyncProgressUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SyncProgressUpsertOne is the builder for "upsert"-ing
+	//  one SyncProgress node.
+	SyncProgressUpsertOne struct {
+		create *SyncProgressCreate
+	}
+
+	// SyncProgressUpsert is the "OnConflict" setter.
+	SyncProgressUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *SyncProgressUpsert) SetUserID(v uuid.UUID) *SyncProgressUpsert {
+	u.Set(syncprogress.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SyncProgressUpsert) UpdateUserID() *SyncProgressUpsert {
+	u.SetExcluded(syncprogress.FieldUserID)
+	return u
+}
+
+// SetDocument sets the "document" field.
+func (u *SyncProgressUpsert) SetDocument(v string) *SyncProgressUpsert {
+	u.Set(syncprogress.FieldDocument, v)
+	return u
+}
+
+// UpdateDocument sets the "document" field to the value that was provided on create.
+func (u *SyncProgressUpsert) UpdateDocument() *SyncProgressUpsert {
+	u.SetExcluded(syncprogress.FieldDocument)
+	return u
+}
+
+// SetProgress sets the "progress" field.
+func (u *SyncProgressUpsert) SetProgress(v string) *SyncProgressUpsert {
+	u.Set(syncprogress.FieldProgress, v)
+	return u
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *SyncProgressUpsert) UpdateProgress() *SyncProgressUpsert {
+	u.SetExcluded(syncprogress.FieldProgress)
+	return u
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *SyncProgressUpsert) SetPercentage(v float64) *SyncProgressUpsert {
+	u.Set(syncprogress.FieldPercentage, v)
+	return u
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *SyncProgressUpsert) UpdatePercentage() *SyncProgressUpsert {
+	u.SetExcluded(syncprogress.FieldPercentage)
+	return u
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *SyncProgressUpsert) AddPercentage(v float64) *SyncProgressUpsert {
+	u.Add(syncprogress.FieldPercentage, v)
+	return u
+}
+
+// SetDevice sets the "device" field.
+func (u *SyncProgressUpsert) SetDevice(v string) *SyncProgressUpsert {
+	u.Set(syncprogress.FieldDevice, v)
+	return u
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *SyncProgressUpsert) UpdateDevice() *SyncProgressUpsert {
+	u.SetExcluded(syncprogress.FieldDevice)
+	return u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *SyncProgressUpsert) SetDeviceID(v string) *SyncProgressUpsert {
+	u.Set(syncprogress.FieldDeviceID, v)
+	return u
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *SyncProgressUpsert) UpdateDeviceID() *SyncProgressUpsert {
+	u.SetExcluded(syncprogress.FieldDeviceID)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SyncProgressUpsert) SetUpdatedAt(v time.Time) *SyncProgressUpsert {
+	u.Set(syncprogress.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SyncProgressUpsert) UpdateUpdatedAt() *SyncProgressUpsert {
+	u.SetExcluded(syncprogress.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SyncProgress.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(syncprogress.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SyncProgressUpsertOne) UpdateNewValues() *SyncProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(syncprogress.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SyncProgress.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SyncProgressUpsertOne) Ignore() *SyncProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SyncProgressUpsertOne) DoNothing() *SyncProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SyncProgressCreate.OnConflict
+// documentation for more info.
+func (u *SyncProgressUpsertOne) Update(set func(*SyncProgressUpsert)) *SyncProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SyncProgressUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SyncProgressUpsertOne) SetUserID(v uuid.UUID) *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SyncProgressUpsertOne) UpdateUserID() *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDocument sets the "document" field.
+func (u *SyncProgressUpsertOne) SetDocument(v string) *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetDocument(v)
+	})
+}
+
+// UpdateDocument sets the "document" field to the value that was provided on create.
+func (u *SyncProgressUpsertOne) UpdateDocument() *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateDocument()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *SyncProgressUpsertOne) SetProgress(v string) *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *SyncProgressUpsertOne) UpdateProgress() *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *SyncProgressUpsertOne) SetPercentage(v float64) *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetPercentage(v)
+	})
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *SyncProgressUpsertOne) AddPercentage(v float64) *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.AddPercentage(v)
+	})
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *SyncProgressUpsertOne) UpdatePercentage() *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdatePercentage()
+	})
+}
+
+// SetDevice sets the "device" field.
+func (u *SyncProgressUpsertOne) SetDevice(v string) *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetDevice(v)
+	})
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *SyncProgressUpsertOne) UpdateDevice() *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateDevice()
+	})
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *SyncProgressUpsertOne) SetDeviceID(v string) *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetDeviceID(v)
+	})
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *SyncProgressUpsertOne) UpdateDeviceID() *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateDeviceID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SyncProgressUpsertOne) SetUpdatedAt(v time.Time) *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SyncProgressUpsertOne) UpdateUpdatedAt() *SyncProgressUpsertOne {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SyncProgressUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SyncProgressCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SyncProgressUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SyncProgressUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: SyncProgressUpsertOne.ID is not supported by MySQL driver. Use SyncProgressUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SyncProgressUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SyncProgressCreateBulk is the builder for creating many SyncProgress entities in bulk.
+type SyncProgressCreateBulk struct {
+	config
+	err      error
+	builders []*SyncProgressCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SyncProgress entities in the database.
+func (_c *SyncProgressCreateBulk) Save(ctx context.Context) ([]*SyncProgress, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SyncProgress, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SyncProgressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SyncProgressCreateBulk) SaveX(ctx context.Context) []*SyncProgress {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SyncProgressCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SyncProgressCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SyncProgress.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SyncProgressUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SyncProgressCreateBulk) OnConflict(opts ...sql.ConflictOption) *SyncProgressUpsertBulk {
+	_c.conflict = opts
+	return &SyncProgressUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SyncProgress.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SyncProgressCreateBulk) OnConflictColumns(columns ...string) *SyncProgressUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SyncProgressUpsertBulk{
+		create: _c,
+	}
+}
+
+// SyncProgressUpsertBulk is the builder for "upsert"-ing
+// a bulk of SyncProgress nodes.
+type SyncProgressUpsertBulk struct {
+	create *SyncProgressCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SyncProgress.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(syncprogress.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SyncProgressUpsertBulk) UpdateNewValues() *SyncProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(syncprogress.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SyncProgress.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SyncProgressUpsertBulk) Ignore() *SyncProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SyncProgressUpsertBulk) DoNothing() *SyncProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SyncProgressCreateBulk.OnConflict
+// documentation for more info.
+func (u *SyncProgressUpsertBulk) Update(set func(*SyncProgressUpsert)) *SyncProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SyncProgressUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SyncProgressUpsertBulk) SetUserID(v uuid.UUID) *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SyncProgressUpsertBulk) UpdateUserID() *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDocument sets the "document" field.
+func (u *SyncProgressUpsertBulk) SetDocument(v string) *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetDocument(v)
+	})
+}
+
+// UpdateDocument sets the "document" field to the value that was provided on create.
+func (u *SyncProgressUpsertBulk) UpdateDocument() *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateDocument()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *SyncProgressUpsertBulk) SetProgress(v string) *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *SyncProgressUpsertBulk) UpdateProgress() *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// SetPercentage sets the "percentage" field.
+func (u *SyncProgressUpsertBulk) SetPercentage(v float64) *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetPercentage(v)
+	})
+}
+
+// AddPercentage adds v to the "percentage" field.
+func (u *SyncProgressUpsertBulk) AddPercentage(v float64) *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.AddPercentage(v)
+	})
+}
+
+// UpdatePercentage sets the "percentage" field to the value that was provided on create.
+func (u *SyncProgressUpsertBulk) UpdatePercentage() *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdatePercentage()
+	})
+}
+
+// SetDevice sets the "device" field.
+func (u *SyncProgressUpsertBulk) SetDevice(v string) *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetDevice(v)
+	})
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *SyncProgressUpsertBulk) UpdateDevice() *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateDevice()
+	})
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *SyncProgressUpsertBulk) SetDeviceID(v string) *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetDeviceID(v)
+	})
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *SyncProgressUpsertBulk) UpdateDeviceID() *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateDeviceID()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SyncProgressUpsertBulk) SetUpdatedAt(v time.Time) *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SyncProgressUpsertBulk) UpdateUpdatedAt() *SyncProgressUpsertBulk {
+	return u.Update(func(s *SyncProgressUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SyncProgressUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SyncProgressCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SyncProgressCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SyncProgressUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
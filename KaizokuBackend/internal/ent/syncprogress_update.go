@@ -0,0 +1,437 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent/predicate"
+	"github.com/technobecet/kaizoku-go/internal/ent/syncprogress"
+)
+
+// SyncProgressUpdate is the builder for updating SyncProgress entities.
+type SyncProgressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SyncProgressMutation
+}
+
+// Where appends a list predicates to the SyncProgressUpdate builder.
+func (_u *SyncProgressUpdate) Where(ps ...predicate.SyncProgress) *SyncProgressUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *SyncProgressUpdate) SetUserID(v uuid.UUID) *SyncProgressUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *SyncProgressUpdate) SetNillableUserID(v *uuid.UUID) *SyncProgressUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDocument sets the "document" field.
+func (_u *SyncProgressUpdate) SetDocument(v string) *SyncProgressUpdate {
+	_u.mutation.SetDocument(v)
+	return _u
+}
+
+// SetNillableDocument sets the "document" field if the given value is not nil.
+func (_u *SyncProgressUpdate) SetNillableDocument(v *string) *SyncProgressUpdate {
+	if v != nil {
+		_u.SetDocument(*v)
+	}
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *SyncProgressUpdate) SetProgress(v string) *SyncProgressUpdate {
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_u *SyncProgressUpdate) SetNillableProgress(v *string) *SyncProgressUpdate {
+	if v != nil {
+		_u.SetProgress(*v)
+	}
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *SyncProgressUpdate) SetPercentage(v float64) *SyncProgressUpdate {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *SyncProgressUpdate) SetNillablePercentage(v *float64) *SyncProgressUpdate {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *SyncProgressUpdate) AddPercentage(v float64) *SyncProgressUpdate {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// SetDevice sets the "device" field.
+func (_u *SyncProgressUpdate) SetDevice(v string) *SyncProgressUpdate {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *SyncProgressUpdate) SetNillableDevice(v *string) *SyncProgressUpdate {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_u *SyncProgressUpdate) SetDeviceID(v string) *SyncProgressUpdate {
+	_u.mutation.SetDeviceID(v)
+	return _u
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_u *SyncProgressUpdate) SetNillableDeviceID(v *string) *SyncProgressUpdate {
+	if v != nil {
+		_u.SetDeviceID(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SyncProgressUpdate) SetUpdatedAt(v time.Time) *SyncProgressUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SyncProgressMutation object of the builder.
+func (_u *SyncProgressUpdate) Mutation() *SyncProgressMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SyncProgressUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SyncProgressUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SyncProgressUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SyncProgressUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SyncProgressUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := syncprogress.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SyncProgressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(syncprogress.Table, syncprogress.Columns, sqlgraph.NewFieldSpec(syncprogress.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(syncprogress.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Document(); ok {
+		_spec.SetField(syncprogress.FieldDocument, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(syncprogress.FieldProgress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(syncprogress.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(syncprogress.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(syncprogress.FieldDevice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DeviceID(); ok {
+		_spec.SetField(syncprogress.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(syncprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{syncprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SyncProgressUpdateOne is the builder for updating a single SyncProgress entity.
+type SyncProgressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SyncProgressMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *SyncProgressUpdateOne) SetUserID(v uuid.UUID) *SyncProgressUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *SyncProgressUpdateOne) SetNillableUserID(v *uuid.UUID) *SyncProgressUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDocument sets the "document" field.
+func (_u *SyncProgressUpdateOne) SetDocument(v string) *SyncProgressUpdateOne {
+	_u.mutation.SetDocument(v)
+	return _u
+}
+
+// SetNillableDocument sets the "document" field if the given value is not nil.
+func (_u *SyncProgressUpdateOne) SetNillableDocument(v *string) *SyncProgressUpdateOne {
+	if v != nil {
+		_u.SetDocument(*v)
+	}
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *SyncProgressUpdateOne) SetProgress(v string) *SyncProgressUpdateOne {
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_u *SyncProgressUpdateOne) SetNillableProgress(v *string) *SyncProgressUpdateOne {
+	if v != nil {
+		_u.SetProgress(*v)
+	}
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *SyncProgressUpdateOne) SetPercentage(v float64) *SyncProgressUpdateOne {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *SyncProgressUpdateOne) SetNillablePercentage(v *float64) *SyncProgressUpdateOne {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *SyncProgressUpdateOne) AddPercentage(v float64) *SyncProgressUpdateOne {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// SetDevice sets the "device" field.
+func (_u *SyncProgressUpdateOne) SetDevice(v string) *SyncProgressUpdateOne {
+	_u.mutation.SetDevice(v)
+	return _u
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_u *SyncProgressUpdateOne) SetNillableDevice(v *string) *SyncProgressUpdateOne {
+	if v != nil {
+		_u.SetDevice(*v)
+	}
+	return _u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_u *SyncProgressUpdateOne) SetDeviceID(v string) *SyncProgressUpdateOne {
+	_u.mutation.SetDeviceID(v)
+	return _u
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_u *SyncProgressUpdateOne) SetNillableDeviceID(v *string) *SyncProgressUpdateOne {
+	if v != nil {
+		_u.SetDeviceID(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SyncProgressUpdateOne) SetUpdatedAt(v time.Time) *SyncProgressUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SyncProgressMutation object of the builder.
+func (_u *SyncProgressUpdateOne) Mutation() *SyncProgressMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SyncProgressUpdate builder.
+func (_u *SyncProgressUpdateOne) Where(ps ...predicate.SyncProgress) *SyncProgressUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SyncProgressUpdateOne) Select(field string, fields ...string) *SyncProgressUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SyncProgress entity.
+func (_u *SyncProgressUpdateOne) Save(ctx context.Context) (*SyncProgress, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SyncProgressUpdateOne) SaveX(ctx context.Context) *SyncProgress {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SyncProgressUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SyncProgressUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SyncProgressUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := syncprogress.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SyncProgressUpdateOne) sqlSave(ctx context.Context) (_node *SyncProgress, err error) {
+	_spec := sqlgraph.NewUpdateSpec(syncprogress.Table, syncprogress.Columns, sqlgraph.NewFieldSpec(syncprogress.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SyncProgress.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, syncprogress.FieldID)
+		for _, f := range fields {
+			if !syncprogress.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != syncprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(syncprogress.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Document(); ok {
+		_spec.SetField(syncprogress.FieldDocument, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(syncprogress.FieldProgress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(syncprogress.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(syncprogress.FieldPercentage, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Device(); ok {
+		_spec.SetField(syncprogress.FieldDevice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DeviceID(); ok {
+		_spec.SetField(syncprogress.FieldDeviceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(syncprogress.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SyncProgress{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{syncprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,24 +12,46 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
 	// DownloadQueueItem is the client for interacting with the DownloadQueueItem builders.
 	DownloadQueueItem *DownloadQueueItemClient
 	// EtagCache is the client for interacting with the EtagCache builders.
 	EtagCache *EtagCacheClient
+	// HookRun is the client for interacting with the HookRun builders.
+	HookRun *HookRunClient
 	// ImportEntry is the client for interacting with the ImportEntry builders.
 	ImportEntry *ImportEntryClient
 	// LatestSeries is the client for interacting with the LatestSeries builders.
 	LatestSeries *LatestSeriesClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// Notifier is the client for interacting with the Notifier builders.
+	Notifier *NotifierClient
 	// ProviderStorage is the client for interacting with the ProviderStorage builders.
 	ProviderStorage *ProviderStorageClient
+	// ReadProgress is the client for interacting with the ReadProgress builders.
+	ReadProgress *ReadProgressClient
 	// Series is the client for interacting with the Series builders.
 	Series *SeriesClient
 	// SeriesProvider is the client for interacting with the SeriesProvider builders.
 	SeriesProvider *SeriesProviderClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
 	// SourceEvent is the client for interacting with the SourceEvent builders.
 	SourceEvent *SourceEventClient
+	// SyncProgress is the client for interacting with the SyncProgress builders.
+	SyncProgress *SyncProgressClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -161,15 +183,26 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.AuditEvent = NewAuditEventClient(tx.config)
 	tx.DownloadQueueItem = NewDownloadQueueItemClient(tx.config)
 	tx.EtagCache = NewEtagCacheClient(tx.config)
+	tx.HookRun = NewHookRunClient(tx.config)
 	tx.ImportEntry = NewImportEntryClient(tx.config)
 	tx.LatestSeries = NewLatestSeriesClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.Notifier = NewNotifierClient(tx.config)
 	tx.ProviderStorage = NewProviderStorageClient(tx.config)
+	tx.ReadProgress = NewReadProgressClient(tx.config)
 	tx.Series = NewSeriesClient(tx.config)
 	tx.SeriesProvider = NewSeriesProviderClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
 	tx.SourceEvent = NewSourceEventClient(tx.config)
+	tx.SyncProgress = NewSyncProgressClient(tx.config)
+	tx.User = NewUserClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -179,7 +212,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: DownloadQueueItem.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
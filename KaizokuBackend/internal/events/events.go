@@ -0,0 +1,110 @@
+// Package events is an in-process publish/subscribe bus for library and
+// download events. Integrations such as webhooks subscribe to it; publishers
+// never wait for subscribers.
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Type names an event.
+type Type string
+
+// Event types.
+const (
+	ChapterDownloaded Type = "chapter.downloaded"
+	ChapterFailed     Type = "chapter.failed"
+	SeriesAdded       Type = "series.added"
+	SeriesDeleted     Type = "series.deleted"
+	ExtensionUpdated  Type = "extension.updated"
+	ExtensionRemoved  Type = "extension.removed"
+	SourceErrorRate   Type = "source.error_rate"
+)
+
+// Types lists every event type that is published.
+var Types = []Type{
+	ChapterDownloaded,
+	ChapterFailed,
+	SeriesAdded,
+	SeriesDeleted,
+	ExtensionUpdated,
+	ExtensionRemoved,
+	SourceErrorRate,
+}
+
+// Valid reports whether t is a known event type.
+func Valid(t Type) bool {
+	for _, known := range Types {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a single occurrence of something subscribers may act on.
+type Event struct {
+	ID   string                 `json:"id"`
+	Type Type                   `json:"type"`
+	Time time.Time              `json:"time"`
+	Data map[string]interface{} `json:"data"`
+}
+
+// New creates an event with a fresh ID and the current time.
+func New(t Type, data map[string]interface{}) Event {
+	return Event{ID: uuid.NewString(), Type: t, Time: time.Now().UTC(), Data: data}
+}
+
+// Handler receives published events.
+type Handler func(ctx context.Context, e Event)
+
+// Bus fans events out to subscribers.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers []Handler
+}
+
+// NewBus creates an empty event bus.
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// Subscribe registers h to receive every published event.
+func (b *Bus) Subscribe(h Handler) {
+	b.mu.Lock()
+	b.handlers = append(b.handlers, h)
+	b.mu.Unlock()
+}
+
+// Publish sends an event to all subscribers. Each subscriber runs in its
+// own goroutine with a context that outlives the caller's, so a slow or
+// failing integration cannot hold up downloads or requests. A nil bus
+// discards events.
+func (b *Bus) Publish(ctx context.Context, t Type, data map[string]interface{}) {
+	if b == nil {
+		return
+	}
+	e := New(t, data)
+
+	b.mu.RLock()
+	handlers := make([]Handler, len(b.handlers))
+	copy(handlers, b.handlers)
+	b.mu.RUnlock()
+
+	ctx = context.WithoutCancel(ctx)
+	for _, h := range handlers {
+		go func(h Handler) {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Error().Interface("panic", r).Str("event", string(e.Type)).Msg("event handler panicked")
+				}
+			}()
+			h(ctx, e)
+		}(h)
+	}
+}
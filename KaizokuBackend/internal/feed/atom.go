@@ -0,0 +1,116 @@
+// Package feed renders subscription feeds of library activity: Atom feeds
+// for feed readers and iCalendar feeds for calendar apps.
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// TypeAtom is the media type of Atom feeds.
+const TypeAtom = "application/atom+xml; charset=utf-8"
+
+// Link is a typed link from a feed or entry.
+type Link struct {
+	Rel   string
+	Href  string
+	Type  string
+	Title string
+}
+
+// Entry is one item of a feed.
+type Entry struct {
+	ID      string
+	Title   string
+	Summary string
+	Updated time.Time
+	Links   []Link
+	// Categories are plain tags, e.g. the series genres.
+	Categories []string
+}
+
+// Atom is an Atom 1.0 feed (RFC 4287).
+type Atom struct {
+	ID       string
+	Title    string
+	Subtitle string
+	Updated  time.Time
+	Links    []Link
+	Entries  []Entry
+}
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"feed"`
+	Xmlns    string      `xml:"xmlns,attr"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Author   atomAuthor  `xml:"author"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel   string `xml:"rel,attr,omitempty"`
+	Href  string `xml:"href,attr"`
+	Type  string `xml:"type,attr,omitempty"`
+	Title string `xml:"title,attr,omitempty"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Updated    string         `xml:"updated"`
+	Summary    string         `xml:"summary,omitempty"`
+	Links      []atomLink     `xml:"link"`
+	Categories []atomCategory `xml:"category"`
+}
+
+func atomLinks(links []Link) []atomLink {
+	out := make([]atomLink, 0, len(links))
+	for _, l := range links {
+		out = append(out, atomLink{Rel: l.Rel, Href: l.Href, Type: l.Type, Title: l.Title})
+	}
+	return out
+}
+
+// Marshal renders the feed as an Atom document.
+func (f *Atom) Marshal() ([]byte, error) {
+	doc := atomFeed{
+		Xmlns:    "http://www.w3.org/2005/Atom",
+		ID:       f.ID,
+		Title:    f.Title,
+		Subtitle: f.Subtitle,
+		Updated:  f.Updated.UTC().Format(time.RFC3339),
+		Author:   atomAuthor{Name: "Kaizoku"},
+		Links:    atomLinks(f.Links),
+	}
+	for _, e := range f.Entries {
+		ae := atomEntry{
+			ID:      e.ID,
+			Title:   e.Title,
+			Updated: e.Updated.UTC().Format(time.RFC3339),
+			Summary: e.Summary,
+			Links:   atomLinks(e.Links),
+		}
+		for _, c := range e.Categories {
+			ae.Categories = append(ae.Categories, atomCategory{Term: c})
+		}
+		doc.Entries = append(doc.Entries, ae)
+	}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/auditevent"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// maxAuditLimit caps the page size of GetAuditEvents.
+const maxAuditLimit = 500
+
+// AuditHandler serves the audit log.
+type AuditHandler struct {
+	db *ent.Client
+}
+
+func auditEventToDTO(e *ent.AuditEvent) types.AuditEventDTO {
+	return types.AuditEventDTO{
+		ID:         e.ID.String(),
+		ActorKind:  e.ActorKind,
+		ActorID:    e.ActorID,
+		ActorName:  e.ActorName,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetIDs:  e.TargetIds,
+		Diff:       e.Diff,
+		Details:    e.Details,
+		IP:         e.IP,
+		CreatedAt:  e.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// GetAuditEvents returns paginated audit events, newest first.
+// limit is capped at 500.
+// GET /api/audit?action=series.delete&actor=alice&targetType=series&targetId=...&from=...&to=...&limit=50&offset=0
+func (h *AuditHandler) GetAuditEvents(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	limit := 50
+	if v, err := strconv.Atoi(c.QueryParam("limit")); err == nil && v > 0 {
+		limit = min(v, maxAuditLimit)
+	}
+	offset := 0
+	if v, err := strconv.Atoi(c.QueryParam("offset")); err == nil && v >= 0 {
+		offset = v
+	}
+
+	query := h.db.AuditEvent.Query()
+	if v := c.QueryParam("action"); v != "" {
+		query = query.Where(auditevent.ActionEQ(v))
+	}
+	if v := c.QueryParam("actor"); v != "" {
+		query = query.Where(auditevent.ActorNameEQ(v))
+	}
+	if v := c.QueryParam("actorKind"); v != "" {
+		query = query.Where(auditevent.ActorKindEQ(v))
+	}
+	if v := c.QueryParam("targetType"); v != "" {
+		query = query.Where(auditevent.TargetTypeEQ(v))
+	}
+	if v := c.QueryParam("targetId"); v != "" {
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(auditevent.FieldTargetIds, v))
+		})
+	}
+	if v := c.QueryParam("from"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid from: expected RFC 3339 timestamp"})
+		}
+		query = query.Where(auditevent.CreatedAtGTE(t))
+	}
+	if v := c.QueryParam("to"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid to: expected RFC 3339 timestamp"})
+		}
+		query = query.Where(auditevent.CreatedAtLTE(t))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("audit: failed to count events")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to count events"})
+	}
+
+	events, err := query.
+		Order(ent.Desc(auditevent.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("audit: failed to query events")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to query events"})
+	}
+
+	dtos := make([]types.AuditEventDTO, 0, len(events))
+	for _, e := range events {
+		dtos = append(dtos, auditEventToDTO(e))
+	}
+
+	return c.JSON(http.StatusOK, types.AuditEventList{
+		Total:  total,
+		Events: dtos,
+	})
+}
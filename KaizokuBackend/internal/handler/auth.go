@@ -0,0 +1,389 @@
+package handler
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
+	"github.com/technobecet/kaizoku-go/internal/service/oidc"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// AuthHandler handles login sessions, OIDC login and API keys.
+type AuthHandler struct {
+	config *config.Config
+	auth   *auth.Service
+	oidc   *oidc.Provider // nil when OIDC is disabled
+}
+
+// oidcStateCookie binds an in-flight OIDC login to the browser that started it.
+const oidcStateCookie = "kaizoku_oidc_state"
+
+// principal returns the authenticated caller of the request, or nil when
+// authentication is disabled.
+func principal(c echo.Context) *auth.Principal {
+	return auth.FromContext(c.Request().Context())
+}
+
+// actorName returns a display name for the caller, for logging.
+func actorName(c echo.Context) string {
+	p := principal(c)
+	switch {
+	case p == nil:
+		return "anonymous"
+	case p.Username != "":
+		return p.Username
+	default:
+		return p.Kind + ":" + p.Name
+	}
+}
+
+// principalUserID returns the acting user's ID, or nil if the caller is not
+// a user (auth disabled or the bootstrap key).
+func principalUserID(c echo.Context) *uuid.UUID {
+	p := principal(c)
+	if p == nil || p.UserID == "" {
+		return nil
+	}
+	id, err := uuid.Parse(p.UserID)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+// apiKeyToDTO converts an Ent APIKey entity to an APIKeyInfo.
+func apiKeyToDTO(k *ent.APIKey) types.APIKeyInfo {
+	info := types.APIKeyInfo{
+		ID:        k.ID.String(),
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		CreatedAt: k.CreatedAt.Format(time.RFC3339),
+	}
+	if k.LastUsedAt != nil {
+		s := k.LastUsedAt.Format(time.RFC3339)
+		info.LastUsedAt = &s
+	}
+	if k.RevokedAt != nil {
+		s := k.RevokedAt.Format(time.RFC3339)
+		info.RevokedAt = &s
+	}
+	return info
+}
+
+// setSessionCookie writes the session cookie; an empty token clears it.
+func setSessionCookie(c echo.Context, token string) {
+	cookie := &http.Cookie{
+		Name:     auth.SessionCookie,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   c.Scheme() == "https",
+		SameSite: http.SameSiteLaxMode,
+	}
+	if token == "" {
+		cookie.MaxAge = -1
+	} else {
+		cookie.Expires = time.Now().Add(auth.SessionTTL)
+	}
+	c.SetCookie(cookie)
+}
+
+// SetRetryAfter tells a throttled client when it may try again.
+func SetRetryAfter(c echo.Context, wait time.Duration) {
+	c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+}
+
+// TooManyAttempts rejects a password attempt from a client that failed
+// too often recently.
+func TooManyAttempts(c echo.Context, wait time.Duration) error {
+	SetRetryAfter(c, wait)
+	return c.JSON(http.StatusTooManyRequests, map[string]string{"error": "too many failed login attempts, try again later"})
+}
+
+// Login verifies a username and password and sets the session cookie.
+// Repeated failures from one IP or for one username are throttled.
+// POST /api/auth/login
+func (h *AuthHandler) Login(c echo.Context) error {
+	var req struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	ctx := c.Request().Context()
+	ip := c.RealIP()
+	if wait := h.auth.RetryAfter(ip, req.Username); wait > 0 {
+		log.Warn().Str("username", req.Username).Str("ip", ip).Msg("throttled login attempt")
+		return TooManyAttempts(c, wait)
+	}
+
+	u, token, err := h.auth.Login(ctx, req.Username, req.Password)
+	if err != nil {
+		if errors.Is(err, auth.ErrUnauthorized) {
+			h.auth.RecordAttempt(ip, req.Username, false)
+			log.Warn().Str("username", req.Username).Str("ip", ip).Msg("failed login attempt")
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid username or password"})
+		}
+		log.Error().Err(err).Msg("failed to log in")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to log in"})
+	}
+	h.auth.RecordAttempt(ip, req.Username, true)
+
+	setSessionCookie(c, token)
+	log.Info().Str("username", u.Username).Msg("user logged in")
+	return c.JSON(http.StatusOK, userToDTO(u))
+}
+
+// GetAuthConfig tells the login page which login methods are available.
+// GET /api/auth/config
+func (h *AuthHandler) GetAuthConfig(c echo.Context) error {
+	resp := map[string]interface{}{
+		"authEnabled": h.config.Auth.Enabled,
+		"oidcEnabled": h.oidc != nil,
+	}
+	if h.oidc != nil {
+		resp["oidcName"] = h.oidc.Name()
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// OIDCLogin starts an OIDC authorization code + PKCE login by redirecting
+// the browser to the identity provider.
+// GET /api/auth/oidc/login
+func (h *AuthHandler) OIDCLogin(c echo.Context) error {
+	if h.oidc == nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "OIDC login is not enabled"})
+	}
+
+	state, authURL, err := h.oidc.AuthURL(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to start OIDC login")
+		return c.JSON(http.StatusBadGateway, map[string]string{"error": "identity provider unavailable"})
+	}
+
+	c.SetCookie(&http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state,
+		Path:     "/api/auth/oidc",
+		HttpOnly: true,
+		Secure:   c.Scheme() == "https",
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   600,
+	})
+	return c.Redirect(http.StatusFound, authURL)
+}
+
+// OIDCCallback completes an OIDC login, maps the user's groups to a role,
+// starts a session and redirects to the library.
+// GET /api/auth/oidc/callback?code=...&state=...
+func (h *AuthHandler) OIDCCallback(c echo.Context) error {
+	if h.oidc == nil {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "OIDC login is not enabled"})
+	}
+
+	if errParam := c.QueryParam("error"); errParam != "" {
+		log.Warn().Str("error", errParam).Str("description", c.QueryParam("error_description")).Msg("OIDC login rejected by identity provider")
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "login rejected by identity provider"})
+	}
+
+	state := c.QueryParam("state")
+	cookie, err := c.Cookie(oidcStateCookie)
+	if err != nil || state == "" || cookie.Value != state {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid login state"})
+	}
+	c.SetCookie(&http.Cookie{Name: oidcStateCookie, Path: "/api/auth/oidc", MaxAge: -1})
+
+	ctx := c.Request().Context()
+
+	identity, err := h.oidc.Exchange(ctx, state, c.QueryParam("code"))
+	if err != nil {
+		if errors.Is(err, oidc.ErrInvalidState) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid login state"})
+		}
+		log.Error().Err(err).Msg("OIDC code exchange failed")
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "login failed"})
+	}
+
+	role := h.oidc.RoleFor(identity.Groups)
+	if role == "" {
+		log.Warn().Str("username", identity.Username).Strs("groups", identity.Groups).Msg("OIDC user is not in any allowed group")
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "not allowed to access Kaizoku"})
+	}
+
+	u, token, err := h.auth.LoginExternal(ctx, auth.SourceOIDC, identity.Subject, identity.Username, role)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrUsernameTaken):
+			return c.JSON(http.StatusConflict, map[string]string{"error": "a local account with this username already exists"})
+		case errors.Is(err, auth.ErrUnauthorized):
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "account is disabled"})
+		}
+		log.Error().Err(err).Msg("failed to sign in OIDC user")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "login failed"})
+	}
+
+	setSessionCookie(c, token)
+	log.Info().Str("username", u.Username).Str("role", u.Role).Msg("user logged in via OIDC")
+	return c.Redirect(http.StatusFound, "/library")
+}
+
+// Logout ends the current session and clears the cookie.
+// POST /api/auth/logout
+func (h *AuthHandler) Logout(c echo.Context) error {
+	if cookie, err := c.Cookie(auth.SessionCookie); err == nil && cookie.Value != "" {
+		if err := h.auth.Logout(c.Request().Context(), cookie.Value); err != nil {
+			log.Warn().Err(err).Msg("failed to delete session")
+		}
+	}
+	setSessionCookie(c, "")
+	return c.JSON(http.StatusOK, nil)
+}
+
+// Me returns the authenticated caller.
+// GET /api/auth/me
+func (h *AuthHandler) Me(c echo.Context) error {
+	p := principal(c)
+	if !h.config.Auth.Enabled || p == nil {
+		return c.JSON(http.StatusOK, types.AuthInfo{AuthEnabled: false, Role: auth.RoleAdmin})
+	}
+	return c.JSON(http.StatusOK, types.AuthInfo{
+		AuthEnabled: true,
+		Kind:        p.Kind,
+		Name:        p.Name,
+		UserID:      p.UserID,
+		Username:    p.Username,
+		Role:        p.Role,
+	})
+}
+
+// ChangePassword changes the caller's own password.
+// PUT /api/auth/password
+func (h *AuthHandler) ChangePassword(c echo.Context) error {
+	var req struct {
+		CurrentPassword string `json:"currentPassword"`
+		NewPassword     string `json:"newPassword"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	userID := principalUserID(c)
+	if userID == nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "caller is not a user"})
+	}
+
+	ctx := c.Request().Context()
+
+	if err := h.auth.ChangePassword(ctx, *userID, req.CurrentPassword, req.NewPassword); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrUnauthorized):
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "current password is incorrect"})
+		case errors.Is(err, auth.ErrWeakPassword):
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		}
+		log.Error().Err(err).Msg("failed to change password")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to change password"})
+	}
+
+	// Changing the password ends all sessions, including this one; start a new one.
+	if principal(c).Kind == auth.KindSession {
+		token, err := h.auth.StartSession(ctx, *userID)
+		if err == nil {
+			setSessionCookie(c, token)
+		}
+	}
+	return c.JSON(http.StatusOK, nil)
+}
+
+// ListKeys returns API keys (secrets are never returned). Admins see all
+// keys; other users see their own.
+// GET /api/auth/keys
+func (h *AuthHandler) ListKeys(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var owner *uuid.UUID
+	if p := principal(c); p != nil && !p.HasRole(auth.RoleAdmin) {
+		owner = principalUserID(c)
+	}
+
+	keys, err := h.auth.ListKeys(ctx, owner)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list api keys")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to list keys"})
+	}
+
+	result := make([]types.APIKeyInfo, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, apiKeyToDTO(k))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// CreateKey creates a new API key owned by the caller and returns its
+// plaintext once. The key acts with the owner's role.
+// POST /api/auth/keys
+func (h *AuthHandler) CreateKey(c echo.Context) error {
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+	if req.Name == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "name required"})
+	}
+
+	ctx := c.Request().Context()
+
+	k, plain, err := h.auth.CreateKey(ctx, req.Name, principalUserID(c))
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create api key")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to create key"})
+	}
+
+	log.Info().Str("id", k.ID.String()).Str("name", k.Name).Str("by", actorName(c)).Msg("api key created")
+	return c.JSON(http.StatusCreated, types.CreatedAPIKey{APIKeyInfo: apiKeyToDTO(k), Key: plain})
+}
+
+// RevokeKey revokes an API key. Requests using it are rejected immediately.
+// Non-admins may only revoke their own keys.
+// DELETE /api/auth/keys/:id
+func (h *AuthHandler) RevokeKey(c echo.Context) error {
+	ctx := c.Request().Context()
+	idStr := c.Param("id")
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	if p := principal(c); p != nil && !p.HasRole(auth.RoleAdmin) {
+		owner := principalUserID(c)
+		k, err := h.auth.GetKey(ctx, id)
+		if err != nil || owner == nil || k.UserID == nil || *k.UserID != *owner {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "key not found"})
+		}
+	}
+
+	if err := h.auth.RevokeKey(ctx, id); err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "key not found"})
+		}
+		log.Error().Err(err).Str("id", idStr).Msg("failed to revoke api key")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to revoke key"})
+	}
+
+	log.Info().Str("id", idStr).Str("by", actorName(c)).Msg("api key revoked")
+	return c.JSON(http.StatusOK, nil)
+}
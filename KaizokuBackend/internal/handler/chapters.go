@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/types"
+	"github.com/technobecet/kaizoku-go/internal/util"
+)
+
+const (
+	// minPageWidth and maxPageWidth bound the width accepted for resized pages.
+	minPageWidth = 64
+	maxPageWidth = 4096
+)
+
+// errChapterNotFound is returned when a series has no downloaded copy of a chapter.
+var errChapterNotFound = errors.New("chapter not found")
+
+// chapterFile is a downloaded chapter and the location of its CBZ on disk.
+type chapterFile struct {
+	Number   float64
+	Chapter  types.Chapter
+	Provider *ent.SeriesProvider
+	Path     string
+}
+
+// chapterPath joins a chapter filename onto the series folder, refusing
+// names that would escape the storage folder.
+func chapterPath(storageFolder, storagePath, filename string) (string, bool) {
+	root := filepath.Clean(storageFolder)
+	p := filepath.Join(root, storagePath, filename)
+	if filename == "" || !strings.HasPrefix(p, root+string(filepath.Separator)) {
+		return "", false
+	}
+	return p, true
+}
+
+// downloadedChapters returns one downloaded copy per chapter number, sorted
+// by number. When several providers have the same chapter, the copy from
+// the most important provider (lowest importance value) wins. Chapters
+// without a number cannot be addressed and are skipped.
+func downloadedChapters(storageFolder string, s *ent.Series, providers []*ent.SeriesProvider) []chapterFile {
+	ordered := make([]*ent.SeriesProvider, len(providers))
+	copy(ordered, providers)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Importance < ordered[j].Importance })
+
+	seen := make(map[float64]bool)
+	var files []chapterFile
+	for _, p := range ordered {
+		for _, ch := range p.Chapters {
+			if ch.Number == nil || ch.Filename == "" || ch.IsDeleted || seen[*ch.Number] {
+				continue
+			}
+			path, ok := chapterPath(storageFolder, s.StoragePath, ch.Filename)
+			if !ok {
+				continue
+			}
+			seen[*ch.Number] = true
+			files = append(files, chapterFile{Number: *ch.Number, Chapter: ch, Provider: p, Path: path})
+		}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Number < files[j].Number })
+	return files
+}
+
+// findChapterFile locates the downloaded copy of a series chapter.
+func findChapterFile(ctx context.Context, db *ent.Client, storageFolder string, seriesID uuid.UUID, number float64) (*ent.Series, *chapterFile, error) {
+	s, err := db.Series.Get(ctx, seriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+	providers, err := s.QueryProviders().All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, f := range downloadedChapters(storageFolder, s, providers) {
+		if f.Number == number {
+			return s, &f, nil
+		}
+	}
+	return s, nil, errChapterNotFound
+}
+
+// parseChapterNumber parses a chapter number from a URL path segment.
+func parseChapterNumber(s string) (float64, error) {
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, errors.New("invalid chapter number")
+	}
+	return n, nil
+}
+
+// chapterTitle returns a display title for a chapter.
+func chapterTitle(f *chapterFile) string {
+	if f.Chapter.Name != "" {
+		return f.Chapter.Name
+	}
+	return "Chapter " + formatFloat(f.Number)
+}
+
+// pageETag identifies one rendition of a page. It changes whenever the CBZ
+// is rewritten, so it is safe to cache pages for a long time.
+func pageETag(info os.FileInfo, index, width int) string {
+	return `"` + strconv.FormatInt(info.ModTime().UnixNano(), 36) + "-" +
+		strconv.FormatInt(info.Size(), 36) + "-" + strconv.Itoa(index) + "-" + strconv.Itoa(width) + `"`
+}
+
+// etagMatches reports whether an If-None-Match header matches etag.
+func etagMatches(header, etag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == etag || v == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// servePage streams one page of a chapter straight from its CBZ, with an
+// ETag and Last-Modified for client caching. When width is positive and
+// smaller than the page, the page is scaled down first; formats that cannot
+// be decoded are served unchanged.
+func servePage(c echo.Context, f *chapterFile, index, width int) error {
+	info, err := os.Stat(f.Path)
+	if err != nil {
+		return c.NoContent(http.StatusNotFound)
+	}
+	if width > 0 {
+		width = min(max(width, minPageWidth), maxPageWidth)
+	}
+
+	etag := pageETag(info, index, width)
+	header := c.Response().Header()
+	header.Set(echo.HeaderCacheControl, "private, max-age=86400")
+	header.Set("ETag", etag)
+	header.Set(echo.HeaderLastModified, info.ModTime().UTC().Format(http.TimeFormat))
+	if etagMatches(c.Request().Header.Get("If-None-Match"), etag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	page, err := util.OpenCBZPage(f.Path, index)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Warn().Err(err).Str("file", f.Path).Msg("failed to open page")
+		}
+		return c.NoContent(http.StatusNotFound)
+	}
+	defer page.Close()
+
+	contentType := mime.TypeByExtension(strings.ToLower(filepath.Ext(page.Name)))
+	if contentType == "" {
+		contentType = echo.MIMEOctetStream
+	}
+
+	if width > 0 {
+		data, err := io.ReadAll(page)
+		if err != nil {
+			log.Warn().Err(err).Str("file", f.Path).Msg("failed to read page")
+			return c.NoContent(http.StatusInternalServerError)
+		}
+		out, resizedType, resized, err := util.ResizeImage(data, width)
+		if err == nil && resized {
+			return c.Blob(http.StatusOK, resizedType, out)
+		}
+		return c.Blob(http.StatusOK, contentType, data)
+	}
+
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(page.Size, 10))
+	return c.Stream(http.StatusOK, contentType, page)
+}
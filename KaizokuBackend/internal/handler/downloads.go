@@ -10,6 +10,7 @@ import (
 	"github.com/technobecet/kaizoku-go/internal/config"
 	"github.com/technobecet/kaizoku-go/internal/ent"
 	"github.com/technobecet/kaizoku-go/internal/job"
+	"github.com/technobecet/kaizoku-go/internal/service/audit"
 	"github.com/technobecet/kaizoku-go/internal/types"
 )
 
@@ -17,6 +18,7 @@ type DownloadsHandler struct {
 	config    *config.Config
 	db        *ent.Client
 	downloads *job.DownloadDispatcher
+	audit     *audit.Recorder
 }
 
 // GetDownloads returns downloads filtered by status with pagination.
@@ -135,6 +137,80 @@ func (h *DownloadsHandler) CancelDownload(c echo.Context) error {
 	return c.JSON(http.StatusOK, nil)
 }
 
+// CancelRunningDownload stops a running download and removes it from the
+// queue. Pages fetched so far are discarded.
+// DELETE /api/downloads/running/:id
+func (h *DownloadsHandler) CancelRunningDownload(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	ctx := c.Request().Context()
+	found, err := h.downloads.CancelRunning(ctx, id)
+	if err != nil {
+		log.Error().Err(err).Str("id", id.String()).Msg("failed to cancel running download")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to cancel download"})
+	}
+	if !found {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "download not running"})
+	}
+
+	log.Info().Str("id", id.String()).Str("by", actorName(c)).Msg("cancelled running download")
+	h.audit.Record(ctx, audit.Entry{
+		Action:     "downloads.cancel_running",
+		TargetType: "download",
+		TargetIDs:  []string{id.String()},
+		IP:         c.RealIP(),
+	})
+	return c.JSON(http.StatusOK, nil)
+}
+
+// GetQueueState reports whether the download queue is paused or in quiet
+// hours.
+// GET /api/downloads/state
+func (h *DownloadsHandler) GetQueueState(c echo.Context) error {
+	state, err := h.downloads.State(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get download queue state")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get download queue state"})
+	}
+	return c.JSON(http.StatusOK, state)
+}
+
+// PauseQueue stops new downloads from starting until ResumeQueue. Running
+// downloads finish and the queue is kept.
+// POST /api/downloads/pause
+func (h *DownloadsHandler) PauseQueue(c echo.Context) error {
+	return h.setPaused(c, true)
+}
+
+// ResumeQueue lets a paused download queue start downloads again.
+// POST /api/downloads/resume
+func (h *DownloadsHandler) ResumeQueue(c echo.Context) error {
+	return h.setPaused(c, false)
+}
+
+func (h *DownloadsHandler) setPaused(c echo.Context, paused bool) error {
+	ctx := c.Request().Context()
+	action := "downloads.resume"
+	if paused {
+		action = "downloads.pause"
+	}
+	state, err := h.downloads.SetPaused(ctx, paused)
+	if err != nil {
+		log.Error().Err(err).Bool("paused", paused).Msg("failed to change download queue state")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to change download queue state"})
+	}
+	log.Info().Bool("paused", paused).Str("by", actorName(c)).Msg("download queue state changed")
+	h.audit.Record(ctx, audit.Entry{
+		Action:     action,
+		TargetType: "download",
+		IP:         c.RealIP(),
+	})
+	return c.JSON(http.StatusOK, state)
+}
+
 // CancelAllScheduled removes all waiting/scheduled downloads from the queue.
 // DELETE /api/downloads/scheduled
 func (h *DownloadsHandler) CancelAllScheduled(c echo.Context) error {
@@ -144,7 +220,13 @@ func (h *DownloadsHandler) CancelAllScheduled(c echo.Context) error {
 		log.Error().Err(err).Msg("failed to cancel all scheduled downloads")
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to cancel scheduled downloads"})
 	}
-	log.Info().Int("deleted", deleted).Msg("cancelled all scheduled downloads")
+	log.Info().Int("deleted", deleted).Str("by", actorName(c)).Msg("cancelled all scheduled downloads")
+	h.audit.Record(ctx, audit.Entry{
+		Action:     "downloads.cancel_scheduled",
+		TargetType: "download",
+		Details:    map[string]interface{}{"deleted": deleted},
+		IP:         c.RealIP(),
+	})
 	return c.JSON(http.StatusOK, map[string]int{"deleted": deleted})
 }
 
@@ -157,6 +239,12 @@ func (h *DownloadsHandler) DeleteAllErrors(c echo.Context) error {
 		log.Error().Err(err).Msg("failed to delete all failed downloads")
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to delete failed downloads"})
 	}
-	log.Info().Int("deleted", deleted).Msg("deleted all failed downloads")
+	log.Info().Int("deleted", deleted).Str("by", actorName(c)).Msg("deleted all failed downloads")
+	h.audit.Record(ctx, audit.Entry{
+		Action:     "downloads.delete_errors",
+		TargetType: "download",
+		Details:    map[string]interface{}{"deleted": deleted},
+		IP:         c.RealIP(),
+	})
 	return c.JSON(http.StatusOK, map[string]int{"deleted": deleted})
 }
@@ -0,0 +1,279 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/series"
+	"github.com/technobecet/kaizoku-go/internal/feed"
+	"github.com/technobecet/kaizoku-go/internal/opds"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
+	"github.com/technobecet/kaizoku-go/internal/service/cadence"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+const (
+	// feedEntryLimit is the number of chapters in a feed.
+	feedEntryLimit = 100
+	// calendarHorizon is how far ahead the calendar lists predicted releases.
+	calendarHorizon = 60 * 24 * time.Hour
+)
+
+// FeedsHandler serves Atom feeds of newly downloaded chapters and a
+// calendar of predicted releases, and manages the per-user tokens that
+// feed readers use to fetch them.
+type FeedsHandler struct {
+	config *config.Config
+	db     *ent.Client
+	auth   *auth.Service
+}
+
+// siteURL returns the scheme and host the request was made to.
+func siteURL(c echo.Context) string {
+	return c.Scheme() + "://" + c.Request().Host
+}
+
+// feedChapter is a downloaded chapter of a series.
+type feedChapter struct {
+	series *ent.Series
+	file   chapterFile
+}
+
+// recentChapters returns the downloaded chapters of the given series,
+// newest download first, at most feedEntryLimit of them.
+func (h *FeedsHandler) recentChapters(list []*ent.Series) []feedChapter {
+	var recent []feedChapter
+	for _, s := range list {
+		for _, f := range downloadedChapters(h.config.Storage.Folder, s, s.Edges.Providers) {
+			if f.Chapter.DownloadDate != nil {
+				recent = append(recent, feedChapter{series: s, file: f})
+			}
+		}
+	}
+	sort.Slice(recent, func(i, j int) bool {
+		return recent[i].file.Chapter.DownloadDate.After(*recent[j].file.Chapter.DownloadDate)
+	})
+	if len(recent) > feedEntryLimit {
+		recent = recent[:feedEntryLimit]
+	}
+	return recent
+}
+
+// chapterFeedEntry builds the entry of one downloaded chapter. It links to
+// the series page in the web UI and to the chapter's CBZ.
+func chapterFeedEntry(site string, s *ent.Series, f *chapterFile) feed.Entry {
+	number := formatFloat(f.Number)
+	title := s.Title + " - Chapter " + number
+	if f.Chapter.Name != "" && f.Chapter.Name != "Chapter "+number {
+		title += ": " + f.Chapter.Name
+	}
+
+	summary := "Downloaded from " + f.Provider.Provider
+	if f.Provider.Scanlator != "" && f.Provider.Scanlator != f.Provider.Provider {
+		summary += " (" + f.Provider.Scanlator + ")"
+	}
+
+	return feed.Entry{
+		ID:      "urn:kaizoku:chapter:" + s.ID.String() + ":" + number,
+		Title:   title,
+		Summary: summary,
+		Updated: *f.Chapter.DownloadDate,
+		Links: []feed.Link{
+			{Rel: "alternate", Href: site + "/library/series?id=" + s.ID.String(), Type: "text/html"},
+			{Rel: "enclosure", Href: site + "/opds/series/" + s.ID.String() + "/chapters/" + number + "/file", Type: opds.TypeCBZ, Title: "Download CBZ"},
+		},
+		Categories: distinctPascalCase(s.Genre),
+	}
+}
+
+// writeAtom renders a feed of chapters.
+func (h *FeedsHandler) writeAtom(c echo.Context, f *feed.Atom, chapters []feedChapter) error {
+	site := siteURL(c)
+	f.Updated = time.Now()
+	if len(chapters) > 0 {
+		f.Updated = *chapters[0].file.Chapter.DownloadDate
+	}
+	f.Links = append([]feed.Link{{Rel: "self", Href: site + c.Request().URL.RequestURI(), Type: feed.TypeAtom}}, f.Links...)
+	for _, ch := range chapters {
+		f.Entries = append(f.Entries, chapterFeedEntry(site, ch.series, &ch.file))
+	}
+
+	data, err := f.Marshal()
+	if err != nil {
+		log.Error().Err(err).Msg("feeds: failed to render feed")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	return c.Blob(http.StatusOK, feed.TypeAtom, data)
+}
+
+// Library returns the most recently downloaded chapters of the whole library.
+// GET /feeds/library.atom?token=...
+func (h *FeedsHandler) Library(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	list, err := h.db.Series.Query().WithProviders().All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("feeds: failed to query series")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	f := &feed.Atom{
+		ID:       "urn:kaizoku:feed:library",
+		Title:    "Kaizoku - New Chapters",
+		Subtitle: "Chapters recently downloaded to the library",
+		Links:    []feed.Link{{Rel: "alternate", Href: siteURL(c) + "/library", Type: "text/html"}},
+	}
+	return h.writeAtom(c, f, h.recentChapters(list))
+}
+
+// Series returns the most recently downloaded chapters of one series.
+// GET /feeds/series/:id.atom?token=...
+func (h *FeedsHandler) Series(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	name, ok := strings.CutSuffix(c.Param("file"), ".atom")
+	if !ok {
+		return c.NoContent(http.StatusNotFound)
+	}
+	id, err := uuid.Parse(name)
+	if err != nil {
+		return c.NoContent(http.StatusNotFound)
+	}
+	s, err := h.db.Series.Query().Where(series.ID(id)).WithProviders().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.NoContent(http.StatusNotFound)
+		}
+		log.Error().Err(err).Msg("feeds: failed to get series")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	f := &feed.Atom{
+		ID:       "urn:kaizoku:feed:series:" + s.ID.String(),
+		Title:    s.Title + " - New Chapters",
+		Subtitle: "Chapters of " + s.Title + " recently downloaded to the library",
+		Links:    []feed.Link{{Rel: "alternate", Href: siteURL(c) + "/library/series?id=" + s.ID.String(), Type: "text/html"}},
+	}
+	return h.writeAtom(c, f, h.recentChapters([]*ent.Series{s}))
+}
+
+// Calendar returns the predicted chapter releases of every series as an
+// iCalendar feed. Overdue releases stay on their expected day, marked as
+// overdue.
+// GET /feeds/calendar.ics?token=...
+func (h *FeedsHandler) Calendar(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	list, err := h.db.Series.Query().WithProviders().All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("feeds: failed to query series")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	now := time.Now()
+	site := siteURL(c)
+	cal := &feed.Calendar{
+		Name:        "Kaizoku Releases",
+		Description: "Predicted chapter releases of the Kaizoku library",
+	}
+	for _, s := range list {
+		p := cadence.Predict(types.SeriesStatus(s.Status), s.Edges.Providers, now)
+		for i, day := range p.Upcoming(now.Add(calendarHorizon)) {
+			summary := s.Title
+			if p.NextChapter != nil {
+				summary += " - Chapter " + formatFloat(*p.NextChapter+float64(i))
+			}
+			if p.Overdue {
+				summary = "Overdue: " + summary
+			}
+			cal.Events = append(cal.Events, feed.Event{
+				UID:         s.ID.String() + "-" + day.Format("20060102") + "@kaizoku",
+				Date:        day,
+				Summary:     summary,
+				Description: fmt.Sprintf("Expected from the %s release schedule. Last release: %s.", p.Cadence, p.LastRelease.Format("Jan 2, 2006")),
+				URL:         site + "/library/series?id=" + s.ID.String(),
+				Categories:  distinctPascalCase(s.Genre),
+			})
+		}
+	}
+	sort.SliceStable(cal.Events, func(i, j int) bool { return cal.Events[i].Date.Before(cal.Events[j].Date) })
+
+	return c.Blob(http.StatusOK, feed.TypeCalendar, cal.Marshal(now))
+}
+
+// feedTokenInfo builds the feed URLs for token.
+func feedTokenInfo(c echo.Context, active bool, token string) types.FeedTokenInfo {
+	query := ""
+	if token != "" {
+		query = "?" + url.Values{"token": {token}}.Encode()
+	}
+	site := siteURL(c)
+	return types.FeedTokenInfo{
+		Active:      active,
+		Token:       token,
+		LibraryURL:  site + "/feeds/library.atom" + query,
+		SeriesURL:   site + "/feeds/series/{id}.atom" + query,
+		CalendarURL: site + "/feeds/calendar.ics" + query,
+	}
+}
+
+// GetFeedToken reports whether the caller has a feed token and returns the
+// feed URLs without it. The token is only shown when it is created.
+// GET /api/auth/feed-token
+func (h *FeedsHandler) GetFeedToken(c echo.Context) error {
+	if !h.config.Auth.Enabled {
+		return c.JSON(http.StatusOK, feedTokenInfo(c, false, ""))
+	}
+	userID := principalUserID(c)
+	if userID == nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "caller is not a user"})
+	}
+
+	active, err := h.auth.HasFeedToken(c.Request().Context(), *userID)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get feed token")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get feed token"})
+	}
+	return c.JSON(http.StatusOK, feedTokenInfo(c, active, ""))
+}
+
+// RotateFeedToken creates a new feed token for the caller and returns it,
+// once, in the feed URLs. Feed URLs using the previous token stop working.
+// POST /api/auth/feed-token
+func (h *FeedsHandler) RotateFeedToken(c echo.Context) error {
+	userID := principalUserID(c)
+	if userID == nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "caller is not a user"})
+	}
+
+	token, err := h.auth.RotateFeedToken(c.Request().Context(), *userID)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to rotate feed token")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to create feed token"})
+	}
+	return c.JSON(http.StatusCreated, feedTokenInfo(c, true, token))
+}
+
+// RevokeFeedToken removes the caller's feed token.
+// DELETE /api/auth/feed-token
+func (h *FeedsHandler) RevokeFeedToken(c echo.Context) error {
+	userID := principalUserID(c)
+	if userID == nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "caller is not a user"})
+	}
+
+	if err := h.auth.RevokeFeedToken(c.Request().Context(), *userID); err != nil {
+		log.Error().Err(err).Msg("failed to revoke feed token")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to revoke feed token"})
+	}
+	return c.JSON(http.StatusOK, nil)
+}
@@ -1,11 +1,17 @@
 package handler
 
 import (
+	"context"
+
 	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
 	"github.com/technobecet/kaizoku-go/internal/config"
 	"github.com/technobecet/kaizoku-go/internal/ent"
 	"github.com/technobecet/kaizoku-go/internal/job"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
+	"github.com/technobecet/kaizoku-go/internal/service/kosync"
+	"github.com/technobecet/kaizoku-go/internal/service/oidc"
+	"github.com/technobecet/kaizoku-go/internal/service/reading"
 	settingssvc "github.com/technobecet/kaizoku-go/internal/service/settings"
 	"github.com/technobecet/kaizoku-go/internal/service/suwayomi"
 )
@@ -20,21 +26,54 @@ type Handler struct {
 	Setup     *SetupHandler
 	Reporting *ReportingHandler
 	Jobs      *JobsHandler
+	Auth      *AuthHandler
+	Users     *UsersHandler
+	Audit     *AuditHandler
+	OPDS      *OPDSHandler
+	Reader    *ReaderHandler
+	KOSync    *KOSyncHandler
+	Webhooks  *WebhooksHandler
+	Notifiers *NotifiersHandler
+	Feeds     *FeedsHandler
+	Hooks     *HooksHandler
 }
 
-func New(cfg *config.Config, db *ent.Client, sw *suwayomi.Client, jobMgr *job.Manager) *Handler {
+func New(cfg *config.Config, db *ent.Client, sw *suwayomi.Client, jobMgr *job.Manager, authSvc *auth.Service) *Handler {
 	ss := settingssvc.NewService(db, cfg, sw)
 	rc := jobMgr.Client
+	rec := jobMgr.JobDeps.Audit
+	rs := reading.NewService(db)
+
+	// kosync indexes downloads as they complete; the full scan runs in the
+	// background so it never holds up a device's request.
+	ks := kosync.NewService(db, cfg, rs)
+	jobMgr.JobDeps.Events.Subscribe(ks.HandleEvent)
+	go ks.Run(context.Background())
+
+	var oidcProvider *oidc.Provider
+	if cfg.Auth.Enabled && cfg.Auth.OIDC.Enabled {
+		oidcProvider = oidc.NewProvider(cfg.Auth.OIDC, nil)
+	}
 
 	return &Handler{
-		Series:    &SeriesHandler{config: cfg, db: db, suwayomi: sw, settings: ss, river: rc, downloads: jobMgr.Downloads, jobDeps: jobMgr.JobDeps},
+		Series:    &SeriesHandler{config: cfg, db: db, suwayomi: sw, settings: ss, river: rc, downloads: jobMgr.Downloads, jobDeps: jobMgr.JobDeps, audit: rec, reading: rs},
 		Search:    &SearchHandler{config: cfg, db: db, suwayomi: sw, settings: ss},
-		Downloads: &DownloadsHandler{config: cfg, db: db, downloads: jobMgr.Downloads},
-		Provider:  &ProviderHandler{config: cfg, db: db, suwayomi: sw},
-		Settings:  NewSettingsHandler(cfg, db, sw),
-		Setup:     &SetupHandler{config: cfg, db: db, suwayomi: sw, river: rc},
+		Downloads: &DownloadsHandler{config: cfg, db: db, downloads: jobMgr.Downloads, audit: rec},
+		Provider:  &ProviderHandler{config: cfg, db: db, suwayomi: sw, audit: rec},
+		Settings:  NewSettingsHandler(cfg, db, sw, rec),
+		Setup:     &SetupHandler{config: cfg, db: db, suwayomi: sw, river: rc, audit: rec},
 		Reporting: &ReportingHandler{db: db},
 		Jobs:      &JobsHandler{pool: jobMgr.Pool},
+		Auth:      &AuthHandler{config: cfg, auth: authSvc, oidc: oidcProvider},
+		Users:     &UsersHandler{auth: authSvc, audit: rec},
+		Audit:     &AuditHandler{db: db},
+		OPDS:      &OPDSHandler{config: cfg, db: db},
+		Reader:    &ReaderHandler{config: cfg, db: db, reading: rs},
+		KOSync:    &KOSyncHandler{kosync: ks},
+		Webhooks:  &WebhooksHandler{db: db, webhooks: jobMgr.JobDeps.Webhooks, river: rc, audit: rec},
+		Feeds:     &FeedsHandler{config: cfg, db: db, auth: authSvc},
+		Notifiers: &NotifiersHandler{config: cfg, db: db, notify: jobMgr.JobDeps.Notify, river: rc, audit: rec},
+		Hooks:     &HooksHandler{db: db, hooks: jobMgr.JobDeps.Hooks},
 	}
 }
 
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/hookrun"
+	hookssvc "github.com/technobecet/kaizoku-go/internal/service/hooks"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// HooksHandler shows the script hooks from config.yaml and their run log.
+// Hooks run commands on the server, so they cannot be changed through the
+// API. All routes are admin-only.
+type HooksHandler struct {
+	db    *ent.Client
+	hooks *hookssvc.Runner
+}
+
+// hookRunToDTO converts an Ent HookRun entity to a HookRunInfo.
+func hookRunToDTO(r *ent.HookRun) types.HookRunInfo {
+	info := types.HookRunInfo{
+		ID:            r.ID.String(),
+		Hook:          r.Hook,
+		Event:         r.Event,
+		SeriesTitle:   r.SeriesTitle,
+		ChapterNumber: r.ChapterNumber,
+		Attempt:       r.Attempt,
+		Status:        r.Status,
+		ExitCode:      r.ExitCode,
+		Output:        r.Output,
+		Error:         r.Error,
+		DurationMs:    r.DurationMs,
+		CreatedAt:     r.CreatedAt.Format(time.RFC3339),
+	}
+	if r.SeriesID != nil {
+		s := r.SeriesID.String()
+		info.SeriesID = &s
+	}
+	return info
+}
+
+// ListHooks returns the configured script hooks.
+// GET /api/hooks
+func (h *HooksHandler) ListHooks(c echo.Context) error {
+	configured := h.hooks.Hooks()
+	result := make([]types.HookInfo, 0, len(configured))
+	for _, hk := range configured {
+		result = append(result, types.HookInfo{
+			Name:       hk.Name,
+			Event:      string(hk.Event),
+			Command:    hk.Command,
+			Timeout:    hk.Timeout.String(),
+			OnFailure:  hk.OnFailure,
+			Retries:    hk.Retries,
+			RetryDelay: hk.RetryDelay.String(),
+		})
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetRuns returns the hook run log with captured output, newest first.
+// GET /api/hooks/runs?hook=sync&status=failed&event=chapter.downloaded&limit=50&offset=0
+func (h *HooksHandler) GetRuns(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	limit := 50
+	if v, err := strconv.Atoi(c.QueryParam("limit")); err == nil && v > 0 {
+		limit = v
+	}
+	offset := 0
+	if v, err := strconv.Atoi(c.QueryParam("offset")); err == nil && v >= 0 {
+		offset = v
+	}
+
+	query := h.db.HookRun.Query()
+	if v := c.QueryParam("hook"); v != "" {
+		query = query.Where(hookrun.HookEQ(v))
+	}
+	if v := c.QueryParam("status"); v != "" {
+		query = query.Where(hookrun.StatusEQ(v))
+	}
+	if v := c.QueryParam("event"); v != "" {
+		query = query.Where(hookrun.EventEQ(v))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to count hook runs")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get hook runs"})
+	}
+	runs, err := query.
+		Order(ent.Desc(hookrun.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get hook runs")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get hook runs"})
+	}
+
+	result := types.HookRunList{Total: total, Runs: make([]types.HookRunInfo, 0, len(runs))}
+	for _, r := range runs {
+		result.Runs = append(result.Runs, hookRunToDTO(r))
+	}
+	return c.JSON(http.StatusOK, result)
+}
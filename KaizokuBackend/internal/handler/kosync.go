@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/service/kosync"
+)
+
+// kosync protocol error codes, as returned by the reference sync server.
+const (
+	KOSyncErrUnauthorized       = 2001
+	KOSyncErrUserExists         = 2002
+	KOSyncErrInvalidRequest     = 2003
+	KOSyncErrDocumentMissing    = 2004
+	KOSyncErrRegistrationClosed = 2005
+)
+
+// KOSyncHandler implements the KOReader progress sync (kosync) protocol.
+type KOSyncHandler struct {
+	kosync *kosync.Service
+}
+
+// KOSyncError writes an error in the kosync protocol format.
+func KOSyncError(c echo.Context, status, code int, message string) error {
+	return c.JSON(status, map[string]interface{}{"code": code, "message": message})
+}
+
+// CreateUser answers KOReader's "Register" button. Kaizoku accounts are
+// managed by admins, so registration is always closed; users log in with
+// their Kaizoku username and password. The answer is the same for every
+// username, so the public endpoint cannot be used to probe for accounts.
+// POST /kosync/users/create
+func (h *KOSyncHandler) CreateUser(c echo.Context) error {
+	return KOSyncError(c, http.StatusPaymentRequired, KOSyncErrRegistrationClosed, "User registration is disabled.")
+}
+
+// Authorize confirms the device's credentials; the auth middleware has
+// already verified them.
+// GET /kosync/users/auth
+func (h *KOSyncHandler) Authorize(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]string{"authorized": "OK"})
+}
+
+// GetProgress returns the caller's last position in a document, or an empty
+// object if there is none.
+// GET /kosync/syncs/progress/:document
+func (h *KOSyncHandler) GetProgress(c echo.Context) error {
+	document := c.Param("document")
+	if document == "" {
+		return KOSyncError(c, http.StatusForbidden, KOSyncErrDocumentMissing, "Field 'document' not provided.")
+	}
+
+	pos, err := h.kosync.GetProgress(c.Request().Context(), readerUserID(c), document)
+	if err != nil {
+		log.Error().Err(err).Msg("kosync: failed to get progress")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get progress"})
+	}
+	if pos == nil {
+		return c.JSON(http.StatusOK, map[string]interface{}{})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"document":   pos.Document,
+		"progress":   pos.Progress,
+		"percentage": pos.Percentage,
+		"device":     pos.Device,
+		"device_id":  pos.DeviceID,
+		"timestamp":  pos.Timestamp.Unix(),
+	})
+}
+
+// UpdateProgress stores a device's position in a document.
+// PUT /kosync/syncs/progress
+func (h *KOSyncHandler) UpdateProgress(c echo.Context) error {
+	var req struct {
+		Document   string  `json:"document"`
+		Progress   string  `json:"progress"`
+		Percentage float64 `json:"percentage"`
+		Device     string  `json:"device"`
+		DeviceID   string  `json:"device_id"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return KOSyncError(c, http.StatusForbidden, KOSyncErrInvalidRequest, "Invalid request")
+	}
+	if req.Document == "" {
+		return KOSyncError(c, http.StatusForbidden, KOSyncErrDocumentMissing, "Field 'document' not provided.")
+	}
+
+	pos, err := h.kosync.UpdateProgress(c.Request().Context(), readerUserID(c), kosync.Position{
+		Document:   req.Document,
+		Progress:   req.Progress,
+		Percentage: req.Percentage,
+		Device:     req.Device,
+		DeviceID:   req.DeviceID,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("kosync: failed to save progress")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to save progress"})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"document":  pos.Document,
+		"timestamp": pos.Timestamp.Unix(),
+	})
+}
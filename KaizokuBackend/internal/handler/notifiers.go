@@ -0,0 +1,380 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/notification"
+	"github.com/technobecet/kaizoku-go/internal/ent/notifier"
+	"github.com/technobecet/kaizoku-go/internal/events"
+	"github.com/technobecet/kaizoku-go/internal/job"
+	"github.com/technobecet/kaizoku-go/internal/service/audit"
+	"github.com/technobecet/kaizoku-go/internal/service/digest"
+	"github.com/technobecet/kaizoku-go/internal/service/mail"
+	"github.com/technobecet/kaizoku-go/internal/service/notify"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// NotifiersHandler manages push-notification targets and their log. All routes are admin-only.
+type NotifiersHandler struct {
+	config *config.Config
+	db     *ent.Client
+	notify *notify.Service
+	river  riverClient
+	audit  *audit.Recorder
+}
+
+// notifierToDTO converts an Ent Notifier entity to a NotifierInfo.
+func notifierToDTO(n *ent.Notifier) types.NotifierInfo {
+	evts := n.Events
+	if evts == nil {
+		evts = []string{}
+	}
+	return types.NotifierInfo{
+		ID:              n.ID.String(),
+		Name:            n.Name,
+		Kind:            n.Kind,
+		URL:             n.URL,
+		Topic:           n.Topic,
+		HasToken:        n.Token != "",
+		Events:          evts,
+		TitleTemplate:   n.TitleTemplate,
+		MessageTemplate: n.MessageTemplate,
+		QuietStart:      n.QuietStart,
+		QuietEnd:        n.QuietEnd,
+		Enabled:         n.Enabled,
+		CreatedAt:       n.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:       n.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// notificationToDTO converts an Ent Notification entity to a NotificationInfo.
+func notificationToDTO(n *ent.Notification) types.NotificationInfo {
+	info := types.NotificationInfo{
+		ID:         n.ID.String(),
+		NotifierID: n.NotifierID.String(),
+		EventType:  n.EventType,
+		Title:      n.Title,
+		Message:    n.Message,
+		Urgent:     n.Urgent,
+		Status:     n.Status,
+		Error:      n.Error,
+		CreatedAt:  n.CreatedAt.Format(time.RFC3339),
+	}
+	if n.SentAt != nil {
+		s := n.SentAt.Format(time.RFC3339)
+		info.SentAt = &s
+	}
+	return info
+}
+
+// notifierRequest is the body of create and update requests. Omitted
+// fields are left unchanged on update.
+type notifierRequest struct {
+	Name            *string   `json:"name"`
+	Kind            *string   `json:"kind"`
+	URL             *string   `json:"url"`
+	Topic           *string   `json:"topic"`
+	Token           *string   `json:"token"`
+	Events          *[]string `json:"events"`
+	TitleTemplate   *string   `json:"titleTemplate"`
+	MessageTemplate *string   `json:"messageTemplate"`
+	QuietStart      *string   `json:"quietStart"`
+	QuietEnd        *string   `json:"quietEnd"`
+	Enabled         *bool     `json:"enabled"`
+}
+
+// apply copies the fields present in the request onto n.
+func (r *notifierRequest) apply(n *ent.Notifier) {
+	if r.Name != nil && strings.TrimSpace(*r.Name) != "" {
+		n.Name = strings.TrimSpace(*r.Name)
+	}
+	if r.Kind != nil {
+		n.Kind = strings.ToLower(strings.TrimSpace(*r.Kind))
+	}
+	if r.URL != nil {
+		n.URL = strings.TrimSpace(*r.URL)
+	}
+	if r.Topic != nil {
+		n.Topic = strings.TrimSpace(*r.Topic)
+	}
+	if r.Token != nil {
+		n.Token = *r.Token
+	}
+	if r.Events != nil {
+		n.Events = *r.Events
+	}
+	if r.TitleTemplate != nil {
+		n.TitleTemplate = *r.TitleTemplate
+	}
+	if r.MessageTemplate != nil {
+		n.MessageTemplate = *r.MessageTemplate
+	}
+	if r.QuietStart != nil {
+		n.QuietStart = strings.TrimSpace(*r.QuietStart)
+	}
+	if r.QuietEnd != nil {
+		n.QuietEnd = strings.TrimSpace(*r.QuietEnd)
+	}
+	if r.Enabled != nil {
+		n.Enabled = *r.Enabled
+	}
+}
+
+// ListNotifiers returns all notifiers with the supported kinds and event types.
+// GET /api/notifiers
+func (h *NotifiersHandler) ListNotifiers(c echo.Context) error {
+	list, err := h.db.Notifier.Query().Order(ent.Asc(notifier.FieldName)).All(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list notifiers")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to list notifiers"})
+	}
+
+	result := make([]types.NotifierInfo, 0, len(list))
+	for _, n := range list {
+		result = append(result, notifierToDTO(n))
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"notifiers":  result,
+		"kinds":      notify.Kinds(),
+		"eventTypes": events.Types,
+	})
+}
+
+// CreateNotifier adds a notifier.
+// POST /api/notifiers
+func (h *NotifiersHandler) CreateNotifier(c echo.Context) error {
+	var req notifierRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+	n := &ent.Notifier{Enabled: true}
+	req.apply(n)
+	if n.Name == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "name required"})
+	}
+	if err := notify.Validate(n); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	n, err := h.db.Notifier.Create().
+		SetName(n.Name).
+		SetKind(n.Kind).
+		SetURL(n.URL).
+		SetTopic(n.Topic).
+		SetToken(n.Token).
+		SetEvents(n.Events).
+		SetTitleTemplate(n.TitleTemplate).
+		SetMessageTemplate(n.MessageTemplate).
+		SetQuietStart(n.QuietStart).
+		SetQuietEnd(n.QuietEnd).
+		SetEnabled(n.Enabled).
+		Save(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create notifier")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to create notifier"})
+	}
+
+	log.Info().Str("name", n.Name).Str("kind", n.Kind).Str("by", actorName(c)).Msg("notifier created")
+	return c.JSON(http.StatusCreated, notifierToDTO(n))
+}
+
+// UpdateNotifier changes a notifier. Omitted fields are left unchanged; an
+// empty token removes it.
+// PATCH /api/notifiers/:id
+func (h *NotifiersHandler) UpdateNotifier(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	var req notifierRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	ctx := c.Request().Context()
+	n, err := h.db.Notifier.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "notifier not found"})
+		}
+		log.Error().Err(err).Msg("failed to get notifier")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to update notifier"})
+	}
+	req.apply(n)
+	if err := notify.Validate(n); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	n, err = h.db.Notifier.UpdateOneID(id).
+		SetName(n.Name).
+		SetKind(n.Kind).
+		SetURL(n.URL).
+		SetTopic(n.Topic).
+		SetToken(n.Token).
+		SetEvents(n.Events).
+		SetTitleTemplate(n.TitleTemplate).
+		SetMessageTemplate(n.MessageTemplate).
+		SetQuietStart(n.QuietStart).
+		SetQuietEnd(n.QuietEnd).
+		SetEnabled(n.Enabled).
+		Save(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to update notifier")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to update notifier"})
+	}
+
+	log.Info().Str("name", n.Name).Str("by", actorName(c)).Msg("notifier updated")
+	return c.JSON(http.StatusOK, notifierToDTO(n))
+}
+
+// DeleteNotifier removes a notifier and its notification log.
+// DELETE /api/notifiers/:id
+func (h *NotifiersHandler) DeleteNotifier(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	ctx := c.Request().Context()
+	n, err := h.db.Notifier.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "notifier not found"})
+		}
+		log.Error().Err(err).Msg("failed to get notifier")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to delete notifier"})
+	}
+
+	if _, err := h.db.Notification.Delete().Where(notification.NotifierID(id)).Exec(ctx); err != nil {
+		log.Error().Err(err).Msg("failed to delete notifications")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to delete notifier"})
+	}
+	if err := h.db.Notifier.DeleteOneID(id).Exec(ctx); err != nil {
+		log.Error().Err(err).Msg("failed to delete notifier")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to delete notifier"})
+	}
+
+	log.Info().Str("name", n.Name).Str("by", actorName(c)).Msg("notifier deleted")
+	h.audit.Record(ctx, audit.Entry{
+		Action:     "notifier.delete",
+		TargetType: "notifier",
+		TargetIDs:  []string{id.String()},
+		Details:    map[string]interface{}{"name": n.Name, "kind": n.Kind, "url": n.URL},
+		IP:         c.RealIP(),
+	})
+	return c.JSON(http.StatusOK, nil)
+}
+
+// TestNotifier queues a test notification for one notifier, regardless of
+// its event filter, quiet hours or enabled flag.
+// POST /api/notifiers/:id/test
+func (h *NotifiersHandler) TestNotifier(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	ctx := c.Request().Context()
+	n, err := h.db.Notifier.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "notifier not found"})
+		}
+		log.Error().Err(err).Msg("failed to get notifier")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to test notifier"})
+	}
+
+	e := events.New(notify.TestEvent, map[string]interface{}{
+		"notifierId": n.ID.String(),
+		"name":       n.Name,
+		"by":         actorName(c),
+	})
+	nt, err := h.notify.CreateNotification(ctx, n, e, notify.StatusPending)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create test notification")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to test notifier"})
+	}
+	if nt.Status == notify.StatusPending {
+		if _, err := h.river.Insert(ctx, job.NotificationArgs{NotificationID: nt.ID}, nil); err != nil {
+			log.Error().Err(err).Msg("failed to enqueue test notification")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to test notifier"})
+		}
+	}
+	return c.JSON(http.StatusAccepted, notificationToDTO(nt))
+}
+
+// TestEmailDigest queues a digest of the last period to the configured
+// recipients, even when the scheduled digest is disabled. It does not move
+// the window of the next scheduled digest.
+// POST /api/notifiers/email-digest/test
+func (h *NotifiersHandler) TestEmailDigest(c echo.Context) error {
+	if err := mail.Validate(h.config.Email); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if _, err := digest.ParseSchedule(h.config.Email); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	ctx := c.Request().Context()
+	if _, err := h.river.Insert(ctx, job.EmailDigestArgs{Test: true}, nil); err != nil {
+		log.Error().Err(err).Msg("failed to enqueue test email digest")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to queue email digest"})
+	}
+	return c.JSON(http.StatusAccepted, map[string]string{"status": "queued"})
+}
+
+// GetNotifications returns the notification log, newest first.
+// GET /api/notifiers/notifications?notifierId=...&status=held&limit=50&offset=0
+func (h *NotifiersHandler) GetNotifications(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	limit := 50
+	if v, err := strconv.Atoi(c.QueryParam("limit")); err == nil && v > 0 {
+		limit = v
+	}
+	offset := 0
+	if v, err := strconv.Atoi(c.QueryParam("offset")); err == nil && v >= 0 {
+		offset = v
+	}
+
+	query := h.db.Notification.Query()
+	if v := c.QueryParam("notifierId"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid notifierId"})
+		}
+		query = query.Where(notification.NotifierID(id))
+	}
+	if v := c.QueryParam("status"); v != "" {
+		query = query.Where(notification.StatusEQ(v))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to count notifications")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get notifications"})
+	}
+	list, err := query.
+		Order(ent.Desc(notification.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get notifications")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get notifications"})
+	}
+
+	result := types.NotificationList{Total: total, Notifications: make([]types.NotificationInfo, 0, len(list))}
+	for _, n := range list {
+		result.Notifications = append(result.Notifications, notificationToDTO(n))
+	}
+	return c.JSON(http.StatusOK, result)
+}
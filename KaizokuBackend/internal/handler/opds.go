@@ -0,0 +1,454 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/series"
+	"github.com/technobecet/kaizoku-go/internal/opds"
+	"github.com/technobecet/kaizoku-go/internal/util"
+)
+
+const (
+	// opdsPageSize is the number of series per page in OPDS listings.
+	opdsPageSize = 50
+	// opdsRecentLimit is the number of chapters in the "recently downloaded" feed.
+	opdsRecentLimit = 50
+)
+
+// OPDSHandler serves the library as OPDS 1.2 (Atom) and OPDS 2.0 (JSON)
+// catalogs. The same routes are registered under /opds/v1.2 and /opds/v2;
+// the prefix selects the output format.
+type OPDSHandler struct {
+	config *config.Config
+	db     *ent.Client
+}
+
+// isV2 reports whether the request is for the OPDS 2.0 catalog.
+func (h *OPDSHandler) isV2(c echo.Context) bool {
+	return strings.HasPrefix(c.Path(), "/opds/v2")
+}
+
+// href returns the catalog URL for path in the requested catalog version.
+func (h *OPDSHandler) href(c echo.Context, path string) string {
+	if h.isV2(c) {
+		return "/opds/v2" + path
+	}
+	return "/opds/v1.2" + path
+}
+
+// feedType returns the media type for links to feeds of the given kind.
+func (h *OPDSHandler) feedType(c echo.Context, kind opds.Kind) string {
+	switch {
+	case h.isV2(c):
+		return opds.TypeOPDS2
+	case kind == opds.Acquisition:
+		return opds.TypeAcquisition
+	default:
+		return opds.TypeNavigation
+	}
+}
+
+// newFeed creates a feed with the self, start and search links every page carries.
+func (h *OPDSHandler) newFeed(c echo.Context, id, title string, kind opds.Kind) *opds.Feed {
+	f := &opds.Feed{
+		ID:      "urn:kaizoku:" + id,
+		Title:   title,
+		Updated: time.Now(),
+		Kind:    kind,
+		Links: []opds.Link{
+			{Rel: opds.RelSelf, Href: c.Request().URL.RequestURI(), Type: h.feedType(c, kind)},
+			{Rel: opds.RelStart, Href: h.href(c, "/catalog"), Type: h.feedType(c, opds.Navigation)},
+		},
+	}
+	if h.isV2(c) {
+		f.Links = append(f.Links, opds.Link{Rel: opds.RelSearch, Href: "/opds/v2/search{?query}", Type: opds.TypeOPDS2, Templated: true})
+	} else {
+		f.Links = append(f.Links, opds.Link{Rel: opds.RelSearch, Href: "/opds/v1.2/opensearch.xml", Type: opds.TypeOpenSearch})
+	}
+	return f
+}
+
+// writeFeed renders the feed in the requested catalog version.
+func (h *OPDSHandler) writeFeed(c echo.Context, f *opds.Feed) error {
+	var (
+		data []byte
+		err  error
+	)
+	if h.isV2(c) {
+		data, err = f.MarshalOPDS2()
+	} else {
+		data, err = f.MarshalAtom()
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("opds: failed to render feed")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	return c.Blob(http.StatusOK, h.feedType(c, f.Kind), data)
+}
+
+// paginate adds paging links and metadata to a feed of total items.
+func (h *OPDSHandler) paginate(c echo.Context, f *opds.Feed, path string, query url.Values, page, total int) {
+	f.Total, f.PerPage, f.Page = total, opdsPageSize, page
+	last := (total + opdsPageSize - 1) / opdsPageSize
+	if last < 1 {
+		last = 1
+	}
+	link := func(rel string, p int) opds.Link {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		q.Set("page", strconv.Itoa(p))
+		return opds.Link{Rel: rel, Href: h.href(c, path) + "?" + q.Encode(), Type: h.feedType(c, f.Kind)}
+	}
+	f.Links = append(f.Links, link(opds.RelFirst, 1), link(opds.RelLast, last))
+	if page > 1 {
+		f.Links = append(f.Links, link(opds.RelPrevious, page-1))
+	}
+	if page < last {
+		f.Links = append(f.Links, link(opds.RelNext, page+1))
+	}
+}
+
+// pageParam returns the 1-based page query parameter.
+func pageParam(c echo.Context) int {
+	if v, err := strconv.Atoi(c.QueryParam("page")); err == nil && v > 0 {
+		return v
+	}
+	return 1
+}
+
+// coverLinks returns image links for a series cover.
+func coverLinks(s *ent.Series) []opds.Link {
+	if s.ThumbnailURL == "" {
+		return nil
+	}
+	href := "/api/" + s.ThumbnailURL
+	return []opds.Link{
+		{Rel: opds.RelImage, Href: href, Type: opds.TypeJPEG},
+		{Rel: opds.RelThumbnail, Href: href, Type: opds.TypeJPEG},
+	}
+}
+
+// seriesEntry returns a navigation entry linking to a series' chapter feed.
+func (h *OPDSHandler) seriesEntry(c echo.Context, s *ent.Series) opds.Entry {
+	e := opds.Entry{
+		ID:         "urn:kaizoku:series:" + s.ID.String(),
+		Title:      s.Title,
+		Summary:    s.Description,
+		Updated:    time.Now(),
+		Categories: distinctPascalCase(s.Genre),
+		Links: []opds.Link{
+			{Rel: opds.RelSubsection, Href: h.href(c, "/series/"+s.ID.String()), Type: h.feedType(c, opds.Acquisition)},
+		},
+	}
+	if s.Author != "" {
+		e.Authors = []string{s.Author}
+	}
+	e.Links = append(e.Links, coverLinks(s)...)
+	return e
+}
+
+// chapterEntry returns a publication entry for a downloaded chapter, with a
+// CBZ acquisition link and an OPDS-PSE page streaming link.
+func chapterEntry(s *ent.Series, f *chapterFile, title string) opds.Entry {
+	base := "/opds/series/" + s.ID.String() + "/chapters/" + formatFloat(f.Number)
+
+	pages := 0
+	if f.Chapter.PageCount != nil {
+		pages = *f.Chapter.PageCount
+	}
+	if pages <= 0 {
+		pages = util.CountCBZPages(f.Path)
+	}
+
+	e := opds.Entry{
+		ID:         "urn:kaizoku:chapter:" + s.ID.String() + ":" + formatFloat(f.Number),
+		Title:      title,
+		Updated:    time.Now(),
+		Categories: distinctPascalCase(s.Genre),
+		Links: []opds.Link{
+			{Rel: opds.RelAcquisition, Href: base + "/file", Type: opds.TypeCBZ, Title: "Download CBZ"},
+		},
+	}
+	if f.Chapter.DownloadDate != nil {
+		e.Updated = *f.Chapter.DownloadDate
+	}
+	if s.Author != "" {
+		e.Authors = []string{s.Author}
+	}
+	if pages > 0 {
+		e.Links = append(e.Links, opds.Link{
+			Rel:       opds.RelPSEStream,
+			Href:      base + "/pages/{pageNumber}?width={maxWidth}",
+			Type:      opds.TypeJPEG,
+			Templated: true,
+			PageCount: pages,
+		})
+	}
+	e.Links = append(e.Links, coverLinks(s)...)
+	return e
+}
+
+// Catalog returns the root navigation feed.
+// GET /opds/v1.2/catalog, GET /opds/v2/catalog
+func (h *OPDSHandler) Catalog(c echo.Context) error {
+	f := h.newFeed(c, "catalog", "Kaizoku", opds.Navigation)
+	now := time.Now()
+	f.Entries = []opds.Entry{
+		{
+			ID: "urn:kaizoku:series", Title: "All Series", Summary: "Every series in the library, by title", Updated: now,
+			Links: []opds.Link{{Rel: opds.RelSubsection, Href: h.href(c, "/series"), Type: h.feedType(c, opds.Navigation)}},
+		},
+		{
+			ID: "urn:kaizoku:recent", Title: "Recently Downloaded", Summary: "The latest downloaded chapters", Updated: now,
+			Links: []opds.Link{{Rel: opds.RelSortNew, Href: h.href(c, "/recent"), Type: h.feedType(c, opds.Acquisition)}},
+		},
+		{
+			ID: "urn:kaizoku:categories", Title: "Categories", Summary: "Series grouped by category", Updated: now,
+			Links: []opds.Link{{Rel: opds.RelSubsection, Href: h.href(c, "/categories"), Type: h.feedType(c, opds.Navigation)}},
+		},
+	}
+	return h.writeFeed(c, f)
+}
+
+// listSeries writes a paginated navigation feed of the series matching query.
+func (h *OPDSHandler) listSeries(c echo.Context, f *opds.Feed, query *ent.SeriesQuery, path string, params url.Values) error {
+	ctx := c.Request().Context()
+	page := pageParam(c)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("opds: failed to count series")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	list, err := query.
+		Order(ent.Asc(series.FieldTitle)).
+		Limit(opdsPageSize).
+		Offset((page - 1) * opdsPageSize).
+		All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("opds: failed to query series")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	for _, s := range list {
+		f.Entries = append(f.Entries, h.seriesEntry(c, s))
+	}
+	h.paginate(c, f, path, params, page, total)
+	return h.writeFeed(c, f)
+}
+
+// AllSeries returns every series in the library, sorted by title.
+// GET /opds/v1.2/series?page=1
+func (h *OPDSHandler) AllSeries(c echo.Context) error {
+	f := h.newFeed(c, "series", "All Series", opds.Navigation)
+	return h.listSeries(c, f, h.db.Series.Query(), "/series", url.Values{})
+}
+
+// Categories lists the categories (series types) present in the library.
+// GET /opds/v1.2/categories
+func (h *OPDSHandler) Categories(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	list, err := h.db.Series.Query().All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("opds: failed to query series")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	counts := make(map[string]int)
+	for _, s := range list {
+		if s.Type != nil && *s.Type != "" {
+			counts[*s.Type]++
+		}
+	}
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	f := h.newFeed(c, "categories", "Categories", opds.Navigation)
+	for _, name := range names {
+		f.Entries = append(f.Entries, opds.Entry{
+			ID:      "urn:kaizoku:category:" + name,
+			Title:   name,
+			Summary: strconv.Itoa(counts[name]) + " series",
+			Updated: time.Now(),
+			Links: []opds.Link{
+				{Rel: opds.RelSubsection, Href: h.href(c, "/categories/"+url.PathEscape(name)), Type: h.feedType(c, opds.Navigation)},
+			},
+		})
+	}
+	return h.writeFeed(c, f)
+}
+
+// Category returns the series of one category.
+// GET /opds/v1.2/categories/:name?page=1
+func (h *OPDSHandler) Category(c echo.Context) error {
+	name, err := url.PathUnescape(c.Param("name"))
+	if err != nil || name == "" {
+		return c.NoContent(http.StatusNotFound)
+	}
+	f := h.newFeed(c, "category:"+name, name, opds.Navigation)
+	f.Links = append(f.Links, opds.Link{Rel: opds.RelUp, Href: h.href(c, "/categories"), Type: h.feedType(c, opds.Navigation)})
+	return h.listSeries(c, f, h.db.Series.Query().Where(series.TypeEQ(name)), "/categories/"+url.PathEscape(name), url.Values{})
+}
+
+// Search returns library series whose title, author or artist matches the query.
+// GET /opds/v1.2/search?q=..., GET /opds/v2/search?query=...
+func (h *OPDSHandler) Search(c echo.Context) error {
+	param := "q"
+	if h.isV2(c) {
+		param = "query"
+	}
+	term := strings.TrimSpace(c.QueryParam(param))
+
+	f := h.newFeed(c, "search", "Search: "+term, opds.Navigation)
+	query := h.db.Series.Query().Where(series.Or(
+		series.TitleContainsFold(term),
+		series.AuthorContainsFold(term),
+		series.ArtistContainsFold(term),
+	))
+	return h.listSeries(c, f, query, "/search", url.Values{param: {term}})
+}
+
+// OpenSearch returns the OpenSearch description used by OPDS 1.2 clients.
+// GET /opds/v1.2/opensearch.xml
+func (h *OPDSHandler) OpenSearch(c echo.Context) error {
+	data, err := opds.MarshalOpenSearch("/opds/v1.2/search?q={searchTerms}")
+	if err != nil {
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	return c.Blob(http.StatusOK, opds.TypeOpenSearch, data)
+}
+
+// Recent returns the most recently downloaded chapters across the library.
+// GET /opds/v1.2/recent
+func (h *OPDSHandler) Recent(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	list, err := h.db.Series.Query().WithProviders().All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("opds: failed to query series")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	type recentChapter struct {
+		series *ent.Series
+		file   chapterFile
+	}
+	var recent []recentChapter
+	for _, s := range list {
+		for _, f := range downloadedChapters(h.config.Storage.Folder, s, s.Edges.Providers) {
+			if f.Chapter.DownloadDate != nil {
+				recent = append(recent, recentChapter{series: s, file: f})
+			}
+		}
+	}
+	sort.Slice(recent, func(i, j int) bool {
+		return recent[i].file.Chapter.DownloadDate.After(*recent[j].file.Chapter.DownloadDate)
+	})
+	if len(recent) > opdsRecentLimit {
+		recent = recent[:opdsRecentLimit]
+	}
+
+	f := h.newFeed(c, "recent", "Recently Downloaded", opds.Acquisition)
+	for _, r := range recent {
+		f.Entries = append(f.Entries, chapterEntry(r.series, &r.file, r.series.Title+" - "+chapterTitle(&r.file)))
+	}
+	return h.writeFeed(c, f)
+}
+
+// Series returns the downloaded chapters of a series as an acquisition feed.
+// GET /opds/v1.2/series/:id
+func (h *OPDSHandler) Series(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.NoContent(http.StatusNotFound)
+	}
+	s, err := h.db.Series.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.NoContent(http.StatusNotFound)
+		}
+		log.Error().Err(err).Msg("opds: failed to get series")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	providers, err := s.QueryProviders().All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("opds: failed to load providers")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+
+	f := h.newFeed(c, "series:"+s.ID.String(), s.Title, opds.Acquisition)
+	f.Links = append(f.Links, opds.Link{Rel: opds.RelUp, Href: h.href(c, "/series"), Type: h.feedType(c, opds.Navigation)})
+	f.Links = append(f.Links, coverLinks(s)...)
+	for _, cf := range downloadedChapters(h.config.Storage.Folder, s, providers) {
+		f.Entries = append(f.Entries, chapterEntry(s, &cf, chapterTitle(&cf)))
+	}
+	return h.writeFeed(c, f)
+}
+
+// chapterFromRequest resolves the :id and :number path parameters to a
+// downloaded chapter, writing a 404 when it does not exist.
+func (h *OPDSHandler) chapterFromRequest(c echo.Context) (*chapterFile, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, false
+	}
+	number, err := parseChapterNumber(c.Param("number"))
+	if err != nil {
+		return nil, false
+	}
+	_, f, err := findChapterFile(c.Request().Context(), h.db, h.config.Storage.Folder, id, number)
+	if err != nil {
+		if !ent.IsNotFound(err) && !errors.Is(err, errChapterNotFound) {
+			log.Error().Err(err).Msg("opds: failed to find chapter")
+		}
+		return nil, false
+	}
+	return f, true
+}
+
+// DownloadChapter streams a chapter's CBZ file. Range requests are supported.
+// GET /opds/series/:id/chapters/:number/file
+func (h *OPDSHandler) DownloadChapter(c echo.Context) error {
+	f, ok := h.chapterFromRequest(c)
+	if !ok {
+		return c.NoContent(http.StatusNotFound)
+	}
+	c.Response().Header().Set(echo.HeaderContentType, opds.TypeCBZ)
+	return c.Attachment(f.Path, filepath.Base(f.Path))
+}
+
+// StreamPage streams a single page image straight from the chapter's CBZ
+// (OPDS Page Streaming Extension). Page numbers are zero-based; the optional
+// width parameter scales the page down for small screens.
+// GET /opds/series/:id/chapters/:number/pages/:page?width=
+func (h *OPDSHandler) StreamPage(c echo.Context) error {
+	f, ok := h.chapterFromRequest(c)
+	if !ok {
+		return c.NoContent(http.StatusNotFound)
+	}
+	index, err := strconv.Atoi(c.Param("page"))
+	if err != nil {
+		return c.NoContent(http.StatusNotFound)
+	}
+	width, _ := strconv.Atoi(c.QueryParam("width"))
+	return servePage(c, f, index, width)
+}
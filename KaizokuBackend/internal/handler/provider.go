@@ -13,6 +13,7 @@ import (
 	"github.com/technobecet/kaizoku-go/internal/config"
 	"github.com/technobecet/kaizoku-go/internal/ent"
 	"github.com/technobecet/kaizoku-go/internal/ent/providerstorage"
+	"github.com/technobecet/kaizoku-go/internal/service/audit"
 	"github.com/technobecet/kaizoku-go/internal/service/suwayomi"
 	"github.com/technobecet/kaizoku-go/internal/types"
 )
@@ -21,6 +22,7 @@ type ProviderHandler struct {
 	config   *config.Config
 	db       *ent.Client
 	suwayomi *suwayomi.Client
+	audit    *audit.Recorder
 
 	mu    sync.RWMutex
 	cache []suwayomi.SuwayomiExtension
@@ -140,6 +142,7 @@ func (h *ProviderHandler) UninstallProvider(c echo.Context) error {
 	}
 
 	// Mark the ProviderStorage entry as disabled
+	var targetIDs []string
 	providers, err := h.db.ProviderStorage.Query().All(ctx)
 	if err == nil {
 		for _, p := range providers {
@@ -147,11 +150,20 @@ func (h *ProviderHandler) UninstallProvider(c echo.Context) error {
 				_, _ = h.db.ProviderStorage.UpdateOneID(p.ID).
 					SetIsDisabled(true).
 					Save(ctx)
+				targetIDs = append(targetIDs, p.ID.String())
 				break
 			}
 		}
 	}
 
+	log.Info().Str("pkg", pkgName).Str("by", actorName(c)).Msg("extension uninstalled")
+	h.audit.Record(ctx, audit.Entry{
+		Action:     "provider.uninstall",
+		TargetType: "provider",
+		TargetIDs:  targetIDs,
+		Details:    map[string]interface{}{"pkg": pkgName},
+		IP:         c.RealIP(),
+	})
 	return c.JSON(http.StatusOK, map[string]string{"message": "Extension uninstalled successfully"})
 }
 
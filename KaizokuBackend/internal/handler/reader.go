@@ -0,0 +1,312 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/series"
+	"github.com/technobecet/kaizoku-go/internal/service/reading"
+	"github.com/technobecet/kaizoku-go/internal/types"
+	"github.com/technobecet/kaizoku-go/internal/util"
+)
+
+// ReaderHandler serves downloaded chapters page by page for the built-in
+// reader. Pages are read straight from the CBZ archives; nothing is
+// extracted to disk.
+type ReaderHandler struct {
+	config  *config.Config
+	db      *ent.Client
+	reading *reading.Service
+}
+
+// readerUserID returns the user whose reading progress a request reads and
+// writes. Callers that are not users (auth disabled, the bootstrap key)
+// share the progress stored under uuid.Nil.
+func readerUserID(c echo.Context) uuid.UUID {
+	if id := principalUserID(c); id != nil {
+		return *id
+	}
+	return uuid.Nil
+}
+
+// progressToDTO converts an Ent ReadProgress entity to a ReadProgressInfo.
+func progressToDTO(p *ent.ReadProgress) types.ReadProgressInfo {
+	return types.ReadProgressInfo{
+		ChapterNumber: p.ChapterNumber,
+		Page:          p.Page,
+		PageCount:     p.PageCount,
+		Completed:     p.Completed,
+		UpdatedAt:     p.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// toReaderChapter converts a located chapter file to a ReaderChapter.
+// pageCount is used when the stored chapter has no page count.
+func toReaderChapter(f *chapterFile, pageCount int) types.ReaderChapter {
+	rc := types.ReaderChapter{
+		Number:    f.Number,
+		Title:     chapterTitle(f),
+		Provider:  f.Provider.Provider,
+		Scanlator: f.Provider.Scanlator,
+		Language:  f.Provider.Language,
+		PageCount: pageCount,
+	}
+	if f.Chapter.PageCount != nil && *f.Chapter.PageCount > 0 {
+		rc.PageCount = *f.Chapter.PageCount
+	}
+	if f.Chapter.DownloadDate != nil {
+		t := f.Chapter.DownloadDate.UTC().Format(time.RFC3339)
+		rc.DownloadDate = &t
+	}
+	return rc
+}
+
+// GetChapters lists the downloaded chapters of a series, sorted by number.
+// GET /api/reader/:id/chapters
+func (h *ReaderHandler) GetChapters(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	s, err := h.db.Series.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Series not found"})
+		}
+		log.Error().Err(err).Msg("reader: failed to get series")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get series"})
+	}
+	providers, err := s.QueryProviders().All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("reader: failed to load providers")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get series"})
+	}
+
+	files := downloadedChapters(h.config.Storage.Folder, s, providers)
+	result := make([]types.ReaderChapter, 0, len(files))
+	for i := range files {
+		result = append(result, toReaderChapter(&files[i], 0))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetChapterPages opens a chapter's CBZ and lists its pages in reading order.
+// GET /api/reader/:id/chapters/:number
+func (h *ReaderHandler) GetChapterPages(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	number, err := parseChapterNumber(c.Param("number"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	s, err := h.db.Series.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Series not found"})
+		}
+		log.Error().Err(err).Msg("reader: failed to get series")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get series"})
+	}
+	providers, err := s.QueryProviders().All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("reader: failed to load providers")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get series"})
+	}
+
+	files := downloadedChapters(h.config.Storage.Folder, s, providers)
+	idx := -1
+	for i := range files {
+		if files[i].Number == number {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "chapter not found"})
+	}
+	f := &files[idx]
+
+	pages, err := util.ListCBZPages(f.Path)
+	if err != nil {
+		log.Warn().Err(err).Str("file", f.Path).Msg("reader: failed to open chapter")
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "chapter file is missing or unreadable"})
+	}
+
+	result := types.ReaderChapterPages{
+		SeriesID:    s.ID.String(),
+		SeriesTitle: s.Title,
+		Chapter:     toReaderChapter(f, len(pages)),
+		Pages:       make([]types.ReaderPage, 0, len(pages)),
+	}
+	result.Chapter.PageCount = len(pages)
+	if ci, err := util.ReadComicInfoFromCBZ(f.Path); err == nil && ci != nil {
+		result.RightToLeft = ci.Manga == "YesAndRightToLeft"
+	}
+	if idx > 0 {
+		result.Previous = &files[idx-1].Number
+	}
+	if idx < len(files)-1 {
+		result.Next = &files[idx+1].Number
+	}
+
+	base := "/api/reader/" + s.ID.String() + "/chapters/" + formatFloat(f.Number) + "/pages/"
+	for i, p := range pages {
+		result.Pages = append(result.Pages, types.ReaderPage{
+			Index: i,
+			Name:  p.Name,
+			Size:  p.Size,
+			URL:   base + strconv.Itoa(i),
+		})
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetPage streams one page of a chapter. Pages are zero-based. The optional
+// width parameter scales the page down server-side (64-4096 px).
+// Responses carry an ETag and honour If-None-Match.
+// GET /api/reader/:id/chapters/:number/pages/:page?width=1200
+func (h *ReaderHandler) GetPage(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	number, err := parseChapterNumber(c.Param("number"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	index, err := strconv.Atoi(c.Param("page"))
+	if err != nil || index < 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid page"})
+	}
+	width, _ := strconv.Atoi(c.QueryParam("width"))
+
+	_, f, err := findChapterFile(c.Request().Context(), h.db, h.config.Storage.Folder, id, number)
+	if err != nil {
+		if ent.IsNotFound(err) || errors.Is(err, errChapterNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "chapter not found"})
+		}
+		log.Error().Err(err).Msg("reader: failed to find chapter")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to find chapter"})
+	}
+	return servePage(c, f, index, width)
+}
+
+// GetProgress returns the caller's reading progress for every chapter of a series.
+// GET /api/reader/:id/progress
+func (h *ReaderHandler) GetProgress(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	progress, err := h.reading.SeriesProgress(c.Request().Context(), readerUserID(c), id)
+	if err != nil {
+		log.Error().Err(err).Msg("reader: failed to load progress")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to load progress"})
+	}
+
+	result := make([]types.ReadProgressInfo, 0, len(progress))
+	for _, p := range progress {
+		result = append(result, progressToDTO(p))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// SetProgress records the caller's position in a chapter. Reaching the last
+// page (when pageCount is given) marks the chapter completed.
+// PUT /api/reader/:id/chapters/:number/progress
+func (h *ReaderHandler) SetProgress(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+	number, err := parseChapterNumber(c.Param("number"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	var req struct {
+		Page      int  `json:"page"`
+		PageCount int  `json:"pageCount"`
+		Completed bool `json:"completed"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	ctx := c.Request().Context()
+	if exists, err := h.db.Series.Query().Where(series.ID(id)).Exist(ctx); err != nil || !exists {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Series not found"})
+	}
+
+	p, err := h.reading.SetProgress(ctx, readerUserID(c), id, number, reading.Progress{
+		Page:      req.Page,
+		PageCount: req.PageCount,
+		Completed: req.Completed,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("reader: failed to save progress")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to save progress"})
+	}
+	return c.JSON(http.StatusOK, progressToDTO(p))
+}
+
+// MarkRead marks chapters of a series as read or unread. Without a chapter
+// list, every downloaded chapter is marked.
+// POST /api/reader/:id/mark
+func (h *ReaderHandler) MarkRead(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req struct {
+		Chapters  []float64 `json:"chapters"`
+		Completed bool      `json:"completed"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	ctx := c.Request().Context()
+	s, err := h.db.Series.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Series not found"})
+		}
+		log.Error().Err(err).Msg("reader: failed to get series")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get series"})
+	}
+
+	numbers := req.Chapters
+	if len(numbers) == 0 {
+		providers, err := s.QueryProviders().All(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("reader: failed to load providers")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get series"})
+		}
+		for _, f := range downloadedChapters(h.config.Storage.Folder, s, providers) {
+			numbers = append(numbers, f.Number)
+		}
+	}
+
+	if err := h.reading.MarkRead(ctx, readerUserID(c), id, numbers, req.Completed); err != nil {
+		log.Error().Err(err).Msg("reader: failed to mark chapters")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to mark chapters"})
+	}
+	return c.JSON(http.StatusOK, nil)
+}
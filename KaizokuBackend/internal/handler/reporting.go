@@ -12,6 +12,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/technobecet/kaizoku-go/internal/ent"
 	"github.com/technobecet/kaizoku-go/internal/ent/sourceevent"
+	"github.com/technobecet/kaizoku-go/internal/service/reporting"
 	"github.com/technobecet/kaizoku-go/internal/types"
 )
 
@@ -42,24 +43,6 @@ func parsePeriod(s string) time.Duration {
 	return 24 * time.Hour
 }
 
-// sourceAgg is an internal accumulator for per-source aggregation.
-type sourceAgg struct {
-	sourceID         string
-	sourceName       string
-	language         string
-	totalDuration    int64
-	maxDuration      int64
-	eventCount       int
-	successCount     int
-	failureCount     int
-	partialCount     int
-	lastEventAt      time.Time
-	lastErrorAt      time.Time
-	lastErrorMessage *string
-	hasError         bool
-	breakdown        map[string]*types.EventTypeBreakdown
-}
-
 // eventToDTO converts an Ent SourceEvent entity to a SourceEventDTO.
 func eventToDTO(e *ent.SourceEvent) types.SourceEventDTO {
 	meta := e.Metadata
@@ -82,59 +65,6 @@ func eventToDTO(e *ent.SourceEvent) types.SourceEventDTO {
 	}
 }
 
-// accumulateEvent updates a sourceAgg with data from one event.
-func accumulateEvent(agg *sourceAgg, e *ent.SourceEvent) {
-	agg.eventCount++
-	agg.totalDuration += e.DurationMs
-	if e.DurationMs > agg.maxDuration {
-		agg.maxDuration = e.DurationMs
-	}
-	switch e.Status {
-	case "success":
-		agg.successCount++
-	case "failed":
-		agg.failureCount++
-	case "partial":
-		agg.partialCount++
-	}
-	if e.CreatedAt.After(agg.lastEventAt) {
-		agg.lastEventAt = e.CreatedAt
-	}
-	if e.Status == "failed" && e.CreatedAt.After(agg.lastErrorAt) {
-		agg.lastErrorAt = e.CreatedAt
-		agg.lastErrorMessage = e.ErrorMessage
-		agg.hasError = true
-	}
-}
-
-// newSourceAgg creates a new sourceAgg from an event.
-func newSourceAgg(e *ent.SourceEvent) *sourceAgg {
-	return &sourceAgg{
-		sourceID:   e.SourceID,
-		sourceName: e.SourceName,
-		language:   e.Language,
-		breakdown:  make(map[string]*types.EventTypeBreakdown),
-	}
-}
-
-// toSummary converts a sourceAgg to a SourceStatsSummary.
-func toSummary(agg *sourceAgg) types.SourceStatsSummary {
-	var fr, avgDur float64
-	if agg.eventCount > 0 {
-		fr = math.Round(float64(agg.failureCount)/float64(agg.eventCount)*10000) / 100
-		avgDur = math.Round(float64(agg.totalDuration)/float64(agg.eventCount)*100) / 100
-	}
-	return types.SourceStatsSummary{
-		SourceID:      agg.sourceID,
-		SourceName:    agg.sourceName,
-		Language:      agg.language,
-		AvgDurationMs: avgDur,
-		EventCount:    agg.eventCount,
-		FailureCount:  agg.failureCount,
-		FailureRate:   fr,
-	}
-}
-
 // GetOverview returns a dashboard summary of source events.
 // GET /api/reporting/overview?period=24h
 func (h *ReportingHandler) GetOverview(c echo.Context) error {
@@ -154,20 +84,11 @@ func (h *ReportingHandler) GetOverview(c echo.Context) error {
 	totalEvents := len(events)
 	var successCount int
 	var totalDuration int64
-	sources := make(map[string]*sourceAgg)
-
 	for _, e := range events {
 		totalDuration += e.DurationMs
 		if e.Status == "success" {
 			successCount++
 		}
-
-		agg, ok := sources[e.SourceID]
-		if !ok {
-			agg = newSourceAgg(e)
-			sources[e.SourceID] = agg
-		}
-		accumulateEvent(agg, e)
 	}
 
 	var successRate float64
@@ -178,10 +99,7 @@ func (h *ReportingHandler) GetOverview(c echo.Context) error {
 	}
 
 	// Build source summary list.
-	summaries := make([]types.SourceStatsSummary, 0, len(sources))
-	for _, agg := range sources {
-		summaries = append(summaries, toSummary(agg))
-	}
+	summaries := reporting.SourceSummaries(events)
 
 	// Slowest: top 10 by avg duration, minimum 5 events.
 	slowest := make([]types.SourceStatsSummary, 0)
@@ -234,7 +152,7 @@ func (h *ReportingHandler) GetOverview(c echo.Context) error {
 		TotalEvents:    totalEvents,
 		SuccessRate:    successRate,
 		AvgDurationMs:  avgDuration,
-		ActiveSources:  len(sources),
+		ActiveSources:  len(summaries),
 		SlowestSources: slowest,
 		FailingSources: failing,
 		RecentErrors:   recentErrors,
@@ -257,71 +175,7 @@ func (h *ReportingHandler) GetSources(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to query events"})
 	}
 
-	sources := make(map[string]*sourceAgg)
-	for _, e := range events {
-		agg, ok := sources[e.SourceID]
-		if !ok {
-			agg = newSourceAgg(e)
-			sources[e.SourceID] = agg
-		}
-		accumulateEvent(agg, e)
-
-		// Event type breakdown.
-		bd, ok := agg.breakdown[e.EventType]
-		if !ok {
-			bd = &types.EventTypeBreakdown{}
-			agg.breakdown[e.EventType] = bd
-		}
-		bd.Total++
-		if e.Status == "success" {
-			bd.Success++
-		} else if e.Status == "failed" {
-			bd.Failed++
-		}
-	}
-
-	result := make([]types.SourceStats, 0, len(sources))
-	for _, agg := range sources {
-		var sr float64
-		var avgDur float64
-		if agg.eventCount > 0 {
-			sr = math.Round(float64(agg.successCount)/float64(agg.eventCount)*10000) / 100
-			avgDur = math.Round(float64(agg.totalDuration)/float64(agg.eventCount)*100) / 100
-		}
-
-		var lastEventAt *string
-		if !agg.lastEventAt.IsZero() {
-			s := agg.lastEventAt.Format(time.RFC3339)
-			lastEventAt = &s
-		}
-		var lastErrorAt *string
-		if agg.hasError {
-			s := agg.lastErrorAt.Format(time.RFC3339)
-			lastErrorAt = &s
-		}
-
-		breakdown := make(map[string]types.EventTypeBreakdown, len(agg.breakdown))
-		for k, v := range agg.breakdown {
-			breakdown[k] = *v
-		}
-
-		result = append(result, types.SourceStats{
-			SourceID:         agg.sourceID,
-			SourceName:       agg.sourceName,
-			Language:         agg.language,
-			TotalEvents:      agg.eventCount,
-			SuccessCount:     agg.successCount,
-			FailureCount:     agg.failureCount,
-			PartialCount:     agg.partialCount,
-			SuccessRate:      sr,
-			AvgDurationMs:    avgDur,
-			MaxDurationMs:    agg.maxDuration,
-			LastEventAt:      lastEventAt,
-			LastErrorAt:      lastErrorAt,
-			LastErrorMessage: agg.lastErrorMessage,
-			Breakdown:        breakdown,
-		})
-	}
+	result := reporting.SourceStats(events)
 
 	// Sort results.
 	switch sortBy {
@@ -26,7 +26,12 @@ import (
 	"github.com/technobecet/kaizoku-go/internal/ent/latestseries"
 	entseries "github.com/technobecet/kaizoku-go/internal/ent/series"
 	"github.com/technobecet/kaizoku-go/internal/ent/seriesprovider"
+	"github.com/technobecet/kaizoku-go/internal/events"
 	"github.com/technobecet/kaizoku-go/internal/job"
+	"github.com/technobecet/kaizoku-go/internal/service/audit"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
+	"github.com/technobecet/kaizoku-go/internal/service/cadence"
+	"github.com/technobecet/kaizoku-go/internal/service/reading"
 	settingssvc "github.com/technobecet/kaizoku-go/internal/service/settings"
 	"github.com/technobecet/kaizoku-go/internal/service/suwayomi"
 	"github.com/technobecet/kaizoku-go/internal/types"
@@ -57,6 +62,8 @@ type SeriesHandler struct {
 	river     riverClient
 	downloads *job.DownloadDispatcher
 	jobDeps   *job.Deps
+	audit     *audit.Recorder
+	reading   *reading.Service
 }
 
 // baseURL returns the API base URL for thumbnail/icon rewriting.
@@ -108,8 +115,11 @@ func (h *SeriesHandler) GetSeries(c echo.Context) error {
 	return c.JSON(http.StatusOK, result)
 }
 
-// GetLibrary returns all series in the library.
-// GET /api/serie/library
+// GetLibrary returns all series in the library with the caller's read state.
+// sort=unread orders by unread chapters (most first), sort=lastRead by the
+// last time the caller read the series (most recent first); order=asc
+// reverses either.
+// GET /api/serie/library?sort=unread|lastRead&order=asc|desc
 func (h *SeriesHandler) GetLibrary(c echo.Context) error {
 	ctx := c.Request().Context()
 
@@ -121,11 +131,51 @@ func (h *SeriesHandler) GetLibrary(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error getting library."})
 	}
 
+	progress, err := h.reading.UserProgress(ctx, readerUserID(c))
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to load reading progress for library")
+	}
+
 	baseURL := h.baseURL(c)
 	result := make([]types.SeriesInfo, 0, len(seriesList))
+	lastRead := make(map[string]time.Time, len(seriesList))
 	for _, s := range seriesList {
 		providers := s.Edges.Providers
-		result = append(result, toSeriesInfo(s, providers, baseURL))
+		info := toSeriesInfo(s, providers, baseURL)
+
+		files := downloadedChapters(h.config.Storage.Folder, s, providers)
+		numbers := make([]float64, 0, len(files))
+		for _, f := range files {
+			numbers = append(numbers, f.Number)
+		}
+		state := reading.ComputeState(numbers, progress[s.ID])
+		info.UnreadCount = state.Unread
+		info.ContinueReading = state.Continue
+		if state.LastRead != nil {
+			t := state.LastRead.UTC().Format(time.RFC3339)
+			info.LastReadUTC = &t
+			lastRead[info.ID] = *state.LastRead
+		}
+		result = append(result, info)
+	}
+
+	desc := c.QueryParam("order") != "asc"
+	switch c.QueryParam("sort") {
+	case "unread":
+		sort.SliceStable(result, func(i, j int) bool {
+			if desc {
+				return result[i].UnreadCount > result[j].UnreadCount
+			}
+			return result[i].UnreadCount < result[j].UnreadCount
+		})
+	case "lastRead":
+		sort.SliceStable(result, func(i, j int) bool {
+			a, b := lastRead[result[i].ID], lastRead[result[j].ID]
+			if desc {
+				return a.After(b)
+			}
+			return a.Before(b)
+		})
 	}
 
 	return c.JSON(http.StatusOK, result)
@@ -1320,6 +1370,9 @@ func (h *SeriesHandler) AddSeries(c echo.Context) error {
 				log.Error().Err(err).Msg("failed to create series")
 				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error adding full series."})
 			}
+			data := job.SeriesEventData(dbSeries)
+			data["origin"] = "manual"
+			h.jobDeps.Events.Publish(ctx, events.SeriesAdded, data)
 		}
 	}
 
@@ -1482,6 +1535,16 @@ func (h *SeriesHandler) UpdateSeries(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid series id"})
 	}
 
+	// Managers may update series, but deleting files from disk is reserved
+	// for admins, as with DELETE /api/serie?alsoPhysical=true.
+	if p := principal(c); p != nil && !p.HasRole(auth.RoleAdmin) {
+		for _, prov := range req.Providers {
+			if prov.IsDeleted && prov.DeleteFiles {
+				return c.JSON(http.StatusForbidden, map[string]string{"error": "Only admins can delete chapter files."})
+			}
+		}
+	}
+
 	ctx := c.Request().Context()
 
 	dbSeries, err := h.db.Series.Get(ctx, uid)
@@ -1704,6 +1767,31 @@ func (h *SeriesHandler) UpdateSeries(c echo.Context) error {
 	return c.JSON(http.StatusOK, result)
 }
 
+// SetSeriesNotify turns push notifications for new chapters of a series on or off.
+// PUT /api/serie/notify?id=...
+func (h *SeriesHandler) SetSeriesNotify(c echo.Context) error {
+	uid, err := uuid.Parse(c.QueryParam("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req struct {
+		Notify bool `json:"notify"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	if err := h.db.Series.UpdateOneID(uid).SetNotify(req.Notify).Exec(c.Request().Context()); err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Series not found"})
+		}
+		log.Error().Err(err).Msg("failed to update series notifications")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to update series"})
+	}
+	return c.JSON(http.StatusOK, map[string]bool{"notify": req.Notify})
+}
+
 // DeleteSeries removes a series from the database.
 // DELETE /api/serie?id=<uuid>&alsoPhysical=false
 func (h *SeriesHandler) DeleteSeries(c echo.Context) error {
@@ -1768,6 +1856,31 @@ func (h *SeriesHandler) DeleteSeries(c echo.Context) error {
 		log.Error().Err(err).Msg("failed to delete series")
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error deleting series."})
 	}
+	log.Info().
+		Str("seriesId", uid.String()).
+		Str("title", dbSeries.Title).
+		Bool("alsoPhysical", alsoPhysical).
+		Str("by", actorName(c)).
+		Msg("series deleted")
+	data := job.SeriesEventData(dbSeries)
+	data["alsoPhysical"] = alsoPhysical
+	h.jobDeps.Events.Publish(ctx, events.SeriesDeleted, data)
+	providerIDs := make([]string, 0, len(providers))
+	for _, p := range providers {
+		providerIDs = append(providerIDs, p.ID.String())
+	}
+	h.audit.Record(ctx, audit.Entry{
+		Action:     "series.delete",
+		TargetType: "series",
+		TargetIDs:  []string{uid.String()},
+		Details: map[string]interface{}{
+			"title":        dbSeries.Title,
+			"storagePath":  dbSeries.StoragePath,
+			"alsoPhysical": alsoPhysical,
+			"providerIds":  providerIDs,
+		},
+		IP: c.RealIP(),
+	})
 
 	// Update InLibrary status for affected latest series
 	if len(suwayomiIDs) > 0 {
@@ -1897,6 +2010,7 @@ func (h *SeriesHandler) toSeriesExtendedInfo(c echo.Context, s *ent.Series, prov
 		ChapterCount:    s.ChapterCount,
 		IsActive:        false,
 		PausedDownloads: s.PauseDownloads,
+		Notify:          s.Notify,
 		Providers:       make([]types.ProviderExtendedInfo, 0, len(providers)),
 	}
 
@@ -1906,6 +2020,16 @@ func (h *SeriesHandler) toSeriesExtendedInfo(c echo.Context, s *ent.Series, prov
 	}
 	info.Path = storagePath
 
+	prediction := cadence.Predict(info.Status, providers, time.Now())
+	info.Cadence = prediction.Cadence
+	info.CadenceDays = prediction.Days()
+	info.NextChapter = prediction.NextChapter
+	info.Overdue = prediction.Overdue
+	if prediction.NextRelease != nil {
+		next := prediction.NextRelease.Format(time.RFC3339)
+		info.NextRelease = &next
+	}
+
 	var lastChangeProvider *types.SmallProviderInfo
 	var lastChangeTime time.Time
 	var maxChapter *float64
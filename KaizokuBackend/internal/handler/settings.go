@@ -4,8 +4,11 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
 	"github.com/technobecet/kaizoku-go/internal/config"
 	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/service/audit"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
 	settingssvc "github.com/technobecet/kaizoku-go/internal/service/settings"
 	"github.com/technobecet/kaizoku-go/internal/service/suwayomi"
 	"github.com/technobecet/kaizoku-go/internal/types"
@@ -15,13 +18,15 @@ type SettingsHandler struct {
 	config   *config.Config
 	db       *ent.Client
 	settings *settingssvc.Service
+	audit    *audit.Recorder
 }
 
-func NewSettingsHandler(cfg *config.Config, db *ent.Client, sw *suwayomi.Client) *SettingsHandler {
+func NewSettingsHandler(cfg *config.Config, db *ent.Client, sw *suwayomi.Client, rec *audit.Recorder) *SettingsHandler {
 	return &SettingsHandler{
 		config:   cfg,
 		db:       db,
 		settings: settingssvc.NewService(db, cfg, sw),
+		audit:    rec,
 	}
 }
 
@@ -30,6 +35,11 @@ func (h *SettingsHandler) GetSettings(c echo.Context) error {
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
+	// Media-server API keys are only shown to admins, who can change them.
+	if p := principal(c); p != nil && !p.HasRole(auth.RoleAdmin) {
+		settings.KomgaAPIKey = ""
+		settings.KavitaAPIKey = ""
+	}
 	return c.JSON(http.StatusOK, settings)
 }
 
@@ -46,10 +56,30 @@ func (h *SettingsHandler) UpdateSettings(c echo.Context) error {
 	if err := c.Bind(&settings); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
 	}
+	if err := settingssvc.ValidateQuietHours(settings.DownloadQuietHours); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	ctx := c.Request().Context()
+
+	// Snapshot the current values before saving so the audit diff is accurate.
+	var before types.Settings
+	if cur, err := h.settings.Get(ctx); err == nil && cur != nil {
+		before = *cur
+	}
 
-	if err := h.settings.Save(c.Request().Context(), &settings); err != nil {
+	if err := h.settings.Save(ctx, &settings); err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
+	log.Info().Str("by", actorName(c)).Msg("settings updated")
+	if diff := audit.Diff(before, settings); len(diff) > 0 {
+		h.audit.Record(ctx, audit.Entry{
+			Action:     "settings.update",
+			TargetType: "settings",
+			Diff:       diff,
+			IP:         c.RealIP(),
+		})
+	}
 	return c.JSON(http.StatusOK, map[string]string{"message": "Settings updated successfully"})
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/technobecet/kaizoku-go/internal/ent"
 	"github.com/technobecet/kaizoku-go/internal/ent/importentry"
 	"github.com/technobecet/kaizoku-go/internal/job"
+	"github.com/technobecet/kaizoku-go/internal/service/audit"
 	"github.com/technobecet/kaizoku-go/internal/service/suwayomi"
 	"github.com/technobecet/kaizoku-go/internal/types"
 	"github.com/technobecet/kaizoku-go/internal/util"
@@ -25,6 +26,7 @@ type SetupHandler struct {
 	db       *ent.Client
 	suwayomi *suwayomi.Client
 	river    riverClient
+	audit    *audit.Recorder
 }
 
 // ScanLocalFiles enqueues a job to scan the storage directory.
@@ -377,6 +379,22 @@ func (h *SetupHandler) ImportSeries(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to enqueue job"})
 	}
 
+	var paths []string
+	if entries, err := h.db.ImportEntry.Query().
+		Where(importentry.ActionEQ(int(types.ImportActionAdd))).
+		All(ctx); err == nil {
+		for _, e := range entries {
+			paths = append(paths, e.ID)
+		}
+	}
+	h.audit.Record(ctx, audit.Entry{
+		Action:     "setup.import",
+		TargetType: "import",
+		TargetIDs:  paths,
+		Details:    map[string]interface{}{"disableDownloads": disableDownloads},
+		IP:         c.RealIP(),
+	})
+
 	return c.JSON(http.StatusOK, map[string]interface{}{"success": true, "message": "Import job queued"})
 }
 
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/service/audit"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// UsersHandler manages user accounts. All routes are admin-only.
+type UsersHandler struct {
+	auth  *auth.Service
+	audit *audit.Recorder
+}
+
+// userToDTO converts an Ent User entity to a UserInfo.
+func userToDTO(u *ent.User) types.UserInfo {
+	info := types.UserInfo{
+		ID:        u.ID.String(),
+		Username:  u.Username,
+		Role:      u.Role,
+		Source:    u.Source,
+		Disabled:  u.Disabled,
+		CreatedAt: u.CreatedAt.Format(time.RFC3339),
+	}
+	if u.LastLoginAt != nil {
+		s := u.LastLoginAt.Format(time.RFC3339)
+		info.LastLoginAt = &s
+	}
+	return info
+}
+
+// userErrorResponse maps auth service validation errors to HTTP responses.
+func userErrorResponse(c echo.Context, err error, action string) error {
+	switch {
+	case ent.IsNotFound(err):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "user not found"})
+	case errors.Is(err, auth.ErrUsernameTaken), errors.Is(err, auth.ErrLastAdmin):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case errors.Is(err, auth.ErrInvalidRole), errors.Is(err, auth.ErrWeakPassword):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	log.Error().Err(err).Msg("failed to " + action)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to " + action})
+}
+
+// ListUsers returns all user accounts.
+// GET /api/users
+func (h *UsersHandler) ListUsers(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	users, err := h.auth.ListUsers(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list users")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to list users"})
+	}
+
+	result := make([]types.UserInfo, 0, len(users))
+	for _, u := range users {
+		result = append(result, userToDTO(u))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// CreateUser creates a local user account.
+// POST /api/users
+func (h *UsersHandler) CreateUser(c echo.Context) error {
+	var req struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Role     string `json:"role"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+	if req.Username == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "username required"})
+	}
+	if req.Role == "" {
+		req.Role = auth.RoleViewer
+	}
+
+	u, err := h.auth.CreateUser(c.Request().Context(), req.Username, req.Password, req.Role)
+	if err != nil {
+		return userErrorResponse(c, err, "create user")
+	}
+
+	log.Info().Str("username", u.Username).Str("role", u.Role).Str("by", actorName(c)).Msg("user created")
+	return c.JSON(http.StatusCreated, userToDTO(u))
+}
+
+// UpdateUser changes a user's role, password or disabled flag.
+// PATCH /api/users/:id
+func (h *UsersHandler) UpdateUser(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req struct {
+		Role     *string `json:"role"`
+		Password *string `json:"password"`
+		Disabled *bool   `json:"disabled"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	ctx := c.Request().Context()
+	before, err := h.auth.GetUser(ctx, id)
+	if err != nil {
+		return userErrorResponse(c, err, "update user")
+	}
+
+	u, err := h.auth.UpdateUser(ctx, id, auth.UserUpdate{
+		Role:     req.Role,
+		Password: req.Password,
+		Disabled: req.Disabled,
+	})
+	if err != nil {
+		return userErrorResponse(c, err, "update user")
+	}
+
+	log.Info().Str("username", u.Username).Str("by", actorName(c)).Msg("user updated")
+	diff := audit.Diff(userToDTO(before), userToDTO(u))
+	if req.Password != nil {
+		diff["password"] = types.AuditChange{Old: "[redacted]", New: "[redacted]"}
+	}
+	h.audit.Record(ctx, audit.Entry{
+		Action:     "user.update",
+		TargetType: "user",
+		TargetIDs:  []string{u.ID.String()},
+		Diff:       diff,
+		Details:    map[string]interface{}{"username": u.Username},
+		IP:         c.RealIP(),
+	})
+	return c.JSON(http.StatusOK, userToDTO(u))
+}
+
+// DeleteUser deletes a user, ends their sessions and revokes their API keys.
+// DELETE /api/users/:id
+func (h *UsersHandler) DeleteUser(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	ctx := c.Request().Context()
+	if err := h.auth.DeleteUser(ctx, id); err != nil {
+		return userErrorResponse(c, err, "delete user")
+	}
+
+	log.Info().Str("id", id.String()).Str("by", actorName(c)).Msg("user deleted")
+	h.audit.Record(ctx, audit.Entry{
+		Action:     "user.delete",
+		TargetType: "user",
+		TargetIDs:  []string{id.String()},
+		IP:         c.RealIP(),
+	})
+	return c.JSON(http.StatusOK, nil)
+}
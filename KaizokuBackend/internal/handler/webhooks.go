@@ -0,0 +1,376 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/webhook"
+	"github.com/technobecet/kaizoku-go/internal/ent/webhookdelivery"
+	"github.com/technobecet/kaizoku-go/internal/events"
+	"github.com/technobecet/kaizoku-go/internal/job"
+	"github.com/technobecet/kaizoku-go/internal/service/audit"
+	webhooksvc "github.com/technobecet/kaizoku-go/internal/service/webhook"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// WebhooksHandler manages webhooks and their delivery log. All routes are admin-only.
+type WebhooksHandler struct {
+	db       *ent.Client
+	webhooks *webhooksvc.Service
+	river    riverClient
+	audit    *audit.Recorder
+}
+
+// webhookToDTO converts an Ent Webhook entity to a WebhookInfo.
+func webhookToDTO(w *ent.Webhook) types.WebhookInfo {
+	evts := w.Events
+	if evts == nil {
+		evts = []string{}
+	}
+	return types.WebhookInfo{
+		ID:           w.ID.String(),
+		Name:         w.Name,
+		URL:          w.URL,
+		Events:       evts,
+		HasSecret:    w.Secret != "",
+		BodyTemplate: w.BodyTemplate,
+		Enabled:      w.Enabled,
+		CreatedAt:    w.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    w.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// deliveryToDTO converts an Ent WebhookDelivery entity to a WebhookDeliveryInfo.
+func deliveryToDTO(d *ent.WebhookDelivery) types.WebhookDeliveryInfo {
+	info := types.WebhookDeliveryInfo{
+		ID:             d.ID.String(),
+		WebhookID:      d.WebhookID.String(),
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		ResponseBody:   d.ResponseBody,
+		Error:          d.Error,
+		DurationMs:     d.DurationMs,
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      d.UpdatedAt.Format(time.RFC3339),
+	}
+	if d.NextAttemptAt != nil {
+		s := d.NextAttemptAt.Format(time.RFC3339)
+		info.NextAttemptAt = &s
+	}
+	return info
+}
+
+// validateWebhook checks the user-supplied parts of a webhook.
+func validateWebhook(url string, evts []string, bodyTemplate string) error {
+	if err := webhooksvc.ValidateURL(url); err != nil {
+		return err
+	}
+	if err := webhooksvc.ValidateEvents(evts); err != nil {
+		return err
+	}
+	return webhooksvc.ValidateTemplate(bodyTemplate)
+}
+
+// ListWebhooks returns all webhooks and the event types they can subscribe to.
+// GET /api/webhooks
+func (h *WebhooksHandler) ListWebhooks(c echo.Context) error {
+	hooks, err := h.db.Webhook.Query().Order(ent.Asc(webhook.FieldName)).All(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list webhooks")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to list webhooks"})
+	}
+
+	result := make([]types.WebhookInfo, 0, len(hooks))
+	for _, w := range hooks {
+		result = append(result, webhookToDTO(w))
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"webhooks":   result,
+		"eventTypes": events.Types,
+	})
+}
+
+// CreateWebhook adds a webhook.
+// POST /api/webhooks
+func (h *WebhooksHandler) CreateWebhook(c echo.Context) error {
+	var req struct {
+		Name         string   `json:"name"`
+		URL          string   `json:"url"`
+		Events       []string `json:"events"`
+		Secret       string   `json:"secret"`
+		BodyTemplate string   `json:"bodyTemplate"`
+		Enabled      *bool    `json:"enabled"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "name required"})
+	}
+	if err := validateWebhook(req.URL, req.Events, req.BodyTemplate); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	create := h.db.Webhook.Create().
+		SetName(req.Name).
+		SetURL(req.URL).
+		SetEvents(req.Events).
+		SetSecret(req.Secret).
+		SetBodyTemplate(req.BodyTemplate)
+	if req.Enabled != nil {
+		create = create.SetEnabled(*req.Enabled)
+	}
+	w, err := create.Save(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create webhook")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to create webhook"})
+	}
+
+	log.Info().Str("name", w.Name).Str("by", actorName(c)).Msg("webhook created")
+	return c.JSON(http.StatusCreated, webhookToDTO(w))
+}
+
+// UpdateWebhook changes a webhook. Omitted fields are left unchanged; an
+// empty secret removes signing.
+// PATCH /api/webhooks/:id
+func (h *WebhooksHandler) UpdateWebhook(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	var req struct {
+		Name         *string   `json:"name"`
+		URL          *string   `json:"url"`
+		Events       *[]string `json:"events"`
+		Secret       *string   `json:"secret"`
+		BodyTemplate *string   `json:"bodyTemplate"`
+		Enabled      *bool     `json:"enabled"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	ctx := c.Request().Context()
+	w, err := h.db.Webhook.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "webhook not found"})
+		}
+		log.Error().Err(err).Msg("failed to get webhook")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to update webhook"})
+	}
+
+	url, evts, tmpl := w.URL, w.Events, w.BodyTemplate
+	if req.URL != nil {
+		url = *req.URL
+	}
+	if req.Events != nil {
+		evts = *req.Events
+	}
+	if req.BodyTemplate != nil {
+		tmpl = *req.BodyTemplate
+	}
+	if err := validateWebhook(url, evts, tmpl); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	update := h.db.Webhook.UpdateOneID(id).
+		SetURL(url).
+		SetEvents(evts).
+		SetBodyTemplate(tmpl)
+	if req.Name != nil && strings.TrimSpace(*req.Name) != "" {
+		update = update.SetName(strings.TrimSpace(*req.Name))
+	}
+	if req.Secret != nil {
+		update = update.SetSecret(*req.Secret)
+	}
+	if req.Enabled != nil {
+		update = update.SetEnabled(*req.Enabled)
+	}
+	w, err = update.Save(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to update webhook")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to update webhook"})
+	}
+
+	log.Info().Str("name", w.Name).Str("by", actorName(c)).Msg("webhook updated")
+	return c.JSON(http.StatusOK, webhookToDTO(w))
+}
+
+// DeleteWebhook removes a webhook and its delivery log.
+// DELETE /api/webhooks/:id
+func (h *WebhooksHandler) DeleteWebhook(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	ctx := c.Request().Context()
+	w, err := h.db.Webhook.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "webhook not found"})
+		}
+		log.Error().Err(err).Msg("failed to get webhook")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to delete webhook"})
+	}
+
+	if _, err := h.db.WebhookDelivery.Delete().Where(webhookdelivery.WebhookID(id)).Exec(ctx); err != nil {
+		log.Error().Err(err).Msg("failed to delete webhook deliveries")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to delete webhook"})
+	}
+	if err := h.db.Webhook.DeleteOneID(id).Exec(ctx); err != nil {
+		log.Error().Err(err).Msg("failed to delete webhook")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to delete webhook"})
+	}
+
+	log.Info().Str("name", w.Name).Str("by", actorName(c)).Msg("webhook deleted")
+	h.audit.Record(ctx, audit.Entry{
+		Action:     "webhook.delete",
+		TargetType: "webhook",
+		TargetIDs:  []string{id.String()},
+		Details:    map[string]interface{}{"name": w.Name, "url": w.URL},
+		IP:         c.RealIP(),
+	})
+	return c.JSON(http.StatusOK, nil)
+}
+
+// TestWebhook queues a webhook.test event for one webhook, regardless of
+// its event filter or enabled flag.
+// POST /api/webhooks/:id/test
+func (h *WebhooksHandler) TestWebhook(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	ctx := c.Request().Context()
+	w, err := h.db.Webhook.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "webhook not found"})
+		}
+		log.Error().Err(err).Msg("failed to get webhook")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to test webhook"})
+	}
+
+	e := events.New(webhooksvc.TestEvent, map[string]interface{}{
+		"webhookId": w.ID.String(),
+		"name":      w.Name,
+		"by":        actorName(c),
+	})
+	d, err := h.webhooks.CreateDelivery(ctx, w, e)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create test delivery")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to test webhook"})
+	}
+	if d.Status == webhooksvc.StatusPending {
+		if _, err := h.river.Insert(ctx, job.WebhookDeliveryArgs{DeliveryID: d.ID}, nil); err != nil {
+			log.Error().Err(err).Msg("failed to enqueue test delivery")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to test webhook"})
+		}
+	}
+	return c.JSON(http.StatusAccepted, deliveryToDTO(d))
+}
+
+// GetDeliveries returns the webhook delivery log, newest first.
+// GET /api/webhooks/deliveries?webhookId=...&status=failed&eventType=chapter.downloaded&limit=50&offset=0
+func (h *WebhooksHandler) GetDeliveries(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	limit := 50
+	if v, err := strconv.Atoi(c.QueryParam("limit")); err == nil && v > 0 {
+		limit = v
+	}
+	offset := 0
+	if v, err := strconv.Atoi(c.QueryParam("offset")); err == nil && v >= 0 {
+		offset = v
+	}
+
+	query := h.db.WebhookDelivery.Query()
+	if v := c.QueryParam("webhookId"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid webhookId"})
+		}
+		query = query.Where(webhookdelivery.WebhookID(id))
+	}
+	if v := c.QueryParam("status"); v != "" {
+		query = query.Where(webhookdelivery.StatusEQ(v))
+	}
+	if v := c.QueryParam("eventType"); v != "" {
+		query = query.Where(webhookdelivery.EventTypeEQ(v))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to count webhook deliveries")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get deliveries"})
+	}
+	deliveries, err := query.
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get webhook deliveries")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to get deliveries"})
+	}
+
+	result := types.WebhookDeliveryList{Total: total, Deliveries: make([]types.WebhookDeliveryInfo, 0, len(deliveries))}
+	for _, d := range deliveries {
+		result.Deliveries = append(result.Deliveries, deliveryToDTO(d))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// RedeliverWebhook sends a logged delivery again as a new delivery with the
+// same payload.
+// POST /api/webhooks/deliveries/:id/redeliver
+func (h *WebhooksHandler) RedeliverWebhook(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid id"})
+	}
+
+	ctx := c.Request().Context()
+	old, err := h.db.WebhookDelivery.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "delivery not found"})
+		}
+		log.Error().Err(err).Msg("failed to get webhook delivery")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to redeliver"})
+	}
+	if old.Payload == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "delivery has no payload to resend"})
+	}
+
+	d, err := h.db.WebhookDelivery.Create().
+		SetWebhookID(old.WebhookID).
+		SetEventID(old.EventID).
+		SetEventType(old.EventType).
+		SetPayload(old.Payload).
+		Save(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create webhook delivery")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to redeliver"})
+	}
+	if _, err := h.river.Insert(ctx, job.WebhookDeliveryArgs{DeliveryID: d.ID}, nil); err != nil {
+		log.Error().Err(err).Msg("failed to enqueue webhook delivery")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to redeliver"})
+	}
+	return c.JSON(http.StatusAccepted, deliveryToDTO(d))
+}
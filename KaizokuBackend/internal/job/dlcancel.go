@@ -0,0 +1,136 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/downloadqueueitem"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// downloadCancelChannel is the Postgres NOTIFY channel that asks the instance
+// running a download to stop it. The payload is the queue item ID.
+const downloadCancelChannel = "kaizoku_download_cancel"
+
+// ErrDownloadCancelled is the cause of a download's context when the download
+// was cancelled while running.
+var ErrDownloadCancelled = errors.New("download cancelled")
+
+// track registers the cancel func of a running download of this instance.
+func (d *DownloadDispatcher) track(id uuid.UUID, cancel context.CancelCauseFunc) {
+	d.mu.Lock()
+	d.cancels[id] = cancel
+	d.mu.Unlock()
+}
+
+// untrack forgets a finished download.
+func (d *DownloadDispatcher) untrack(id uuid.UUID) {
+	d.mu.Lock()
+	delete(d.cancels, id)
+	d.mu.Unlock()
+}
+
+// cancelLocal stops a download running on this instance. It reports whether
+// the download was found.
+func (d *DownloadDispatcher) cancelLocal(id uuid.UUID) bool {
+	d.mu.Lock()
+	cancel, ok := d.cancels[id]
+	d.mu.Unlock()
+	if ok {
+		cancel(ErrDownloadCancelled)
+	}
+	return ok
+}
+
+// stopRunning removes a running download from the queue, then stops it here
+// or, through NOTIFY, on the instance that claimed it. The row is deleted
+// first so that the cancellation survives an owner that died: its lease can
+// no longer be reclaimed. It reports false when the item is not running
+// anymore.
+func (d *DownloadDispatcher) stopRunning(ctx context.Context, item *ent.DownloadQueueItem) (bool, error) {
+	n, err := d.db.DownloadQueueItem.Delete().
+		Where(
+			downloadqueueitem.IDEQ(item.ID),
+			downloadqueueitem.StatusEQ(types.DLStatusRunning),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("delete running download %s: %w", item.ID, err)
+	}
+	if n == 0 {
+		// It finished meanwhile.
+		return false, nil
+	}
+
+	if d.cancelLocal(item.ID) {
+		return true, nil
+	}
+	if item.WorkerID == nil || *item.WorkerID == d.workerID || d.deps == nil || d.deps.Pool == nil {
+		return true, nil
+	}
+	if _, err := d.deps.Pool.Exec(ctx, "SELECT pg_notify($1, $2)", downloadCancelChannel, item.ID.String()); err != nil {
+		// The item is gone from the queue; its instance finishes the
+		// chapter it is on, or nothing if it died.
+		log.Warn().Err(err).Str("id", item.ID.String()).Msg("failed to signal download cancellation")
+	}
+	return true, nil
+}
+
+// CancelRunning stops a running download and removes it from the queue. It
+// reports false when the item is not running.
+func (d *DownloadDispatcher) CancelRunning(ctx context.Context, id uuid.UUID) (bool, error) {
+	item, err := d.db.DownloadQueueItem.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if item.Status != types.DLStatusRunning {
+		return false, nil
+	}
+	return d.stopRunning(ctx, item)
+}
+
+// cancelRunningWhere stops the running downloads whose args match and
+// returns how many it stopped.
+func (d *DownloadDispatcher) cancelRunningWhere(ctx context.Context, match func(types.DownloadChapterArgs) bool) (int, error) {
+	items, err := d.db.DownloadQueueItem.Query().
+		Where(downloadqueueitem.StatusEQ(types.DLStatusRunning)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	stopped := 0
+	for _, item := range items {
+		if !match(item.Args) {
+			continue
+		}
+		ok, err := d.stopRunning(ctx, item)
+		if err != nil {
+			return stopped, err
+		}
+		if ok {
+			stopped++
+		}
+	}
+	return stopped, nil
+}
+
+// dropCancelled removes the queue item of a cancelled download, if
+// stopRunning has not already. ctx may be cancelled already.
+func (d *DownloadDispatcher) dropCancelled(ctx context.Context, itemID uuid.UUID, args types.DownloadChapterArgs, chapStr string) {
+	log.Info().
+		Str("title", args.Title).
+		Str("provider", args.ProviderName).
+		Str("chapter", chapStr).
+		Msg("chapter download cancelled")
+	if err := d.db.DownloadQueueItem.DeleteOneID(itemID).Exec(context.WithoutCancel(ctx)); err != nil && !ent.IsNotFound(err) {
+		log.Warn().Err(err).Str("id", itemID.String()).Msg("failed to remove cancelled download")
+	}
+}
@@ -0,0 +1,175 @@
+package job
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/downloadqueueitem"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// Several Kaizoku instances may share one download queue. An instance claims
+// waiting items by marking them running under its worker ID with a lease,
+// and renews the leases of its downloads while they run. Items whose lease
+// expired, because their instance died, go back to waiting for anyone to
+// claim. Per-provider limits count running items of all instances.
+
+const (
+	// leaseDuration is how long a claimed item stays reserved without a
+	// heartbeat.
+	leaseDuration = 2 * time.Minute
+	// heartbeatInterval is how often running items' leases are renewed.
+	heartbeatInterval = 30 * time.Second
+	// claimLockName names the advisory lock that serializes claims, so that
+	// concurrent instances see each other's running counts.
+	claimLockName = "kaizoku_download_claim"
+)
+
+// claimSQL picks the eligible items, fair-share across providers: the first
+// waiting item of every provider, then the second, and so on, skipping
+// providers whose running items already reach the per-provider limit. A
+// provider's chapter budget (see RateLimiter.ChapterBudget) caps how many
+// of its items are picked; items beyond it stay waiting.
+const claimSQL = `
+WITH running AS (
+	SELECT group_key, count(*) AS n
+	FROM download_queue_items
+	WHERE status = $1
+	GROUP BY group_key
+), ranked AS (
+	SELECT q.id, q.group_key,
+		row_number() OVER (PARTITION BY q.group_key ORDER BY q.priority, q.scheduled_at, q.id) AS rn,
+		COALESCE(r.n, 0) AS n
+	FROM download_queue_items q
+	LEFT JOIN running r ON r.group_key = q.group_key
+	WHERE q.status = $2 AND q.scheduled_at <= now()
+), budget AS (
+	SELECT * FROM unnest($7::text[], $8::int[]) AS b(source, allowance)
+), picked AS (
+	SELECT ranked.id FROM ranked
+	LEFT JOIN budget b ON b.source = lower(ranked.group_key)
+	WHERE rn + n <= $3 AND (COALESCE(b.allowance, $9) < 0 OR rn <= COALESCE(b.allowance, $9))
+	ORDER BY rn, ranked.group_key
+	LIMIT $4
+), locked AS (
+	SELECT q.id FROM download_queue_items q
+	WHERE q.id IN (SELECT id FROM picked) AND q.status = $2
+	FOR UPDATE SKIP LOCKED
+)
+UPDATE download_queue_items q
+SET status = $1, worker_id = $5, started_at = now(), lease_expires_at = now() + $6 * interval '1 second'
+FROM locked
+WHERE q.id = locked.id
+RETURNING q.id`
+
+// newWorkerID identifies this dispatcher instance in claimed items.
+func newWorkerID() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "kaizoku"
+	}
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(suffix))
+}
+
+// claim marks up to limit eligible items as running under this instance and
+// returns them. budget and def are the chapter allowances from
+// RateLimiter.ChapterBudget.
+func (d *DownloadDispatcher) claim(ctx context.Context, limit, maxGroup int, budget map[string]int, def int) ([]*ent.DownloadQueueItem, error) {
+	sources := make([]string, 0, len(budget))
+	allowances := make([]int32, 0, len(budget))
+	for source, n := range budget {
+		sources = append(sources, source)
+		allowances = append(allowances, int32(n))
+	}
+
+	tx, err := d.deps.Pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin claim: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", claimLockName); err != nil {
+		return nil, fmt.Errorf("lock claims: %w", err)
+	}
+	rows, err := tx.Query(ctx, claimSQL,
+		types.DLStatusRunning, types.DLStatusWaiting, maxGroup, limit,
+		d.workerID, int(leaseDuration.Seconds()), sources, allowances, def)
+	if err != nil {
+		return nil, fmt.Errorf("claim downloads: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("claim downloads: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit claim: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	items, err := d.db.DownloadQueueItem.Query().
+		Where(downloadqueueitem.IDIn(ids...)).
+		Order(
+			ent.Asc(downloadqueueitem.FieldPriority),
+			ent.Asc(downloadqueueitem.FieldScheduledAt),
+		).
+		All(ctx)
+	if err != nil {
+		// The leases expire and the items are reclaimed.
+		return nil, fmt.Errorf("load claimed downloads: %w", err)
+	}
+	return items, nil
+}
+
+// reclaimExpired returns running items whose lease expired to waiting and
+// wakes the dispatcher to claim them. Items without a lease were claimed
+// before leases existed, by an instance that is gone.
+func (d *DownloadDispatcher) reclaimExpired(ctx context.Context) {
+	tag, err := d.deps.Pool.Exec(ctx, `
+UPDATE download_queue_items
+SET status = $1, worker_id = NULL, lease_expires_at = NULL, started_at = NULL
+WHERE status = $2 AND (lease_expires_at IS NULL OR lease_expires_at < now())`,
+		types.DLStatusWaiting, types.DLStatusRunning)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to reclaim expired download leases")
+		return
+	}
+	if n := tag.RowsAffected(); n > 0 {
+		log.Info().Int64("count", n).Msg("reclaimed downloads with expired leases")
+		d.wake()
+	}
+}
+
+// heartbeat renews the leases of this instance's running items, and
+// reclaims expired ones, until ctx is cancelled.
+func (d *DownloadDispatcher) heartbeat(ctx context.Context) {
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := d.deps.Pool.Exec(ctx, `
+UPDATE download_queue_items
+SET lease_expires_at = now() + $1 * interval '1 second'
+WHERE worker_id = $2 AND status = $3`,
+				int(leaseDuration.Seconds()), d.workerID, types.DLStatusRunning)
+			if err != nil && ctx.Err() == nil {
+				log.Warn().Err(err).Msg("failed to renew download leases")
+			}
+			d.reclaimExpired(ctx)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/downloadqueueitem"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+const (
+	// downloadQueueChannel is the Postgres NOTIFY channel signalled when a
+	// download is queued.
+	downloadQueueChannel = "kaizoku_download_queue"
+	// dispatchFallbackInterval is the longest the dispatcher sleeps without
+	// a wakeup. It picks up changes that send no notification, such as raised
+	// concurrency limits, and covers a lost LISTEN connection.
+	dispatchFallbackInterval = 30 * time.Second
+	// minDispatchInterval is the shortest time between two dispatches.
+	minDispatchInterval = 500 * time.Millisecond
+	// listenRetryDelay is the wait before reconnecting the LISTEN connection.
+	listenRetryDelay = 5 * time.Second
+)
+
+// wake requests a dispatch. It never blocks; wakeups that arrive while one is
+// pending are merged.
+func (d *DownloadDispatcher) wake() {
+	select {
+	case d.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// notify signals that downloads were queued. The notification reaches the
+// dispatcher through its LISTEN connection; without a pool, or when NOTIFY
+// fails, the dispatcher is woken directly.
+func (d *DownloadDispatcher) notify(ctx context.Context) {
+	if d.deps == nil || d.deps.Pool == nil {
+		d.wake()
+		return
+	}
+	if _, err := d.deps.Pool.Exec(ctx, "SELECT pg_notify($1, '')", downloadQueueChannel); err != nil {
+		log.Debug().Err(err).Msg("failed to notify download dispatcher")
+		d.wake()
+	}
+}
+
+// listen holds a dedicated connection that LISTENs for queued downloads,
+// waking the dispatcher on each notification, and for cancelled downloads,
+// reconnecting until ctx is cancelled.
+func (d *DownloadDispatcher) listen(ctx context.Context) {
+	if d.deps == nil || d.deps.Pool == nil {
+		return
+	}
+	for {
+		err := d.listenOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warn().Err(err).Dur("retry_in", listenRetryDelay).Msg("download queue listener disconnected")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+func (d *DownloadDispatcher) listenOnce(ctx context.Context) error {
+	pooled, err := d.deps.Pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// The connection stays subscribed, so take it out of the pool for good.
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	for _, channel := range []string{downloadQueueChannel, downloadCancelChannel} {
+		if _, err := conn.Exec(ctx, "LISTEN "+channel); err != nil {
+			return err
+		}
+	}
+	// Catch up on anything queued while not listening.
+	d.wake()
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		if n.Channel == downloadCancelChannel {
+			if id, err := uuid.Parse(n.Payload); err == nil {
+				d.cancelLocal(id)
+			}
+			continue
+		}
+		d.wake()
+	}
+}
+
+// nextScheduled returns when the earliest waiting download that is not yet
+// due becomes due.
+func (d *DownloadDispatcher) nextScheduled(ctx context.Context) (time.Time, bool) {
+	item, err := d.db.DownloadQueueItem.Query().
+		Where(
+			downloadqueueitem.StatusEQ(types.DLStatusWaiting),
+			downloadqueueitem.ScheduledAtGT(time.Now()),
+		).
+		Order(ent.Asc(downloadqueueitem.FieldScheduledAt)).
+		First(ctx)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return item.ScheduledAt, true
+}
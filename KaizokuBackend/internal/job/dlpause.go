@@ -0,0 +1,181 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent/setting"
+	settingssvc "github.com/technobecet/kaizoku-go/internal/service/settings"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// The download queue stops starting downloads while it is paused through the
+// API or inside a quiet-hours window. Running downloads finish either way.
+// Both are kept in the settings table, which every instance reads on each
+// dispatch, so a pause applies to all instances that share the queue.
+
+const (
+	// downloadsPausedSetting holds when the queue was paused; the row is
+	// absent while the queue runs.
+	downloadsPausedSetting = "DownloadsPausedAt"
+	// Settings rows of the quiet-hours settings (see the settings service).
+	quietHoursEnabledSetting = "DownloadQuietHoursEnabled"
+	quietHoursSetting        = "DownloadQuietHours"
+	// queueStateProgressID identifies queue state updates on the progress hub.
+	queueStateProgressID = "download-queue"
+)
+
+// State reports whether the download queue starts new downloads.
+func (d *DownloadDispatcher) State(ctx context.Context) (types.DownloadQueueState, error) {
+	state, _, err := d.loadState(ctx, time.Now())
+	return state, err
+}
+
+// loadState reads the queue state at now, and when the current quiet-hours
+// window ends.
+func (d *DownloadDispatcher) loadState(ctx context.Context, now time.Time) (types.DownloadQueueState, time.Time, error) {
+	rows, err := d.db.Setting.Query().
+		Where(setting.IDIn(downloadsPausedSetting, quietHoursEnabledSetting, quietHoursSetting)).
+		All(ctx)
+	if err != nil {
+		return types.DownloadQueueState{}, time.Time{}, fmt.Errorf("load download queue state: %w", err)
+	}
+	kv := make(map[string]string, len(rows))
+	for _, r := range rows {
+		kv[r.ID] = r.Value
+	}
+
+	var state types.DownloadQueueState
+	if at := kv[downloadsPausedSetting]; at != "" {
+		state.Paused = true
+		state.PausedAt = &at
+	}
+	var quietEnd time.Time
+	if enabled, _ := strconv.ParseBool(kv[quietHoursEnabledSetting]); enabled {
+		if end, ok := quietUntil(settingssvc.ParseQuietHours(kv[quietHoursSetting]), now); ok {
+			quietEnd = end
+			until := end.UTC().Format(time.RFC3339)
+			state.QuietHours = true
+			state.QuietUntil = &until
+		}
+	}
+	state.Active = !state.Paused && !state.QuietHours
+	return state, quietEnd, nil
+}
+
+// quietUntil reports whether t falls inside one of the windows, and when
+// the latest of those windows ends.
+func quietUntil(windows []types.QuietHoursWindow, t time.Time) (time.Time, bool) {
+	var until time.Time
+	for _, w := range windows {
+		start, err1 := time.Parse("15:04", w.Start)
+		end, err2 := time.Parse("15:04", w.End)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		// A window containing t started today or, spanning midnight,
+		// yesterday.
+		for _, offset := range []int{0, -1} {
+			day := t.AddDate(0, 0, offset)
+			from := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, t.Location())
+			to := time.Date(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), 0, 0, t.Location())
+			if !to.After(from) {
+				to = to.AddDate(0, 0, 1)
+			}
+			if !onWeekday(w.Days, from.Weekday()) || t.Before(from) || !t.Before(to) {
+				continue
+			}
+			if to.After(until) {
+				until = to
+			}
+		}
+	}
+	return until, !until.IsZero()
+}
+
+// onWeekday reports whether day is in days; empty days means every day.
+func onWeekday(days []int, day time.Weekday) bool {
+	if len(days) == 0 {
+		return true
+	}
+	for _, d := range days {
+		if time.Weekday(d) == day {
+			return true
+		}
+	}
+	return false
+}
+
+// SetPaused pauses or resumes the download queue on every instance.
+func (d *DownloadDispatcher) SetPaused(ctx context.Context, paused bool) (types.DownloadQueueState, error) {
+	if paused {
+		// Keep the original time when already paused.
+		exists, err := d.db.Setting.Query().Where(setting.IDEQ(downloadsPausedSetting)).Exist(ctx)
+		if err != nil {
+			return types.DownloadQueueState{}, fmt.Errorf("pause downloads: %w", err)
+		}
+		if !exists {
+			err := d.db.Setting.Create().
+				SetID(downloadsPausedSetting).
+				SetValue(time.Now().UTC().Format(time.RFC3339)).
+				OnConflictColumns("id").
+				UpdateNewValues().
+				Exec(ctx)
+			if err != nil {
+				return types.DownloadQueueState{}, fmt.Errorf("pause downloads: %w", err)
+			}
+		}
+	} else {
+		if _, err := d.db.Setting.Delete().Where(setting.IDEQ(downloadsPausedSetting)).Exec(ctx); err != nil {
+			return types.DownloadQueueState{}, fmt.Errorf("resume downloads: %w", err)
+		}
+	}
+
+	// Every instance re-reads the state on its next dispatch and publishes
+	// the change to its clients.
+	d.notify(ctx)
+	return d.State(ctx)
+}
+
+// publishState records the state seen by a dispatch and broadcasts it over
+// the progress hub when it changed.
+func (d *DownloadDispatcher) publishState(state types.DownloadQueueState, quietEnd time.Time) {
+	d.mu.Lock()
+	prev := d.state
+	d.state = &state
+	d.quietEnd = quietEnd
+	d.mu.Unlock()
+
+	if prev != nil && prev.Active == state.Active && prev.Paused == state.Paused &&
+		prev.QuietHours == state.QuietHours && derefStrDefault(prev.QuietUntil, "") == derefStrDefault(state.QuietUntil, "") {
+		return
+	}
+
+	status := types.ProgressStatusRunning
+	message := "Downloads running"
+	switch {
+	case state.Paused:
+		status = types.ProgressStatusQueued
+		message = "Downloads paused"
+	case state.QuietHours:
+		status = types.ProgressStatusQueued
+		message = "Quiet hours until " + quietEnd.Format("15:04")
+	}
+	if prev != nil || !state.Active {
+		log.Info().Bool("paused", state.Paused).Bool("quietHours", state.QuietHours).Msg(message)
+	}
+	if d.deps != nil && d.deps.Progress != nil {
+		d.deps.Progress.BroadcastProgress(queueStateProgressID, int(types.JobTypeDownloadQueue),
+			int(status), 0, message, state)
+	}
+}
+
+// resumeAt returns when the current quiet-hours window ends, or zero.
+func (d *DownloadDispatcher) resumeAt() time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.quietEnd
+}
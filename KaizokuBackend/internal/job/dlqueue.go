@@ -2,9 +2,11 @@ package job
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -13,22 +15,37 @@ import (
 	"github.com/technobecet/kaizoku-go/internal/ent"
 	"github.com/technobecet/kaizoku-go/internal/ent/downloadqueueitem"
 	"github.com/technobecet/kaizoku-go/internal/ent/seriesprovider"
+	"github.com/technobecet/kaizoku-go/internal/service/hooks"
+	"github.com/technobecet/kaizoku-go/internal/tracing"
 	"github.com/technobecet/kaizoku-go/internal/types"
 )
 
 // DownloadDispatcher replaces River for download jobs.
-// It polls the download_queue_items table and dispatches downloads with
-// strict FIFO ordering and per-provider concurrency control.
+// It dispatches downloads from the download_queue_items table with strict
+// FIFO ordering and per-provider concurrency control. Dispatch runs when an
+// item is queued (Postgres NOTIFY), when a download finishes, when the next
+// scheduled item becomes due, and on a slow fallback poll. Items are claimed
+// through the database (see dlclaim.go), so several instances can share one
+// queue.
 type DownloadDispatcher struct {
 	db       *ent.Client
 	deps     *Deps
-	maxTotal int // max concurrent downloads globally (config fallback)
-	maxGroup int // max concurrent downloads per provider (config fallback)
+	workerID string
+	maxTotal int // max concurrent downloads of this instance (config fallback)
+	maxGroup int // max concurrent downloads per provider, across instances (config fallback)
 
 	mu      sync.Mutex
-	running map[string]int // group_key -> count of running downloads
-	total   int            // total running count
+	running map[string]int                        // group_key -> count of this instance's running downloads
+	total   int                                   // this instance's running count
+	cancels map[uuid.UUID]context.CancelCauseFunc // item ID -> cancel func of this instance's running downloads
 	wg      sync.WaitGroup
+
+	state    *types.DownloadQueueState // pause state seen by the last dispatch
+	quietEnd time.Time                 // end of the current quiet-hours window
+	budgetAt time.Time                 // when a source held by its chapter budget may start again
+
+	wakeCh   chan struct{} // requests a dispatch; buffered so wakeups coalesce
+	lastTick atomic.Int64  // unix nanos of the last dispatch loop iteration
 }
 
 // NewDownloadDispatcher creates a new download dispatcher.
@@ -42,9 +59,12 @@ func NewDownloadDispatcher(db *ent.Client, deps *Deps, maxTotal, maxGroup int) *
 	return &DownloadDispatcher{
 		db:       db,
 		deps:     deps,
+		workerID: newWorkerID(),
 		maxTotal: maxTotal,
 		maxGroup: maxGroup,
 		running:  make(map[string]int),
+		cancels:  make(map[uuid.UUID]context.CancelCauseFunc),
+		wakeCh:   make(chan struct{}, 1),
 	}
 }
 
@@ -67,11 +87,20 @@ func (d *DownloadDispatcher) getLimits(ctx context.Context) (maxTotal, maxGroup
 
 // Run starts the dispatch loop. Blocks until ctx is cancelled.
 func (d *DownloadDispatcher) Run(ctx context.Context) {
-	// Reset any "running" items from a previous crash back to "waiting"
-	d.resetStaleRunning(ctx)
+	log.Info().Str("worker", d.workerID).Msg("download dispatcher starting")
+	// Return items left running by instances that are gone to "waiting"
+	d.reclaimExpired(ctx)
+
+	go d.listen(ctx)
+
+	// Leases are renewed until the running downloads have finished, which
+	// may be after ctx is cancelled.
+	hbCtx, hbCancel := context.WithCancel(context.Background())
+	defer hbCancel()
+	go d.heartbeat(hbCtx)
 
-	ticker := time.NewTicker(500 * time.Millisecond)
-	defer ticker.Stop()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
 
 	for {
 		select {
@@ -80,12 +109,55 @@ func (d *DownloadDispatcher) Run(ctx context.Context) {
 			d.wg.Wait()
 			log.Info().Msg("download dispatcher stopped")
 			return
-		case <-ticker.C:
-			d.dispatch(ctx)
+		case <-d.wakeCh:
+		case <-timer.C:
+		}
+
+		d.lastTick.Store(time.Now().UnixNano())
+		d.dispatch(ctx)
+
+		// Sleep until the next scheduled item is due, a wakeup arrives, or
+		// the fallback poll is due.
+		wait := dispatchFallbackInterval
+		if next, ok := d.nextScheduled(ctx); ok {
+			wait = min(wait, time.Until(next))
+		}
+		if end := d.resumeAt(); !end.IsZero() {
+			wait = min(wait, time.Until(end))
+		}
+		if at := d.chapterBudgetAt(); !at.IsZero() {
+			wait = min(wait, time.Until(at))
+		}
+		timer.Reset(wait)
+
+		// Bursts of wakeups (e.g. a whole series being queued) are batched
+		// into one dispatch.
+		select {
+		case <-ctx.Done():
+		case <-time.After(minDispatchInterval):
 		}
 	}
 }
 
+// LastTick returns when the dispatch loop last started an iteration, or the
+// zero time before the loop has started. The health check uses it to detect
+// a stalled loop.
+func (d *DownloadDispatcher) LastTick() time.Time {
+	n := d.lastTick.Load()
+	if n == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, n)
+}
+
+// chapterBudgetAt returns when a source held back by its chapters-per-hour
+// limit may start a download again, or zero.
+func (d *DownloadDispatcher) chapterBudgetAt() time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.budgetAt
+}
+
 // Stop waits for all running downloads to complete.
 func (d *DownloadDispatcher) Stop() {
 	d.wg.Wait()
@@ -151,6 +223,9 @@ func (d *DownloadDispatcher) enqueue(ctx context.Context, args types.DownloadCha
 		}
 	}
 
+	if tp := tracing.TraceParent(ctx); tp != "" {
+		args.TraceParent = tp
+	}
 	_, err := d.db.DownloadQueueItem.Create().
 		SetGroupKey(args.ProviderName).
 		SetStatus(types.DLStatusWaiting).
@@ -161,141 +236,57 @@ func (d *DownloadDispatcher) enqueue(ctx context.Context, args types.DownloadCha
 	if err != nil {
 		return err
 	}
+	d.notify(ctx)
 	return nil
 }
 
-// resetStaleRunning resets any items stuck in "running" state from a previous crash.
-func (d *DownloadDispatcher) resetStaleRunning(ctx context.Context) {
-	n, err := d.db.DownloadQueueItem.Update().
-		Where(downloadqueueitem.StatusEQ(types.DLStatusRunning)).
-		SetStatus(types.DLStatusWaiting).
-		ClearStartedAt().
-		Save(ctx)
+// dispatch starts as many eligible downloads as the concurrency limits allow,
+// unless the queue is paused.
+func (d *DownloadDispatcher) dispatch(ctx context.Context) {
+	state, quietEnd, err := d.loadState(ctx, time.Now())
 	if err != nil {
-		log.Warn().Err(err).Msg("failed to reset stale running downloads")
-	} else if n > 0 {
-		log.Info().Int("count", n).Msg("reset stale running downloads to waiting")
+		log.Warn().Err(err).Msg("failed to read download queue state")
+	} else {
+		d.publishState(state, quietEnd)
+		if !state.Active {
+			return
+		}
 	}
-}
 
-// dispatch is the core polling function called every 500ms.
-func (d *DownloadDispatcher) dispatch(ctx context.Context) {
 	maxTotal, maxGroup := d.getLimits(ctx)
 
 	d.mu.Lock()
 	available := maxTotal - d.total
-	if available <= 0 {
-		d.mu.Unlock()
-		return
-	}
-	// Snapshot running counts
-	runningSnapshot := make(map[string]int, len(d.running))
-	for k, v := range d.running {
-		runningSnapshot[k] = v
-	}
 	d.mu.Unlock()
-
-	// Get distinct group keys with eligible items to prevent source starvation.
-	// A simple global Limit query would starve sources with higher chapter numbers.
-	groupKeys, err := d.db.DownloadQueueItem.Query().
-		Where(
-			downloadqueueitem.StatusEQ(types.DLStatusWaiting),
-			downloadqueueitem.ScheduledAtLTE(time.Now()),
-		).
-		Unique(true).
-		Select(downloadqueueitem.FieldGroupKey).
-		Strings(ctx)
-	if err != nil || len(groupKeys) == 0 {
+	if available <= 0 {
 		return
 	}
 
-	// Fetch top items per group, respecting per-group running limits
-	grouped := make(map[string][]*ent.DownloadQueueItem)
-	var groupOrder []string
-	for _, gk := range groupKeys {
-		slotsLeft := maxGroup - runningSnapshot[gk]
-		if slotsLeft <= 0 {
-			continue
-		}
-
-		items, err := d.db.DownloadQueueItem.Query().
-			Where(
-				downloadqueueitem.StatusEQ(types.DLStatusWaiting),
-				downloadqueueitem.ScheduledAtLTE(time.Now()),
-				downloadqueueitem.GroupKeyEQ(gk),
-			).
-			Order(
-				ent.Asc(downloadqueueitem.FieldPriority),
-				ent.Asc(downloadqueueitem.FieldScheduledAt),
-				ent.Asc(downloadqueueitem.FieldID),
-			).
-			Limit(slotsLeft).
-			All(ctx)
-		if err != nil || len(items) == 0 {
-			continue
-		}
-
-		grouped[gk] = items
-		groupOrder = append(groupOrder, gk)
-	}
+	// Items of sources out of chapters-per-hour budget stay waiting; the
+	// loop wakes again when the first of them may start.
+	budget, def, next := d.deps.RateLimits.ChapterBudget()
+	d.mu.Lock()
+	d.budgetAt = next
+	d.mu.Unlock()
 
-	if len(groupOrder) == 0 {
+	items, err := d.claim(ctx, available, maxGroup, budget, def)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to claim downloads")
 		return
 	}
-
-	// Fair-share round-robin: take 1 from each group in turn,
-	// respecting per-group limits, until we fill available slots.
-	var toStart []*ent.DownloadQueueItem
-	started := 0
-	for started < available {
-		pickedAny := false
-		for _, groupKey := range groupOrder {
-			if started >= available {
-				break
-			}
-			jobs := grouped[groupKey]
-			if len(jobs) == 0 {
-				continue
-			}
-
-			currentRunning := runningSnapshot[groupKey]
-			if currentRunning >= maxGroup {
-				continue
-			}
-
-			// Take the first (highest priority) job from this group
-			item := jobs[0]
-			grouped[groupKey] = jobs[1:]
-
-			toStart = append(toStart, item)
-			runningSnapshot[groupKey]++
-			started++
-			pickedAny = true
-		}
-		if !pickedAny {
-			break // No group has eligible items
-		}
-	}
-
-	// Start the selected downloads
-	for _, item := range toStart {
-		d.startDownload(ctx, item)
+	for _, item := range items {
+		d.deps.RateLimits.TakeChapter(item.GroupKey)
+		d.startDownload(item)
 	}
 }
 
-// startDownload marks an item as running and launches a goroutine for the download.
-func (d *DownloadDispatcher) startDownload(ctx context.Context, item *ent.DownloadQueueItem) {
-	now := time.Now()
-
-	// Mark as running in DB
-	_, err := d.db.DownloadQueueItem.UpdateOneID(item.ID).
-		SetStatus(types.DLStatusRunning).
-		SetStartedAt(now).
-		Save(ctx)
-	if err != nil {
-		log.Warn().Err(err).Msg("failed to mark download as running")
-		return
-	}
+// startDownload launches a goroutine for a claimed download.
+func (d *DownloadDispatcher) startDownload(item *ent.DownloadQueueItem) {
+	// Use a fresh context (not the dispatch ticker context) so downloads
+	// can complete even during graceful shutdown. Only CancelRunning stops
+	// them.
+	dlCtx, cancel := context.WithCancelCause(context.Background())
+	d.track(item.ID, cancel)
 
 	d.mu.Lock()
 	d.running[item.GroupKey]++
@@ -306,6 +297,8 @@ func (d *DownloadDispatcher) startDownload(ctx context.Context, item *ent.Downlo
 	go func() {
 		defer d.wg.Done()
 		defer func() {
+			d.untrack(item.ID)
+			cancel(nil)
 			d.mu.Lock()
 			d.running[item.GroupKey]--
 			if d.running[item.GroupKey] <= 0 {
@@ -313,11 +306,11 @@ func (d *DownloadDispatcher) startDownload(ctx context.Context, item *ent.Downlo
 			}
 			d.total--
 			d.mu.Unlock()
+			// A slot is free: start the next download, here or on another
+			// instance waiting for this provider.
+			d.notify(context.Background())
 		}()
 
-		// Use a fresh context (not the dispatch ticker context) so downloads
-		// can complete even during graceful shutdown.
-		dlCtx := context.Background()
 		d.executeDownload(dlCtx, item.ID, item.Args)
 	}()
 }
@@ -329,13 +322,26 @@ func (d *DownloadDispatcher) executeDownload(ctx context.Context, itemID uuid.UU
 		chapStr = formatChapterNumber(*args.ChapterNumber)
 	}
 
+	ctx = tracing.ContextWithTraceParent(ctx, args.TraceParent)
+	ctx, span := tracing.Start(ctx, "download "+args.ProviderName, tracing.KindConsumer,
+		tracing.String("kaizoku.download.id", itemID.String()),
+		tracing.String("kaizoku.series.title", args.Title),
+		tracing.String("kaizoku.provider", args.ProviderName),
+		tracing.String("kaizoku.chapter", chapStr))
+	defer span.End()
+
 	// Validate state before downloading — series may have been paused, provider disabled/deleted.
 	if err := d.validateDownloadState(ctx, args); err != nil {
+		if errors.Is(context.Cause(ctx), ErrDownloadCancelled) {
+			d.dropCancelled(ctx, itemID, args, chapStr)
+			return
+		}
 		log.Info().Err(err).
 			Str("title", args.Title).
 			Str("provider", args.ProviderName).
 			Str("chapter", chapStr).
 			Msg("download skipped (state changed)")
+		span.SetAttributes(tracing.String("kaizoku.download.result", "skipped"))
 
 		// Mark as failed so it doesn't retry automatically
 		d.db.DownloadQueueItem.UpdateOneID(itemID).
@@ -345,7 +351,27 @@ func (d *DownloadDispatcher) executeDownload(ctx context.Context, itemID uuid.UU
 		return
 	}
 
+	dlStart := time.Now()
 	cbzFilename, err := d.deps.performDownload(ctx, args, chapStr, itemID.String())
+	cancelled := err != nil && errors.Is(context.Cause(ctx), ErrDownloadCancelled)
+	// The download is over: record its outcome even if a cancellation
+	// arrived after the last page.
+	ctx = context.WithoutCancel(ctx)
+
+	result := "succeeded"
+	switch {
+	case cancelled:
+		result = "cancelled"
+	case err != nil:
+		result = "failed"
+	}
+	downloadDuration.Observe(time.Since(dlStart).Seconds(), args.ProviderName, result)
+	span.SetAttributes(tracing.String("kaizoku.download.result", result))
+	if cancelled {
+		d.dropCancelled(ctx, itemID, args, chapStr)
+		return
+	}
+	span.RecordError(err)
 	if err != nil {
 		log.Warn().Err(err).
 			Str("title", args.Title).
@@ -359,6 +385,12 @@ func (d *DownloadDispatcher) executeDownload(ctx context.Context, itemID uuid.UU
 			SetCompletedAt(time.Now()).
 			Save(ctx)
 
+		// A failed hook is not the source's fault: leave the chapter failed
+		// instead of trying other providers.
+		if errors.Is(err, hooks.ErrFailed) {
+			return
+		}
+
 		// Set the original item ID so cascade handlers can clean up
 		args.OriginalItemID = itemID
 
@@ -419,6 +451,8 @@ func (d *DownloadDispatcher) GetMetrics(ctx context.Context) types.DownloadsMetr
 		Count(ctx)
 	metrics.Failed = failed
 
+	metrics.Throttled = d.deps.RateLimits.Throttles()
+
 	return metrics
 }
 
@@ -512,8 +546,14 @@ func (d *DownloadDispatcher) RetryDownload(ctx context.Context, id uuid.UUID) er
 		SetScheduledAt(time.Now()).
 		ClearStartedAt().
 		ClearCompletedAt().
+		ClearWorkerID().
+		ClearLeaseExpiresAt().
 		Save(ctx)
-	return err
+	if err != nil {
+		return err
+	}
+	d.notify(ctx)
+	return nil
 }
 
 // DeleteDownload removes a download from the queue.
@@ -521,8 +561,21 @@ func (d *DownloadDispatcher) DeleteDownload(ctx context.Context, id uuid.UUID) e
 	return d.db.DownloadQueueItem.DeleteOneID(id).Exec(ctx)
 }
 
-// CancelSeriesDownloads deletes all waiting downloads for a given series.
+// CancelSeriesDownloads deletes all waiting downloads for a given series and
+// stops its running ones.
 func (d *DownloadDispatcher) CancelSeriesDownloads(ctx context.Context, seriesID uuid.UUID) (int, error) {
+	stopped, err := d.cancelRunningWhere(ctx, func(args types.DownloadChapterArgs) bool {
+		return args.SeriesID == seriesID
+	})
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := d.deleteWaitingSeries(ctx, seriesID)
+	return stopped + deleted, err
+}
+
+// deleteWaitingSeries deletes the waiting downloads of a series.
+func (d *DownloadDispatcher) deleteWaitingSeries(ctx context.Context, seriesID uuid.UUID) (int, error) {
 	items, err := d.db.DownloadQueueItem.Query().
 		Where(downloadqueueitem.StatusEQ(types.DLStatusWaiting)).
 		All(ctx)
@@ -654,8 +707,16 @@ func (d *DownloadDispatcher) validateDownloadState(ctx context.Context, args typ
 	return nil
 }
 
-// CancelProviderDownloads deletes all waiting downloads for a given provider.
+// CancelProviderDownloads deletes all waiting downloads for a given provider
+// and stops its running ones.
 func (d *DownloadDispatcher) CancelProviderDownloads(ctx context.Context, providerID uuid.UUID) (int, error) {
+	stopped, err := d.cancelRunningWhere(ctx, func(args types.DownloadChapterArgs) bool {
+		return args.ProviderID == providerID
+	})
+	if err != nil {
+		return 0, err
+	}
+
 	items, err := d.db.DownloadQueueItem.Query().
 		Where(downloadqueueitem.StatusEQ(types.DLStatusWaiting)).
 		All(ctx)
@@ -663,7 +724,7 @@ func (d *DownloadDispatcher) CancelProviderDownloads(ctx context.Context, provid
 		return 0, err
 	}
 
-	deleted := 0
+	deleted := stopped
 	for _, item := range items {
 		if item.Args.ProviderID == providerID {
 			if err := d.db.DownloadQueueItem.DeleteOneID(item.ID).Exec(ctx); err == nil {
@@ -674,8 +735,16 @@ func (d *DownloadDispatcher) CancelProviderDownloads(ctx context.Context, provid
 	return deleted, nil
 }
 
-// CancelProviderDownloadsByName deletes all waiting downloads matching a provider name for a series.
+// CancelProviderDownloadsByName deletes all waiting downloads matching a provider name for a series
+// and stops the running ones.
 func (d *DownloadDispatcher) CancelProviderDownloadsByName(ctx context.Context, seriesID uuid.UUID, providerName string) (int, error) {
+	stopped, err := d.cancelRunningWhere(ctx, func(args types.DownloadChapterArgs) bool {
+		return args.SeriesID == seriesID && args.ProviderName == providerName
+	})
+	if err != nil {
+		return 0, err
+	}
+
 	items, err := d.db.DownloadQueueItem.Query().
 		Where(downloadqueueitem.StatusEQ(types.DLStatusWaiting)).
 		All(ctx)
@@ -683,7 +752,7 @@ func (d *DownloadDispatcher) CancelProviderDownloadsByName(ctx context.Context,
 		return 0, err
 	}
 
-	deleted := 0
+	deleted := stopped
 	for _, item := range items {
 		if item.Args.SeriesID == seriesID && item.Args.ProviderName == providerName {
 			if err := d.db.DownloadQueueItem.DeleteOneID(item.ID).Exec(ctx); err == nil {
@@ -695,11 +764,12 @@ func (d *DownloadDispatcher) CancelProviderDownloadsByName(ctx context.Context,
 }
 
 // PauseSeriesDownloads cancels all waiting downloads for a series (used when pause is toggled on).
+// Running downloads are left to finish; only an explicit cancel stops them.
 func (d *DownloadDispatcher) PauseSeriesDownloads(ctx context.Context, seriesID uuid.UUID) (int, error) {
-	return d.CancelSeriesDownloads(ctx, seriesID)
+	return d.deleteWaitingSeries(ctx, seriesID)
 }
 
-// CancelDisabledProviderDownloads cancels waiting downloads for all disabled providers of a series.
+// CancelDisabledProviderDownloads cancels waiting and running downloads for all disabled providers of a series.
 func (d *DownloadDispatcher) CancelDisabledProviderDownloads(ctx context.Context, seriesID uuid.UUID) (int, error) {
 	// Get all disabled providers for this series
 	disabledProviders, err := d.db.SeriesProvider.Query().
@@ -721,6 +791,13 @@ func (d *DownloadDispatcher) CancelDisabledProviderDownloads(ctx context.Context
 		return 0, nil
 	}
 
+	stopped, err := d.cancelRunningWhere(ctx, func(args types.DownloadChapterArgs) bool {
+		return args.SeriesID == seriesID && providerIDs[args.ProviderID]
+	})
+	if err != nil {
+		return 0, err
+	}
+
 	items, err := d.db.DownloadQueueItem.Query().
 		Where(downloadqueueitem.StatusEQ(types.DLStatusWaiting)).
 		All(ctx)
@@ -728,7 +805,7 @@ func (d *DownloadDispatcher) CancelDisabledProviderDownloads(ctx context.Context
 		return 0, err
 	}
 
-	deleted := 0
+	deleted := stopped
 	for _, item := range items {
 		if item.Args.SeriesID == seriesID && providerIDs[item.Args.ProviderID] {
 			if err := d.db.DownloadQueueItem.DeleteOneID(item.ID).Exec(ctx); err == nil {
@@ -2,7 +2,9 @@ package job
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -14,7 +16,15 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/technobecet/kaizoku-go/internal/config"
 	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/events"
+	"github.com/technobecet/kaizoku-go/internal/service/audit"
+	"github.com/technobecet/kaizoku-go/internal/service/digest"
+	"github.com/technobecet/kaizoku-go/internal/service/hooks"
+	"github.com/technobecet/kaizoku-go/internal/service/libraryscan"
+	"github.com/technobecet/kaizoku-go/internal/service/mqtt"
+	"github.com/technobecet/kaizoku-go/internal/service/notify"
 	"github.com/technobecet/kaizoku-go/internal/service/suwayomi"
+	"github.com/technobecet/kaizoku-go/internal/service/webhook"
 )
 
 // Manager manages the River job queue client and custom download dispatcher.
@@ -23,6 +33,8 @@ type Manager struct {
 	Pool       *pgxpool.Pool
 	Downloads  *DownloadDispatcher
 	JobDeps    *Deps
+
+	riverStarted atomic.Bool
 }
 
 // NewManager creates a River client (for non-download jobs) and a custom DownloadDispatcher.
@@ -42,7 +54,17 @@ func NewManager(ctx context.Context, cfg *config.Config, db *ent.Client, sw *suw
 		Config:          cfg,
 		Settings:        settings,
 		SuwayomiProcess: swProcess,
+		Audit:           audit.NewRecorder(db),
+		Events:          events.NewBus(),
+		Webhooks:        webhook.NewService(db, nil),
+		LibraryScan:     libraryscan.NewScanner(settings, cfg.Storage.Folder, nil),
+		Notify:          notify.NewService(db, nil),
+		Hooks:           hooks.NewRunner(db, cfg),
+		RateLimits:      NewRateLimiter(cfg.RateLimits),
 	}
+	deps.Events.Subscribe(deps.enqueueWebhooks)
+	deps.Events.Subscribe(deps.enqueueNotifications)
+	deps.Events.Subscribe(deps.enqueueSeriesHooks)
 
 	// Register River workers (non-download jobs only)
 	workers := river.NewWorkers()
@@ -59,6 +81,12 @@ func NewManager(ctx context.Context, cfg *config.Config, db *ent.Client, sw *suw
 	river.AddWorker(workers, &RefreshAllLatestWorker{Deps: deps})
 	river.AddWorker(workers, &VerifyAllSeriesWorker{Deps: deps})
 	river.AddWorker(workers, &UpgradeAllSourcesWorker{Deps: deps})
+	river.AddWorker(workers, &WebhookDeliveryWorker{Deps: deps})
+	river.AddWorker(workers, &ScriptHookWorker{Deps: deps})
+	river.AddWorker(workers, &SourceHealthWorker{Deps: deps})
+	river.AddWorker(workers, &NotificationWorker{Deps: deps})
+	river.AddWorker(workers, &NotificationDigestWorker{Deps: deps})
+	river.AddWorker(workers, &EmailDigestWorker{Deps: deps})
 
 	// Parse schedule intervals from config
 	extUpdateInterval, err := time.ParseDuration(cfg.Settings.ExtensionsUpdateSchedule)
@@ -103,16 +131,45 @@ func NewManager(ctx context.Context, cfg *config.Config, db *ent.Client, sw *suw
 			},
 			&river.PeriodicJobOpts{ID: "refresh_all_latest"},
 		),
+		river.NewPeriodicJob(
+			river.PeriodicInterval(sourceHealthInterval),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return SourceHealthArgs{}, nil
+			},
+			&river.PeriodicJobOpts{ID: "source_health"},
+		),
+		river.NewPeriodicJob(
+			river.PeriodicInterval(notificationDigestInterval),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return NotificationDigestArgs{}, nil
+			},
+			&river.PeriodicJobOpts{ID: "notification_digest"},
+		),
+	}
+	if cfg.Email.Enabled {
+		if schedule, err := digest.ParseSchedule(cfg.Email); err != nil {
+			log.Warn().Err(err).Msg("invalid email digest schedule, email digest disabled")
+		} else {
+			periodicJobs = append(periodicJobs, river.NewPeriodicJob(
+				schedule,
+				func() (river.JobArgs, *river.InsertOpts) {
+					return EmailDigestArgs{}, nil
+				},
+				&river.PeriodicJobOpts{ID: "email_digest"},
+			))
+		}
 	}
 
 	riverClient, err := river.NewClient(riverpgxv5.New(pool), &river.Config{
 		Queues: map[string]river.QueueConfig{
 			QueueDefault: {MaxWorkers: 5}, // get_chapters, get_latest
 			QueueBatch:   {MaxWorkers: 2}, // batch/bulk jobs (verify, refresh, import, etc.)
+			QueueHooks:   {MaxWorkers: 2}, // script hooks
 		},
 		Workers:      workers,
 		PeriodicJobs: periodicJobs,
 		ErrorHandler: &logErrorHandler{},
+		Middleware:   []rivertype.Middleware{&traceMiddleware{}},
 	})
 	if err != nil {
 		pool.Close()
@@ -129,12 +186,19 @@ func NewManager(ctx context.Context, cfg *config.Config, db *ent.Client, sw *suw
 	deps.DownloadQueue = dlDispatcher
 	deps.RiverClient = riverClient
 
-	return &Manager{
+	if cfg.MQTT.Enabled {
+		deps.MQTT = mqtt.NewPublisher(cfg, db, dlDispatcher.GetMetrics)
+		deps.Events.Subscribe(deps.MQTT.HandleEvent)
+	}
+
+	m := &Manager{
 		Client:    riverClient,
 		Pool:      pool,
 		Downloads: dlDispatcher,
 		JobDeps:   deps,
-	}, nil
+	}
+	m.registerMetrics()
+	return m, nil
 }
 
 // Start begins processing River jobs and the download dispatcher.
@@ -142,13 +206,19 @@ func (m *Manager) Start(ctx context.Context) error {
 	log.Info().Msg("starting River job queue")
 	// Start download dispatcher in background
 	go m.Downloads.Run(ctx)
-	return m.Client.Start(ctx)
+	go m.JobDeps.MQTT.Run(ctx)
+	if err := m.Client.Start(ctx); err != nil {
+		return err
+	}
+	m.riverStarted.Store(true)
+	return nil
 }
 
 // Stop gracefully stops job processing.
 func (m *Manager) Stop(ctx context.Context) error {
 	log.Info().Msg("stopping River job queue and download dispatcher")
 	m.Downloads.Stop()
+	m.riverStarted.Store(false)
 	if err := m.Client.Stop(ctx); err != nil {
 		return err
 	}
@@ -156,6 +226,18 @@ func (m *Manager) Stop(ctx context.Context) error {
 	return nil
 }
 
+// RiverHealth reports whether the River client has started and its
+// connection pool can reach the database.
+func (m *Manager) RiverHealth(ctx context.Context) error {
+	if !m.riverStarted.Load() {
+		return errors.New("job queue not started")
+	}
+	if err := m.Pool.Ping(ctx); err != nil {
+		return fmt.Errorf("job queue database: %w", err)
+	}
+	return nil
+}
+
 // CreateSchema creates the River schema tables if they don't exist.
 func (m *Manager) CreateSchema(ctx context.Context) error {
 	migrator, err := rivermigrate.New[pgx.Tx](riverpgxv5.New(m.Pool), nil)
@@ -0,0 +1,219 @@
+package job
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/downloadqueueitem"
+	"github.com/technobecet/kaizoku-go/internal/ent/seriesprovider"
+	"github.com/technobecet/kaizoku-go/internal/metrics"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+var (
+	downloadDuration = metrics.Default.NewHistogramVec(
+		"kaizoku_download_duration_seconds",
+		"Duration of chapter downloads, including failed ones.",
+		[]float64{1, 2.5, 5, 10, 20, 30, 60, 120, 300, 600}, "provider", "result")
+	downloadPages = metrics.Default.NewHistogramVec(
+		"kaizoku_download_pages",
+		"Page counts of downloaded chapters.",
+		[]float64{5, 10, 20, 30, 50, 75, 100, 150, 200, 300}, "provider")
+	queueItems = metrics.Default.NewGaugeVec(
+		"kaizoku_download_queue_items",
+		"Download queue items by status and provider (group key).",
+		"status", "group_key")
+	downloadsRunning = metrics.Default.NewGaugeVec(
+		"kaizoku_downloads_running",
+		"Downloads in progress per provider.",
+		"provider")
+	sourceThrottleWait = metrics.Default.NewCounterVec(
+		"kaizoku_source_throttle_wait_seconds_total",
+		"Time requests spent waiting for a source's rate limit.",
+		"source")
+	riverJobs = metrics.Default.NewGaugeVec(
+		"kaizoku_river_jobs",
+		"Background jobs by kind and state.",
+		"kind", "state")
+	librarySeries = metrics.Default.NewGaugeVec(
+		"kaizoku_library_series",
+		"Series in the library.")
+	libraryChapters = metrics.Default.NewGaugeVec(
+		"kaizoku_library_chapters",
+		"Downloaded chapters in the library.")
+	libraryBytes = metrics.Default.NewGaugeVec(
+		"kaizoku_library_size_bytes",
+		"Disk space used by the files in the storage folder.")
+)
+
+// queueStatusNames labels the download queue statuses.
+var queueStatusNames = map[int]string{
+	types.DLStatusWaiting:   "waiting",
+	types.DLStatusRunning:   "running",
+	types.DLStatusCompleted: "completed",
+	types.DLStatusFailed:    "failed",
+}
+
+const (
+	// metricsTimeout bounds the queries of one scrape.
+	metricsTimeout = 10 * time.Second
+	// libraryScanInterval is how often the chapter count and disk usage,
+	// which need a full pass over the library, are recomputed.
+	libraryScanInterval = 10 * time.Minute
+)
+
+// registerMetrics adds the scrape-time collectors of the job manager.
+func (m *Manager) registerMetrics() {
+	lib := &libraryStats{db: m.JobDeps.DB, folder: m.JobDeps.Config.Storage.Folder}
+	metrics.Default.AddCollector(func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, metricsTimeout)
+		defer cancel()
+		m.Downloads.collectMetrics(ctx)
+		collectJobMetrics(ctx, m.Pool)
+		lib.collect(ctx)
+	})
+}
+
+// collectMetrics reports the queue by status and provider, and the
+// downloads running right now.
+func (d *DownloadDispatcher) collectMetrics(ctx context.Context) {
+	var rows []struct {
+		Status   int    `json:"status"`
+		GroupKey string `json:"group_key"`
+		Count    int    `json:"count"`
+	}
+	err := d.db.DownloadQueueItem.Query().
+		GroupBy(downloadqueueitem.FieldStatus, downloadqueueitem.FieldGroupKey).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		log.Warn().Err(err).Msg("metrics: failed to count download queue")
+	} else {
+		queueItems.Reset()
+		for _, r := range rows {
+			status, ok := queueStatusNames[r.Status]
+			if !ok {
+				status = strconv.Itoa(r.Status)
+			}
+			queueItems.Set(float64(r.Count), status, r.GroupKey)
+		}
+	}
+
+	d.mu.Lock()
+	running := make(map[string]int, len(d.running))
+	for k, v := range d.running {
+		running[k] = v
+	}
+	d.mu.Unlock()
+	downloadsRunning.Reset()
+	for provider, n := range running {
+		downloadsRunning.Set(float64(n), provider)
+	}
+}
+
+// collectJobMetrics counts River jobs by kind and state.
+func collectJobMetrics(ctx context.Context, pool *pgxpool.Pool) {
+	rows, err := pool.Query(ctx, `SELECT kind, state, COUNT(*) FROM river_job GROUP BY kind, state`)
+	if err != nil {
+		log.Warn().Err(err).Msg("metrics: failed to count jobs")
+		return
+	}
+	defer rows.Close()
+
+	riverJobs.Reset()
+	for rows.Next() {
+		var kind, state string
+		var cnt int
+		if err := rows.Scan(&kind, &state, &cnt); err != nil {
+			continue
+		}
+		riverJobs.Set(float64(cnt), kind, state)
+	}
+}
+
+// libraryStats reports the library size. The chapter count and disk usage
+// are recomputed in the background at most every libraryScanInterval, so a
+// scrape never waits for a walk of the storage folder.
+type libraryStats struct {
+	db     *ent.Client
+	folder string
+
+	mu       sync.Mutex
+	scanned  time.Time
+	scanning bool
+}
+
+func (l *libraryStats) collect(ctx context.Context) {
+	if n, err := l.db.Series.Query().Count(ctx); err == nil {
+		librarySeries.Set(float64(n))
+	}
+
+	l.mu.Lock()
+	stale := !l.scanning && time.Since(l.scanned) >= libraryScanInterval
+	if stale {
+		l.scanning = true
+	}
+	l.mu.Unlock()
+	if stale {
+		go l.scan()
+	}
+}
+
+func (l *libraryStats) scan() {
+	defer func() {
+		l.mu.Lock()
+		l.scanning = false
+		l.scanned = time.Now()
+		l.mu.Unlock()
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), libraryScanInterval)
+	defer cancel()
+
+	providers, err := l.db.SeriesProvider.Query().
+		Select(seriesprovider.FieldChapters).
+		All(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("metrics: failed to count chapters")
+	} else {
+		chapters := 0
+		for _, sp := range providers {
+			for _, ch := range sp.Chapters {
+				if ch.Filename != "" && !ch.IsDeleted {
+					chapters++
+				}
+			}
+		}
+		libraryChapters.Set(float64(chapters))
+	}
+
+	if l.folder == "" {
+		return
+	}
+	var size int64
+	err = filepath.WalkDir(l.folder, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // skip unreadable entries
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("metrics: failed to measure library folder")
+		return
+	}
+	libraryBytes.Set(float64(size))
+}
@@ -0,0 +1,296 @@
+package job
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/service/suwayomi"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// RateLimiter holds requests to each source to its configured rate, with a
+// token bucket for pages per minute and one for chapters per hour. Requests
+// are spaced evenly rather than sent in bursts. A 429 with Retry-After from
+// a source holds all its requests until then. Limits apply per instance.
+type RateLimiter struct {
+	cfg config.RateLimitsConfig
+
+	mu      sync.Mutex
+	sources map[string]*sourceLimit // by lower-case source name
+}
+
+// sourceLimit is the rate-limit state of one source.
+type sourceLimit struct {
+	name         string
+	pages        *tokenBucket // nil when unlimited
+	chapters     *tokenBucket // nil when unlimited
+	blockedUntil time.Time
+	waiting      int
+}
+
+// tokenBucket refills at rate tokens per second up to one token.
+type tokenBucket struct {
+	limit  int // per period, as configured
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit int, period time.Duration, now time.Time) *tokenBucket {
+	if limit <= 0 {
+		return nil
+	}
+	return &tokenBucket{limit: limit, rate: float64(limit) / period.Seconds(), tokens: 1, last: now}
+}
+
+// reserve takes a token and returns how long to wait before it may be used.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.tokens = b.level(now)
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// level returns the tokens in the bucket at now, without taking any.
+func (b *tokenBucket) level(now time.Time) float64 {
+	return min(1, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+}
+
+// available reports whether a token can be taken at now without waiting.
+// The small tolerance absorbs float rounding at the refill time.
+func (b *tokenBucket) available(now time.Time) bool {
+	return b.level(now) >= 1-1e-9
+}
+
+// refilledAt returns when the bucket next holds a whole token.
+func (b *tokenBucket) refilledAt(now time.Time) time.Time {
+	return now.Add(time.Duration((1 - b.level(now)) / b.rate * float64(time.Second)))
+}
+
+// NewRateLimiter creates a rate limiter with the limits from config.yaml.
+func NewRateLimiter(cfg config.RateLimitsConfig) *RateLimiter {
+	return &RateLimiter{cfg: cfg, sources: make(map[string]*sourceLimit)}
+}
+
+// source returns the state of a source, creating it on first use. Callers
+// hold l.mu.
+func (l *RateLimiter) source(name string, now time.Time) *sourceLimit {
+	key := strings.ToLower(name)
+	if s, ok := l.sources[key]; ok {
+		return s
+	}
+	pages, chapters := l.cfg.PagesPerMinute, l.cfg.ChaptersPerHour
+	for _, sc := range l.cfg.Sources {
+		if strings.EqualFold(sc.Source, name) {
+			pages, chapters = sc.PagesPerMinute, sc.ChaptersPerHour
+			break
+		}
+	}
+	s := &sourceLimit{
+		name:     name,
+		pages:    newTokenBucket(pages, time.Minute, now),
+		chapters: newTokenBucket(chapters, time.Hour, now),
+	}
+	l.sources[key] = s
+	return s
+}
+
+// WaitPage blocks until a request to the source fits its pages-per-minute
+// limit. Every request to a source counts as a page.
+func (l *RateLimiter) WaitPage(ctx context.Context, source string) error {
+	return l.wait(ctx, source, func(s *sourceLimit) *tokenBucket { return s.pages })
+}
+
+// ChapterBudget reports how many chapter downloads each source may start
+// now, so that the download dispatcher only claims items it can start right
+// away and leaves the rest waiting. limited maps lower-case source names to
+// their allowance, and def applies to every other source; -1 means
+// unlimited. next is when the earliest held source may start a chapter
+// again, or zero when none is held.
+func (l *RateLimiter) ChapterBudget() (limited map[string]int, def int, next time.Time) {
+	if l == nil {
+		return nil, -1, time.Time{}
+	}
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limited = make(map[string]int)
+	hold := func(until time.Time) {
+		if next.IsZero() || until.Before(next) {
+			next = until
+		}
+	}
+	for key, s := range l.sources {
+		switch {
+		case s.blockedUntil.After(now):
+			limited[key] = 0
+			hold(s.blockedUntil)
+		case s.chapters == nil:
+			limited[key] = -1
+		case s.chapters.available(now):
+			limited[key] = 1
+		default:
+			limited[key] = 0
+			hold(s.chapters.refilledAt(now))
+		}
+	}
+	// Sources with their own limit that have not been used yet.
+	for _, sc := range l.cfg.Sources {
+		key := strings.ToLower(sc.Source)
+		if _, ok := limited[key]; ok {
+			continue
+		}
+		limited[key] = -1
+		if sc.ChaptersPerHour > 0 {
+			limited[key] = 1
+		}
+	}
+	def = -1
+	if l.cfg.ChaptersPerHour > 0 {
+		def = 1
+	}
+	return limited, def, next
+}
+
+// TakeChapter takes a chapter from the source's chapters-per-hour budget
+// for a download that is starting.
+func (l *RateLimiter) TakeChapter(source string) {
+	if l == nil {
+		return
+	}
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if b := l.source(source, now).chapters; b != nil {
+		b.reserve(now)
+	}
+}
+
+func (l *RateLimiter) wait(ctx context.Context, source string, bucket func(*sourceLimit) *tokenBucket) error {
+	if l == nil {
+		return nil
+	}
+	now := time.Now()
+	l.mu.Lock()
+	s := l.source(source, now)
+	b := bucket(s)
+	var delay time.Duration
+	if b != nil {
+		delay = b.reserve(now)
+	}
+	delay = max(delay, s.blockedUntil.Sub(now))
+	if delay <= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	s.waiting++
+	l.mu.Unlock()
+
+	start := time.Now()
+	defer func() {
+		sourceThrottleWait.Add(time.Since(start).Seconds(), s.name)
+		l.mu.Lock()
+		s.waiting--
+		l.mu.Unlock()
+	}()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if b != nil {
+				// Give back the unused token.
+				l.mu.Lock()
+				b.tokens++
+				l.mu.Unlock()
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+		// A 429 may have blocked the source while waiting.
+		l.mu.Lock()
+		blocked := time.Until(s.blockedUntil)
+		l.mu.Unlock()
+		if blocked <= 0 {
+			return nil
+		}
+		timer.Reset(blocked)
+	}
+}
+
+// Block holds all requests to the source for d, after it answered 429 with
+// a Retry-After header.
+func (l *RateLimiter) Block(source string, d time.Duration) {
+	if l == nil || d <= 0 {
+		return
+	}
+	now := time.Now()
+	l.mu.Lock()
+	s := l.source(source, now)
+	until := now.Add(d)
+	extended := until.After(s.blockedUntil)
+	if extended {
+		s.blockedUntil = until
+	}
+	l.mu.Unlock()
+	if extended {
+		log.Warn().Str("source", source).Dur("retry_after", d).Msg("source rate limited, holding its requests")
+	}
+}
+
+// WithSource returns a context whose Suwayomi requests report 429 responses
+// with Retry-After to the source's limit.
+func (l *RateLimiter) WithSource(ctx context.Context, source string) context.Context {
+	if l == nil {
+		return ctx
+	}
+	return suwayomi.WithRateLimitHandler(ctx, func(retryAfter time.Duration) {
+		l.Block(source, retryAfter)
+	})
+}
+
+// Throttles returns the sources whose limits are holding back requests.
+func (l *RateLimiter) Throttles() []types.SourceThrottle {
+	out := []types.SourceThrottle{}
+	if l == nil {
+		return out
+	}
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, s := range l.sources {
+		blocked := s.blockedUntil.After(now)
+		held := s.chapters != nil && !s.chapters.available(now)
+		if s.waiting == 0 && !blocked && !held {
+			continue
+		}
+		t := types.SourceThrottle{Source: s.name, Waiting: s.waiting}
+		if s.pages != nil {
+			t.PagesPerMinute = s.pages.limit
+		}
+		if s.chapters != nil {
+			t.ChaptersPerHour = s.chapters.limit
+		}
+		if blocked {
+			until := s.blockedUntil.UTC().Format(time.RFC3339)
+			t.BlockedUntil = &until
+		}
+		if held {
+			at := s.chapters.refilledAt(now).UTC().Format(time.RFC3339)
+			t.NextChapterAt = &at
+		}
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Source < out[j].Source })
+	return out
+}
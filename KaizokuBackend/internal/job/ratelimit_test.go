@@ -0,0 +1,72 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/technobecet/kaizoku-go/internal/config"
+)
+
+func TestChapterBudget(t *testing.T) {
+	l := NewRateLimiter(config.RateLimitsConfig{
+		Sources: []config.SourceRateLimitConfig{
+			{Source: "MangaDex", ChaptersPerHour: 60},
+			{Source: "Pages Only", PagesPerMinute: 30},
+		},
+	})
+
+	budget, def, next := l.ChapterBudget()
+	if def != -1 || budget["mangadex"] != 1 || budget["pages only"] != -1 || !next.IsZero() {
+		t.Fatalf("fresh budget = %v, def %d, next %v", budget, def, next)
+	}
+
+	// A claimed chapter uses MangaDex's budget until the bucket refills a
+	// minute later; other sources are unaffected.
+	start := time.Now()
+	l.TakeChapter("mangadex")
+	l.TakeChapter("Pages Only")
+	l.TakeChapter("Unlisted")
+	budget, def, next = l.ChapterBudget()
+	if budget["mangadex"] != 0 || budget["pages only"] != -1 || budget["unlisted"] != -1 || def != -1 {
+		t.Fatalf("budget after taking = %v, def %d", budget, def)
+	}
+	if wait := next.Sub(start); wait < 59*time.Second || wait > time.Minute+time.Second {
+		t.Fatalf("next chapter in %v, want a minute", wait)
+	}
+
+	throttles := l.Throttles()
+	if len(throttles) != 1 || throttles[0].Source != "mangadex" || throttles[0].NextChapterAt == nil || throttles[0].ChaptersPerHour != 60 {
+		t.Fatalf("throttles = %+v", throttles)
+	}
+
+	// A 429 holds a source without a chapter limit too.
+	l.Block("Pages Only", time.Hour)
+	budget, _, next = l.ChapterBudget()
+	if budget["pages only"] != 0 {
+		t.Fatalf("blocked source budget = %d", budget["pages only"])
+	}
+	if next.Sub(start) > time.Minute+time.Second {
+		t.Fatalf("next = %v, want the earlier MangaDex refill", next.Sub(start))
+	}
+}
+
+func TestChapterBudgetDefault(t *testing.T) {
+	l := NewRateLimiter(config.RateLimitsConfig{
+		ChaptersPerHour: 10,
+		Sources:         []config.SourceRateLimitConfig{{Source: "Unlimited"}},
+	})
+	budget, def, _ := l.ChapterBudget()
+	if def != 1 || budget["unlimited"] != -1 {
+		t.Fatalf("budget = %v, def %d", budget, def)
+	}
+	l.TakeChapter("Any Source")
+	if budget, _, _ := l.ChapterBudget(); budget["any source"] != 0 {
+		t.Fatalf("budget after taking = %v", budget)
+	}
+
+	var nilLimiter *RateLimiter
+	if budget, def, next := nilLimiter.ChapterBudget(); budget != nil || def != -1 || !next.IsZero() {
+		t.Fatalf("nil limiter budget = %v, %d, %v", budget, def, next)
+	}
+	nilLimiter.TakeChapter("Any Source")
+}
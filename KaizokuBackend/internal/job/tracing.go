@@ -0,0 +1,61 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/technobecet/kaizoku-go/internal/tracing"
+)
+
+// traceMetadataKey is the job metadata key holding the traceparent of the
+// span that inserted the job.
+const traceMetadataKey = "traceparent"
+
+// traceMiddleware carries the trace context through River: inserted jobs
+// remember the current span, and workers run in a span that continues it.
+type traceMiddleware struct {
+	river.MiddlewareDefaults
+}
+
+// InsertMany stores the current traceparent in each job's metadata.
+func (m *traceMiddleware) InsertMany(ctx context.Context, manyParams []*rivertype.JobInsertParams, doInner func(context.Context) ([]*rivertype.JobInsertResult, error)) ([]*rivertype.JobInsertResult, error) {
+	if tp := tracing.TraceParent(ctx); tp != "" {
+		for _, params := range manyParams {
+			metadata := map[string]interface{}{}
+			if len(params.Metadata) > 0 {
+				if err := json.Unmarshal(params.Metadata, &metadata); err != nil {
+					continue
+				}
+			}
+			metadata[traceMetadataKey] = tp
+			if encoded, err := json.Marshal(metadata); err == nil {
+				params.Metadata = encoded
+			}
+		}
+	}
+	return doInner(ctx)
+}
+
+// Work runs the job in a consumer span.
+func (m *traceMiddleware) Work(ctx context.Context, job *rivertype.JobRow, doInner func(context.Context) error) error {
+	if !tracing.Enabled() {
+		return doInner(ctx)
+	}
+	var metadata struct {
+		TraceParent string `json:"traceparent"`
+	}
+	_ = json.Unmarshal(job.Metadata, &metadata)
+	ctx = tracing.ContextWithTraceParent(ctx, metadata.TraceParent)
+	ctx, span := tracing.Start(ctx, "job "+job.Kind, tracing.KindConsumer,
+		tracing.Int64("river.job.id", job.ID),
+		tracing.String("river.job.kind", job.Kind),
+		tracing.String("river.queue", job.Queue),
+		tracing.Int("river.job.attempt", job.Attempt))
+	defer span.End()
+
+	err := doInner(ctx)
+	span.RecordError(err)
+	return err
+}
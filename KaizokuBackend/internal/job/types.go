@@ -6,12 +6,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/rivertype"
+	"github.com/technobecet/kaizoku-go/internal/service/hooks"
 )
 
 // Queue names used by River (downloads are handled by custom DownloadDispatcher, not River).
 const (
 	QueueDefault = "default" // High-volume per-item jobs (get_chapters, get_latest)
 	QueueBatch   = "batch"   // Bulk/batch jobs that must not be starved by per-item work
+	QueueHooks   = "hooks"   // Script hooks, which may run for minutes
 )
 
 // --- River job argument types (non-download jobs only) ---
@@ -167,6 +169,56 @@ func (ImportSeriesArgs) InsertOpts() river.InsertOpts {
 	}
 }
 
+// WebhookDeliveryArgs represents a job to send one stored webhook delivery.
+type WebhookDeliveryArgs struct {
+	DeliveryID uuid.UUID `json:"deliveryId"`
+}
+
+func (WebhookDeliveryArgs) Kind() string { return "webhook_delivery" }
+
+func (WebhookDeliveryArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       QueueDefault,
+		MaxAttempts: 10,
+	}
+}
+
+// ScriptHookArgs represents a job to run one script hook in the background.
+type ScriptHookArgs struct {
+	Hook    string        `json:"hook"`
+	Context hooks.Context `json:"context"`
+}
+
+func (ScriptHookArgs) Kind() string { return "script_hook" }
+
+// InsertOpts allows a single attempt: the hook's own policy decides on
+// retries, and every attempt is recorded in the run log.
+func (ScriptHookArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       QueueHooks,
+		MaxAttempts: 1,
+	}
+}
+
+// SourceHealthArgs represents a job to check source error rates.
+type SourceHealthArgs struct{}
+
+func (SourceHealthArgs) Kind() string { return "source_health" }
+
+func (SourceHealthArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue: QueueBatch,
+		UniqueOpts: river.UniqueOpts{
+			ByState: []rivertype.JobState{
+				rivertype.JobStatePending,
+				rivertype.JobStateScheduled,
+				rivertype.JobStateAvailable,
+				rivertype.JobStateRunning,
+			},
+		},
+	}
+}
+
 // VerifyAllSeriesArgs represents a job to verify all series in the library.
 type VerifyAllSeriesArgs struct{}
 
@@ -204,3 +256,62 @@ func (UpgradeAllSourcesArgs) InsertOpts() river.InsertOpts {
 		},
 	}
 }
+
+// NotificationArgs represents a job to send one stored push notification.
+type NotificationArgs struct {
+	NotificationID uuid.UUID `json:"notificationId"`
+}
+
+func (NotificationArgs) Kind() string { return "notification" }
+
+func (NotificationArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       QueueDefault,
+		MaxAttempts: 5,
+	}
+}
+
+// EmailDigestArgs represents a job to email the digest of chapters
+// downloaded since the previous digest. Test digests are sent even when
+// the scheduled digest is disabled and do not move the digest window.
+type EmailDigestArgs struct {
+	Test bool `json:"test,omitempty"`
+}
+
+func (EmailDigestArgs) Kind() string { return "email_digest" }
+
+func (EmailDigestArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       QueueBatch,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+			ByState: []rivertype.JobState{
+				rivertype.JobStatePending,
+				rivertype.JobStateScheduled,
+				rivertype.JobStateAvailable,
+				rivertype.JobStateRunning,
+			},
+		},
+	}
+}
+
+// NotificationDigestArgs represents a job to send the notifications held
+// during quiet hours.
+type NotificationDigestArgs struct{}
+
+func (NotificationDigestArgs) Kind() string { return "notification_digest" }
+
+func (NotificationDigestArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue: QueueBatch,
+		UniqueOpts: river.UniqueOpts{
+			ByState: []rivertype.JobState{
+				rivertype.JobStatePending,
+				rivertype.JobStateScheduled,
+				rivertype.JobStateAvailable,
+				rivertype.JobStateRunning,
+			},
+		},
+	}
+}
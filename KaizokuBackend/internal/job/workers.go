@@ -27,7 +27,17 @@ import (
 	"github.com/technobecet/kaizoku-go/internal/ent/series"
 	"github.com/technobecet/kaizoku-go/internal/ent/seriesprovider"
 	"github.com/technobecet/kaizoku-go/internal/ent/sourceevent"
+	"github.com/technobecet/kaizoku-go/internal/events"
+	"github.com/technobecet/kaizoku-go/internal/service/audit"
+	"github.com/technobecet/kaizoku-go/internal/service/digest"
+	"github.com/technobecet/kaizoku-go/internal/service/hooks"
+	"github.com/technobecet/kaizoku-go/internal/service/libraryscan"
+	"github.com/technobecet/kaizoku-go/internal/service/mqtt"
+	"github.com/technobecet/kaizoku-go/internal/service/mail"
+	"github.com/technobecet/kaizoku-go/internal/service/notify"
 	"github.com/technobecet/kaizoku-go/internal/service/suwayomi"
+	"github.com/technobecet/kaizoku-go/internal/service/webhook"
+	"github.com/technobecet/kaizoku-go/internal/tracing"
 	"github.com/technobecet/kaizoku-go/internal/types"
 	"github.com/technobecet/kaizoku-go/internal/util"
 )
@@ -53,6 +63,14 @@ type Deps struct {
 	DownloadQueue   *DownloadDispatcher       // Custom download queue (replaces River for downloads)
 	RiverClient     RiverInserter             // For enqueuing River jobs from shared functions
 	SuwayomiProcess SuwayomiProcessController // nil when using custom API
+	Audit           *audit.Recorder           // Records destructive automated actions
+	Events          *events.Bus               // Publishes library and download events
+	Webhooks        *webhook.Service          // Delivers events to configured webhooks
+	LibraryScan     *libraryscan.Scanner      // Triggers Komga/Kavita scans after downloads
+	Notify          *notify.Service           // Sends push notifications for events
+	MQTT            *mqtt.Publisher           // Publishes state to an MQTT broker; nil when disabled
+	Hooks           *hooks.Runner             // Runs script hooks from config.yaml
+	RateLimits      *RateLimiter              // Per-source request-rate limits from config.yaml
 }
 
 // SuwayomiProcessController allows stopping/starting the Suwayomi process for backups.
@@ -144,8 +162,27 @@ func (d *Deps) performDownload(ctx context.Context, args types.DownloadChapterAr
 		dlMeta["url"] = args.URL
 	}
 
+	// cancelled records a download stopped through CancelRunning. Pages
+	// fetched so far are discarded.
+	cancelled := func(pagesFetched int) (string, error) {
+		dlMeta["pagesBeforeCancel"] = strconv.Itoa(pagesFetched)
+		util.LogSourceEvent(d.DB, dlSourceID, args.ProviderName, args.Language,
+			"download", "cancelled", time.Since(dlStart).Milliseconds(),
+			util.WithMetadata(dlMeta))
+		d.Progress.BroadcastProgress(jobID, int(types.JobTypeDownload),
+			int(types.ProgressStatusFailed), 100,
+			fmt.Sprintf("Cancelled %s Ch.%s", args.Title, chapStr), cardInfo)
+		return "", fmt.Errorf("download %s Ch.%s: %w", args.Title, chapStr, context.Cause(ctx))
+	}
+
+	// The chapters-per-hour budget was taken when the item was claimed.
+	ctx = d.RateLimits.WithSource(ctx, args.ProviderName)
+
 	chInfo, err := d.Suwayomi.GetChapter(ctx, args.SuwayomiID, args.ChapterIndex)
 	if err != nil {
+		if ctx.Err() != nil {
+			return cancelled(0)
+		}
 		util.LogSourceEvent(d.DB, dlSourceID, args.ProviderName, args.Language,
 			"download", "failed", time.Since(dlStart).Milliseconds(),
 			util.WithError(err), util.WithMetadata(dlMeta))
@@ -187,7 +224,13 @@ func (d *Deps) performDownload(ctx context.Context, args types.DownloadChapterAr
 	var pages []util.PageData
 	var fetchErr error
 	for i := 0; ; i++ {
+		if err := d.RateLimits.WaitPage(ctx, args.ProviderName); err != nil {
+			break
+		}
 		data, _, err := d.Suwayomi.GetPage(ctx, args.SuwayomiID, args.ChapterIndex, i)
+		if ctx.Err() != nil {
+			break
+		}
 		if errors.Is(err, suwayomi.ErrNotFound) {
 			// 404 means no more pages — graceful exit like .NET
 			break
@@ -228,6 +271,10 @@ func (d *Deps) performDownload(ctx context.Context, args types.DownloadChapterAr
 			fmt.Sprintf("Downloading %s Ch.%s (%d/%d)", args.Title, chapStr, i+1, pageCountHint), cardInfo)
 	}
 
+	if ctx.Err() != nil {
+		return cancelled(len(pages))
+	}
+
 	// If a page failed mid-download, discard everything and return error for reschedule
 	if fetchErr != nil {
 		dlMeta["pagesBeforeFailure"] = strconv.Itoa(len(pages))
@@ -297,10 +344,42 @@ func (d *Deps) performDownload(ctx context.Context, args types.DownloadChapterAr
 	)
 	destPath := filepath.Join(d.Config.Storage.Folder, args.StoragePath, cbzFilename)
 
-	if err := util.CreateCBZ(destPath, pages, &ci); err != nil {
+	_, statErr := os.Stat(destPath)
+	existed := statErr == nil
+	_, cbzSpan := tracing.Start(ctx, "create cbz", tracing.KindInternal,
+		tracing.Int("kaizoku.download.pages", len(pages)))
+	err = util.CreateCBZ(destPath, pages, &ci)
+	cbzSpan.RecordError(err)
+	cbzSpan.End()
+	if err != nil {
 		return "", fmt.Errorf("create CBZ: %w", err)
 	}
 
+	// Hooks with the fail policy run before the chapter is recorded, so that
+	// they can fail the download. The others are queued once it is recorded,
+	// so they don't hold the download slot.
+	hc := hooks.Context{
+		Event:         events.ChapterDownloaded,
+		SeriesID:      args.SeriesID.String(),
+		Title:         args.Title,
+		SeriesPath:    filepath.Join(d.Config.Storage.Folder, args.StoragePath),
+		CBZPath:       destPath,
+		ChapterNumber: args.ChapterNumber,
+		ChapterName:   args.ChapterName,
+		Provider:      args.ProviderName,
+		Scanlator:     args.Scanlator,
+		Language:      args.Language,
+	}
+	if err := d.Hooks.RunBlocking(ctx, hc); err != nil {
+		if !existed {
+			if rmErr := os.Remove(destPath); rmErr != nil {
+				log.Warn().Err(rmErr).Str("file", destPath).Msg("failed to remove CBZ after hook failure")
+			}
+		}
+		return "", err
+	}
+	d.LibraryScan.Notify(ctx, args.StoragePath)
+
 	// Update provider's chapters in database
 	now := time.Now().UTC()
 	chapters := make([]types.Chapter, len(sp.Chapters))
@@ -361,6 +440,7 @@ func (d *Deps) performDownload(ctx context.Context, args types.DownloadChapterAr
 	if _, err := update.Save(ctx); err != nil {
 		return "", fmt.Errorf("update provider chapters: %w", err)
 	}
+	d.enqueueHooks(ctx, hc)
 
 	// Save kaizoku.json
 	if err := saveSeriesKaizokuJSON(ctx, d.DB, args.SeriesID, d.Config.Storage.Folder); err != nil {
@@ -374,6 +454,7 @@ func (d *Deps) performDownload(ctx context.Context, args types.DownloadChapterAr
 	util.LogSourceEvent(d.DB, dlSourceID, args.ProviderName, args.Language,
 		"download", "success", time.Since(dlStart).Milliseconds(),
 		util.WithItemsCount(len(pages)), util.WithMetadata(dlMeta))
+	downloadPages.Observe(float64(len(pages)), args.ProviderName)
 
 	return cbzFilename, nil
 }
@@ -559,8 +640,34 @@ func (d *Deps) scheduleFullCascadeRetry(ctx context.Context, args types.Download
 	return true
 }
 
+// chapterEventData describes a chapter download for event subscribers.
+func chapterEventData(args types.DownloadChapterArgs) map[string]interface{} {
+	return map[string]interface{}{
+		"seriesId":      args.SeriesID.String(),
+		"title":         args.Title,
+		"provider":      args.ProviderName,
+		"scanlator":     args.Scanlator,
+		"language":      args.Language,
+		"chapterNumber": args.ChapterNumber,
+		"chapterName":   args.ChapterName,
+	}
+}
+
+// SeriesEventData describes a series for event subscribers.
+func SeriesEventData(s *ent.Series) map[string]interface{} {
+	return map[string]interface{}{
+		"seriesId":    s.ID.String(),
+		"title":       s.Title,
+		"storagePath": s.StoragePath,
+		"type":        s.Type,
+		"status":      s.Status,
+	}
+}
+
 // markChapterPermanentlyFailed marks a chapter as permanently failed in the provider's chapter list.
 func (d *Deps) markChapterPermanentlyFailed(ctx context.Context, args types.DownloadChapterArgs) {
+	d.Events.Publish(ctx, events.ChapterFailed, chapterEventData(args))
+
 	if args.ChapterNumber == nil {
 		return
 	}
@@ -597,6 +704,11 @@ func (d *Deps) markChapterPermanentlyFailed(ctx context.Context, args types.Down
 
 // handleDownloadSuccess is called after a successful non-replacement download.
 func (d *Deps) handleDownloadSuccess(ctx context.Context, args types.DownloadChapterArgs, cbzFilename string) {
+	data := chapterEventData(args)
+	data["filename"] = cbzFilename
+	data["storagePath"] = args.StoragePath
+	d.Events.Publish(ctx, events.ChapterDownloaded, data)
+
 	sp, err := d.DB.SeriesProvider.Get(ctx, args.ProviderID)
 	if err != nil {
 		return
@@ -695,6 +807,8 @@ func (d *Deps) cleanupInferiorCopies(ctx context.Context, args types.DownloadCha
 		return
 	}
 
+	var removed []string
+	var providerIDs []string
 	for _, other := range inferiorProviders {
 		chapters := make([]types.Chapter, len(other.Chapters))
 		copy(chapters, other.Chapters)
@@ -715,6 +829,7 @@ func (d *Deps) cleanupInferiorCopies(ctx context.Context, args types.DownloadCha
 			chapters[i].IsDeleted = true
 			chapters[i].Filename = ""
 			changed = true
+			removed = append(removed, ch.Filename)
 			log.Info().
 				Str("file", ch.Filename).
 				Str("provider", other.Provider).
@@ -722,12 +837,27 @@ func (d *Deps) cleanupInferiorCopies(ctx context.Context, args types.DownloadCha
 		}
 
 		if changed {
+			providerIDs = append(providerIDs, other.ID.String())
 			if _, err := d.DB.SeriesProvider.UpdateOneID(other.ID).
 				SetChapters(chapters).Save(ctx); err != nil {
 				log.Warn().Err(err).Str("provider", other.Provider).Msg("failed to update chapters after cleanup")
 			}
 		}
 	}
+
+	if len(removed) > 0 {
+		d.Audit.RecordSystem(ctx, "download", audit.Entry{
+			Action:     "chapter.cleanup_inferior",
+			TargetType: "series_provider",
+			TargetIDs:  providerIDs,
+			Details: map[string]interface{}{
+				"seriesId":      args.SeriesID.String(),
+				"chapterNumber": *args.ChapterNumber,
+				"keptProvider":  args.ProviderName,
+				"files":         removed,
+			},
+		})
+	}
 }
 
 // cleanupDuplicateChapters removes chapter files from inferior providers when a better
@@ -804,6 +934,18 @@ func (d *Deps) handleReplacementSuccess(ctx context.Context, args types.Download
 	// Clean up all inferior copies from less-important providers
 	d.cleanupInferiorCopies(ctx, args)
 
+	d.Audit.RecordSystem(ctx, "download", audit.Entry{
+		Action:     "chapter.replace",
+		TargetType: "series",
+		TargetIDs:  []string{args.SeriesID.String()},
+		Details: map[string]interface{}{
+			"title":               args.Title,
+			"provider":            args.ProviderName,
+			"replacingProviderId": args.ReplacingProviderID.String(),
+			"replacedFile":        args.ReplacingFilename,
+		},
+	})
+
 	log.Info().
 		Str("title", args.Title).
 		Str("replaced", args.ReplacingFilename).
@@ -1002,7 +1144,11 @@ func (w *GetChaptersWorker) Work(ctx context.Context, job *river.Job[GetChapters
 
 	// Fetch chapters from Suwayomi
 	chStart := time.Now()
-	onlineChapters, err := w.Deps.Suwayomi.GetChapters(ctx, sp.SuwayomiID, true)
+	srcCtx := w.Deps.RateLimits.WithSource(ctx, sp.Provider)
+	if err := w.Deps.RateLimits.WaitPage(srcCtx, sp.Provider); err != nil {
+		return err
+	}
+	onlineChapters, err := w.Deps.Suwayomi.GetChapters(srcCtx, sp.SuwayomiID, true)
 	chDuration := time.Since(chStart).Milliseconds()
 	chMeta := map[string]string{"origin": "background_job"}
 	if sp.URL != nil && *sp.URL != "" {
@@ -1446,8 +1592,13 @@ func (w *GetLatestWorker) Work(ctx context.Context, job *river.Job[GetLatestArgs
 	upToDate := false
 	itemsCount := 0
 
+	// Requests to the source share its rate limit with downloads.
+	srcCtx := w.Deps.RateLimits.WithSource(ctx, sourceName)
 	for page := 1; !upToDate || neverDone; page++ {
-		result, err := w.Deps.Suwayomi.GetLatestSeries(ctx, sourceID, page)
+		if err := w.Deps.RateLimits.WaitPage(srcCtx, sourceName); err != nil {
+			return err
+		}
+		result, err := w.Deps.Suwayomi.GetLatestSeries(srcCtx, sourceID, page)
 		if err != nil {
 			log.Warn().Err(err).Int("page", page).Msg("failed to fetch latest page")
 			util.LogSourceEvent(w.Deps.DB, sourceID, sourceName, sourceLang,
@@ -1466,7 +1617,10 @@ func (w *GetLatestWorker) Work(ctx context.Context, job *river.Job[GetLatestArgs
 
 			var fullData *suwayomi.SuwayomiSeries
 			if needFullFetch {
-				fullData, err = w.Deps.Suwayomi.GetFullSeriesData(ctx, manga.ID, true)
+				if err := w.Deps.RateLimits.WaitPage(srcCtx, sourceName); err != nil {
+					return err
+				}
+				fullData, err = w.Deps.Suwayomi.GetFullSeriesData(srcCtx, manga.ID, true)
 				if err != nil {
 					log.Warn().Err(err).Int("mangaId", manga.ID).Msg("failed to fetch full series data")
 					continue
@@ -1474,7 +1628,10 @@ func (w *GetLatestWorker) Work(ctx context.Context, job *river.Job[GetLatestArgs
 			}
 
 			// Fetch chapters
-			chapters, err := w.Deps.Suwayomi.GetChapters(ctx, manga.ID, true)
+			if err := w.Deps.RateLimits.WaitPage(srcCtx, sourceName); err != nil {
+				return err
+			}
+			chapters, err := w.Deps.Suwayomi.GetChapters(srcCtx, manga.ID, true)
 			if err != nil {
 				log.Warn().Err(err).Int("mangaId", manga.ID).Msg("failed to fetch chapters")
 				continue
@@ -1642,24 +1799,38 @@ func (w *UpdateExtensionsWorker) Work(ctx context.Context, job *river.Job[Update
 	}
 
 	// Step 2: Auto-update extensions that have updates
-	updated := false
+	// (previous version name by package, for the extension.updated event)
+	updated := make(map[string]string)
 	for _, ext := range extensions {
 		if ext.HasUpdate {
 			log.Info().Str("pkg", ext.PkgName).Msg("updating extension")
 			if err := w.Deps.Suwayomi.UpdateExtension(ctx, ext.PkgName); err != nil {
 				log.Warn().Err(err).Str("pkg", ext.PkgName).Msg("failed to update extension")
 			} else {
-				updated = true
+				updated[ext.PkgName] = ext.VersionName
 			}
 		}
 	}
 
 	// Re-fetch if any were updated
-	if updated {
+	if len(updated) > 0 {
 		extensions, err = w.Deps.Suwayomi.GetExtensions(ctx)
 		if err != nil {
 			return fmt.Errorf("re-fetch extensions: %w", err)
 		}
+		for _, ext := range extensions {
+			previous, ok := updated[ext.PkgName]
+			if !ok {
+				continue
+			}
+			w.Deps.Events.Publish(ctx, events.ExtensionUpdated, map[string]interface{}{
+				"pkgName":         ext.PkgName,
+				"name":            ext.Name,
+				"language":        ext.Lang,
+				"version":         ext.VersionName,
+				"previousVersion": previous,
+			})
+		}
 	}
 
 	// Step 3: Fetch all sources
@@ -1699,10 +1870,31 @@ func (w *UpdateExtensionsWorker) processExtension(
 	}
 
 	if provider != nil {
-		// Sync disabled state
-		if provider.IsDisabled != !ext.Installed {
+		// Uninstalling through Kaizoku disables the provider first, so an
+		// enabled provider whose extension is gone was removed upstream.
+		removed := ""
+		switch {
+		case !provider.IsDisabled && !ext.Installed:
+			removed = "uninstalled"
+		case ext.Installed && ext.Obsolete && !provider.IsObsolete:
+			removed = "obsolete"
+		}
+		if removed != "" {
+			log.Warn().Str("pkg", ext.PkgName).Str("reason", removed).Msg("extension removed upstream")
+			w.Deps.Events.Publish(ctx, events.ExtensionRemoved, map[string]interface{}{
+				"pkgName":  ext.PkgName,
+				"name":     ext.Name,
+				"language": ext.Lang,
+				"version":  ext.VersionName,
+				"reason":   removed,
+			})
+		}
+
+		// Sync disabled and obsolete state
+		if provider.IsDisabled != !ext.Installed || provider.IsObsolete != ext.Obsolete {
 			_, err := w.Deps.DB.ProviderStorage.UpdateOneID(provider.ID).
 				SetIsDisabled(!ext.Installed).
+				SetIsObsolete(ext.Obsolete).
 				Save(ctx)
 			if err != nil {
 				log.Warn().Err(err).Str("name", ext.Name).Msg("failed to sync provider disabled state")
@@ -2104,6 +2296,13 @@ func (w *DailyUpdateWorker) Work(ctx context.Context, job *river.Job[DailyUpdate
 		log.Info().Int("count", seDeleted).Msg("cleaned up old source events")
 	}
 
+	// Clean up hook runs older than 30 days
+	if n, err := w.Deps.Hooks.Prune(ctx, cutoff); err != nil {
+		log.Warn().Err(err).Msg("failed to clean up old hook runs")
+	} else if n > 0 {
+		log.Info().Int("count", n).Msg("cleaned up old hook runs")
+	}
+
 	// Backup Suwayomi H2 database (stop → backup → restart)
 	configDir := config.ConfigDir()
 	if w.Deps.SuwayomiProcess != nil && w.Deps.SuwayomiProcess.IsRunning() {
@@ -2857,6 +3056,9 @@ func (w *ImportSeriesWorker) createSeriesFromImport(
 		if err != nil {
 			return uuid.Nil, fmt.Errorf("create series: %w", err)
 		}
+		data := SeriesEventData(dbSeries)
+		data["origin"] = "import"
+		w.Deps.Events.Publish(ctx, events.SeriesAdded, data)
 	}
 
 	// Build a map of existing providers to avoid creating duplicates
@@ -4150,6 +4352,7 @@ func (w *UpgradeAllSourcesWorker) upgradeSeriesSources(ctx context.Context, s *e
 
 	// Find chapters downloaded by non-best providers
 	queued := 0
+	var replaced []string
 	baseTime := time.Now().UTC()
 	modifiedProviders := make(map[uuid.UUID]bool)
 	for _, p := range providers {
@@ -4208,9 +4411,23 @@ func (w *UpgradeAllSourcesWorker) upgradeSeriesSources(ctx context.Context, s *e
 				continue
 			}
 			queued++
+			replaced = append(replaced, ch.Filename)
 		}
 	}
 
+	if queued > 0 {
+		w.Deps.Audit.RecordSystem(ctx, "upgrade_all_sources", audit.Entry{
+			Action:     "sources.upgrade",
+			TargetType: "series",
+			TargetIDs:  []string{s.ID.String()},
+			Details: map[string]interface{}{
+				"title":        s.Title,
+				"queued":       queued,
+				"replacements": replaced,
+			},
+		})
+	}
+
 	// Save providers where we reset permanently failed flags
 	for _, p := range providers {
 		if modifiedProviders[p.ID] {
@@ -4252,3 +4469,343 @@ func buildChapterRanges(chapters []types.Chapter) []types.StartStop {
 	ranges = append(ranges, types.StartStop{Start: start, Stop: end})
 	return ranges
 }
+
+// ============================================================
+// WebhookDeliveryWorker — sends webhook deliveries with backoff
+// ============================================================
+
+type WebhookDeliveryWorker struct {
+	river.WorkerDefaults[WebhookDeliveryArgs]
+	Deps *Deps
+}
+
+func (w *WebhookDeliveryWorker) Timeout(job *river.Job[WebhookDeliveryArgs]) time.Duration {
+	return time.Minute
+}
+
+// NextRetry backs off exponentially from 30 seconds, capped at 6 hours, so
+// a delivery keeps being retried for about a day.
+func (w *WebhookDeliveryWorker) NextRetry(job *river.Job[WebhookDeliveryArgs]) time.Time {
+	return time.Now().Add(webhookBackoff(job.Attempt))
+}
+
+// webhookBackoff returns the delay after the given failed attempt (1-based).
+func webhookBackoff(attempt int) time.Duration {
+	return min(30*time.Second<<min(max(attempt-1, 0), 10), 6*time.Hour)
+}
+
+func (w *WebhookDeliveryWorker) Work(ctx context.Context, job *river.Job[WebhookDeliveryArgs]) error {
+	final := job.Attempt >= job.MaxAttempts
+	return w.Deps.Webhooks.Deliver(ctx, job.Args.DeliveryID, final, time.Now().Add(webhookBackoff(job.Attempt)))
+}
+
+// enqueueWebhooks stores deliveries of an event for every subscribed
+// webhook and queues them for sending. It is subscribed to the event bus.
+func (d *Deps) enqueueWebhooks(ctx context.Context, e events.Event) {
+	ids, err := d.Webhooks.CreateDeliveries(ctx, e)
+	if err != nil {
+		log.Warn().Err(err).Str("event", string(e.Type)).Msg("failed to create webhook deliveries")
+	}
+	for _, id := range ids {
+		if _, err := d.RiverClient.Insert(ctx, WebhookDeliveryArgs{DeliveryID: id}, nil); err != nil {
+			log.Warn().Err(err).Str("deliveryId", id.String()).Msg("failed to enqueue webhook delivery")
+		}
+	}
+}
+
+// ============================================================
+// ScriptHookWorker — runs script hooks in the background
+// ============================================================
+
+type ScriptHookWorker struct {
+	river.WorkerDefaults[ScriptHookArgs]
+	Deps *Deps
+}
+
+// Timeout leaves the runner's own MaxRunTime limit to stop the hook, so the
+// run log says why it stopped.
+func (w *ScriptHookWorker) Timeout(job *river.Job[ScriptHookArgs]) time.Duration {
+	return hooks.MaxRunTime + time.Minute
+}
+
+func (w *ScriptHookWorker) Work(ctx context.Context, job *river.Job[ScriptHookArgs]) error {
+	if err := w.Deps.Hooks.RunHook(ctx, job.Args.Hook, job.Args.Context); err != nil {
+		log.Warn().Err(err).Str("hook", job.Args.Hook).Str("title", job.Args.Context.Title).Msg("hooks: hook failed")
+	}
+	return nil
+}
+
+// enqueueHooks queues the background hooks for hc.Event.
+func (d *Deps) enqueueHooks(ctx context.Context, hc hooks.Context) {
+	for _, name := range d.Hooks.Queued(hc.Event) {
+		if _, err := d.RiverClient.Insert(ctx, ScriptHookArgs{Hook: name, Context: hc}, nil); err != nil {
+			log.Warn().Err(err).Str("hook", name).Msg("failed to enqueue script hook")
+		}
+	}
+}
+
+// enqueueSeriesHooks queues the hooks of series events. It is subscribed to
+// the event bus.
+func (d *Deps) enqueueSeriesHooks(ctx context.Context, e events.Event) {
+	if hc, ok := d.Hooks.SeriesContext(e); ok {
+		d.enqueueHooks(ctx, hc)
+	}
+}
+
+// ============================================================
+// SourceHealthWorker — publishes source error rate crossings
+// ============================================================
+
+// sourceHealthInterval is how often source error rates are checked.
+const sourceHealthInterval = 15 * time.Minute
+
+type SourceHealthWorker struct {
+	river.WorkerDefaults[SourceHealthArgs]
+	Deps *Deps
+}
+
+func (w *SourceHealthWorker) Timeout(job *river.Job[SourceHealthArgs]) time.Duration {
+	return 2 * time.Minute
+}
+
+// Work compares each source's error rate over the current window with the
+// window one check earlier and publishes a source.error_rate event when
+// the rate crossed the configured threshold in either direction. Comparing
+// two windows keeps the check stateless across restarts.
+func (w *SourceHealthWorker) Work(ctx context.Context, job *river.Job[SourceHealthArgs]) error {
+	cfg := w.Deps.Config.Events
+	if cfg.SourceErrorRate <= 0 {
+		return nil
+	}
+	window, err := time.ParseDuration(cfg.SourceErrorWindow)
+	if err != nil || window <= 0 {
+		window = time.Hour
+	}
+
+	now := time.Now()
+	curStart := now.Add(-window)
+	prevEnd := now.Add(-sourceHealthInterval)
+	prevStart := prevEnd.Add(-window)
+
+	evts, err := w.Deps.DB.SourceEvent.Query().
+		Where(sourceevent.CreatedAtGTE(prevStart)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query source events: %w", err)
+	}
+
+	type windowCounts struct {
+		name, language               string
+		cur, curFail, prev, prevFail int
+	}
+	bySource := make(map[string]*windowCounts)
+	for _, e := range evts {
+		c, ok := bySource[e.SourceID]
+		if !ok {
+			c = &windowCounts{name: e.SourceName, language: e.Language}
+			bySource[e.SourceID] = c
+		}
+		failed := e.Status == "failed"
+		if !e.CreatedAt.Before(curStart) {
+			c.cur++
+			if failed {
+				c.curFail++
+			}
+		}
+		if e.CreatedAt.Before(prevEnd) {
+			c.prev++
+			if failed {
+				c.prevFail++
+			}
+		}
+	}
+
+	minEvents := max(cfg.SourceErrorMinEvents, 1)
+	for id, c := range bySource {
+		if c.cur < minEvents {
+			continue
+		}
+		rate := float64(c.curFail) / float64(c.cur)
+		above := rate >= cfg.SourceErrorRate
+		wasAbove := c.prev >= minEvents && float64(c.prevFail)/float64(c.prev) >= cfg.SourceErrorRate
+		if above == wasAbove {
+			continue
+		}
+
+		state := "above"
+		if !above {
+			state = "recovered"
+		}
+		log.Info().Str("source", c.name).Float64("errorRate", rate).Str("state", state).Msg("source error rate crossed threshold")
+		w.Deps.Events.Publish(ctx, events.SourceErrorRate, map[string]interface{}{
+			"sourceId":   id,
+			"sourceName": c.name,
+			"language":   c.language,
+			"state":      state,
+			"errorRate":  rate,
+			"threshold":  cfg.SourceErrorRate,
+			"operations": c.cur,
+			"failures":   c.curFail,
+			"window":     window.String(),
+		})
+	}
+	return nil
+}
+
+// ============================================================
+// NotificationWorker — sends push notifications
+// ============================================================
+
+type NotificationWorker struct {
+	river.WorkerDefaults[NotificationArgs]
+	Deps *Deps
+}
+
+func (w *NotificationWorker) Timeout(job *river.Job[NotificationArgs]) time.Duration {
+	return time.Minute
+}
+
+func (w *NotificationWorker) Work(ctx context.Context, job *river.Job[NotificationArgs]) error {
+	final := job.Attempt >= job.MaxAttempts
+	return w.Deps.Notify.Send(ctx, job.Args.NotificationID, final)
+}
+
+// enqueueNotifications stores notifications of an event for every
+// subscribed notifier and queues those outside quiet hours for sending.
+// It is subscribed to the event bus.
+func (d *Deps) enqueueNotifications(ctx context.Context, e events.Event) {
+	ids, err := d.Notify.CreateNotifications(ctx, e)
+	if err != nil {
+		log.Warn().Err(err).Str("event", string(e.Type)).Msg("failed to create notifications")
+	}
+	for _, id := range ids {
+		if _, err := d.RiverClient.Insert(ctx, NotificationArgs{NotificationID: id}, nil); err != nil {
+			log.Warn().Err(err).Str("notificationId", id.String()).Msg("failed to enqueue notification")
+		}
+	}
+}
+
+// ============================================================
+// NotificationDigestWorker — sends quiet-hours digests
+// ============================================================
+
+const (
+	// notificationDigestInterval is how often held notifications are checked;
+	// a digest goes out at most this long after quiet hours end.
+	notificationDigestInterval = 5 * time.Minute
+	// notificationRetention is how long sent and failed notifications are kept.
+	notificationRetention = 30 * 24 * time.Hour
+)
+
+type NotificationDigestWorker struct {
+	river.WorkerDefaults[NotificationDigestArgs]
+	Deps *Deps
+}
+
+func (w *NotificationDigestWorker) Timeout(job *river.Job[NotificationDigestArgs]) time.Duration {
+	return 2 * time.Minute
+}
+
+func (w *NotificationDigestWorker) Work(ctx context.Context, job *river.Job[NotificationDigestArgs]) error {
+	if n, err := w.Deps.Notify.Prune(ctx, time.Now().Add(-notificationRetention)); err != nil {
+		log.Warn().Err(err).Msg("failed to prune notifications")
+	} else if n > 0 {
+		log.Debug().Int("count", n).Msg("pruned old notifications")
+	}
+
+	sent, err := w.Deps.Notify.SendDigests(ctx)
+	if sent > 0 {
+		log.Info().Int("digests", sent).Msg("sent notification digests")
+	}
+	if err != nil {
+		// Held notifications stay held and are retried on the next run.
+		log.Warn().Err(err).Msg("failed to send some notification digests")
+	}
+	return nil
+}
+
+// ============================================================
+// EmailDigestWorker — emails the digest of new chapters
+// ============================================================
+
+// emailDigestSettingKey stores when the last digest window ended.
+const emailDigestSettingKey = "EmailDigestLastSent"
+
+type EmailDigestWorker struct {
+	river.WorkerDefaults[EmailDigestArgs]
+	Deps *Deps
+}
+
+func (w *EmailDigestWorker) Timeout(job *river.Job[EmailDigestArgs]) time.Duration {
+	return 5 * time.Minute
+}
+
+func (w *EmailDigestWorker) Work(ctx context.Context, job *river.Job[EmailDigestArgs]) error {
+	cfg := w.Deps.Config.Email
+	if !cfg.Enabled && !job.Args.Test {
+		return nil
+	}
+	schedule, err := digest.ParseSchedule(cfg)
+	if err != nil {
+		return err
+	}
+
+	until := time.Now()
+	since := until.Add(-schedule.Period())
+	if !job.Args.Test {
+		if rec, err := w.Deps.DB.Setting.Get(ctx, emailDigestSettingKey); err == nil {
+			if t, err := time.Parse(time.RFC3339, rec.Value); err == nil && t.Before(until) {
+				since = t
+			}
+		}
+	}
+
+	dg, err := digest.Compile(ctx, w.Deps.DB, since, until, w.Deps.Config.Events)
+	if err != nil {
+		return fmt.Errorf("compile email digest: %w", err)
+	}
+
+	if dg.Empty() && !job.Args.Test {
+		log.Info().Msg("nothing new for the email digest, skipping")
+	} else {
+		images := digest.AttachCovers(ctx, dg, w.fetchCover)
+		msg, err := digest.Render(dg, images)
+		if err != nil {
+			return err
+		}
+		if err := mail.Send(ctx, cfg, msg); err != nil {
+			return fmt.Errorf("send email digest: %w", err)
+		}
+		log.Info().
+			Int("series", len(dg.Series)).
+			Int("chapters", dg.ChapterCount()).
+			Int("failed", len(dg.Failed)).
+			Int("sources", len(dg.Sources)).
+			Bool("test", job.Args.Test).
+			Msg("sent email digest")
+	}
+
+	if job.Args.Test {
+		return nil
+	}
+	return w.Deps.DB.Setting.Create().
+		SetID(emailDigestSettingKey).
+		SetValue(until.UTC().Format(time.RFC3339)).
+		OnConflictColumns("id").UpdateNewValues().
+		Exec(ctx)
+}
+
+// fetchCover loads a series cover through Suwayomi. Only proxied
+// thumbnails (serie/thumb/<mangaId>) are supported.
+func (w *EmailDigestWorker) fetchCover(ctx context.Context, thumbnailURL string) ([]byte, string, error) {
+	_, id, ok := strings.Cut(thumbnailURL, "serie/thumb/")
+	if !ok {
+		return nil, "", fmt.Errorf("unsupported thumbnail url %q", thumbnailURL)
+	}
+	id, _, _ = strings.Cut(id, "!")
+	mangaID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, "", fmt.Errorf("unsupported thumbnail url %q", thumbnailURL)
+	}
+	return w.Deps.Suwayomi.GetMangaThumbnail(ctx, mangaID)
+}
@@ -0,0 +1,152 @@
+// Package metrics holds Kaizoku's Prometheus instrumentation: labelled
+// counters, gauges and histograms on top of the Prometheus client library,
+// rendered in the text exposition format. Values that are cheap to read but
+// expensive to track, such as database counts, are filled in by collectors
+// that run on each scrape.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+)
+
+// ContentType is the media type of the text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Bucket layouts for histograms.
+var (
+	// LatencyBuckets suit request latencies, in seconds.
+	LatencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+)
+
+// Default is the registry served on /metrics.
+var Default = NewRegistry()
+
+// Collector refreshes gauges right before a scrape.
+type Collector func(ctx context.Context)
+
+// Registry holds metrics and collectors.
+type Registry struct {
+	reg *prometheus.Registry
+
+	mu         sync.Mutex
+	collectors []Collector
+	// scrapeMu keeps concurrent scrapes from interleaving their collectors.
+	scrapeMu sync.Mutex
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{reg: prometheus.NewRegistry()}
+}
+
+// register adds c to the registry. Registering an identical metric twice
+// returns the first one instead of failing.
+func register[T prometheus.Collector](r *Registry, c T) T {
+	if err := r.reg.Register(c); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(T); ok {
+				return existing
+			}
+		}
+		panic("metrics: " + err.Error())
+	}
+	return c
+}
+
+// AddCollector registers a collector that runs before every scrape.
+func (r *Registry) AddCollector(c Collector) {
+	r.mu.Lock()
+	r.collectors = append(r.collectors, c)
+	r.mu.Unlock()
+}
+
+// Write runs the collectors and renders every metric.
+func (r *Registry) Write(ctx context.Context, w io.Writer) error {
+	r.mu.Lock()
+	collectors := append([]Collector(nil), r.collectors...)
+	r.mu.Unlock()
+
+	r.scrapeMu.Lock()
+	defer r.scrapeMu.Unlock()
+	for _, c := range collectors {
+		c(ctx)
+	}
+	families, err := r.reg.Gather()
+	if err != nil {
+		return err
+	}
+	enc := expfmt.NewEncoder(w, expfmt.NewFormat(expfmt.TypeTextPlain))
+	for _, mf := range families {
+		if err := enc.Encode(mf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CounterVec is a counter with labels.
+type CounterVec struct{ v *prometheus.CounterVec }
+
+// NewCounterVec registers a counter.
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	v := prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels)
+	return &CounterVec{v: register(r, v)}
+}
+
+// Inc adds one to the series with the given label values.
+func (c *CounterVec) Inc(values ...string) {
+	c.v.WithLabelValues(values...).Inc()
+}
+
+// Add adds delta, which must not be negative, to the series with the given
+// label values.
+func (c *CounterVec) Add(delta float64, values ...string) {
+	c.v.WithLabelValues(values...).Add(delta)
+}
+
+// GaugeVec is a gauge with labels.
+type GaugeVec struct{ v *prometheus.GaugeVec }
+
+// NewGaugeVec registers a gauge.
+func (r *Registry) NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	v := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, labels)
+	return &GaugeVec{v: register(r, v)}
+}
+
+// Set sets the series with the given label values.
+func (g *GaugeVec) Set(value float64, values ...string) {
+	g.v.WithLabelValues(values...).Set(value)
+}
+
+// Add adds delta to the series with the given label values.
+func (g *GaugeVec) Add(delta float64, values ...string) {
+	g.v.WithLabelValues(values...).Add(delta)
+}
+
+// Reset removes every series, so that label combinations that no longer
+// exist disappear. Collectors call it before setting fresh values.
+func (g *GaugeVec) Reset() {
+	g.v.Reset()
+}
+
+// HistogramVec is a histogram with labels.
+type HistogramVec struct{ v *prometheus.HistogramVec }
+
+// NewHistogramVec registers a histogram with the given upper bucket bounds,
+// in increasing order.
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	v := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, labels)
+	return &HistogramVec{v: register(r, v)}
+}
+
+// Observe records a value in the series with the given label values.
+func (h *HistogramVec) Observe(value float64, values ...string) {
+	h.v.WithLabelValues(values...).Observe(value)
+}
@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+)
+
+// scrape renders r and checks that the output parses as the text format.
+func scrape(t *testing.T, r *Registry) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := r.Write(context.Background(), &buf); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	parser := expfmt.NewTextParser(model.LegacyValidation)
+	if _, err := parser.TextToMetricFamilies(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("output is not valid text exposition: %v\n%s", err, buf.String())
+	}
+	return buf.String()
+}
+
+func assertLines(t *testing.T, out string, want ...string) {
+	t.Helper()
+	lines := make(map[string]bool)
+	for _, l := range strings.Split(out, "\n") {
+		lines[l] = true
+	}
+	for _, w := range want {
+		if !lines[w] {
+			t.Errorf("missing line %q in:\n%s", w, out)
+		}
+	}
+}
+
+func TestCounterLabelEscaping(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("test_requests_total", "Requests with \"quotes\", a \\ and a\nnewline.", "source")
+	c.Inc(`Manga "Plus"`)
+	c.Add(2.5, `C:\manga`)
+	c.Inc("line\nbreak")
+
+	assertLines(t, scrape(t, r),
+		`# HELP test_requests_total Requests with "quotes", a \\ and a\nnewline.`,
+		`# TYPE test_requests_total counter`,
+		`test_requests_total{source="Manga \"Plus\""} 1`,
+		`test_requests_total{source="C:\\manga"} 2.5`,
+		`test_requests_total{source="line\nbreak"} 1`,
+	)
+}
+
+func TestHistogram(t *testing.T) {
+	r := NewRegistry()
+	h := r.NewHistogramVec("test_duration_seconds", "Durations.", []float64{0.1, 0.5, 1}, "provider")
+	h.Observe(0.05, "a")
+	h.Observe(0.3, "a")
+	h.Observe(0.5, "a")
+	h.Observe(7, "a")
+
+	assertLines(t, scrape(t, r),
+		`# TYPE test_duration_seconds histogram`,
+		`test_duration_seconds_bucket{provider="a",le="0.1"} 1`,
+		`test_duration_seconds_bucket{provider="a",le="0.5"} 3`,
+		`test_duration_seconds_bucket{provider="a",le="1"} 3`,
+		`test_duration_seconds_bucket{provider="a",le="+Inf"} 4`,
+		`test_duration_seconds_sum{provider="a"} 7.85`,
+		`test_duration_seconds_count{provider="a"} 4`,
+	)
+}
+
+func TestGaugeResetAndCollectors(t *testing.T) {
+	r := NewRegistry()
+	g := r.NewGaugeVec("test_queue_items", "Queue items.", "status")
+	r.NewGaugeVec("test_unused", "Never set.")
+
+	runs := 0
+	r.AddCollector(func(context.Context) {
+		runs++
+		g.Reset()
+		if runs == 1 {
+			g.Set(3, "waiting")
+		}
+		g.Set(1, "running")
+	})
+
+	out := scrape(t, r)
+	assertLines(t, out, `test_queue_items{status="waiting"} 3`, `test_queue_items{status="running"} 1`)
+	if strings.Contains(out, "test_unused") {
+		t.Errorf("metric without series rendered:\n%s", out)
+	}
+
+	out = scrape(t, r)
+	if strings.Contains(out, `status="waiting"`) {
+		t.Errorf("series removed by Reset still rendered:\n%s", out)
+	}
+	if runs != 2 {
+		t.Errorf("collector ran %d times, want once per scrape", runs)
+	}
+}
+
+func TestDuplicateRegistration(t *testing.T) {
+	r := NewRegistry()
+	a := r.NewCounterVec("test_total", "Help.", "kind")
+	b := r.NewCounterVec("test_total", "Help.", "kind")
+	a.Inc("x")
+	b.Inc("x")
+	assertLines(t, scrape(t, r), `test_total{kind="x"} 2`)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("registering a conflicting metric did not panic")
+		}
+	}()
+	r.NewGaugeVec("test_total", "Help.", "other")
+}
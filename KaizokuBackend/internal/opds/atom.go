@@ -0,0 +1,152 @@
+package opds
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// XML namespaces used in OPDS 1.2 documents.
+const (
+	nsAtom       = "http://www.w3.org/2005/Atom"
+	nsOPDS       = "http://opds-spec.org/2010/catalog"
+	nsPSE        = "http://vaemendis.net/opds-pse/ns"
+	nsOpenSearch = "http://a9.com/-/spec/opensearch/1.1/"
+	nsDCTerms    = "http://purl.org/dc/terms/"
+)
+
+type atomFeed struct {
+	XMLName      xml.Name    `xml:"feed"`
+	Xmlns        string      `xml:"xmlns,attr"`
+	XmlnsOPDS    string      `xml:"xmlns:opds,attr"`
+	XmlnsPSE     string      `xml:"xmlns:pse,attr"`
+	XmlnsOS      string      `xml:"xmlns:opensearch,attr"`
+	XmlnsDC      string      `xml:"xmlns:dcterms,attr"`
+	ID           string      `xml:"id"`
+	Title        string      `xml:"title"`
+	Updated      string      `xml:"updated"`
+	Author       atomAuthor  `xml:"author"`
+	TotalResults int         `xml:"opensearch:totalResults,omitempty"`
+	ItemsPerPage int         `xml:"opensearch:itemsPerPage,omitempty"`
+	StartIndex   int         `xml:"opensearch:startIndex,omitempty"`
+	Links        []atomLink  `xml:"link"`
+	Entries      []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel   string `xml:"rel,attr,omitempty"`
+	Href  string `xml:"href,attr"`
+	Type  string `xml:"type,attr,omitempty"`
+	Title string `xml:"title,attr,omitempty"`
+	Count int    `xml:"pse:count,attr,omitempty"`
+}
+
+type atomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Updated    string         `xml:"updated"`
+	Authors    []atomAuthor   `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+	Summary    *atomText      `xml:"summary"`
+	Links      []atomLink     `xml:"link"`
+}
+
+func atomLinks(links []Link) []atomLink {
+	out := make([]atomLink, 0, len(links))
+	for _, l := range links {
+		out = append(out, atomLink{Rel: l.Rel, Href: l.Href, Type: l.Type, Title: l.Title, Count: l.PageCount})
+	}
+	return out
+}
+
+// MarshalAtom renders the feed as an OPDS 1.2 Atom document.
+func (f *Feed) MarshalAtom() ([]byte, error) {
+	doc := atomFeed{
+		Xmlns:     nsAtom,
+		XmlnsOPDS: nsOPDS,
+		XmlnsPSE:  nsPSE,
+		XmlnsOS:   nsOpenSearch,
+		XmlnsDC:   nsDCTerms,
+		ID:        f.ID,
+		Title:     f.Title,
+		Updated:   f.Updated.UTC().Format(time.RFC3339),
+		Author:    atomAuthor{Name: "Kaizoku"},
+		Links:     atomLinks(f.Links),
+	}
+	if f.PerPage > 0 {
+		doc.TotalResults = f.Total
+		doc.ItemsPerPage = f.PerPage
+		doc.StartIndex = (f.Page-1)*f.PerPage + 1
+	}
+
+	for _, e := range f.Entries {
+		ae := atomEntry{
+			ID:      e.ID,
+			Title:   e.Title,
+			Updated: e.Updated.UTC().Format(time.RFC3339),
+			Links:   atomLinks(e.Links),
+		}
+		for _, a := range e.Authors {
+			ae.Authors = append(ae.Authors, atomAuthor{Name: a})
+		}
+		for _, c := range e.Categories {
+			ae.Categories = append(ae.Categories, atomCategory{Term: c, Label: c})
+		}
+		if e.Summary != "" {
+			ae.Summary = &atomText{Type: "text", Text: e.Summary}
+		}
+		doc.Entries = append(doc.Entries, ae)
+	}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+type openSearchDescription struct {
+	XMLName        xml.Name      `xml:"OpenSearchDescription"`
+	Xmlns          string        `xml:"xmlns,attr"`
+	ShortName      string        `xml:"ShortName"`
+	Description    string        `xml:"Description"`
+	InputEncoding  string        `xml:"InputEncoding"`
+	OutputEncoding string        `xml:"OutputEncoding"`
+	URL            openSearchURL `xml:"Url"`
+}
+
+type openSearchURL struct {
+	Type     string `xml:"type,attr"`
+	Template string `xml:"template,attr"`
+}
+
+// MarshalOpenSearch renders an OpenSearch description whose results are
+// served at template (which must contain {searchTerms}).
+func MarshalOpenSearch(template string) ([]byte, error) {
+	doc := openSearchDescription{
+		Xmlns:          nsOpenSearch,
+		ShortName:      "Kaizoku",
+		Description:    "Search the Kaizoku library",
+		InputEncoding:  "UTF-8",
+		OutputEncoding: "UTF-8",
+		URL:            openSearchURL{Type: TypeNavigation, Template: template},
+	}
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
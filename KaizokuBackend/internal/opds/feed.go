@@ -0,0 +1,93 @@
+// Package opds builds OPDS catalog feeds. A Feed is assembled once and can
+// be rendered either as an OPDS 1.2 Atom document or as OPDS 2.0 JSON.
+package opds
+
+import "time"
+
+// Media types used by OPDS catalogs.
+const (
+	TypeNavigation  = "application/atom+xml;profile=opds-catalog;kind=navigation"
+	TypeAcquisition = "application/atom+xml;profile=opds-catalog;kind=acquisition"
+	TypeOPDS2       = "application/opds+json"
+	TypeOpenSearch  = "application/opensearchdescription+xml"
+	TypeCBZ         = "application/vnd.comicbook+zip"
+	TypeJPEG        = "image/jpeg"
+)
+
+// Link relations used by OPDS catalogs.
+const (
+	RelSelf        = "self"
+	RelStart       = "start"
+	RelUp          = "up"
+	RelNext        = "next"
+	RelPrevious    = "previous"
+	RelFirst       = "first"
+	RelLast        = "last"
+	RelSearch      = "search"
+	RelSubsection  = "subsection"
+	RelAcquisition = "http://opds-spec.org/acquisition"
+	RelImage       = "http://opds-spec.org/image"
+	RelThumbnail   = "http://opds-spec.org/image/thumbnail"
+	RelSortNew     = "http://opds-spec.org/sort/new"
+	// RelPSEStream is the OPDS Page Streaming Extension link, whose href is
+	// a template containing {pageNumber} (zero-based) and {maxWidth}.
+	RelPSEStream = "http://vaemendis.net/opds-pse/stream"
+)
+
+// Kind is the kind of an OPDS feed.
+type Kind int
+
+const (
+	// Navigation feeds link to other feeds.
+	Navigation Kind = iota
+	// Acquisition feeds list publications that can be downloaded.
+	Acquisition
+)
+
+// Link is a typed link from a feed or entry.
+type Link struct {
+	Rel   string
+	Href  string
+	Type  string
+	Title string
+	// Templated marks hrefs containing URI template variables.
+	Templated bool
+	// PageCount is the number of pages behind an OPDS-PSE stream link.
+	PageCount int
+}
+
+// Entry is a navigation entry or a publication.
+type Entry struct {
+	ID         string
+	Title      string
+	Summary    string
+	Updated    time.Time
+	Authors    []string
+	Categories []string
+	Links      []Link
+}
+
+// isPublication reports whether the entry carries an acquisition link.
+func (e *Entry) isPublication() bool {
+	for _, l := range e.Links {
+		if l.Rel == RelAcquisition {
+			return true
+		}
+	}
+	return false
+}
+
+// Feed is a single catalog page.
+type Feed struct {
+	ID      string
+	Title   string
+	Updated time.Time
+	Kind    Kind
+	Links   []Link
+	Entries []Entry
+
+	// Pagination metadata; zero when the feed is not paginated.
+	Total   int
+	PerPage int
+	Page    int
+}
@@ -0,0 +1,117 @@
+package opds
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type jsonFeed struct {
+	Metadata     jsonFeedMetadata  `json:"metadata"`
+	Links        []jsonLink        `json:"links"`
+	Navigation   []jsonLink        `json:"navigation,omitempty"`
+	Publications []jsonPublication `json:"publications,omitempty"`
+}
+
+type jsonFeedMetadata struct {
+	Title         string `json:"title"`
+	Modified      string `json:"modified,omitempty"`
+	NumberOfItems int    `json:"numberOfItems,omitempty"`
+	ItemsPerPage  int    `json:"itemsPerPage,omitempty"`
+	CurrentPage   int    `json:"currentPage,omitempty"`
+}
+
+type jsonLink struct {
+	Rel        string                 `json:"rel,omitempty"`
+	Href       string                 `json:"href"`
+	Type       string                 `json:"type,omitempty"`
+	Title      string                 `json:"title,omitempty"`
+	Templated  bool                   `json:"templated,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type jsonContributor struct {
+	Name string `json:"name"`
+}
+
+type jsonPublication struct {
+	Metadata jsonPublicationMetadata `json:"metadata"`
+	Links    []jsonLink              `json:"links"`
+	Images   []jsonLink              `json:"images,omitempty"`
+}
+
+type jsonPublicationMetadata struct {
+	Type        string            `json:"@type"`
+	Identifier  string            `json:"identifier"`
+	Title       string            `json:"title"`
+	Author      []jsonContributor `json:"author,omitempty"`
+	Subject     []jsonContributor `json:"subject,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Modified    string            `json:"modified,omitempty"`
+}
+
+func jsonLinkFrom(l Link) jsonLink {
+	jl := jsonLink{Rel: l.Rel, Href: l.Href, Type: l.Type, Title: l.Title, Templated: l.Templated}
+	if l.PageCount > 0 {
+		jl.Properties = map[string]interface{}{"numberOfItems": l.PageCount}
+	}
+	return jl
+}
+
+// MarshalOPDS2 renders the feed as an OPDS 2.0 JSON document. Entries with
+// an acquisition link become publications; all others become navigation links.
+func (f *Feed) MarshalOPDS2() ([]byte, error) {
+	doc := jsonFeed{
+		Metadata: jsonFeedMetadata{
+			Title:    f.Title,
+			Modified: f.Updated.UTC().Format(time.RFC3339),
+		},
+		Links: make([]jsonLink, 0, len(f.Links)),
+	}
+	if f.PerPage > 0 {
+		doc.Metadata.NumberOfItems = f.Total
+		doc.Metadata.ItemsPerPage = f.PerPage
+		doc.Metadata.CurrentPage = f.Page
+	}
+	for _, l := range f.Links {
+		doc.Links = append(doc.Links, jsonLinkFrom(l))
+	}
+
+	for _, e := range f.Entries {
+		if !e.isPublication() {
+			for _, l := range e.Links {
+				if l.Rel == RelSubsection || l.Rel == RelSortNew {
+					doc.Navigation = append(doc.Navigation, jsonLink{Href: l.Href, Type: l.Type, Title: e.Title})
+					break
+				}
+			}
+			continue
+		}
+
+		pub := jsonPublication{
+			Metadata: jsonPublicationMetadata{
+				Type:        "http://schema.org/Book",
+				Identifier:  e.ID,
+				Title:       e.Title,
+				Description: e.Summary,
+				Modified:    e.Updated.UTC().Format(time.RFC3339),
+			},
+		}
+		for _, a := range e.Authors {
+			pub.Metadata.Author = append(pub.Metadata.Author, jsonContributor{Name: a})
+		}
+		for _, c := range e.Categories {
+			pub.Metadata.Subject = append(pub.Metadata.Subject, jsonContributor{Name: c})
+		}
+		for _, l := range e.Links {
+			switch l.Rel {
+			case RelImage, RelThumbnail:
+				pub.Images = append(pub.Images, jsonLinkFrom(l))
+			default:
+				pub.Links = append(pub.Links, jsonLinkFrom(l))
+			}
+		}
+		doc.Publications = append(doc.Publications, pub)
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/job"
+	"github.com/technobecet/kaizoku-go/internal/service/suwayomi"
+	"github.com/technobecet/kaizoku-go/internal/util"
+)
+
+const (
+	// healthCheckTimeout bounds each readiness check.
+	healthCheckTimeout = 5 * time.Second
+	// dispatcherStallAfter is how long the download dispatch loop may go
+	// without an iteration before liveness fails. It runs at least every
+	// 30 seconds.
+	dispatcherStallAfter = 2 * time.Minute
+)
+
+// Health check statuses.
+const (
+	healthOK   = "ok"
+	healthFail = "fail"
+)
+
+// healthResult is the outcome of one health check.
+type healthResult struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latencyMs"`
+	// Check-specific details.
+	FreeBytes *uint64    `json:"freeBytes,omitempty"`
+	LastTick  *time.Time `json:"lastTick,omitempty"`
+}
+
+// healthReport is the body of /health/live and /health/ready. Status is
+// "fail" when any check failed.
+type healthReport struct {
+	Status string                  `json:"status"`
+	Checks map[string]healthResult `json:"checks"`
+}
+
+// healthChecker runs the liveness and readiness checks.
+type healthChecker struct {
+	config    *config.Config
+	db        *ent.Client
+	suwayomi  *suwayomi.Client
+	jobs      *job.Manager
+	swProcess job.SuwayomiProcessController
+}
+
+type healthCheck func(ctx context.Context, r *healthResult) error
+
+// registerHealth exposes the health endpoints. They are unauthenticated so
+// container orchestrators can probe them, and report only check outcomes.
+func registerHealth(e *echo.Echo, h *healthChecker) {
+	e.GET("/health", HealthCheck)
+	e.GET("/health/live", h.Live)
+	e.GET("/health/ready", h.Ready)
+}
+
+// Live reports whether the process is working: it fails only when the
+// download dispatch loop has stalled, which a restart fixes.
+// GET /health/live
+func (h *healthChecker) Live(c echo.Context) error {
+	return h.respond(c, map[string]healthCheck{
+		"dispatcher": h.checkDispatcherStall,
+	})
+}
+
+// Ready reports whether Kaizoku can serve requests and run jobs: the
+// database, job queue, Suwayomi and storage folder are all usable.
+// GET /health/ready
+func (h *healthChecker) Ready(c echo.Context) error {
+	checks := map[string]healthCheck{
+		"database":   h.checkDatabase,
+		"jobQueue":   h.checkJobQueue,
+		"suwayomi":   h.checkSuwayomi,
+		"storage":    h.checkStorage,
+		"dispatcher": h.checkDispatcher,
+	}
+	if h.swProcess != nil {
+		checks["suwayomiProcess"] = h.checkSuwayomiProcess
+	}
+	return h.respond(c, checks)
+}
+
+// respond runs the checks concurrently and writes the report, with status
+// 503 when any check failed.
+func (h *healthChecker) respond(c echo.Context, checks map[string]healthCheck) error {
+	report := healthReport{Status: healthOK, Checks: make(map[string]healthResult, len(checks))}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check healthCheck) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(c.Request().Context(), healthCheckTimeout)
+			defer cancel()
+
+			result := healthResult{Status: healthOK}
+			start := time.Now()
+			if err := check(ctx, &result); err != nil {
+				result.Status = healthFail
+				result.Error = err.Error()
+			}
+			result.LatencyMs = time.Since(start).Milliseconds()
+
+			mu.Lock()
+			report.Checks[name] = result
+			if result.Status != healthOK {
+				report.Status = healthFail
+			}
+			mu.Unlock()
+		}(name, check)
+	}
+	wg.Wait()
+
+	status := http.StatusOK
+	if report.Status != healthOK {
+		status = http.StatusServiceUnavailable
+	}
+	return c.JSON(status, report)
+}
+
+func (h *healthChecker) checkDatabase(ctx context.Context, _ *healthResult) error {
+	if _, err := h.db.Setting.Query().Exist(ctx); err != nil {
+		return fmt.Errorf("database unreachable: %w", err)
+	}
+	return nil
+}
+
+func (h *healthChecker) checkJobQueue(ctx context.Context, _ *healthResult) error {
+	return h.jobs.RiverHealth(ctx)
+}
+
+func (h *healthChecker) checkSuwayomi(ctx context.Context, _ *healthResult) error {
+	if _, err := h.suwayomi.GetServerSettings(suwayomi.WithNoRetry(ctx)); err != nil {
+		return fmt.Errorf("suwayomi not responding: %w", err)
+	}
+	return nil
+}
+
+func (h *healthChecker) checkSuwayomiProcess(_ context.Context, _ *healthResult) error {
+	if !h.swProcess.IsRunning() {
+		return errors.New("embedded suwayomi process is not running")
+	}
+	return nil
+}
+
+// checkStorage verifies that the storage folder is writable and, when
+// storage.min_free_mb is set, has enough free space.
+func (h *healthChecker) checkStorage(_ context.Context, r *healthResult) error {
+	folder := h.config.Storage.Folder
+	f, err := os.CreateTemp(folder, ".kaizoku-health-*")
+	if err != nil {
+		return fmt.Errorf("storage folder not writable: %w", err)
+	}
+	f.Close()
+	os.Remove(f.Name())
+
+	free, err := util.DiskFree(folder)
+	if err != nil {
+		return fmt.Errorf("read free space: %w", err)
+	}
+	r.FreeBytes = &free
+	if need := uint64(h.config.Storage.MinFreeMB) << 20; need > 0 && free < need {
+		return fmt.Errorf("only %d MB free, need %d MB", free>>20, h.config.Storage.MinFreeMB)
+	}
+	return nil
+}
+
+// checkDispatcher fails until the download dispatch loop has started, and
+// when it has stalled since.
+func (h *healthChecker) checkDispatcher(ctx context.Context, r *healthResult) error {
+	if h.jobs.Downloads.LastTick().IsZero() {
+		return errors.New("download dispatcher not started")
+	}
+	return h.checkDispatcherStall(ctx, r)
+}
+
+// checkDispatcherStall fails only when the download dispatch loop has
+// started and then stalled. Startup can take a while (migrations, Suwayomi),
+// and liveness must not restart a process that is still coming up.
+func (h *healthChecker) checkDispatcherStall(_ context.Context, r *healthResult) error {
+	last := h.jobs.Downloads.LastTick()
+	if last.IsZero() {
+		return nil
+	}
+	r.LastTick = &last
+	if since := time.Since(last); since > dispatcherStallAfter {
+		return fmt.Errorf("download dispatcher stalled for %s", since.Round(time.Second))
+	}
+	return nil
+}
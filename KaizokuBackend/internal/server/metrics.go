@@ -0,0 +1,65 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/metrics"
+)
+
+var httpRequestDuration = metrics.Default.NewHistogramVec(
+	"kaizoku_http_request_duration_seconds",
+	"Latency of HTTP requests by route.",
+	metrics.LatencyBuckets, "method", "route", "code")
+
+// httpMetrics records the latency of every request, labelled with the
+// route pattern (e.g. /api/serie/:id) rather than the raw path.
+func httpMetrics() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+			code := responseCode(c, err)
+			httpRequestDuration.Observe(time.Since(start).Seconds(), c.Request().Method, routeLabel(c), strconv.Itoa(code))
+			return err
+		}
+	}
+}
+
+// responseCode is the status the client receives, including errors that
+// Echo's error handler has not written yet.
+func responseCode(c echo.Context, err error) int {
+	if err == nil {
+		return c.Response().Status
+	}
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		return he.Code
+	}
+	return http.StatusInternalServerError
+}
+
+// routeLabel is the matched route pattern, or "unmatched".
+func routeLabel(c echo.Context) string {
+	if route := c.Path(); route != "" {
+		return route
+	}
+	return "unmatched"
+}
+
+// Metrics serves the Prometheus metrics. Scrapers authenticate like the
+// API, usually with an API key as a bearer token.
+// GET /metrics
+func Metrics(c echo.Context) error {
+	var buf bytes.Buffer
+	if err := metrics.Default.Write(c.Request().Context(), &buf); err != nil {
+		log.Error().Err(err).Msg("failed to render metrics")
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	return c.Blob(http.StatusOK, metrics.ContentType, buf.Bytes())
+}
@@ -1,20 +1,42 @@
 package server
 
 import (
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/handler"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
 )
 
-func setupMiddleware(e *echo.Echo) {
+func setupMiddleware(e *echo.Echo, cfg *config.Config) {
+	// Client IP for audit entries and login throttling
+	e.IPExtractor = ipExtractor(cfg.Server)
+
 	// Recovery
 	e.Use(middleware.Recover())
 
-	// CORS
+	// Request latency metrics
+	e.Use(httpMetrics())
+
+	// Request tracing (no-op unless tracing is enabled)
+	e.Use(traceRequests())
+
+	// CORS — only explicitly configured origins; the bundled frontend is same-origin.
+	// Credentials are never allowed with a "*" origin, which would let any
+	// site make requests with the user's session cookie.
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{"http://localhost:5001", "http://localhost:3000", "*"},
-		AllowMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders: []string{"Content-Type", "Authorization", "Accept"},
+		AllowOrigins:     cfg.Server.CORSOrigins,
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Content-Type", "Authorization", "Accept", "X-Api-Key"},
+		AllowCredentials: !slices.Contains(cfg.Server.CORSOrigins, "*"),
 	}))
 
 	// Gzip compression
@@ -24,21 +46,300 @@ func setupMiddleware(e *echo.Echo) {
 
 	// Request logging via zerolog
 	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
-		LogURI:    true,
-		LogStatus: true,
-		LogMethod: true,
-		LogError:  true,
+		LogURI:     true,
+		LogURIPath: true,
+		LogStatus:  true,
+		LogMethod:  true,
+		LogError:   true,
 		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
 			evt := log.Info()
 			if v.Error != nil {
 				evt = log.Error().Err(v.Error)
 			}
+			// Never log credentials passed in the query string (progress hub
+			// access_token, feed token).
+			uri := v.URI
+			if strings.Contains(uri, "token=") {
+				uri = v.URIPath
+			}
 			evt.
 				Str("method", v.Method).
-				Str("uri", v.URI).
+				Str("uri", uri).
 				Int("status", v.Status).
 				Msg("request")
 			return nil
 		},
 	}))
 }
+
+// proxyAuth holds the trusted reverse-proxy settings used for header-based SSO.
+type proxyAuth struct {
+	header      string
+	defaultRole string
+	trusted     []netip.Prefix
+}
+
+// ipExtractor reads the client IP from X-Forwarded-For only when the
+// request came from a proxy listed in TrustedProxies. Other clients,
+// including those on the local network, get their connection address, so
+// they cannot choose the IP that failed logins are throttled by.
+func ipExtractor(cfg config.ServerConfig) echo.IPExtractor {
+	opts := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, entry := range cfg.TrustedProxies {
+		entry = strings.TrimSpace(entry)
+		if _, ipNet, err := net.ParseCIDR(entry); err == nil {
+			opts = append(opts, echo.TrustIPRange(ipNet))
+		} else if ip := net.ParseIP(entry); ip != nil {
+			bits := 8 * len(ip)
+			opts = append(opts, echo.TrustIPRange(&net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}))
+		}
+	}
+	return echo.ExtractIPFromXFFHeader(opts...)
+}
+
+// newProxyAuth parses the trusted proxy list. It returns nil when proxy
+// authentication is not configured. Bare IPs are treated as single-host
+// prefixes; invalid entries are logged and skipped.
+func newProxyAuth(cfg config.ServerConfig) *proxyAuth {
+	if len(cfg.TrustedProxies) == 0 || cfg.ProxyAuthHeader == "" {
+		return nil
+	}
+
+	pa := &proxyAuth{header: cfg.ProxyAuthHeader, defaultRole: cfg.ProxyDefaultRole}
+	for _, entry := range cfg.TrustedProxies {
+		entry = strings.TrimSpace(entry)
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			pa.trusted = append(pa.trusted, prefix.Masked())
+			continue
+		}
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			pa.trusted = append(pa.trusted, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		log.Warn().Str("entry", entry).Msg("ignoring invalid trusted proxy entry")
+	}
+	if len(pa.trusted) == 0 {
+		return nil
+	}
+	if !auth.ValidRole(pa.defaultRole) {
+		log.Warn().Str("role", pa.defaultRole).Msg("invalid proxy default role, using viewer")
+		pa.defaultRole = auth.RoleViewer
+	}
+
+	log.Info().Str("header", pa.header).Int("trustedProxies", len(pa.trusted)).Msg("reverse-proxy authentication enabled")
+	return pa
+}
+
+// isTrusted reports whether the direct peer of r is a trusted proxy. It uses
+// the TCP remote address only; X-Forwarded-For is client-controlled and is
+// never consulted here.
+func (pa *proxyAuth) isTrusted(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range pa.trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// requireAuth rejects requests that are not authenticated. When a trusted
+// reverse proxy is configured, its user header is accepted as the identity
+// (and rejected from any other source). API keys are accepted from the
+// X-Api-Key header or an "Authorization: Bearer" header. HTTP Basic auth
+// accepts a username with either its password or an API key, for clients
+// such as OPDS readers that support nothing else; browsers authenticate with
+// the session cookie set by /api/auth/login.
+// When allowQuery is set, the access_token query parameter is also accepted;
+// this is only used for the progress hub because browsers cannot set headers
+// on WebSocket upgrades.
+func requireAuth(cfg *config.Config, svc *auth.Service, proxy *proxyAuth, allowQuery bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if !cfg.Auth.Enabled {
+			return next
+		}
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+
+			var (
+				p   *auth.Principal
+				err error
+			)
+			if proxy != nil && c.Request().Header.Get(proxy.header) != "" {
+				if !proxy.isTrusted(c.Request()) {
+					log.Warn().
+						Str("header", proxy.header).
+						Str("remote", c.Request().RemoteAddr).
+						Msg("rejected proxy auth header from untrusted source")
+					return c.JSON(http.StatusUnauthorized, map[string]string{"error": "untrusted proxy"})
+				}
+				p, err = svc.AuthenticateProxyUser(ctx, strings.TrimSpace(c.Request().Header.Get(proxy.header)), proxy.defaultRole)
+			} else if key := credentialFromRequest(c.Request(), allowQuery); key != "" {
+				p, err = svc.AuthenticateKey(ctx, key)
+			} else if username, password, ok := c.Request().BasicAuth(); ok {
+				if wait := svc.RetryAfter(c.RealIP(), username); wait > 0 {
+					return handler.TooManyAttempts(c, wait)
+				}
+				p, err = svc.AuthenticateBasic(ctx, username, password)
+				if err == nil || errors.Is(err, auth.ErrUnauthorized) {
+					svc.RecordAttempt(c.RealIP(), username, err == nil)
+				}
+			} else if cookie, cerr := c.Cookie(auth.SessionCookie); cerr == nil && cookie.Value != "" {
+				p, err = svc.AuthenticateSession(ctx, cookie.Value)
+			} else {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "authentication required"})
+			}
+
+			if err != nil {
+				if !errors.Is(err, auth.ErrUnauthorized) {
+					log.Error().Err(err).Msg("failed to authenticate request")
+					return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to authenticate"})
+				}
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid credentials"})
+			}
+
+			c.SetRequest(c.Request().WithContext(auth.WithPrincipal(ctx, p)))
+			return next(c)
+		}
+	}
+}
+
+// requireKOSyncAuth authenticates KOReader sync requests, which carry the
+// username and the MD5 digest of the password in the x-auth-user and
+// x-auth-key headers. Failures use the kosync error format.
+func requireKOSyncAuth(cfg *config.Config, svc *auth.Service) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if !cfg.Auth.Enabled {
+			return next
+		}
+		return func(c echo.Context) error {
+			username := c.Request().Header.Get("x-auth-user")
+			key := c.Request().Header.Get("x-auth-key")
+			if username == "" || key == "" {
+				return handler.KOSyncError(c, http.StatusUnauthorized, handler.KOSyncErrUnauthorized, "Unauthorized")
+			}
+
+			if wait := svc.RetryAfter(c.RealIP(), username); wait > 0 {
+				handler.SetRetryAfter(c, wait)
+				return handler.KOSyncError(c, http.StatusTooManyRequests, handler.KOSyncErrUnauthorized, "Too many failed attempts")
+			}
+
+			ctx := c.Request().Context()
+			p, err := svc.AuthenticateKOSync(ctx, username, key)
+			if err != nil {
+				if !errors.Is(err, auth.ErrUnauthorized) {
+					log.Error().Err(err).Msg("failed to authenticate kosync request")
+					return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to authenticate"})
+				}
+				svc.RecordAttempt(c.RealIP(), username, false)
+				return handler.KOSyncError(c, http.StatusUnauthorized, handler.KOSyncErrUnauthorized, "Unauthorized")
+			}
+			svc.RecordAttempt(c.RealIP(), username, true)
+
+			c.SetRequest(c.Request().WithContext(auth.WithPrincipal(ctx, p)))
+			return next(c)
+		}
+	}
+}
+
+// requireFeedAuth authenticates feed requests by the token query
+// parameter, since feed readers can rarely send credentials. Feed tokens
+// are only accepted on the feed routes.
+func requireFeedAuth(cfg *config.Config, svc *auth.Service) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if !cfg.Auth.Enabled {
+			return next
+		}
+		return func(c echo.Context) error {
+			token := c.QueryParam("token")
+			if token == "" {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "feed token required"})
+			}
+
+			ctx := c.Request().Context()
+			p, err := svc.AuthenticateFeed(ctx, token)
+			if err != nil {
+				if !errors.Is(err, auth.ErrUnauthorized) {
+					log.Error().Err(err).Msg("failed to authenticate feed request")
+					return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to authenticate"})
+				}
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid feed token"})
+			}
+
+			c.SetRequest(c.Request().WithContext(auth.WithPrincipal(ctx, p)))
+			return next(c)
+		}
+	}
+}
+
+// basicChallenge adds a WWW-Authenticate header to 401 responses so that
+// clients relying on HTTP Basic auth prompt for credentials.
+func basicChallenge(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Response().Before(func() {
+			if c.Response().Status == http.StatusUnauthorized {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="Kaizoku", charset="UTF-8"`)
+			}
+		})
+		return next(c)
+	}
+}
+
+// requireRole rejects requests whose principal lacks the given role.
+// It must run after requireAuth.
+func requireRole(cfg *config.Config, role string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		if !cfg.Auth.Enabled {
+			return next
+		}
+		return func(c echo.Context) error {
+			if !auth.FromContext(c.Request().Context()).HasRole(role) {
+				return c.JSON(http.StatusForbidden, map[string]string{"error": "insufficient permissions"})
+			}
+			return next(c)
+		}
+	}
+}
+
+// requireRoleForWrites lets any authenticated user read (GET/HEAD) and
+// requires the given role for every other method.
+func requireRoleForWrites(cfg *config.Config, role string) echo.MiddlewareFunc {
+	check := requireRole(cfg, role)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		checked := check(next)
+		return func(c echo.Context) error {
+			switch c.Request().Method {
+			case http.MethodGet, http.MethodHead:
+				return next(c)
+			}
+			return checked(c)
+		}
+	}
+}
+
+// credentialFromRequest extracts an API key from the request headers (and
+// optionally the query string).
+func credentialFromRequest(r *http.Request, allowQuery bool) string {
+	if key := strings.TrimSpace(r.Header.Get("X-Api-Key")); key != "" {
+		return key
+	}
+	if h := r.Header.Get(echo.HeaderAuthorization); len(h) > 7 && strings.EqualFold(h[:7], "Bearer ") {
+		return strings.TrimSpace(h[7:])
+	}
+	if allowQuery {
+		return r.URL.Query().Get("access_token")
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/technobecet/kaizoku-go/internal/config"
+)
+
+func TestIPExtractor(t *testing.T) {
+	extract := ipExtractor(config.ServerConfig{TrustedProxies: []string{"203.0.113.7", "198.51.100.0/24"}})
+	for _, tc := range []struct {
+		remote, xff, want string
+	}{
+		{"192.0.2.10:1234", "", "192.0.2.10"},
+		// A public client cannot choose its IP.
+		{"192.0.2.10:1234", "10.9.9.9", "192.0.2.10"},
+		// Neither can clients on the local network.
+		{"172.18.0.2:1234", "192.0.2.20", "172.18.0.2"},
+		{"127.0.0.1:1234", "192.0.2.21", "127.0.0.1"},
+		// Configured proxies are trusted.
+		{"203.0.113.7:1234", "192.0.2.22", "192.0.2.22"},
+		{"198.51.100.40:1234", "192.0.2.23", "192.0.2.23"},
+		// Spoofed hops before the trusted proxy are ignored.
+		{"203.0.113.7:1234", "10.9.9.9, 192.0.2.24", "192.0.2.24"},
+	} {
+		req := httptest.NewRequest("GET", "/api/auth/login", nil)
+		req.RemoteAddr = tc.remote
+		if tc.xff != "" {
+			req.Header.Set("X-Forwarded-For", tc.xff)
+		}
+		if got := extract(req); got != tc.want {
+			t.Errorf("remote %s, X-Forwarded-For %q: got %s, want %s", tc.remote, tc.xff, got, tc.want)
+		}
+	}
+}
@@ -4,27 +4,89 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/technobecet/kaizoku-go/internal/config"
 	"github.com/technobecet/kaizoku-go/internal/handler"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
 )
 
-func registerRoutes(e *echo.Echo, h *handler.Handler) {
-	// Health check
-	e.GET("/health", HealthCheck)
+// registerRoutes wires all HTTP routes. Every /api route requires
+// authentication; reads are open to all roles, while mutations need the
+// manager role (library and downloads) or admin (everything else).
+func registerRoutes(e *echo.Echo, cfg *config.Config, h *handler.Handler, authMW, feedMW echo.MiddlewareFunc) {
+	managerWrites := requireRoleForWrites(cfg, auth.RoleManager)
+	adminWrites := requireRoleForWrites(cfg, auth.RoleAdmin)
+	manager := requireRole(cfg, auth.RoleManager)
+	admin := requireRole(cfg, auth.RoleAdmin)
+
+	// Prometheus metrics
+	e.GET("/metrics", Metrics, authMW)
 
 	// Root redirect to library
 	e.GET("/", func(c echo.Context) error {
 		return c.Redirect(http.StatusFound, "/library")
 	})
 
-	api := e.Group("/api")
+	// Login routes are the only unauthenticated API routes
+	e.GET("/api/auth/config", h.Auth.GetAuthConfig)
+	e.POST("/api/auth/login", h.Auth.Login)
+	e.GET("/api/auth/oidc/login", h.Auth.OIDCLogin)
+	e.GET("/api/auth/oidc/callback", h.Auth.OIDCCallback)
+
+	api := e.Group("/api", authMW)
+
+	// Auth / API keys (each user manages their own keys; admins see all)
+	authGroup := api.Group("/auth")
+	authGroup.POST("/logout", h.Auth.Logout)
+	authGroup.GET("/me", h.Auth.Me)
+	authGroup.PUT("/password", h.Auth.ChangePassword)
+	authGroup.GET("/keys", h.Auth.ListKeys)
+	authGroup.POST("/keys", h.Auth.CreateKey)
+	authGroup.DELETE("/keys/:id", h.Auth.RevokeKey)
+	authGroup.GET("/feed-token", h.Feeds.GetFeedToken)
+	authGroup.POST("/feed-token", h.Feeds.RotateFeedToken)
+	authGroup.DELETE("/feed-token", h.Feeds.RevokeFeedToken)
+
+	// Users (admin only)
+	users := api.Group("/users", admin)
+	users.GET("", h.Users.ListUsers)
+	users.POST("", h.Users.CreateUser)
+	users.PATCH("/:id", h.Users.UpdateUser)
+	users.DELETE("/:id", h.Users.DeleteUser)
+
+	// Audit log (admin only)
+	api.GET("/audit", h.Audit.GetAuditEvents, admin)
+
+	// Webhooks (admin only)
+	webhooks := api.Group("/webhooks", admin)
+	webhooks.GET("", h.Webhooks.ListWebhooks)
+	webhooks.POST("", h.Webhooks.CreateWebhook)
+	webhooks.GET("/deliveries", h.Webhooks.GetDeliveries)
+	webhooks.POST("/deliveries/:id/redeliver", h.Webhooks.RedeliverWebhook)
+	webhooks.PATCH("/:id", h.Webhooks.UpdateWebhook)
+	webhooks.DELETE("/:id", h.Webhooks.DeleteWebhook)
+	webhooks.POST("/:id/test", h.Webhooks.TestWebhook)
+
+	// Push notifications (admin only)
+	notifiers := api.Group("/notifiers", admin)
+	notifiers.GET("", h.Notifiers.ListNotifiers)
+	notifiers.POST("", h.Notifiers.CreateNotifier)
+	notifiers.GET("/notifications", h.Notifiers.GetNotifications)
+	notifiers.POST("/email-digest/test", h.Notifiers.TestEmailDigest)
+	notifiers.PATCH("/:id", h.Notifiers.UpdateNotifier)
+	notifiers.DELETE("/:id", h.Notifiers.DeleteNotifier)
+	notifiers.POST("/:id/test", h.Notifiers.TestNotifier)
+
+	// Script hooks (admin only)
+	api.GET("/hooks", h.Hooks.ListHooks, admin)
+	api.GET("/hooks/runs", h.Hooks.GetRuns, admin)
 
 	// Series / Library
-	serie := api.Group("/serie")
+	serie := api.Group("/serie", managerWrites)
 	serie.GET("", h.Series.GetSeries)
 	serie.GET("/library", h.Series.GetLibrary)
 	serie.GET("/latest", h.Series.GetLatest)
-	serie.GET("/verify", h.Series.VerifyIntegrity)
-	serie.GET("/cleanup", h.Series.CleanupSeries)
+	serie.GET("/verify", h.Series.VerifyIntegrity, manager)
+	serie.GET("/cleanup", h.Series.CleanupSeries, manager)
 	serie.GET("/deep-verify", h.Series.DeepVerify)
 	serie.POST("/verify-all", h.Series.VerifyAll)
 	serie.POST("/upgrade-all-sources", h.Series.UpgradeAllSources)
@@ -35,27 +97,32 @@ func registerRoutes(e *echo.Echo, h *handler.Handler) {
 	serie.POST("", h.Series.AddSeries)
 	serie.POST("/update-all", h.Series.UpdateAllSeries)
 	serie.PATCH("", h.Series.UpdateSeries)
-	serie.DELETE("", h.Series.DeleteSeries)
+	serie.PUT("/notify", h.Series.SetSeriesNotify)
+	serie.DELETE("", h.Series.DeleteSeries, admin)
 	serie.POST("/match", h.Series.SetProviderMatch)
 
 	// Search
-	search := api.Group("/search")
+	search := api.Group("/search", managerWrites)
 	search.GET("", h.Search.SearchSeries)
 	search.GET("/sources", h.Search.GetSearchSources)
 	search.POST("/augment", h.Search.AugmentSeries)
 
 	// Downloads
-	downloads := api.Group("/downloads")
+	downloads := api.Group("/downloads", managerWrites)
 	downloads.GET("", h.Downloads.GetDownloads)
 	downloads.GET("/series", h.Downloads.GetSeriesDownloads)
 	downloads.GET("/metrics", h.Downloads.GetDownloadMetrics)
+	downloads.GET("/state", h.Downloads.GetQueueState)
+	downloads.POST("/pause", h.Downloads.PauseQueue)
+	downloads.POST("/resume", h.Downloads.ResumeQueue)
 	downloads.PATCH("", h.Downloads.ManageErrorDownload)
 	downloads.DELETE("/scheduled", h.Downloads.CancelAllScheduled)
 	downloads.DELETE("/scheduled/item", h.Downloads.CancelDownload)
+	downloads.DELETE("/running/:id", h.Downloads.CancelRunningDownload)
 	downloads.DELETE("/errors", h.Downloads.DeleteAllErrors)
 
 	// Provider
-	provider := api.Group("/provider")
+	provider := api.Group("/provider", adminWrites)
 	provider.GET("/list", h.Provider.GetProviders)
 	provider.GET("/preferences/:pkg", h.Provider.GetProviderPreferences)
 	provider.GET("/icon/:apk", h.Provider.GetProviderIcon)
@@ -65,13 +132,13 @@ func registerRoutes(e *echo.Echo, h *handler.Handler) {
 	provider.POST("/preferences", h.Provider.SetProviderPreferences)
 
 	// Settings
-	settings := api.Group("/settings")
+	settings := api.Group("/settings", adminWrites)
 	settings.GET("", h.Settings.GetSettings)
 	settings.GET("/languages", h.Settings.GetLanguages)
 	settings.PUT("", h.Settings.UpdateSettings)
 
 	// Setup / Import wizard
-	setup := api.Group("/setup")
+	setup := api.Group("/setup", adminWrites)
 	setup.POST("/scan", h.Setup.ScanLocalFiles)
 	setup.POST("/install-extensions", h.Setup.InstallExtensions)
 	setup.POST("/search", h.Setup.SearchProviders)
@@ -88,7 +155,41 @@ func registerRoutes(e *echo.Echo, h *handler.Handler) {
 	reporting.GET("/source/:sourceId/events", h.Reporting.GetSourceEvents)
 	reporting.GET("/source/:sourceId/timeline", h.Reporting.GetSourceTimeline)
 
+	// Reader (pages are streamed from the CBZ files)
+	reader := api.Group("/reader")
+	reader.GET("/:id/chapters", h.Reader.GetChapters)
+	reader.GET("/:id/chapters/:number", h.Reader.GetChapterPages)
+	reader.GET("/:id/chapters/:number/pages/:page", h.Reader.GetPage)
+	reader.GET("/:id/progress", h.Reader.GetProgress)
+	reader.PUT("/:id/chapters/:number/progress", h.Reader.SetProgress)
+	reader.POST("/:id/mark", h.Reader.MarkRead)
+
 	// Jobs
 	jobs := api.Group("/jobs")
 	jobs.GET("/status", h.Jobs.GetJobStatus)
+
+	// OPDS catalogs for e-readers (read-only; Basic auth is accepted)
+	opds := e.Group("/opds", basicChallenge, authMW)
+	opds.GET("", func(c echo.Context) error {
+		return c.Redirect(http.StatusFound, "/opds/v1.2/catalog")
+	})
+	for _, version := range []string{"/v1.2", "/v2"} {
+		catalog := opds.Group(version)
+		catalog.GET("/catalog", h.OPDS.Catalog)
+		catalog.GET("/series", h.OPDS.AllSeries)
+		catalog.GET("/series/:id", h.OPDS.Series)
+		catalog.GET("/categories", h.OPDS.Categories)
+		catalog.GET("/categories/:name", h.OPDS.Category)
+		catalog.GET("/recent", h.OPDS.Recent)
+		catalog.GET("/search", h.OPDS.Search)
+	}
+	opds.GET("/v1.2/opensearch.xml", h.OPDS.OpenSearch)
+	opds.GET("/series/:id/chapters/:number/file", h.OPDS.DownloadChapter)
+	opds.GET("/series/:id/chapters/:number/pages/:page", h.OPDS.StreamPage)
+
+	// Atom and calendar feeds (authenticated by a per-user feed token)
+	feeds := e.Group("/feeds", feedMW)
+	feeds.GET("/library.atom", h.Feeds.Library)
+	feeds.GET("/series/:file", h.Feeds.Series)
+	feeds.GET("/calendar.ics", h.Feeds.Calendar)
 }
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/handler"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
+)
+
+// asRole authenticates every request as a user with the given role.
+func asRole(role string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			p := &auth.Principal{Kind: auth.KindSession, Username: role, Role: role}
+			c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(), p)))
+			return next(c)
+		}
+	}
+}
+
+func TestRoutesRequireRole(t *testing.T) {
+	cfg := &config.Config{Auth: config.AuthConfig{Enabled: true}}
+	for _, tc := range []struct {
+		role, method, path, body string
+	}{
+		// Only admins may delete series, which can remove files from disk.
+		{auth.RoleManager, http.MethodDelete, "/api/serie?id=00000000-0000-0000-0000-000000000001&alsoPhysical=true", ""},
+		// Or delete a provider's chapter files through a series update.
+		{auth.RoleManager, http.MethodPatch, "/api/serie", `{"id":"00000000-0000-0000-0000-000000000001","providers":[{"id":"00000000-0000-0000-0000-000000000002","isDeleted":true,"deleteFiles":true}]}`},
+		{auth.RoleViewer, http.MethodPost, "/api/downloads/pause", ""},
+		{auth.RoleViewer, http.MethodPatch, "/api/serie", `{"id":"00000000-0000-0000-0000-000000000001"}`},
+		{auth.RoleManager, http.MethodPut, "/api/settings", "{}"},
+	} {
+		e := echo.New()
+		registerRoutes(e, cfg, &handler.Handler{Series: &handler.SeriesHandler{}}, asRole(tc.role), asRole(tc.role))
+
+		req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("%s %s %s as %s: status %d, want 403", tc.method, tc.path, tc.body, tc.role, rec.Code)
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/technobecet/kaizoku-go/internal/ent"
 	"github.com/technobecet/kaizoku-go/internal/handler"
 	"github.com/technobecet/kaizoku-go/internal/job"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
 	"github.com/technobecet/kaizoku-go/internal/service/suwayomi"
 	"github.com/technobecet/kaizoku-go/internal/ws"
 )
@@ -25,12 +26,12 @@ type Server struct {
 	ProgressHub *ws.Hub
 }
 
-func New(cfg *config.Config, db *ent.Client, sw *suwayomi.Client, jobMgr *job.Manager, hub *ws.Hub) *Server {
+func New(cfg *config.Config, db *ent.Client, sw *suwayomi.Client, jobMgr *job.Manager, hub *ws.Hub, authSvc *auth.Service) *Server {
 	e := echo.New()
 	e.HideBanner = true
 	e.HidePort = true
 
-	h := handler.New(cfg, db, sw, jobMgr)
+	h := handler.New(cfg, db, sw, jobMgr, authSvc)
 
 	s := &Server{
 		echo:        e,
@@ -40,17 +41,35 @@ func New(cfg *config.Config, db *ent.Client, sw *suwayomi.Client, jobMgr *job.Ma
 		ProgressHub: hub,
 	}
 
-	setupMiddleware(e)
-	registerRoutes(e, h)
-	registerProgressHub(e, hub)
+	setupMiddleware(e, cfg)
+	proxy := newProxyAuth(cfg.Server)
+	registerHealth(e, &healthChecker{config: cfg, db: db, suwayomi: sw, jobs: jobMgr, swProcess: jobMgr.JobDeps.SuwayomiProcess})
+	registerRoutes(e, cfg, h, requireAuth(cfg, authSvc, proxy, false), requireFeedAuth(cfg, authSvc))
+	registerProgressHub(e, hub, requireAuth(cfg, authSvc, proxy, true))
+	registerKOSync(e, h, requireKOSyncAuth(cfg, authSvc))
 	registerStaticFiles(e)
 
 	return s
 }
 
-func registerProgressHub(e *echo.Echo, hub *ws.Hub) {
-	e.POST("/progress/negotiate", hub.HandleNegotiate)
-	e.GET("/progress", hub.HandleWebSocket)
+// registerProgressHub exposes the SignalR-compatible progress hub. Clients
+// authenticate like the REST API (session cookie or API key); since browsers
+// cannot set headers on WebSocket upgrades, an API key may also be passed as
+// the access_token query parameter (SignalR's accessTokenFactory convention).
+func registerProgressHub(e *echo.Echo, hub *ws.Hub, authMW echo.MiddlewareFunc) {
+	e.POST("/progress/negotiate", hub.HandleNegotiate, authMW)
+	e.GET("/progress", hub.HandleWebSocket, authMW)
+}
+
+// registerKOSync exposes the KOReader progress sync server under /kosync,
+// which is the URL users enter as KOReader's custom sync server. Devices
+// authenticate with kosync's own headers instead of the API credentials.
+func registerKOSync(e *echo.Echo, h *handler.Handler, authMW echo.MiddlewareFunc) {
+	e.POST("/kosync/users/create", h.KOSync.CreateUser)
+	ko := e.Group("/kosync", authMW)
+	ko.GET("/users/auth", h.KOSync.Authorize)
+	ko.GET("/syncs/progress/:document", h.KOSync.GetProgress)
+	ko.PUT("/syncs/progress", h.KOSync.UpdateProgress)
 }
 
 func (s *Server) Start() error {
@@ -62,7 +81,9 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	return s.echo.Shutdown(ctx)
 }
 
-// HealthCheck returns 200 OK.
+// HealthCheck returns 200 OK. It is intentionally unauthenticated so container
+// orchestrators and uptime monitors can probe it, and reveals nothing beyond liveness.
+// /health/live and /health/ready run actual checks.
 func HealthCheck(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
 }
@@ -90,8 +111,9 @@ func registerStaticFiles(e *echo.Echo) {
 		return func(c echo.Context) error {
 			path := c.Request().URL.Path
 
-			// Skip API routes, WebSocket, and health check
-			if strings.HasPrefix(path, "/api/") || strings.HasPrefix(path, "/progress") || path == "/health" {
+			// Skip API routes, WebSocket and the other backend-served
+			// trees (health checks, OPDS, feeds, KOReader sync, metrics)
+			if strings.HasPrefix(path, "/api/") || strings.HasPrefix(path, "/progress") || isBackendPath(path) {
 				return next(c)
 			}
 
@@ -123,3 +145,16 @@ func registerStaticFiles(e *echo.Echo) {
 		}
 	})
 }
+
+// backendPrefixes are path trees served by the backend outside /api.
+var backendPrefixes = []string{"/health", "/opds", "/feeds", "/kosync", "/metrics"}
+
+// isBackendPath reports whether path belongs to one of backendPrefixes.
+func isBackendPath(path string) bool {
+	for _, p := range backendPrefixes {
+		if path == p || strings.HasPrefix(path, p+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/technobecet/kaizoku-go/internal/tracing"
+)
+
+// traceRequests starts a server span for every request, continuing the
+// caller's trace when it sends a traceparent header.
+func traceRequests() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !tracing.Enabled() {
+				return next(c)
+			}
+			req := c.Request()
+			route := routeLabel(c)
+			ctx := tracing.ContextWithTraceParent(req.Context(), req.Header.Get("traceparent"))
+			ctx, span := tracing.Start(ctx, req.Method+" "+route, tracing.KindServer,
+				tracing.String("http.request.method", req.Method),
+				tracing.String("http.route", route),
+				tracing.String("url.path", req.URL.Path))
+			defer span.End()
+			c.SetRequest(req.WithContext(ctx))
+
+			err := next(c)
+			code := responseCode(c, err)
+			span.SetAttributes(tracing.Int("http.response.status_code", code))
+			if code >= http.StatusInternalServerError {
+				if err != nil {
+					span.RecordError(err)
+				} else {
+					span.SetError(http.StatusText(code))
+				}
+			}
+			return err
+		}
+	}
+}
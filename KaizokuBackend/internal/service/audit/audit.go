@@ -0,0 +1,147 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/service/auth"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// Actor kinds that are not auth principal kinds.
+const (
+	KindSystem    = "system"
+	KindAnonymous = "anonymous"
+)
+
+// Entry describes a single auditable action.
+type Entry struct {
+	// Action is a dotted name such as "series.delete" or "settings.update".
+	Action     string
+	TargetType string
+	TargetIDs  []string
+	Diff       map[string]types.AuditChange
+	Details    map[string]interface{}
+	// IP is the client address for HTTP-triggered actions.
+	IP string
+}
+
+// Recorder writes audit events to the database.
+type Recorder struct {
+	db *ent.Client
+}
+
+// NewRecorder creates a new audit recorder.
+func NewRecorder(db *ent.Client) *Recorder {
+	return &Recorder{db: db}
+}
+
+// Record stores an event attributed to the principal in ctx, or to
+// "anonymous" when the request was not authenticated. Failures are logged
+// and never fail the audited operation.
+func (r *Recorder) Record(ctx context.Context, e Entry) {
+	kind, id, name := KindAnonymous, "", KindAnonymous
+	if p := auth.FromContext(ctx); p != nil {
+		kind, id, name = p.Kind, p.ID, p.Name
+		if p.Username != "" {
+			id, name = p.UserID, p.Username
+		}
+	}
+	r.save(ctx, kind, id, name, e)
+}
+
+// RecordSystem stores an event performed by a background component (e.g. a
+// job worker) rather than a user.
+func (r *Recorder) RecordSystem(ctx context.Context, component string, e Entry) {
+	r.save(ctx, KindSystem, "", component, e)
+}
+
+func (r *Recorder) save(ctx context.Context, kind, id, name string, e Entry) {
+	if r == nil {
+		return
+	}
+	// The audited operation has already happened; record it even if the
+	// request is cancelled in the meantime.
+	ctx = context.WithoutCancel(ctx)
+
+	create := r.db.AuditEvent.Create().
+		SetActorKind(kind).
+		SetActorName(name).
+		SetAction(e.Action).
+		SetTargetType(e.TargetType)
+	if id != "" {
+		create.SetActorID(id)
+	}
+	if len(e.TargetIDs) > 0 {
+		create.SetTargetIds(e.TargetIDs)
+	}
+	if len(e.Diff) > 0 {
+		create.SetDiff(e.Diff)
+	}
+	if len(e.Details) > 0 {
+		create.SetDetails(e.Details)
+	}
+	if e.IP != "" {
+		create.SetIP(e.IP)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		log.Error().Err(err).Str("action", e.Action).Str("actor", name).Msg("failed to record audit event")
+	}
+}
+
+// sensitiveKey reports whether a field name looks like it holds a secret.
+func sensitiveKey(k string) bool {
+	k = strings.ToLower(k)
+	for _, s := range []string{"password", "secret", "token", "apikey"} {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff compares the top-level JSON fields of before and after and returns
+// the ones that changed. Values of secret-looking fields are redacted.
+func Diff(before, after interface{}) map[string]types.AuditChange {
+	b, a := toMap(before), toMap(after)
+	diff := make(map[string]types.AuditChange)
+	for k, nv := range a {
+		ov, ok := b[k]
+		if ok && reflect.DeepEqual(ov, nv) {
+			continue
+		}
+		diff[k] = change(k, ov, nv)
+	}
+	for k, ov := range b {
+		if _, ok := a[k]; !ok {
+			diff[k] = change(k, ov, nil)
+		}
+	}
+	return diff
+}
+
+func change(k string, ov, nv interface{}) types.AuditChange {
+	if sensitiveKey(k) {
+		return types.AuditChange{Old: "[redacted]", New: "[redacted]"}
+	}
+	return types.AuditChange{Old: ov, New: nv}
+}
+
+// toMap round-trips v through JSON so structs compare by their API shape.
+func toMap(v interface{}) map[string]interface{} {
+	m := map[string]interface{}{}
+	if v == nil {
+		return m
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return m
+	}
+	_ = json.Unmarshal(data, &m)
+	return m
+}
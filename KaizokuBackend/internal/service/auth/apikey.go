@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/apikey"
+)
+
+const (
+	// keyPrefix marks Kaizoku API keys so they are recognisable in configs and logs.
+	keyPrefix = "kz_"
+	// displayPrefixLen is how many characters of the key are kept for listings.
+	displayPrefixLen = 11
+	// touchInterval is the minimum time between last_used_at updates for a key.
+	touchInterval = time.Minute
+)
+
+// HashKey returns the hex-encoded SHA-256 of a plaintext API key.
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateKey returns a new random plaintext API key.
+func generateKey() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+	return keyPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// CreateKey generates and stores a new API key owned by userID (nil for an
+// unowned admin key). The plaintext key is returned once and is not
+// recoverable afterwards.
+func (s *Service) CreateKey(ctx context.Context, name string, userID *uuid.UUID) (*ent.APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", fmt.Errorf("key name is required")
+	}
+
+	plain, err := generateKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	k, err := s.db.APIKey.Create().
+		SetName(name).
+		SetPrefix(plain[:displayPrefixLen]).
+		SetKeyHash(HashKey(plain)).
+		SetNillableUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("save api key: %w", err)
+	}
+	return k, plain, nil
+}
+
+// ListKeys returns API keys, newest first, including revoked ones. When
+// userID is non-nil only that user's keys are returned.
+func (s *Service) ListKeys(ctx context.Context, userID *uuid.UUID) ([]*ent.APIKey, error) {
+	q := s.db.APIKey.Query()
+	if userID != nil {
+		q = q.Where(apikey.UserID(*userID))
+	}
+	return q.Order(ent.Desc(apikey.FieldCreatedAt)).All(ctx)
+}
+
+// GetKey returns a single API key by ID.
+func (s *Service) GetKey(ctx context.Context, id uuid.UUID) (*ent.APIKey, error) {
+	return s.db.APIKey.Get(ctx, id)
+}
+
+// RevokeKey marks an API key as revoked. Revoking an already revoked key is a no-op.
+func (s *Service) RevokeKey(ctx context.Context, id uuid.UUID) error {
+	k, err := s.db.APIKey.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if k.RevokedAt != nil {
+		return nil
+	}
+	return s.db.APIKey.UpdateOneID(id).SetRevokedAt(time.Now()).Exec(ctx)
+}
+
+// AuthenticateKey resolves a plaintext API key to a principal.
+func (s *Service) AuthenticateKey(ctx context.Context, key string) (*Principal, error) {
+	if !strings.HasPrefix(key, keyPrefix) {
+		return nil, ErrUnauthorized
+	}
+
+	k, err := s.db.APIKey.Query().
+		Where(apikey.KeyHash(HashKey(key)), apikey.RevokedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUnauthorized
+		}
+		return nil, fmt.Errorf("lookup api key: %w", err)
+	}
+
+	p := &Principal{Kind: KindAPIKey, ID: k.ID.String(), Name: k.Name, Role: RoleAdmin}
+	if k.UserID != nil {
+		u, err := s.db.User.Get(ctx, *k.UserID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, ErrUnauthorized
+			}
+			return nil, fmt.Errorf("lookup api key owner: %w", err)
+		}
+		if u.Disabled {
+			return nil, ErrUnauthorized
+		}
+		p.UserID = u.ID.String()
+		p.Username = u.Username
+		p.Role = u.Role
+	}
+
+	s.touchKey(k.ID)
+
+	return p, nil
+}
+
+// touchKey records key usage in the background, at most once per touchInterval.
+func (s *Service) touchKey(id uuid.UUID) {
+	now := time.Now()
+	s.mu.Lock()
+	if last, ok := s.lastTouched[id.String()]; ok && now.Sub(last) < touchInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastTouched[id.String()] = now
+	s.mu.Unlock()
+
+	go func() {
+		if err := s.db.APIKey.UpdateOneID(id).SetLastUsedAt(now).Exec(context.Background()); err != nil {
+			log.Warn().Err(err).Str("keyId", id.String()).Msg("failed to update api key last_used_at")
+		}
+	}()
+}
+
+// BootstrapKey creates an initial admin API key when none exist yet and
+// returns its plaintext. An empty string means keys were already configured.
+func (s *Service) BootstrapKey(ctx context.Context) (string, error) {
+	n, err := s.db.APIKey.Query().Count(ctx)
+	if err != nil {
+		return "", fmt.Errorf("count api keys: %w", err)
+	}
+	if n > 0 {
+		return "", nil
+	}
+
+	_, plain, err := s.CreateKey(ctx, "bootstrap", nil)
+	if err != nil {
+		return "", err
+	}
+	return plain, nil
+}
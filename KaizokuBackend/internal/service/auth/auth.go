@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/technobecet/kaizoku-go/internal/ent"
+)
+
+var (
+	// ErrUnauthorized is returned when a credential is missing, unknown or revoked.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrInvalidRole is returned when a role name is not one of the known roles.
+	ErrInvalidRole = errors.New("invalid role")
+	// ErrWeakPassword is returned when a password is shorter than minPasswordLen.
+	ErrWeakPassword = errors.New("password must be at least 8 characters")
+	// ErrUsernameTaken is returned when creating a user whose name already exists.
+	ErrUsernameTaken = errors.New("username already exists")
+	// ErrLastAdmin is returned when an operation would leave no enabled admin.
+	ErrLastAdmin = errors.New("at least one enabled admin is required")
+)
+
+// Principal kinds.
+const (
+	KindAPIKey  = "api_key"
+	KindSession = "session"
+	KindProxy   = "proxy"
+	KindBasic   = "basic"
+	KindKOSync  = "kosync"
+	KindFeed    = "feed"
+)
+
+// Principal describes the authenticated caller of a request.
+type Principal struct {
+	Kind string `json:"kind"`
+	// ID is the API key or session ID that authenticated the request.
+	ID string `json:"id"`
+	// Name is the API key name, or the username for sessions.
+	Name string `json:"name"`
+	// UserID and Username identify the acting user. Both are empty for the
+	// bootstrap API key, which is not owned by any user.
+	UserID   string `json:"userId"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the given principal.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal stored in ctx, or nil if the request
+// was not authenticated (e.g. auth is disabled).
+func FromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// Service manages API keys and authenticates incoming credentials.
+type Service struct {
+	db *ent.Client
+
+	// lastTouched throttles last_used_at writes to one per key per minute;
+	// basicCache remembers recently verified Basic credentials.
+	mu          sync.Mutex
+	lastTouched map[string]time.Time
+	basicCache  map[string]basicEntry
+
+	throttle *throttle
+}
+
+// NewService creates a new auth service.
+func NewService(db *ent.Client) *Service {
+	return &Service{
+		db:          db,
+		lastTouched: make(map[string]time.Time),
+		basicCache:  make(map[string]basicEntry),
+		throttle:    newThrottle(),
+	}
+}
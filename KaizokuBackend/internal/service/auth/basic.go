@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+)
+
+// basicCacheTTL is how long a verified Basic credential skips bcrypt.
+// Readers send credentials with every page request, and bcrypt is
+// deliberately slow.
+const basicCacheTTL = 5 * time.Minute
+
+// basicEntry is a recently verified username/password pair.
+type basicEntry struct {
+	userID  uuid.UUID
+	expires time.Time
+}
+
+// AuthenticateBasic resolves HTTP Basic credentials to a principal. The
+// password may be a local account password or an API key, so clients that
+// only support Basic auth (e.g. OPDS readers) can use either.
+func (s *Service) AuthenticateBasic(ctx context.Context, username, password string) (*Principal, error) {
+	if strings.HasPrefix(password, keyPrefix) {
+		return s.AuthenticateKey(ctx, password)
+	}
+
+	cacheKey := HashKey(username + "\x00" + password)
+	now := time.Now()
+
+	s.mu.Lock()
+	e, ok := s.basicCache[cacheKey]
+	s.mu.Unlock()
+
+	var u *ent.User
+	if ok && now.Before(e.expires) {
+		var err error
+		u, err = s.db.User.Get(ctx, e.userID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, ErrUnauthorized
+			}
+			return nil, fmt.Errorf("lookup user: %w", err)
+		}
+		if u.Disabled {
+			return nil, ErrUnauthorized
+		}
+	} else {
+		var err error
+		u, err = s.checkPassword(ctx, username, password)
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		for k, v := range s.basicCache {
+			if now.After(v.expires) {
+				delete(s.basicCache, k)
+			}
+		}
+		s.basicCache[cacheKey] = basicEntry{userID: u.ID, expires: now.Add(basicCacheTTL)}
+		s.mu.Unlock()
+	}
+
+	return &Principal{
+		Kind:     KindBasic,
+		ID:       u.ID.String(),
+		Name:     u.Username,
+		UserID:   u.ID.String(),
+		Username: u.Username,
+		Role:     u.Role,
+	}, nil
+}
+
+// forgetBasicCredentials drops all cached Basic credentials, so password
+// changes and account removals take effect immediately.
+func (s *Service) forgetBasicCredentials() {
+	s.mu.Lock()
+	s.basicCache = make(map[string]basicEntry)
+	s.mu.Unlock()
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/user"
+)
+
+// feedTokenPrefix marks feed tokens so they are not mistaken for API keys.
+const feedTokenPrefix = "kzf_"
+
+// HasFeedToken reports whether the user has a feed token. Only its hash is
+// stored, so the token itself is shown once, when it is rotated.
+func (s *Service) HasFeedToken(ctx context.Context, userID uuid.UUID) (bool, error) {
+	u, err := s.db.User.Get(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return u.FeedTokenHash != nil, nil
+}
+
+// RotateFeedToken gives the user a new feed token, invalidating the old one,
+// and returns its plaintext.
+func (s *Service) RotateFeedToken(ctx context.Context, userID uuid.UUID) (string, error) {
+	token, err := randomToken(24)
+	if err != nil {
+		return "", err
+	}
+	token = feedTokenPrefix + token
+	if err := s.db.User.UpdateOneID(userID).SetFeedTokenHash(HashKey(token)).Exec(ctx); err != nil {
+		return "", fmt.Errorf("save feed token: %w", err)
+	}
+	return token, nil
+}
+
+// RevokeFeedToken removes the user's feed token.
+func (s *Service) RevokeFeedToken(ctx context.Context, userID uuid.UUID) error {
+	return s.db.User.UpdateOneID(userID).ClearFeedTokenHash().Exec(ctx)
+}
+
+// AuthenticateFeed resolves a feed token to a principal. Feed tokens only
+// grant access to the read-only feeds.
+func (s *Service) AuthenticateFeed(ctx context.Context, token string) (*Principal, error) {
+	if !strings.HasPrefix(token, feedTokenPrefix) {
+		return nil, ErrUnauthorized
+	}
+	u, err := s.db.User.Query().Where(user.FeedTokenHash(HashKey(token))).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUnauthorized
+		}
+		return nil, fmt.Errorf("lookup feed token: %w", err)
+	}
+	if u.Disabled {
+		return nil, ErrUnauthorized
+	}
+	return &Principal{
+		Kind:     KindFeed,
+		ID:       u.ID.String(),
+		Name:     u.Username,
+		UserID:   u.ID.String(),
+		Username: u.Username,
+		Role:     u.Role,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/user"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// KOReader never sends the password itself: its sync plugin sends the hex
+// MD5 digest of what the user typed. Kaizoku keeps a bcrypt hash of that
+// digest next to the password hash, so the same username and password work
+// in KOReader.
+
+// hashKOSyncKey returns the bcrypt hash of the digest KOReader sends for password.
+func hashKOSyncKey(password string) (string, error) {
+	sum := md5.Sum([]byte(password))
+	h, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(sum[:])), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("hash kosync key: %w", err)
+	}
+	return string(h), nil
+}
+
+// ensureKOSyncHash stores the kosync hash for a user who logged in with a
+// password but has none yet (accounts created before kosync support).
+func (s *Service) ensureKOSyncHash(ctx context.Context, u *ent.User, password string) {
+	if u.KosyncHash != "" {
+		return
+	}
+	hash, err := hashKOSyncKey(password)
+	if err == nil {
+		err = s.db.User.UpdateOneID(u.ID).SetKosyncHash(hash).Exec(ctx)
+	}
+	if err != nil {
+		log.Warn().Err(err).Str("user", u.Username).Msg("failed to store kosync key")
+	}
+}
+
+// AuthenticateKOSync resolves kosync credentials (username and the MD5
+// digest of the password) to a principal. Verified credentials are cached
+// like Basic credentials, as devices authenticate every sync request.
+func (s *Service) AuthenticateKOSync(ctx context.Context, username, key string) (*Principal, error) {
+	key = strings.ToLower(strings.TrimSpace(key))
+	cacheKey := HashKey("kosync\x00" + username + "\x00" + key)
+	now := time.Now()
+
+	s.mu.Lock()
+	e, ok := s.basicCache[cacheKey]
+	s.mu.Unlock()
+
+	var u *ent.User
+	if ok && now.Before(e.expires) {
+		var err error
+		u, err = s.db.User.Get(ctx, e.userID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, ErrUnauthorized
+			}
+			return nil, fmt.Errorf("lookup user: %w", err)
+		}
+		if u.Disabled {
+			return nil, ErrUnauthorized
+		}
+	} else {
+		var err error
+		u, err = s.db.User.Query().Where(user.Username(strings.TrimSpace(username))).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(key))
+				return nil, ErrUnauthorized
+			}
+			return nil, fmt.Errorf("lookup user: %w", err)
+		}
+		if u.Disabled || u.KosyncHash == "" ||
+			bcrypt.CompareHashAndPassword([]byte(u.KosyncHash), []byte(key)) != nil {
+			return nil, ErrUnauthorized
+		}
+		s.mu.Lock()
+		s.basicCache[cacheKey] = basicEntry{userID: u.ID, expires: now.Add(basicCacheTTL)}
+		s.mu.Unlock()
+	}
+
+	return &Principal{
+		Kind:     KindKOSync,
+		ID:       u.ID.String(),
+		Name:     u.Username,
+		UserID:   u.ID.String(),
+		Username: u.Username,
+		Role:     u.Role,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package auth
+
+// Roles, from least to most privileged.
+const (
+	RoleViewer  = "viewer"
+	RoleManager = "manager"
+	RoleAdmin   = "admin"
+)
+
+var roleRank = map[string]int{
+	RoleViewer:  1,
+	RoleManager: 2,
+	RoleAdmin:   3,
+}
+
+// ValidRole reports whether r is a known role.
+func ValidRole(r string) bool {
+	_, ok := roleRank[r]
+	return ok
+}
+
+// HasRole reports whether the principal has at least the given role.
+func (p *Principal) HasRole(min string) bool {
+	if p == nil {
+		return false
+	}
+	return roleRank[p.Role] >= roleRank[min]
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Failed password attempts are throttled per client IP, and per username
+// from that IP. After the free attempts each further failure doubles the
+// wait before the next attempt, so bcrypt cannot be brute forced through
+// the login, Basic or kosync endpoints. The username count is kept per IP
+// so that nobody can lock an account out by failing logins for it from
+// elsewhere. A client IP gets more free attempts than a username because a
+// household or office may share one address.
+const (
+	userFreeAttempts   = 5
+	ipFreeAttempts     = 20
+	throttleBaseDelay  = time.Second
+	throttleMaxDelay   = 15 * time.Minute
+	throttleForget     = time.Hour
+	maxThrottleEntries = 10000
+)
+
+// attempts counts consecutive failures for one IP or one username from an
+// IP.
+type attempts struct {
+	failures int
+	last     time.Time
+}
+
+// throttle tracks failed password attempts.
+type throttle struct {
+	mu      sync.Mutex
+	entries map[string]*attempts
+	now     func() time.Time
+}
+
+func newThrottle() *throttle {
+	return &throttle{entries: make(map[string]*attempts), now: time.Now}
+}
+
+func throttleKeys(ip, username string) (ipKey, userKey string) {
+	return "ip:" + ip, "user:" + ip + "\x00" + strings.ToLower(strings.TrimSpace(username))
+}
+
+// retryAfter returns how long the caller must wait, or zero.
+func (t *throttle) retryAfter(ip, username string) time.Duration {
+	ipKey, userKey := throttleKeys(ip, username)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	return max(t.wait(ipKey, ipFreeAttempts, now), t.wait(userKey, userFreeAttempts, now))
+}
+
+func (t *throttle) wait(key string, free int, now time.Time) time.Duration {
+	a := t.entries[key]
+	if a == nil || a.failures < free || now.Sub(a.last) > throttleForget {
+		return 0
+	}
+	d := min(throttleBaseDelay<<min(a.failures-free, 20), throttleMaxDelay)
+	return max(a.last.Add(d).Sub(now), 0)
+}
+
+// fail records a failed attempt.
+func (t *throttle) fail(ip, username string) {
+	ipKey, userKey := throttleKeys(ip, username)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	if len(t.entries) >= maxThrottleEntries {
+		for k, a := range t.entries {
+			if now.Sub(a.last) > throttleForget {
+				delete(t.entries, k)
+			}
+		}
+	}
+	for _, key := range []string{ipKey, userKey} {
+		a := t.entries[key]
+		if a == nil || now.Sub(a.last) > throttleForget {
+			if len(t.entries) >= maxThrottleEntries {
+				continue
+			}
+			a = &attempts{}
+			t.entries[key] = a
+		}
+		a.failures++
+		a.last = now
+	}
+}
+
+// succeed clears the username's failures from ip. The IP's own failures
+// are kept, so logging in to one account does not reset guessing against
+// others.
+func (t *throttle) succeed(ip, username string) {
+	_, userKey := throttleKeys(ip, username)
+	t.mu.Lock()
+	delete(t.entries, userKey)
+	t.mu.Unlock()
+}
+
+// RetryAfter returns how long the client at ip must wait before its next
+// password attempt for username, or zero if it may try now.
+func (s *Service) RetryAfter(ip, username string) time.Duration {
+	return s.throttle.retryAfter(ip, username)
+}
+
+// RecordAttempt records the outcome of a password attempt for throttling.
+func (s *Service) RecordAttempt(ip, username string, ok bool) {
+	if ok {
+		s.throttle.succeed(ip, username)
+		return
+	}
+	s.throttle.fail(ip, username)
+}
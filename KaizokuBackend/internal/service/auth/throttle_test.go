@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestThrottle() (*throttle, *time.Time) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	t := newThrottle()
+	t.now = func() time.Time { return now }
+	return t, &now
+}
+
+func TestThrottleUsernameBackoff(t *testing.T) {
+	th, now := newTestThrottle()
+	for i := 0; i < userFreeAttempts; i++ {
+		if d := th.retryAfter("10.0.0.1", "admin"); d != 0 {
+			t.Fatalf("attempt %d throttled for %v", i+1, d)
+		}
+		th.fail("10.0.0.1", "admin")
+	}
+
+	// Each failure past the free attempts doubles the wait.
+	for _, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		if d := th.retryAfter("10.0.0.1", " Admin "); d != want {
+			t.Fatalf("retryAfter = %v, want %v", d, want)
+		}
+		*now = now.Add(want)
+		if d := th.retryAfter("10.0.0.1", "admin"); d != 0 {
+			t.Fatalf("still throttled for %v after waiting", d)
+		}
+		th.fail("10.0.0.1", "admin")
+	}
+	if d := th.retryAfter("10.0.0.1", "other"); d != 0 {
+		t.Fatalf("other username throttled for %v", d)
+	}
+	// Failures from one IP do not lock the account out for everyone else.
+	if d := th.retryAfter("10.0.0.2", "admin"); d != 0 {
+		t.Fatalf("admin throttled for %v from another IP", d)
+	}
+
+	th.succeed("10.0.0.1", "ADMIN")
+	if d := th.retryAfter("10.0.0.1", "admin"); d != 0 {
+		t.Fatalf("throttled for %v after a successful login", d)
+	}
+}
+
+func TestThrottleIPBackoff(t *testing.T) {
+	th, _ := newTestThrottle()
+	for i := 0; i < ipFreeAttempts; i++ {
+		// A different username every time, as in password spraying.
+		th.fail("10.0.0.1", string(rune('a'+i)))
+	}
+	if d := th.retryAfter("10.0.0.1", "fresh"); d != time.Second {
+		t.Fatalf("retryAfter = %v, want 1s", d)
+	}
+	if d := th.retryAfter("10.0.0.2", "fresh"); d != 0 {
+		t.Fatalf("other IP throttled for %v", d)
+	}
+
+	// Logging in to an account the sprayer owns does not reset the IP.
+	th.succeed("10.0.0.1", "a")
+	if d := th.retryAfter("10.0.0.1", "fresh"); d == 0 {
+		t.Fatal("IP no longer throttled after a successful login")
+	}
+}
+
+func TestThrottleCapAndForget(t *testing.T) {
+	th, now := newTestThrottle()
+	for i := 0; i < userFreeAttempts+30; i++ {
+		th.fail("10.0.0.1", "admin")
+	}
+	if d := th.retryAfter("10.0.0.1", "admin"); d != throttleMaxDelay {
+		t.Fatalf("retryAfter = %v, want the %v cap", d, throttleMaxDelay)
+	}
+
+	*now = now.Add(throttleForget + time.Second)
+	if d := th.retryAfter("10.0.0.1", "admin"); d != 0 {
+		t.Fatalf("throttled for %v after the failures were forgotten", d)
+	}
+	th.fail("10.0.0.1", "admin")
+	if d := th.retryAfter("10.0.0.1", "admin"); d != 0 {
+		t.Fatalf("one failure after forgetting throttled for %v", d)
+	}
+}
@@ -0,0 +1,439 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/apikey"
+	"github.com/technobecet/kaizoku-go/internal/ent/session"
+	"github.com/technobecet/kaizoku-go/internal/ent/user"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// SessionCookie is the name of the browser session cookie.
+	SessionCookie = "kaizoku_session"
+	// SessionTTL is how long a login session stays valid.
+	SessionTTL = 30 * 24 * time.Hour
+
+	minPasswordLen = 8
+)
+
+// User sources.
+const (
+	SourceLocal = "local"
+	SourceProxy = "proxy"
+	SourceOIDC  = "oidc"
+)
+
+// dummyHash is compared against when a username does not exist so that
+// failed logins take the same time whether or not the user exists.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("kaizoku-dummy-password"), bcrypt.DefaultCost)
+
+// UserUpdate describes optional changes to a user account.
+type UserUpdate struct {
+	Role     *string
+	Password *string
+	Disabled *bool
+}
+
+func hashPassword(password string) (string, error) {
+	if len(password) < minPasswordLen {
+		return "", ErrWeakPassword
+	}
+	h, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("hash password: %w", err)
+	}
+	return string(h), nil
+}
+
+// randomToken returns n random bytes encoded as URL-safe base64.
+func randomToken(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// ListUsers returns all users ordered by username.
+func (s *Service) ListUsers(ctx context.Context) ([]*ent.User, error) {
+	return s.db.User.Query().Order(ent.Asc(user.FieldUsername)).All(ctx)
+}
+
+// GetUser returns a single user by ID.
+func (s *Service) GetUser(ctx context.Context, id uuid.UUID) (*ent.User, error) {
+	return s.db.User.Get(ctx, id)
+}
+
+// CreateUser creates a local user with a password.
+func (s *Service) CreateUser(ctx context.Context, username, password, role string) (*ent.User, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, fmt.Errorf("username is required")
+	}
+	if !ValidRole(role) {
+		return nil, ErrInvalidRole
+	}
+	hash, err := hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	kosyncHash, err := hashKOSyncKey(password)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.db.User.Create().
+		SetUsername(username).
+		SetPasswordHash(hash).
+		SetKosyncHash(kosyncHash).
+		SetRole(role).
+		SetSource(SourceLocal).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrUsernameTaken
+		}
+		return nil, fmt.Errorf("create user: %w", err)
+	}
+	return u, nil
+}
+
+// UpdateUser applies role, password and disabled changes to a user. Disabling
+// a user or changing their password ends all of their sessions.
+func (s *Service) UpdateUser(ctx context.Context, id uuid.UUID, upd UserUpdate) (*ent.User, error) {
+	u, err := s.db.User.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	demoted := upd.Role != nil && *upd.Role != RoleAdmin
+	disabled := upd.Disabled != nil && *upd.Disabled
+	if u.Role == RoleAdmin && !u.Disabled && (demoted || disabled) {
+		if err := s.ensureOtherAdmin(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	q := s.db.User.UpdateOneID(id)
+	if upd.Role != nil {
+		if !ValidRole(*upd.Role) {
+			return nil, ErrInvalidRole
+		}
+		q = q.SetRole(*upd.Role)
+	}
+	if upd.Password != nil {
+		hash, err := hashPassword(*upd.Password)
+		if err != nil {
+			return nil, err
+		}
+		kosyncHash, err := hashKOSyncKey(*upd.Password)
+		if err != nil {
+			return nil, err
+		}
+		q = q.SetPasswordHash(hash).SetKosyncHash(kosyncHash)
+	}
+	if upd.Disabled != nil {
+		q = q.SetDisabled(*upd.Disabled)
+	}
+
+	u, err = q.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update user: %w", err)
+	}
+
+	if disabled || upd.Password != nil {
+		s.forgetBasicCredentials()
+		if _, err := s.db.Session.Delete().Where(session.UserID(id)).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("delete sessions: %w", err)
+		}
+	}
+	return u, nil
+}
+
+// DeleteUser removes a user, their sessions and revokes their API keys.
+func (s *Service) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	u, err := s.db.User.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if u.Role == RoleAdmin && !u.Disabled {
+		if err := s.ensureOtherAdmin(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.db.Session.Delete().Where(session.UserID(id)).Exec(ctx); err != nil {
+		return fmt.Errorf("delete sessions: %w", err)
+	}
+	if err := s.db.APIKey.Update().
+		Where(apikey.UserID(id), apikey.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("revoke api keys: %w", err)
+	}
+	return s.db.User.DeleteOneID(id).Exec(ctx)
+}
+
+// ChangePassword updates a local user's password after verifying the current one.
+func (s *Service) ChangePassword(ctx context.Context, id uuid.UUID, current, next string) error {
+	u, err := s.db.User.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if u.PasswordHash == "" || bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(current)) != nil {
+		return ErrUnauthorized
+	}
+	_, err = s.UpdateUser(ctx, id, UserUpdate{Password: &next})
+	return err
+}
+
+// ensureOtherAdmin returns ErrLastAdmin unless an enabled admin other than id exists.
+func (s *Service) ensureOtherAdmin(ctx context.Context, id uuid.UUID) error {
+	n, err := s.db.User.Query().
+		Where(user.Role(RoleAdmin), user.Disabled(false), user.IDNEQ(id)).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("count admins: %w", err)
+	}
+	if n == 0 {
+		return ErrLastAdmin
+	}
+	return nil
+}
+
+// Login verifies a username and password and starts a new session. It
+// returns the user and the session token to store in the cookie.
+func (s *Service) Login(ctx context.Context, username, password string) (*ent.User, string, error) {
+	u, err := s.checkPassword(ctx, username, password)
+	if err != nil {
+		return nil, "", err
+	}
+
+	token, err := s.StartSession(ctx, u.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	return u, token, nil
+}
+
+// checkPassword verifies a local user's password and returns the user.
+func (s *Service) checkPassword(ctx context.Context, username, password string) (*ent.User, error) {
+	u, err := s.db.User.Query().Where(user.Username(strings.TrimSpace(username))).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+			return nil, ErrUnauthorized
+		}
+		return nil, fmt.Errorf("lookup user: %w", err)
+	}
+	if u.Disabled || u.PasswordHash == "" ||
+		bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) != nil {
+		return nil, ErrUnauthorized
+	}
+	s.ensureKOSyncHash(ctx, u, password)
+	return u, nil
+}
+
+// StartSession creates a session for the user and returns its token.
+// Expired sessions are pruned opportunistically.
+func (s *Service) StartSession(ctx context.Context, userID uuid.UUID) (string, error) {
+	token, err := randomToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	if err := s.db.Session.Create().
+		SetTokenHash(HashKey(token)).
+		SetUserID(userID).
+		SetExpiresAt(now.Add(SessionTTL)).
+		Exec(ctx); err != nil {
+		return "", fmt.Errorf("create session: %w", err)
+	}
+	_ = s.db.User.UpdateOneID(userID).SetLastLoginAt(now).Exec(ctx)
+	_, _ = s.db.Session.Delete().Where(session.ExpiresAtLT(now)).Exec(ctx)
+
+	return token, nil
+}
+
+// Logout ends the session identified by token.
+func (s *Service) Logout(ctx context.Context, token string) error {
+	_, err := s.db.Session.Delete().Where(session.TokenHash(HashKey(token))).Exec(ctx)
+	return err
+}
+
+// AuthenticateSession resolves a session cookie value to a principal.
+func (s *Service) AuthenticateSession(ctx context.Context, token string) (*Principal, error) {
+	sess, err := s.db.Session.Query().
+		Where(session.TokenHash(HashKey(token)), session.ExpiresAtGT(time.Now())).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUnauthorized
+		}
+		return nil, fmt.Errorf("lookup session: %w", err)
+	}
+
+	u, err := s.db.User.Get(ctx, sess.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUnauthorized
+		}
+		return nil, fmt.Errorf("lookup session user: %w", err)
+	}
+	if u.Disabled {
+		return nil, ErrUnauthorized
+	}
+
+	return &Principal{
+		Kind:     KindSession,
+		ID:       sess.ID.String(),
+		Name:     u.Username,
+		UserID:   u.ID.String(),
+		Username: u.Username,
+		Role:     u.Role,
+	}, nil
+}
+
+// AuthenticateProxyUser resolves a username asserted by a trusted reverse
+// proxy to a principal. Unknown users are provisioned with defaultRole and
+// no password, so they can only sign in through the proxy.
+func (s *Service) AuthenticateProxyUser(ctx context.Context, username, defaultRole string) (*Principal, error) {
+	if username == "" {
+		return nil, ErrUnauthorized
+	}
+
+	u, err := s.db.User.Query().Where(user.Username(username)).Only(ctx)
+	if ent.IsNotFound(err) {
+		u, err = s.db.User.Create().
+			SetUsername(username).
+			SetRole(defaultRole).
+			SetSource(SourceProxy).
+			SetLastLoginAt(time.Now()).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			// Provisioned concurrently by another request
+			u, err = s.db.User.Query().Where(user.Username(username)).Only(ctx)
+		} else if err == nil {
+			log.Info().Str("username", username).Str("role", defaultRole).Msg("provisioned user from proxy header")
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("lookup proxy user: %w", err)
+	}
+	if u.Disabled {
+		return nil, ErrUnauthorized
+	}
+
+	return &Principal{
+		Kind:     KindProxy,
+		ID:       u.ID.String(),
+		Name:     u.Username,
+		UserID:   u.ID.String(),
+		Username: u.Username,
+		Role:     u.Role,
+	}, nil
+}
+
+// LoginExternal signs in a user authenticated by an external identity
+// provider and starts a session. The account is matched by (source,
+// externalID), created on first login, and its role is synced on every
+// login. A local or proxy account with the same username is never taken
+// over; ErrUsernameTaken is returned instead.
+func (s *Service) LoginExternal(ctx context.Context, source, externalID, username, role string) (*ent.User, string, error) {
+	if externalID == "" || username == "" || !ValidRole(role) {
+		return nil, "", ErrUnauthorized
+	}
+
+	u, err := s.db.User.Query().
+		Where(user.Source(source), user.ExternalID(externalID)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		u, err = s.db.User.Create().
+			SetUsername(username).
+			SetRole(role).
+			SetSource(source).
+			SetExternalID(externalID).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			return nil, "", ErrUsernameTaken
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("create user: %w", err)
+		}
+		log.Info().Str("username", username).Str("source", source).Str("role", role).Msg("provisioned external user")
+	case err != nil:
+		return nil, "", fmt.Errorf("lookup user: %w", err)
+	case u.Role != role:
+		u, err = s.db.User.UpdateOneID(u.ID).SetRole(role).Save(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("sync user role: %w", err)
+		}
+	}
+	if u.Disabled {
+		return nil, "", ErrUnauthorized
+	}
+
+	token, err := s.StartSession(ctx, u.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	return u, token, nil
+}
+
+// OpenAccessAllowed reports whether authentication may be turned off. That
+// is only allowed for installs upgraded from a version without
+// authentication: the database already holds a library but no users or API
+// keys. New installs and installs that have set up accounts always
+// authenticate.
+func (s *Service) OpenAccessAllowed(ctx context.Context) (bool, error) {
+	users, err := s.db.User.Query().Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check users: %w", err)
+	}
+	keys, err := s.db.APIKey.Query().Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check api keys: %w", err)
+	}
+	if users || keys {
+		return false, nil
+	}
+	library, err := s.db.Series.Query().Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check series: %w", err)
+	}
+	return library, nil
+}
+
+// BootstrapAdmin creates an "admin" user with a random password when no
+// users exist yet and returns the password. An empty string means users
+// were already configured.
+func (s *Service) BootstrapAdmin(ctx context.Context) (string, error) {
+	n, err := s.db.User.Query().Count(ctx)
+	if err != nil {
+		return "", fmt.Errorf("count users: %w", err)
+	}
+	if n > 0 {
+		return "", nil
+	}
+
+	password, err := randomToken(12)
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.CreateUser(ctx, "admin", password, RoleAdmin); err != nil {
+		return "", err
+	}
+	return password, nil
+}
@@ -0,0 +1,184 @@
+// Package cadence infers how often a series releases chapters from the
+// upload dates reported by its sources, and predicts the next release.
+package cadence
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// Cadence names.
+const (
+	// Unknown means there are too few dated releases to tell.
+	Unknown  = "unknown"
+	Daily    = "daily"
+	Weekly   = "weekly"
+	Biweekly = "biweekly"
+	Monthly  = "monthly"
+	// Regular is a steady schedule that is not one of the named ones; see
+	// Prediction.Interval.
+	Regular   = "regular"
+	Irregular = "irregular"
+	// Ended means the series is completed or cancelled.
+	Ended = "ended"
+)
+
+const (
+	day = 24 * time.Hour
+	// historyReleases is how many recent release days the cadence is
+	// inferred from, so that old schedule changes do not count.
+	historyReleases = 10
+	// minReleases is the fewest release days needed to infer a cadence.
+	minReleases = 4
+	// maxSpread is the largest median deviation between releases, as a
+	// share of the typical interval, for a schedule to count as steady.
+	maxSpread = 0.25
+	// maxUpcoming caps the predicted releases listed per series.
+	maxUpcoming = 60
+)
+
+// Prediction is the inferred release schedule of a series.
+type Prediction struct {
+	Cadence string
+	// Interval is the typical time between releases; zero when the cadence
+	// is unknown, irregular or ended.
+	Interval    time.Duration
+	LastRelease *time.Time
+	// NextRelease is the day the next chapter is expected; nil when the
+	// schedule cannot be predicted or the series is on hiatus.
+	NextRelease *time.Time
+	NextChapter *float64
+	// Overdue reports that NextRelease has passed without a new chapter.
+	Overdue bool
+}
+
+// Days returns Interval in whole days.
+func (p Prediction) Days() int {
+	return int(math.Round(p.Interval.Hours() / 24))
+}
+
+// Upcoming returns the predicted release days from NextRelease up to and
+// including until. An overdue series only lists its missed release.
+func (p Prediction) Upcoming(until time.Time) []time.Time {
+	if p.NextRelease == nil {
+		return nil
+	}
+	if p.Overdue {
+		return []time.Time{*p.NextRelease}
+	}
+	var out []time.Time
+	for t := *p.NextRelease; !t.After(until) && len(out) < maxUpcoming; t = t.Add(p.Interval) {
+		out = append(out, t)
+	}
+	return out
+}
+
+// Predict infers the release schedule of a series from the upload dates of
+// its chapters. Each chapter counts once, on the earliest date any source
+// uploaded it; several chapters released on the same day count as one
+// release.
+func Predict(status types.SeriesStatus, providers []*ent.SeriesProvider, now time.Time) Prediction {
+	released := make(map[float64]time.Time)
+	for _, p := range providers {
+		if p.IsUnknown {
+			continue
+		}
+		for _, ch := range p.Chapters {
+			if ch.Number == nil || ch.ProviderUploadDate == nil || ch.ProviderUploadDate.IsZero() {
+				continue
+			}
+			if t, ok := released[*ch.Number]; !ok || ch.ProviderUploadDate.Before(t) {
+				released[*ch.Number] = *ch.ProviderUploadDate
+			}
+		}
+	}
+
+	p := Prediction{Cadence: Unknown}
+	var maxChapter float64
+	seen := make(map[time.Time]bool)
+	var days []time.Time
+	for number, t := range released {
+		maxChapter = max(maxChapter, number)
+		d := t.UTC().Truncate(day)
+		if !seen[d] {
+			seen[d] = true
+			days = append(days, d)
+		}
+	}
+	if len(released) > 0 {
+		next := math.Floor(maxChapter) + 1
+		p.NextChapter = &next
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	if len(days) > 0 {
+		last := days[len(days)-1]
+		p.LastRelease = &last
+	}
+
+	switch status {
+	case types.SeriesStatusCompleted, types.SeriesStatusPublishingFinished, types.SeriesStatusCancelled:
+		p.Cadence = Ended
+		return p
+	}
+	if len(days) < minReleases {
+		return p
+	}
+	if len(days) > historyReleases {
+		days = days[len(days)-historyReleases:]
+	}
+
+	gaps := make([]float64, 0, len(days)-1)
+	for i := 1; i < len(days); i++ {
+		gaps = append(gaps, days[i].Sub(days[i-1]).Hours()/24)
+	}
+	typical := median(gaps)
+	deviations := make([]float64, len(gaps))
+	for i, g := range gaps {
+		deviations[i] = math.Abs(g - typical)
+	}
+	if median(deviations) > typical*maxSpread {
+		p.Cadence = Irregular
+		return p
+	}
+
+	p.Cadence, p.Interval = classify(typical)
+	if status == types.SeriesStatusOnHiatus {
+		return p
+	}
+
+	next := p.LastRelease.Add(p.Interval)
+	p.NextRelease = &next
+	grace := max(day, p.Interval/4)
+	p.Overdue = now.After(next.Add(grace))
+	return p
+}
+
+// classify names a typical interval between releases, in days.
+func classify(days float64) (string, time.Duration) {
+	switch {
+	case days < 1.5:
+		return Daily, day
+	case days >= 5 && days <= 9:
+		return Weekly, 7 * day
+	case days >= 12 && days <= 16:
+		return Biweekly, 14 * day
+	case days >= 26 && days <= 35:
+		return Monthly, time.Duration(math.Round(days)) * day
+	default:
+		return Regular, time.Duration(math.Round(days)) * day
+	}
+}
+
+func median(values []float64) float64 {
+	s := append([]float64(nil), values...)
+	sort.Float64s(s)
+	n := len(s)
+	if n%2 == 1 {
+		return s[n/2]
+	}
+	return (s[n/2-1] + s[n/2]) / 2
+}
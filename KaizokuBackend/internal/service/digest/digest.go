@@ -0,0 +1,306 @@
+// Package digest compiles the periodic email summary: chapters downloaded
+// since the previous digest grouped by series, chapters whose downloads
+// failed, and sources whose error rate was above the threshold.
+package digest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/downloadqueueitem"
+	"github.com/technobecet/kaizoku-go/internal/ent/series"
+	"github.com/technobecet/kaizoku-go/internal/ent/seriesprovider"
+	"github.com/technobecet/kaizoku-go/internal/ent/sourceevent"
+	"github.com/technobecet/kaizoku-go/internal/service/mail"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+const (
+	// maxCovers caps how many cover images are embedded in one email.
+	maxCovers = 30
+	// defaultErrorRate is used when the source error-rate event is disabled.
+	defaultErrorRate = 0.5
+)
+
+// Chapter is a downloaded chapter.
+type Chapter struct {
+	Number       *float64
+	Name         string
+	Provider     string
+	DownloadedAt time.Time
+}
+
+// Label returns "Chapter N" or the chapter name when it has no number.
+func (c Chapter) Label() string {
+	if c.Number != nil {
+		return "Chapter " + formatNumber(*c.Number)
+	}
+	if c.Name != "" {
+		return c.Name
+	}
+	return "Chapter"
+}
+
+// Series groups the new chapters of one series.
+type Series struct {
+	ID           uuid.UUID
+	Title        string
+	ThumbnailURL string
+	// CoverCID is the Content-ID of the embedded cover, if one was attached.
+	CoverCID string
+	Chapters []Chapter
+}
+
+// FailedChapter is a chapter whose download failed in the period.
+type FailedChapter struct {
+	SeriesTitle string
+	Provider    string
+	Number      *float64
+	FailedAt    time.Time
+}
+
+// Label returns "Chapter N", or "Chapter" when the number is unknown.
+func (f FailedChapter) Label() string {
+	if f.Number != nil {
+		return "Chapter " + formatNumber(*f.Number)
+	}
+	return "Chapter"
+}
+
+// Source is a source whose error rate was above the threshold.
+type Source struct {
+	Name       string
+	Language   string
+	Operations int
+	Failures   int
+	ErrorRate  float64
+}
+
+// Digest is the content of one digest email.
+type Digest struct {
+	Since   time.Time
+	Until   time.Time
+	Series  []*Series
+	Failed  []FailedChapter
+	Sources []Source
+}
+
+// Empty reports whether there is nothing to send.
+func (d *Digest) Empty() bool {
+	return len(d.Series) == 0 && len(d.Failed) == 0 && len(d.Sources) == 0
+}
+
+// ChapterCount returns the number of new chapters across all series.
+func (d *Digest) ChapterCount() int {
+	n := 0
+	for _, s := range d.Series {
+		n += len(s.Chapters)
+	}
+	return n
+}
+
+// Compile gathers everything that happened in [since, until).
+func Compile(ctx context.Context, db *ent.Client, since, until time.Time, ev config.EventsConfig) (*Digest, error) {
+	d := &Digest{Since: since, Until: until}
+	var err error
+	if d.Series, err = newChapters(ctx, db, since, until); err != nil {
+		return nil, err
+	}
+	if d.Failed, err = failedChapters(ctx, db, since, until); err != nil {
+		return nil, err
+	}
+	if d.Sources, err = degradedSources(ctx, db, since, until, ev); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// newChapters returns the chapters downloaded in the period, grouped by
+// series and sorted by title and chapter number.
+func newChapters(ctx context.Context, db *ent.Client, since, until time.Time) ([]*Series, error) {
+	providers, err := db.SeriesProvider.Query().
+		Where(seriesprovider.IsUnknownEQ(false)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query series providers: %w", err)
+	}
+
+	bySeries := make(map[uuid.UUID]*Series)
+	for _, sp := range providers {
+		for _, ch := range sp.Chapters {
+			if ch.DownloadDate == nil || ch.Filename == "" || ch.IsDeleted {
+				continue
+			}
+			if ch.DownloadDate.Before(since) || !ch.DownloadDate.Before(until) {
+				continue
+			}
+			s, ok := bySeries[sp.SeriesID]
+			if !ok {
+				s = &Series{ID: sp.SeriesID}
+				bySeries[sp.SeriesID] = s
+			}
+			s.Chapters = append(s.Chapters, Chapter{
+				Number:       ch.Number,
+				Name:         ch.Name,
+				Provider:     sp.Provider,
+				DownloadedAt: *ch.DownloadDate,
+			})
+		}
+	}
+	if len(bySeries) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(bySeries))
+	for id := range bySeries {
+		ids = append(ids, id)
+	}
+	rows, err := db.Series.Query().Where(series.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query series: %w", err)
+	}
+
+	result := make([]*Series, 0, len(rows))
+	for _, row := range rows {
+		s := bySeries[row.ID]
+		s.Title = row.Title
+		s.ThumbnailURL = row.ThumbnailURL
+		sort.Slice(s.Chapters, func(i, j int) bool {
+			a, b := s.Chapters[i].Number, s.Chapters[j].Number
+			if a == nil || b == nil {
+				return s.Chapters[i].DownloadedAt.Before(s.Chapters[j].DownloadedAt)
+			}
+			return *a < *b
+		})
+		result = append(result, s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return strings.ToLower(result[i].Title) < strings.ToLower(result[j].Title)
+	})
+	return result, nil
+}
+
+// failedChapters returns the failed downloads still in the error list,
+// one entry per series, provider and chapter.
+func failedChapters(ctx context.Context, db *ent.Client, since, until time.Time) ([]FailedChapter, error) {
+	items, err := db.DownloadQueueItem.Query().
+		Where(
+			downloadqueueitem.StatusEQ(types.DLStatusFailed),
+			downloadqueueitem.CompletedAtGTE(since),
+			downloadqueueitem.CompletedAtLT(until),
+		).
+		Order(ent.Asc(downloadqueueitem.FieldCompletedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query failed downloads: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var result []FailedChapter
+	for _, it := range items {
+		key := it.Args.SeriesID.String() + "|" + it.Args.ProviderName
+		if it.Args.ChapterNumber != nil {
+			key += "|" + formatNumber(*it.Args.ChapterNumber)
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, FailedChapter{
+			SeriesTitle: it.Args.Title,
+			Provider:    it.Args.ProviderName,
+			Number:      it.Args.ChapterNumber,
+			FailedAt:    *it.CompletedAt,
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return strings.ToLower(result[i].SeriesTitle) < strings.ToLower(result[j].SeriesTitle)
+	})
+	return result, nil
+}
+
+// degradedSources returns sources whose error rate over the period was at
+// or above the configured threshold, worst first.
+func degradedSources(ctx context.Context, db *ent.Client, since, until time.Time, ev config.EventsConfig) ([]Source, error) {
+	evts, err := db.SourceEvent.Query().
+		Where(sourceevent.CreatedAtGTE(since), sourceevent.CreatedAtLT(until)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query source events: %w", err)
+	}
+
+	bySource := make(map[string]*Source)
+	for _, e := range evts {
+		s, ok := bySource[e.SourceID]
+		if !ok {
+			s = &Source{Name: e.SourceName, Language: e.Language}
+			bySource[e.SourceID] = s
+		}
+		s.Operations++
+		if e.Status == "failed" {
+			s.Failures++
+		}
+	}
+
+	threshold := ev.SourceErrorRate
+	if threshold <= 0 {
+		threshold = defaultErrorRate
+	}
+	minEvents := max(ev.SourceErrorMinEvents, 1)
+
+	var result []Source
+	for _, s := range bySource {
+		if s.Operations < minEvents {
+			continue
+		}
+		s.ErrorRate = float64(s.Failures) / float64(s.Operations)
+		if s.ErrorRate >= threshold {
+			result = append(result, *s)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].ErrorRate != result[j].ErrorRate {
+			return result[i].ErrorRate > result[j].ErrorRate
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// CoverFetcher loads the cover image of a series from its thumbnail URL.
+type CoverFetcher func(ctx context.Context, thumbnailURL string) ([]byte, string, error)
+
+// AttachCovers fetches covers for up to maxCovers series, sets their
+// CoverCID and returns the images to embed. Series whose cover cannot be
+// loaded are shown without one.
+func AttachCovers(ctx context.Context, d *Digest, fetch CoverFetcher) []mail.InlineImage {
+	var images []mail.InlineImage
+	for _, s := range d.Series {
+		if len(images) >= maxCovers {
+			break
+		}
+		if s.ThumbnailURL == "" {
+			continue
+		}
+		data, contentType, err := fetch(ctx, s.ThumbnailURL)
+		if err != nil || len(data) == 0 {
+			continue
+		}
+		if contentType == "" {
+			contentType = "image/jpeg"
+		}
+		s.CoverCID = "cover-" + s.ID.String() + "@kaizoku"
+		images = append(images, mail.InlineImage{CID: s.CoverCID, ContentType: contentType, Data: data})
+	}
+	return images
+}
+
+func formatNumber(n float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", n), "0"), ".")
+}
@@ -0,0 +1,128 @@
+package digest
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+
+	"github.com/technobecet/kaizoku-go/internal/service/mail"
+)
+
+const dateLayout = "Jan 2, 2006"
+
+var funcs = map[string]any{
+	"date": func(d *Digest) string {
+		return d.Since.Local().Format(dateLayout) + " – " + d.Until.Local().Format(dateLayout)
+	},
+	// cid marks inline image references as safe; html/template would
+	// otherwise reject the cid: scheme.
+	"cid":     func(id string) htmltemplate.URL { return htmltemplate.URL("cid:" + id) },
+	"percent": func(f float64) string { return fmt.Sprintf("%.0f%%", f*100) },
+	"labels": func(chs []Chapter) string {
+		labels := make([]string, len(chs))
+		for i, ch := range chs {
+			labels[i] = ch.Label()
+		}
+		return strings.Join(labels, ", ")
+	},
+}
+
+var textTemplate = template.Must(template.New("text").Funcs(funcs).Parse(
+	`Kaizoku digest for {{date .}}
+{{if .Series}}
+New chapters ({{.ChapterCount}})
+{{range .Series}}
+  {{.Title}}: {{labels .Chapters}}
+{{- end}}
+{{end}}{{if .Failed}}
+Failed downloads ({{len .Failed}})
+{{range .Failed}}
+  {{.SeriesTitle}} {{.Label}} from {{.Provider}}
+{{- end}}
+{{end}}{{if .Sources}}
+Degraded sources ({{len .Sources}})
+{{range .Sources}}
+  {{.Name}}{{if .Language}} ({{.Language}}){{end}}: {{percent .ErrorRate}} of {{.Operations}} operations failed
+{{- end}}
+{{end}}`))
+
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("html").Funcs(funcs).Parse(`<!DOCTYPE html>
+<html>
+<body style="margin:0;padding:16px;font-family:Arial,Helvetica,sans-serif;color:#222;background:#f4f4f5">
+<div style="max-width:640px;margin:0 auto;background:#fff;border-radius:8px;padding:24px">
+<h1 style="font-size:20px;margin:0 0 4px">Kaizoku digest</h1>
+<p style="margin:0 0 24px;color:#666">{{date .}}</p>
+{{if .Series}}
+<h2 style="font-size:16px;border-bottom:1px solid #eee;padding-bottom:4px">New chapters ({{.ChapterCount}})</h2>
+<table style="width:100%;border-collapse:collapse">
+{{range .Series}}
+<tr>
+<td style="width:64px;padding:8px 8px 8px 0;vertical-align:top">{{if .CoverCID}}<img src="{{cid .CoverCID}}" alt="" width="56" style="display:block;border-radius:4px">{{end}}</td>
+<td style="padding:8px 0;vertical-align:top"><strong>{{.Title}}</strong><br><span style="color:#555">{{labels .Chapters}}</span></td>
+</tr>
+{{end}}
+</table>
+{{end}}
+{{if .Failed}}
+<h2 style="font-size:16px;border-bottom:1px solid #eee;padding-bottom:4px;color:#b91c1c">Failed downloads ({{len .Failed}})</h2>
+<ul style="padding-left:20px">
+{{range .Failed}}<li>{{.SeriesTitle}} {{.Label}} <span style="color:#666">from {{.Provider}}</span></li>
+{{end}}
+</ul>
+{{end}}
+{{if .Sources}}
+<h2 style="font-size:16px;border-bottom:1px solid #eee;padding-bottom:4px;color:#b45309">Degraded sources ({{len .Sources}})</h2>
+<ul style="padding-left:20px">
+{{range .Sources}}<li>{{.Name}}{{if .Language}} ({{.Language}}){{end}}: {{percent .ErrorRate}} of {{.Operations}} operations failed</li>
+{{end}}
+</ul>
+{{end}}
+</div>
+</body>
+</html>
+`))
+
+// Render builds the digest email. images are the covers returned by
+// AttachCovers.
+func Render(d *Digest, images []mail.InlineImage) (mail.Message, error) {
+	var text, html bytes.Buffer
+	if err := textTemplate.Execute(&text, d); err != nil {
+		return mail.Message{}, fmt.Errorf("render text digest: %w", err)
+	}
+	if err := htmlTemplate.Execute(&html, d); err != nil {
+		return mail.Message{}, fmt.Errorf("render html digest: %w", err)
+	}
+	return mail.Message{
+		Subject: subject(d),
+		Text:    text.String(),
+		HTML:    html.String(),
+		Inline:  images,
+	}, nil
+}
+
+// subject summarises the digest, e.g. "Kaizoku: 12 new chapters, 1 failed".
+func subject(d *Digest) string {
+	var parts []string
+	if n := d.ChapterCount(); n > 0 {
+		parts = append(parts, plural(n, "new chapter"))
+	}
+	if n := len(d.Failed); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d failed", n))
+	}
+	if n := len(d.Sources); n > 0 {
+		parts = append(parts, plural(n, "degraded source"))
+	}
+	if len(parts) == 0 {
+		return "Kaizoku: no new chapters"
+	}
+	return "Kaizoku: " + strings.Join(parts, ", ")
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
@@ -0,0 +1,77 @@
+package digest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/technobecet/kaizoku-go/internal/config"
+)
+
+// Schedule is a daily or weekly send time. It implements River's
+// PeriodicSchedule.
+type Schedule struct {
+	Weekly  bool
+	Weekday time.Weekday
+	Hour    int
+	Minute  int
+}
+
+// ParseSchedule reads the send time from the email configuration.
+func ParseSchedule(cfg config.EmailConfig) (Schedule, error) {
+	var s Schedule
+	switch strings.ToLower(cfg.Frequency) {
+	case "", "daily":
+	case "weekly":
+		s.Weekly = true
+		day, err := parseWeekday(cfg.Weekday)
+		if err != nil {
+			return Schedule{}, err
+		}
+		s.Weekday = day
+	default:
+		return Schedule{}, fmt.Errorf("email frequency must be daily or weekly, not %q", cfg.Frequency)
+	}
+
+	t, err := time.Parse("15:04", cfg.Time)
+	if err != nil {
+		return Schedule{}, fmt.Errorf("email time must be HH:MM, not %q", cfg.Time)
+	}
+	s.Hour, s.Minute = t.Hour(), t.Minute()
+	return s, nil
+}
+
+// Period is the time covered by one digest.
+func (s Schedule) Period() time.Duration {
+	if s.Weekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// Next returns the first send time after current, in server time.
+func (s Schedule) Next(current time.Time) time.Time {
+	local := current.Local()
+	next := time.Date(local.Year(), local.Month(), local.Day(), s.Hour, s.Minute, 0, 0, time.Local)
+	if s.Weekly {
+		next = next.AddDate(0, 0, (int(s.Weekday)-int(next.Weekday())+7)%7)
+	}
+	for !next.After(current) {
+		if s.Weekly {
+			next = next.AddDate(0, 0, 7)
+		} else {
+			next = next.AddDate(0, 0, 1)
+		}
+	}
+	return next
+}
+
+func parseWeekday(name string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		full := strings.ToLower(d.String())
+		if n := strings.ToLower(name); n == full || n == full[:3] {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("email weekday %q is not a day of the week", name)
+}
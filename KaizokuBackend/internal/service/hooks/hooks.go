@@ -0,0 +1,390 @@
+// Package hooks runs user-configured external commands on library events,
+// e.g. to sync downloaded chapters to other storage. Hooks are defined in
+// config.yaml only, never through the API, and every run is recorded with
+// the command's output.
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/hookrun"
+	"github.com/technobecet/kaizoku-go/internal/events"
+)
+
+// Run statuses.
+const (
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusTimedOut  = "timed_out"
+)
+
+// Failure policies.
+const (
+	PolicyIgnore = "ignore"
+	PolicyRetry  = "retry"
+	PolicyFail   = "fail"
+)
+
+const (
+	defaultTimeout    = 5 * time.Minute
+	defaultRetries    = 3
+	defaultRetryDelay = 30 * time.Second
+	// maxOutput is how much of a command's output is kept in the run log.
+	maxOutput = 64 << 10
+	// waitDelay bounds how long a killed command's children may keep its
+	// output open.
+	waitDelay = 5 * time.Second
+)
+
+// MaxRunTime caps how long one hook may take, retries included.
+const MaxRunTime = 20 * time.Minute
+
+// ErrFailed is returned by Run when a hook with the fail policy did not
+// succeed.
+var ErrFailed = errors.New("hook failed")
+
+// hookEvents lists the events hooks can run on.
+var hookEvents = []events.Type{events.ChapterDownloaded, events.SeriesAdded, events.SeriesDeleted}
+
+// Context describes what a hook runs for. The command receives it as JSON
+// on stdin and as KAIZOKU_* environment variables.
+type Context struct {
+	Event         events.Type `json:"event"`
+	SeriesID      string      `json:"seriesId"`
+	Title         string      `json:"title"`
+	SeriesPath    string      `json:"seriesPath,omitempty"`
+	CBZPath       string      `json:"cbzPath,omitempty"`
+	ChapterNumber *float64    `json:"chapterNumber,omitempty"`
+	ChapterName   string      `json:"chapterName,omitempty"`
+	Provider      string      `json:"provider,omitempty"`
+	Scanlator     string      `json:"scanlator,omitempty"`
+	Language      string      `json:"language,omitempty"`
+}
+
+// env returns the context as environment variables.
+func (c Context) env(hook string) []string {
+	vars := map[string]string{
+		"KAIZOKU_HOOK":           hook,
+		"KAIZOKU_EVENT":          string(c.Event),
+		"KAIZOKU_SERIES_ID":      c.SeriesID,
+		"KAIZOKU_SERIES_TITLE":   c.Title,
+		"KAIZOKU_SERIES_PATH":    c.SeriesPath,
+		"KAIZOKU_CBZ_PATH":       c.CBZPath,
+		"KAIZOKU_CHAPTER_NAME":   c.ChapterName,
+		"KAIZOKU_PROVIDER":       c.Provider,
+		"KAIZOKU_SCANLATOR":      c.Scanlator,
+		"KAIZOKU_LANGUAGE":       c.Language,
+		"KAIZOKU_CHAPTER_NUMBER": "",
+	}
+	if c.ChapterNumber != nil {
+		vars["KAIZOKU_CHAPTER_NUMBER"] = strconv.FormatFloat(*c.ChapterNumber, 'f', -1, 64)
+	}
+	out := make([]string, 0, len(vars))
+	for k, v := range vars {
+		out = append(out, k+"="+v)
+	}
+	return out
+}
+
+// Hook is a validated hook configuration.
+type Hook struct {
+	Name       string
+	Event      events.Type
+	Command    []string
+	Timeout    time.Duration
+	OnFailure  string
+	Retries    int
+	RetryDelay time.Duration
+}
+
+// Runner runs the configured hooks.
+type Runner struct {
+	db      *ent.Client
+	storage string
+	hooks   []Hook
+}
+
+// NewRunner validates the configured hooks. Invalid hooks are logged and
+// skipped so that a typo does not keep the server from starting.
+func NewRunner(db *ent.Client, cfg *config.Config) *Runner {
+	r := &Runner{db: db, storage: cfg.Storage.Folder}
+	for i, hc := range cfg.Hooks {
+		h, err := parseHook(hc)
+		if err != nil {
+			log.Warn().Err(err).Int("index", i).Str("hook", hc.Name).Msg("hooks: ignoring invalid hook")
+			continue
+		}
+		r.hooks = append(r.hooks, h)
+	}
+	if len(r.hooks) > 0 {
+		log.Info().Int("count", len(r.hooks)).Msg("hooks: script hooks configured")
+	}
+	return r
+}
+
+func parseHook(hc config.HookConfig) (Hook, error) {
+	h := Hook{
+		Name:       hc.Name,
+		Event:      events.Type(hc.Event),
+		Command:    hc.Command,
+		Timeout:    defaultTimeout,
+		OnFailure:  hc.OnFailure,
+		Retries:    hc.Retries,
+		RetryDelay: defaultRetryDelay,
+	}
+	if h.Name == "" {
+		return h, errors.New("name is required")
+	}
+	known := false
+	for _, t := range hookEvents {
+		known = known || t == h.Event
+	}
+	if !known {
+		return h, fmt.Errorf("unsupported event %q", hc.Event)
+	}
+	if len(h.Command) == 0 || h.Command[0] == "" {
+		return h, errors.New("command is required")
+	}
+	switch h.OnFailure {
+	case "":
+		h.OnFailure = PolicyIgnore
+	case PolicyIgnore, PolicyRetry, PolicyFail:
+	default:
+		return h, fmt.Errorf("unknown on_failure policy %q", hc.OnFailure)
+	}
+	if h.OnFailure == PolicyFail && h.Event != events.ChapterDownloaded {
+		return h, errors.New("on_failure fail only applies to chapter.downloaded hooks")
+	}
+	if hc.Timeout != "" {
+		d, err := time.ParseDuration(hc.Timeout)
+		if err != nil || d <= 0 {
+			return h, fmt.Errorf("invalid timeout %q", hc.Timeout)
+		}
+		h.Timeout = d
+	}
+	if hc.RetryDelay != "" {
+		d, err := time.ParseDuration(hc.RetryDelay)
+		if err != nil || d < 0 {
+			return h, fmt.Errorf("invalid retry_delay %q", hc.RetryDelay)
+		}
+		h.RetryDelay = d
+	}
+	if h.Retries <= 0 {
+		h.Retries = defaultRetries
+	}
+	return h, nil
+}
+
+// Hooks returns the configured hooks.
+func (r *Runner) Hooks() []Hook {
+	if r == nil {
+		return nil
+	}
+	return r.hooks
+}
+
+// RunBlocking runs the hooks for hc.Event that have the fail policy, in
+// order. The first failure stops the remaining hooks and returns an error
+// wrapping ErrFailed. Other hooks are not run; see Queued. A nil runner
+// does nothing.
+func (r *Runner) RunBlocking(ctx context.Context, hc Context) error {
+	if r == nil {
+		return nil
+	}
+	for _, h := range r.hooks {
+		if h.Event != hc.Event || h.OnFailure != PolicyFail {
+			continue
+		}
+		if err := r.runHook(ctx, h, hc); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrFailed, h.Name, err)
+		}
+	}
+	return nil
+}
+
+// Queued returns the names of the hooks for event that run in the
+// background, in order: every hook except those with the fail policy.
+func (r *Runner) Queued(event events.Type) []string {
+	if r == nil {
+		return nil
+	}
+	var names []string
+	for _, h := range r.hooks {
+		if h.Event == event && h.OnFailure != PolicyFail {
+			names = append(names, h.Name)
+		}
+	}
+	return names
+}
+
+// RunHook runs the named hook for hc, retrying it under the retry policy.
+// Failures are recorded in the run log; the error is only for logging.
+func (r *Runner) RunHook(ctx context.Context, name string, hc Context) error {
+	if r == nil {
+		return nil
+	}
+	for _, h := range r.hooks {
+		if h.Name == name && h.Event == hc.Event {
+			return r.runHook(ctx, h, hc)
+		}
+	}
+	return fmt.Errorf("hook %q is no longer configured", name)
+}
+
+// SeriesContext builds the hook context of a series.added or series.deleted
+// bus event. It reports false for other events.
+func (r *Runner) SeriesContext(e events.Event) (Context, bool) {
+	if r == nil || (e.Type != events.SeriesAdded && e.Type != events.SeriesDeleted) {
+		return Context{}, false
+	}
+	hc := Context{Event: e.Type}
+	hc.SeriesID, _ = e.Data["seriesId"].(string)
+	hc.Title, _ = e.Data["title"].(string)
+	if p, _ := e.Data["storagePath"].(string); p != "" {
+		hc.SeriesPath = filepath.Join(r.storage, p)
+	}
+	return hc, true
+}
+
+// runHook runs a hook, retrying it under the retry policy, for at most
+// MaxRunTime in total.
+func (r *Runner) runHook(ctx context.Context, h Hook, hc Context) error {
+	ctx, cancel := context.WithTimeout(ctx, MaxRunTime)
+	defer cancel()
+
+	attempts := 1
+	if h.OnFailure == PolicyRetry {
+		attempts += h.Retries
+	}
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("%w (last attempt: %v)", ctx.Err(), err)
+			case <-time.After(h.RetryDelay):
+			}
+		}
+		if err = r.runOnce(ctx, h, hc, attempt); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// runOnce runs the command once and records the outcome.
+func (r *Runner) runOnce(ctx context.Context, h Hook, hc Context, attempt int) error {
+	payload, err := json.Marshal(hc)
+	if err != nil {
+		return fmt.Errorf("encode context: %w", err)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, h.Timeout)
+	defer cancel()
+	cmd := exec.CommandContext(runCtx, h.Command[0], h.Command[1:]...)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Env = append(os.Environ(), hc.env(h.Name)...)
+	cmd.WaitDelay = waitDelay
+	out := &limitedBuffer{max: maxOutput}
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	start := time.Now()
+	err = cmd.Run()
+	duration := time.Since(start)
+
+	status := StatusSucceeded
+	var exitCode *int
+	if cmd.ProcessState != nil {
+		code := cmd.ProcessState.ExitCode()
+		exitCode = &code
+	}
+	switch {
+	case err == nil:
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		status = StatusTimedOut
+		err = fmt.Errorf("stopped at the %s limit for all attempts", MaxRunTime)
+	case errors.Is(runCtx.Err(), context.DeadlineExceeded):
+		status = StatusTimedOut
+		err = fmt.Errorf("timed out after %s", h.Timeout)
+	default:
+		status = StatusFailed
+	}
+
+	create := r.db.HookRun.Create().
+		SetHook(h.Name).
+		SetEvent(string(hc.Event)).
+		SetSeriesTitle(hc.Title).
+		SetNillableChapterNumber(hc.ChapterNumber).
+		SetAttempt(attempt).
+		SetStatus(status).
+		SetNillableExitCode(exitCode).
+		SetOutput(out.String()).
+		SetDurationMs(duration.Milliseconds())
+	if id, perr := uuid.Parse(hc.SeriesID); perr == nil {
+		create = create.SetSeriesID(id)
+	}
+	if err != nil {
+		create = create.SetError(cleanText(err.Error()))
+	}
+	if _, serr := create.Save(context.WithoutCancel(ctx)); serr != nil {
+		log.Warn().Err(serr).Str("hook", h.Name).Msg("hooks: failed to record run")
+	}
+	return err
+}
+
+// Prune deletes hook runs older than the cutoff.
+func (r *Runner) Prune(ctx context.Context, olderThan time.Time) (int, error) {
+	if r == nil {
+		return 0, nil
+	}
+	return r.db.HookRun.Delete().
+		Where(hookrun.CreatedAtLT(olderThan)).
+		Exec(ctx)
+}
+
+// limitedBuffer keeps the first max bytes written to it and discards the
+// rest, so a chatty command cannot exhaust memory.
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := b.max - b.buf.Len(); room < len(p) {
+		b.buf.Write(p[:max(room, 0)])
+		b.truncated = true
+	} else {
+		b.buf.Write(p)
+	}
+	return len(p), nil
+}
+
+func (b *limitedBuffer) String() string {
+	s := cleanText(b.buf.String())
+	if b.truncated {
+		s += "\n[output truncated]"
+	}
+	return s
+}
+
+// cleanText makes command output safe for a Postgres text column, which
+// rejects invalid UTF-8 and NUL bytes.
+func cleanText(s string) string {
+	return strings.ReplaceAll(strings.ToValidUTF8(s, ""), "\x00", "")
+}
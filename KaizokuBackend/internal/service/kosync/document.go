@@ -0,0 +1,246 @@
+package kosync
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/events"
+)
+
+// refreshInterval is how often Run rescans the library. Downloads are
+// indexed as they complete, so the rescan only picks up imported and
+// replaced chapters.
+const refreshInterval = time.Hour
+
+// Document is a downloaded chapter identified by a KOReader document hash.
+type Document struct {
+	SeriesID      uuid.UUID
+	ChapterNumber float64
+	Path          string
+}
+
+// fileStamp caches the partial MD5 of a file until its size or mtime
+// changes, along with the chapter it belongs to.
+type fileStamp struct {
+	size    int64
+	modTime time.Time
+	hash    string
+	doc     Document
+}
+
+// PartialMD5 computes KOReader's default document hash: the MD5 of 1 KiB
+// samples taken at offsets 0 and 1024·4^i for i = 0..10, stopping at the
+// end of the file.
+func PartialMD5(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	buf := make([]byte, 1024)
+	for i := -1; i <= 10; i++ {
+		var offset int64
+		if i >= 0 {
+			offset = 1024 << (2 * i)
+		}
+		n, err := f.ReadAt(buf, offset)
+		if n == 0 {
+			if err != nil && err != io.EOF {
+				return "", err
+			}
+			break
+		}
+		h.Write(buf[:n])
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// FilenameMD5 computes KOReader's alternative document hash, the MD5 of
+// the file name.
+func FilenameMD5(path string) string {
+	sum := md5.Sum([]byte(filepath.Base(path)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Resolve returns the chapter a document hash refers to, or nil if no
+// indexed chapter matches. It only consults the index, so unknown hashes
+// cost nothing; chapters are indexed as they are downloaded and by Run.
+func (s *Service) Resolve(hash string) *Document {
+	s.mu.Lock()
+	doc, ok := s.docs[strings.ToLower(hash)]
+	s.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return &doc
+}
+
+// Run indexes the library, then re-indexes it every refreshInterval to
+// pick up imported and replaced chapters, until ctx is cancelled.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.Index(ctx); err != nil && ctx.Err() == nil {
+			log.Warn().Err(err).Msg("kosync: failed to index library")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// HandleEvent keeps the index current between full scans. It is subscribed
+// to the event bus: downloaded chapters are hashed as they arrive and the
+// documents of deleted series are dropped.
+func (s *Service) HandleEvent(ctx context.Context, e events.Event) {
+	switch e.Type {
+	case events.ChapterDownloaded:
+		s.indexChapter(e.Data)
+	case events.SeriesDeleted:
+		idStr, _ := e.Data["seriesId"].(string)
+		if id, err := uuid.Parse(idStr); err == nil {
+			s.dropSeries(id)
+		}
+	}
+}
+
+// Index hashes every downloaded chapter. Files whose size and mtime are
+// unchanged reuse their previous hash, so rescans only read new files.
+func (s *Service) Index(ctx context.Context) error {
+	seriesList, err := s.db.Series.Query().WithProviders().All(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+
+	stamps := make(map[string]fileStamp)
+	for _, sr := range seriesList {
+		for _, p := range sr.Edges.Providers {
+			for _, ch := range p.Chapters {
+				if ch.Number == nil || ch.Filename == "" || ch.IsDeleted {
+					continue
+				}
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				path, ok := s.chapterPath(sr.StoragePath, ch.Filename)
+				if !ok {
+					continue
+				}
+				if st, ok := s.stamp(path, Document{SeriesID: sr.ID, ChapterNumber: *ch.Number, Path: path}); ok {
+					stamps[path] = st
+				}
+			}
+		}
+	}
+	// A chapter indexed from its download event may not have been recorded
+	// when the series were loaded; keep earlier entries while the file exists.
+	for path, st := range s.stamps {
+		if _, ok := stamps[path]; ok {
+			continue
+		}
+		if _, err := os.Stat(path); err == nil {
+			stamps[path] = st
+		}
+	}
+	s.stamps = stamps
+
+	docs := make(map[string]Document, 2*len(stamps))
+	for path, st := range stamps {
+		docs[st.hash] = st.doc
+		docs[FilenameMD5(path)] = st.doc
+	}
+	s.mu.Lock()
+	s.docs = docs
+	s.mu.Unlock()
+	return nil
+}
+
+// indexChapter hashes the chapter described by a chapter.downloaded event.
+func (s *Service) indexChapter(data map[string]interface{}) {
+	idStr, _ := data["seriesId"].(string)
+	storagePath, _ := data["storagePath"].(string)
+	filename, _ := data["filename"].(string)
+	number, _ := data["chapterNumber"].(*float64)
+	id, err := uuid.Parse(idStr)
+	if err != nil || number == nil || filename == "" {
+		return
+	}
+	path, ok := s.chapterPath(storagePath, filename)
+	if !ok {
+		return
+	}
+
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+	st, ok := s.stamp(path, Document{SeriesID: id, ChapterNumber: *number, Path: path})
+	if !ok {
+		return
+	}
+	s.stamps[path] = st
+	s.mu.Lock()
+	s.docs[st.hash] = st.doc
+	s.docs[FilenameMD5(path)] = st.doc
+	s.mu.Unlock()
+}
+
+// dropSeries removes a deleted series' chapters from the index.
+func (s *Service) dropSeries(id uuid.UUID) {
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+	for path, st := range s.stamps {
+		if st.doc.SeriesID == id {
+			delete(s.stamps, path)
+		}
+	}
+	s.mu.Lock()
+	for hash, doc := range s.docs {
+		if doc.SeriesID == id {
+			delete(s.docs, hash)
+		}
+	}
+	s.mu.Unlock()
+}
+
+// chapterPath returns the absolute path of a chapter file, refusing paths
+// that escape the storage folder.
+func (s *Service) chapterPath(storagePath, filename string) (string, bool) {
+	root := filepath.Clean(s.config.Storage.Folder)
+	path := filepath.Join(root, storagePath, filename)
+	return path, strings.HasPrefix(path, root+string(filepath.Separator))
+}
+
+// stamp returns the index entry for a chapter file, hashing it unless its
+// size and mtime match the previous entry. The caller holds indexMu.
+func (s *Service) stamp(path string, doc Document) (fileStamp, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}, false
+	}
+	st, ok := s.stamps[path]
+	if !ok || st.size != info.Size() || !st.modTime.Equal(info.ModTime()) {
+		hash, err := PartialMD5(path)
+		if err != nil {
+			log.Debug().Err(err).Str("path", path).Msg("kosync: failed to hash chapter")
+			return fileStamp{}, false
+		}
+		st = fileStamp{size: info.Size(), modTime: info.ModTime(), hash: hash}
+	}
+	st.doc = doc
+	return st, true
+}
@@ -0,0 +1,68 @@
+package kosync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/events"
+)
+
+func TestHandleEventIndexesDownloads(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "One Piece"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(root, "One Piece", "[MangaDex] One Piece 1100.cbz")
+	if err := os.WriteFile(path, []byte(strings.Repeat("cbz", 4096)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := PartialMD5(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// No database: a lookup must never fall back to scanning the library.
+	s := NewService(nil, &config.Config{Storage: config.StorageConfig{Folder: root}}, nil)
+	if doc := s.Resolve(hash); doc != nil {
+		t.Fatalf("Resolve before indexing = %+v", doc)
+	}
+
+	seriesID := uuid.New()
+	number := 1100.0
+	ctx := context.Background()
+	s.HandleEvent(ctx, events.Event{Type: events.ChapterDownloaded, Data: map[string]interface{}{
+		"seriesId":      seriesID.String(),
+		"storagePath":   "One Piece",
+		"filename":      filepath.Base(path),
+		"chapterNumber": &number,
+	}})
+	for _, h := range []string{hash, strings.ToUpper(hash), FilenameMD5(path)} {
+		doc := s.Resolve(h)
+		if doc == nil || doc.SeriesID != seriesID || doc.ChapterNumber != number || doc.Path != path {
+			t.Fatalf("Resolve(%s) = %+v", h, doc)
+		}
+	}
+
+	// Paths outside the storage folder are ignored.
+	s.HandleEvent(ctx, events.Event{Type: events.ChapterDownloaded, Data: map[string]interface{}{
+		"seriesId":      uuid.NewString(),
+		"storagePath":   "..",
+		"filename":      filepath.Base(root),
+		"chapterNumber": &number,
+	}})
+	if len(s.stamps) != 1 {
+		t.Fatalf("indexed %d files, want 1", len(s.stamps))
+	}
+
+	s.HandleEvent(ctx, events.Event{Type: events.SeriesDeleted, Data: map[string]interface{}{
+		"seriesId": seriesID.String(),
+	}})
+	if doc := s.Resolve(hash); doc != nil {
+		t.Fatalf("Resolve after the series was deleted = %+v", doc)
+	}
+}
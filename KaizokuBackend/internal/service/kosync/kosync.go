@@ -0,0 +1,177 @@
+// Package kosync implements the storage side of KOReader's progress sync
+// protocol. Documents are matched to downloaded chapters by their KOReader
+// hash, so progress synced from a device also updates Kaizoku's read state.
+package kosync
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/syncprogress"
+	"github.com/technobecet/kaizoku-go/internal/service/reading"
+	"github.com/technobecet/kaizoku-go/internal/util"
+)
+
+// kaizokuDevice is reported as the device for positions that come from
+// Kaizoku's own reader rather than a KOReader device.
+const kaizokuDevice = "Kaizoku"
+
+// Position is a reading position in the shape the kosync protocol uses.
+type Position struct {
+	Document   string
+	Progress   string
+	Percentage float64
+	Device     string
+	DeviceID   string
+	Timestamp  time.Time
+}
+
+// Service stores synced positions and maps them onto chapter read state.
+type Service struct {
+	db      *ent.Client
+	config  *config.Config
+	reading *reading.Service
+
+	// mu guards docs; indexMu serialises indexing and guards stamps.
+	mu      sync.Mutex
+	docs    map[string]Document
+	indexMu sync.Mutex
+	stamps  map[string]fileStamp
+}
+
+// NewService creates a new kosync service.
+func NewService(db *ent.Client, cfg *config.Config, rs *reading.Service) *Service {
+	return &Service{
+		db:      db,
+		config:  cfg,
+		reading: rs,
+		docs:    make(map[string]Document),
+		stamps:  make(map[string]fileStamp),
+	}
+}
+
+// GetProgress returns the user's latest position in a document, or nil if
+// there is none. When the document is a Kaizoku chapter that was read more
+// recently in Kaizoku's own reader, that position is returned instead, so
+// devices pick up where the browser left off.
+func (s *Service) GetProgress(ctx context.Context, userID uuid.UUID, document string) (*Position, error) {
+	document = strings.ToLower(document)
+
+	sp, err := s.db.SyncProgress.Query().
+		Where(syncprogress.UserID(userID), syncprogress.Document(document)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("load sync progress: %w", err)
+	}
+
+	var pos *Position
+	if sp != nil {
+		pos = &Position{
+			Document:   sp.Document,
+			Progress:   sp.Progress,
+			Percentage: sp.Percentage,
+			Device:     sp.Device,
+			DeviceID:   sp.DeviceID,
+			Timestamp:  sp.UpdatedAt,
+		}
+	}
+
+	doc := s.Resolve(document)
+	if doc == nil {
+		return pos, nil
+	}
+	rp, err := s.reading.ChapterProgress(ctx, userID, doc.SeriesID, doc.ChapterNumber)
+	if err != nil {
+		return nil, fmt.Errorf("load read progress: %w", err)
+	}
+	if rp == nil || (pos != nil && !rp.UpdatedAt.After(pos.Timestamp)) {
+		return pos, nil
+	}
+
+	pageCount := rp.PageCount
+	if pageCount == 0 {
+		pages, err := util.ListCBZPages(doc.Path)
+		if err != nil || len(pages) == 0 {
+			return pos, nil
+		}
+		pageCount = len(pages)
+	}
+	page := rp.Page
+	if rp.Completed && page < pageCount-1 {
+		page = pageCount - 1
+	}
+	return &Position{
+		Document:   document,
+		Progress:   strconv.Itoa(page + 1),
+		Percentage: float64(page+1) / float64(pageCount),
+		Device:     kaizokuDevice,
+		DeviceID:   kaizokuDevice,
+		Timestamp:  rp.UpdatedAt,
+	}, nil
+}
+
+// UpdateProgress stores a position reported by a device. If the document
+// is a Kaizoku chapter, the chapter's read progress is updated too; for
+// CBZ files KOReader reports the one-based page number as progress.
+func (s *Service) UpdateProgress(ctx context.Context, userID uuid.UUID, pos Position) (*Position, error) {
+	pos.Document = strings.ToLower(pos.Document)
+
+	if doc := s.Resolve(pos.Document); doc != nil {
+		s.updateReadState(ctx, userID, doc, pos)
+	}
+
+	// Saved after the read state so that it is the newer of the two and
+	// GetProgress returns the device's own position.
+	now := time.Now()
+	err := s.db.SyncProgress.Create().
+		SetUserID(userID).
+		SetDocument(pos.Document).
+		SetProgress(pos.Progress).
+		SetPercentage(pos.Percentage).
+		SetDevice(pos.Device).
+		SetDeviceID(pos.DeviceID).
+		SetUpdatedAt(now).
+		OnConflictColumns(syncprogress.FieldUserID, syncprogress.FieldDocument).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("save sync progress: %w", err)
+	}
+	pos.Timestamp = now
+	return &pos, nil
+}
+
+// updateReadState mirrors a synced position onto the chapter's read
+// progress. Failures are logged; the synced position is still stored.
+func (s *Service) updateReadState(ctx context.Context, userID uuid.UUID, doc *Document, pos Position) {
+	pages, err := util.ListCBZPages(doc.Path)
+	if err != nil || len(pages) == 0 {
+		log.Debug().Err(err).Str("path", doc.Path).Msg("kosync: failed to list chapter pages")
+		return
+	}
+	pageCount := len(pages)
+
+	page, err := strconv.Atoi(strings.TrimSpace(pos.Progress))
+	if err != nil {
+		page = int(math.Round(pos.Percentage * float64(pageCount)))
+	}
+	page = min(max(page-1, 0), pageCount-1)
+
+	_, err = s.reading.SetProgress(ctx, userID, doc.SeriesID, doc.ChapterNumber, reading.Progress{
+		Page:      page,
+		PageCount: pageCount,
+		Completed: pos.Percentage >= 1,
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("kosync: failed to update read progress")
+	}
+}
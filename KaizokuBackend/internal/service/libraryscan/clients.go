@@ -0,0 +1,133 @@
+package libraryscan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// komga triggers library scans through the Komga REST API. Komga cannot
+// scan a single folder, so each series path is resolved to the library
+// whose root contains it and every affected library is scanned once.
+type komga struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+type komgaLibrary struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Root string `json:"root"`
+}
+
+func (k *komga) scan(ctx context.Context, paths []string) error {
+	var libs []komgaLibrary
+	if err := k.do(ctx, http.MethodGet, "/api/v1/libraries", &libs); err != nil {
+		return fmt.Errorf("list libraries: %w", err)
+	}
+
+	ids := make(map[string]bool)
+	var order, missing []string
+	for _, p := range paths {
+		lib := libraryFor(libs, p)
+		if lib == nil {
+			missing = append(missing, p)
+			continue
+		}
+		if !ids[lib.ID] {
+			ids[lib.ID] = true
+			order = append(order, lib.ID)
+		}
+	}
+	for _, id := range order {
+		if err := k.do(ctx, http.MethodPost, "/api/v1/libraries/"+id+"/scan", nil); err != nil {
+			return fmt.Errorf("scan library %s: %w", id, err)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("no library contains %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// libraryFor returns the library with the longest root that contains p.
+func libraryFor(libs []komgaLibrary, p string) *komgaLibrary {
+	p = normalizePath(p)
+	var best *komgaLibrary
+	for i := range libs {
+		root := normalizePath(libs[i].Root)
+		if p != root && !strings.HasPrefix(p, strings.TrimSuffix(root, "/")+"/") {
+			continue
+		}
+		if best == nil || len(root) > len(normalizePath(best.Root)) {
+			best = &libs[i]
+		}
+	}
+	return best
+}
+
+func (k *komga) do(ctx context.Context, method, endpoint string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(k.baseURL, "/")+endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-API-Key", k.apiKey)
+	return doRequest(k.client, req, out)
+}
+
+// kavita triggers folder scans through Kavita's scan-folder endpoint, which
+// authenticates with the API key in the body and scans only the series
+// folder that changed.
+type kavita struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+func (k *kavita) scan(ctx context.Context, paths []string) error {
+	for _, p := range paths {
+		body, _ := json.Marshal(map[string]string{"apiKey": k.apiKey, "folderPath": p})
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+			strings.TrimSuffix(k.baseURL, "/")+"/api/Library/scan-folder", bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if err := doRequest(k.client, req, nil); err != nil {
+			return fmt.Errorf("scan folder %s: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// doRequest sends req and decodes a JSON response into out when non-nil.
+// Any non-2xx status is returned as an error including a short body excerpt.
+func doRequest(client *http.Client, req *http.Request, out interface{}) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s %s: status %d: %s", req.Method, req.URL.Path, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// normalizePath cleans p using forward slashes so Windows-style and Unix
+// library roots compare the same way.
+func normalizePath(p string) string {
+	return path.Clean(strings.ReplaceAll(p, `\`, "/"))
+}
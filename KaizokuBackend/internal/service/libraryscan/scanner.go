@@ -0,0 +1,170 @@
+// Package libraryscan tells Komga and Kavita to rescan when new chapters
+// are written. Notifications are debounced: paths are collected until no
+// new download has arrived for the configured delay, then each enabled
+// server is asked to scan once for the whole batch.
+package libraryscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+const (
+	// requestTimeout bounds a single call to a media server.
+	requestTimeout = 30 * time.Second
+	// defaultDelay is used when the configured delay is missing or invalid.
+	defaultDelay = 30 * time.Second
+	// maxBatchWait caps how long a steady stream of downloads can postpone
+	// a scan, so a long queue still becomes visible while it runs.
+	maxBatchWait = 10 * time.Minute
+)
+
+// SettingsReader provides the UI-configured settings.
+type SettingsReader interface {
+	Get(ctx context.Context) (*types.Settings, error)
+}
+
+// Scanner batches series paths and triggers media-server scans.
+type Scanner struct {
+	settings      SettingsReader
+	storageFolder string
+	client        *http.Client
+
+	mu      sync.Mutex
+	pending map[string]struct{}
+	first   time.Time
+	timer   *time.Timer
+}
+
+// NewScanner creates a scanner for series stored under storageFolder. A nil
+// client uses a client with a per-request timeout.
+func NewScanner(settings SettingsReader, storageFolder string, client *http.Client) *Scanner {
+	if client == nil {
+		client = &http.Client{Timeout: requestTimeout}
+	}
+	return &Scanner{
+		settings:      settings,
+		storageFolder: storageFolder,
+		client:        client,
+		pending:       make(map[string]struct{}),
+	}
+}
+
+// Notify records that a chapter was written to the series folder
+// storagePath (relative to the storage folder) and (re)starts the debounce
+// timer. It is a no-op when no media server is enabled.
+func (s *Scanner) Notify(ctx context.Context, storagePath string) {
+	if s == nil || storagePath == "" {
+		return
+	}
+	st, err := s.settings.Get(ctx)
+	if err != nil || (!st.KomgaEnabled && !st.KavitaEnabled) {
+		return
+	}
+	delay := parseDelay(st.LibraryScanDelay)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[storagePath] = struct{}{}
+	if s.timer == nil {
+		s.first = time.Now()
+		s.timer = time.AfterFunc(delay, s.flush)
+		return
+	}
+	if time.Since(s.first)+delay <= maxBatchWait {
+		s.timer.Reset(delay)
+	}
+}
+
+// flush scans everything collected since the last flush.
+func (s *Scanner) flush() {
+	s.mu.Lock()
+	paths := make([]string, 0, len(s.pending))
+	for p := range s.pending {
+		paths = append(paths, p)
+	}
+	s.pending = make(map[string]struct{})
+	s.timer = nil
+	s.mu.Unlock()
+
+	if len(paths) == 0 {
+		return
+	}
+	sort.Strings(paths)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	if err := s.Scan(ctx, paths); err != nil {
+		log.Warn().Err(err).Int("series", len(paths)).Msg("library scan trigger failed")
+		return
+	}
+	log.Info().Int("series", len(paths)).Msg("library scan triggered")
+}
+
+// Scan immediately asks every enabled media server to pick up the given
+// series folders. Errors from one server do not prevent the other from
+// being called.
+func (s *Scanner) Scan(ctx context.Context, storagePaths []string) error {
+	st, err := s.settings.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("load settings: %w", err)
+	}
+
+	var errs []error
+	if st.KomgaEnabled {
+		k := &komga{baseURL: st.KomgaURL, apiKey: st.KomgaAPIKey, client: s.client}
+		if err := k.scan(ctx, s.remotePaths(st.KomgaLibraryPath, storagePaths)); err != nil {
+			errs = append(errs, fmt.Errorf("komga: %w", err))
+		}
+	}
+	if st.KavitaEnabled {
+		k := &kavita{baseURL: st.KavitaURL, apiKey: st.KavitaAPIKey, client: s.client}
+		if err := k.scan(ctx, s.remotePaths(st.KavitaLibraryPath, storagePaths)); err != nil {
+			errs = append(errs, fmt.Errorf("kavita: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// remotePaths maps series folders to the paths the media server sees. An
+// empty root means the server shares Kaizoku's view of the storage folder;
+// otherwise root replaces the storage folder (e.g. a different container
+// mount point).
+func (s *Scanner) remotePaths(root string, storagePaths []string) []string {
+	out := make([]string, 0, len(storagePaths))
+	for _, p := range storagePaths {
+		if root == "" {
+			out = append(out, filepath.Join(s.storageFolder, p))
+		} else {
+			out = append(out, path.Join(root, filepath.ToSlash(p)))
+		}
+	}
+	return out
+}
+
+// parseDelay parses the "HH:MM:SS" scan delay.
+func parseDelay(v string) time.Duration {
+	parts := strings.Split(v, ":")
+	if len(parts) != 3 {
+		return defaultDelay
+	}
+	h, err1 := strconv.Atoi(parts[0])
+	m, err2 := strconv.Atoi(parts[1])
+	sec, err3 := strconv.Atoi(parts[2])
+	if err1 != nil || err2 != nil || err3 != nil {
+		return defaultDelay
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec)*time.Second
+}
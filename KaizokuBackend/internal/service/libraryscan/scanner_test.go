@@ -0,0 +1,227 @@
+package libraryscan
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+type staticSettings struct{ st types.Settings }
+
+func (s staticSettings) Get(context.Context) (*types.Settings, error) { return &s.st, nil }
+
+// mediaServer is a stub Komga or Kavita server that records the scans it
+// was asked for.
+type mediaServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	scans []string
+}
+
+func (m *mediaServer) record(s string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.scans = append(m.scans, s)
+}
+
+func (m *mediaServer) recorded() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.scans...)
+}
+
+func newKomga(t *testing.T, apiKey string, libs []komgaLibrary) *mediaServer {
+	m := &mediaServer{}
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != apiKey {
+			http.Error(w, `{"error":"Unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/libraries":
+			json.NewEncoder(w).Encode(libs)
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/api/v1/libraries/") && strings.HasSuffix(r.URL.Path, "/scan"):
+			m.record(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/libraries/"), "/scan"))
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(m.Close)
+	return m
+}
+
+func newKavita(t *testing.T, apiKey string) *mediaServer {
+	m := &mediaServer{}
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			APIKey     string `json:"apiKey"`
+			FolderPath string `json:"folderPath"`
+		}
+		if r.Method != http.MethodPost || r.URL.Path != "/api/Library/scan-folder" {
+			http.NotFound(w, r)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.APIKey != apiKey {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		m.record(body.FolderPath)
+	}))
+	t.Cleanup(m.Close)
+	return m
+}
+
+func TestScanKomga(t *testing.T) {
+	komga := newKomga(t, "komga-key", []komgaLibrary{
+		{ID: "lib-manga", Name: "Manga", Root: "/data/manga"},
+		{ID: "lib-manhwa", Name: "Manhwa", Root: "/data/manga/manhwa"},
+		{ID: "lib-comics", Name: "Comics", Root: "/data/comics"},
+	})
+	s := NewScanner(staticSettings{types.Settings{
+		KomgaEnabled:     true,
+		KomgaURL:         komga.URL + "/",
+		KomgaAPIKey:      "komga-key",
+		KomgaLibraryPath: "/data/manga",
+	}}, "/storage", nil)
+
+	err := s.Scan(context.Background(), []string{"One Piece", "Berserk", "manhwa/Solo Leveling"})
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	// Both series in the Manga library trigger a single scan; the nested
+	// library wins for the series under its root.
+	if got := strings.Join(komga.recorded(), ","); got != "lib-manga,lib-manhwa" {
+		t.Fatalf("scanned libraries = %s", got)
+	}
+}
+
+func TestScanKomgaNoLibrary(t *testing.T) {
+	komga := newKomga(t, "komga-key", []komgaLibrary{{ID: "lib-manga", Root: "/data/manga"}})
+	s := NewScanner(staticSettings{types.Settings{
+		KomgaEnabled: true,
+		KomgaURL:     komga.URL,
+		KomgaAPIKey:  "komga-key",
+	}}, "/elsewhere", nil)
+
+	err := s.Scan(context.Background(), []string{"One Piece"})
+	if err == nil || !strings.Contains(err.Error(), "no library contains /elsewhere/One Piece") {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestScanKavita(t *testing.T) {
+	kavita := newKavita(t, "kavita-key")
+	s := NewScanner(staticSettings{types.Settings{
+		KavitaEnabled: true,
+		KavitaURL:     kavita.URL,
+		KavitaAPIKey:  "kavita-key",
+	}}, "/storage", nil)
+
+	if err := s.Scan(context.Background(), []string{"Berserk", "One Piece"}); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if got := strings.Join(kavita.recorded(), ","); got != "/storage/Berserk,/storage/One Piece" {
+		t.Fatalf("scanned folders = %s", got)
+	}
+}
+
+func TestScanAuthFailure(t *testing.T) {
+	komga := newKomga(t, "komga-key", nil)
+	kavita := newKavita(t, "kavita-key")
+	s := NewScanner(staticSettings{types.Settings{
+		KomgaEnabled:  true,
+		KomgaURL:      komga.URL,
+		KomgaAPIKey:   "wrong",
+		KavitaEnabled: true,
+		KavitaURL:     kavita.URL,
+		KavitaAPIKey:  "wrong",
+	}}, "/storage", nil)
+
+	err := s.Scan(context.Background(), []string{"One Piece"})
+	if err == nil {
+		t.Fatal("Scan with a wrong API key succeeded")
+	}
+	// One server failing does not stop the other from being called, and
+	// both errors carry the status code.
+	for _, want := range []string{"komga: list libraries: GET /api/v1/libraries: status 401", "kavita: scan folder /storage/One Piece: POST /api/Library/scan-folder: status 401"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("err = %v, want it to contain %q", err, want)
+		}
+	}
+	if len(komga.recorded())+len(kavita.recorded()) != 0 {
+		t.Fatal("scan recorded despite authentication failure")
+	}
+}
+
+func TestScanTimeout(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(slow.Close)
+	t.Cleanup(func() { close(release) })
+
+	s := NewScanner(staticSettings{types.Settings{
+		KomgaEnabled:  true,
+		KomgaURL:      slow.URL,
+		KavitaEnabled: true,
+		KavitaURL:     slow.URL,
+	}}, "/storage", &http.Client{Timeout: 100 * time.Millisecond})
+
+	start := time.Now()
+	err := s.Scan(context.Background(), []string{"One Piece"})
+	if err == nil || !strings.Contains(err.Error(), "komga") || !strings.Contains(err.Error(), "kavita") {
+		t.Fatalf("err = %v, want both servers to time out", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Scan returned after %v", elapsed)
+	}
+}
+
+func TestNotifyBatches(t *testing.T) {
+	kavita := newKavita(t, "kavita-key")
+	s := NewScanner(staticSettings{types.Settings{
+		KavitaEnabled:    true,
+		KavitaURL:        kavita.URL,
+		KavitaAPIKey:     "kavita-key",
+		LibraryScanDelay: "00:00:01",
+	}}, "/storage", nil)
+
+	s.Notify(context.Background(), "One Piece")
+	s.Notify(context.Background(), "Berserk")
+	s.Notify(context.Background(), "One Piece")
+	if got := kavita.recorded(); len(got) != 0 {
+		t.Fatalf("scanned before the delay: %v", got)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(kavita.recorded()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := strings.Join(kavita.recorded(), ","); got != "/storage/Berserk,/storage/One Piece" {
+		t.Fatalf("scanned folders = %s", got)
+	}
+}
+
+func TestParseDelay(t *testing.T) {
+	for in, want := range map[string]time.Duration{
+		"00:00:30": 30 * time.Second,
+		"01:02:03": time.Hour + 2*time.Minute + 3*time.Second,
+		"":         defaultDelay,
+		"5m":       defaultDelay,
+		"aa:00:00": defaultDelay,
+	} {
+		if got := parseDelay(in); got != want {
+			t.Errorf("parseDelay(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
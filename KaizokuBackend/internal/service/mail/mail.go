@@ -0,0 +1,254 @@
+// Package mail builds MIME messages and sends them over SMTP. It supports
+// implicit TLS, STARTTLS and plain connections, so it works with hosted
+// providers as well as local catchers such as MailHog.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/technobecet/kaizoku-go/internal/config"
+)
+
+const (
+	// dialTimeout bounds connecting to the SMTP server.
+	dialTimeout = 30 * time.Second
+	// sendTimeout bounds the whole SMTP conversation when ctx has no
+	// earlier deadline, so a server that stops responding cannot stall
+	// the caller.
+	sendTimeout = 2 * time.Minute
+)
+
+// InlineImage is an image referenced from the HTML body as cid:<CID>.
+type InlineImage struct {
+	CID         string
+	ContentType string
+	Data        []byte
+}
+
+// Message is an email with a plain-text and an HTML body.
+type Message struct {
+	Subject string
+	Text    string
+	HTML    string
+	Inline  []InlineImage
+}
+
+// Validate checks that the email configuration can be used to send mail.
+func Validate(cfg config.EmailConfig) error {
+	switch {
+	case cfg.Host == "":
+		return errors.New("email host is required")
+	case cfg.Port <= 0:
+		return errors.New("email port is required")
+	case cfg.From == "":
+		return errors.New("email sender is required")
+	case len(cfg.To) == 0:
+		return errors.New("at least one email recipient is required")
+	}
+	switch cfg.Security {
+	case "starttls", "tls", "none":
+	default:
+		return fmt.Errorf("email security must be starttls, tls or none, not %q", cfg.Security)
+	}
+	return nil
+}
+
+// Send delivers m to the configured recipients.
+func Send(ctx context.Context, cfg config.EmailConfig, m Message) error {
+	if err := Validate(cfg); err != nil {
+		return err
+	}
+	body, err := Build(cfg.From, cfg.To, m, time.Now())
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	tlsConfig := &tls.Config{ServerName: cfg.Host}
+
+	var conn net.Conn
+	if cfg.Security == "tls" {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("connect to %s: %w", addr, err)
+	}
+	deadline := time.Now().Add(sendTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer c.Close()
+
+	if cfg.Security == "starttls" {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return errors.New("server does not support STARTTLS")
+		}
+		if err := c.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("starttls: %w", err)
+		}
+	}
+	if cfg.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err := c.Mail(addressOf(cfg.From)); err != nil {
+		return fmt.Errorf("smtp MAIL FROM: %w", err)
+	}
+	for _, to := range cfg.To {
+		if err := c.Rcpt(addressOf(to)); err != nil {
+			return fmt.Errorf("smtp RCPT TO %s: %w", to, err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA: %w", err)
+	}
+	if _, err := w.Write(body); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("send message: %w", err)
+	}
+	return c.Quit()
+}
+
+// addressOf extracts the bare address from "Name <addr>" forms.
+func addressOf(s string) string {
+	if i := strings.LastIndex(s, "<"); i >= 0 {
+		if j := strings.LastIndex(s, ">"); j > i {
+			return s[i+1 : j]
+		}
+	}
+	return strings.TrimSpace(s)
+}
+
+// Build renders m as a MIME message. Without inline images the body is
+// multipart/alternative; with them it is wrapped in multipart/related so
+// the HTML part can reference the images by Content-ID.
+func Build(from string, to []string, m Message, now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(k, v string) { fmt.Fprintf(&buf, "%s: %s\r\n", k, v) }
+
+	header("From", from)
+	header("To", strings.Join(to, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", "<"+randomID()+"@kaizoku>")
+	header("MIME-Version", "1.0")
+
+	alt := &bytes.Buffer{}
+	altWriter := multipart.NewWriter(alt)
+	if err := writeText(altWriter, "text/plain; charset=utf-8", m.Text); err != nil {
+		return nil, err
+	}
+	if err := writeText(altWriter, "text/html; charset=utf-8", m.HTML); err != nil {
+		return nil, err
+	}
+	if err := altWriter.Close(); err != nil {
+		return nil, err
+	}
+	altType := "multipart/alternative; boundary=" + altWriter.Boundary()
+
+	if len(m.Inline) == 0 {
+		header("Content-Type", altType)
+		buf.WriteString("\r\n")
+		buf.Write(alt.Bytes())
+		return buf.Bytes(), nil
+	}
+
+	related := multipart.NewWriter(&buf)
+	header("Content-Type", `multipart/related; type="multipart/alternative"; boundary=`+related.Boundary())
+	buf.WriteString("\r\n")
+
+	part, err := related.CreatePart(textproto.MIMEHeader{"Content-Type": {altType}})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(alt.Bytes()); err != nil {
+		return nil, err
+	}
+	for _, img := range m.Inline {
+		part, err := related.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {img.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-ID":                {"<" + img.CID + ">"},
+			"Content-Disposition":       {"inline"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeBase64(part, img.Data); err != nil {
+			return nil, err
+		}
+	}
+	if err := related.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeText adds a quoted-printable text part.
+func writeText(w *multipart.Writer, contentType, text string) error {
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write([]byte(text)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// writeBase64 writes data as base64 in 76-character lines.
+func writeBase64(w io.Writer, data []byte) error {
+	enc := base64.StdEncoding.EncodeToString(data)
+	for len(enc) > 76 {
+		if _, err := w.Write([]byte(enc[:76] + "\r\n")); err != nil {
+			return err
+		}
+		enc = enc[76:]
+	}
+	_, err := w.Write([]byte(enc + "\r\n"))
+	return err
+}
+
+func randomID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
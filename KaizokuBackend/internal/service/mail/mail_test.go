@@ -0,0 +1,248 @@
+package mail
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/technobecet/kaizoku-go/internal/config"
+)
+
+// smtpStub is an in-process SMTP server. It accepts AUTH PLAIN for one
+// username and password and hands every delivered message to the test.
+// With silent set it accepts connections but never sends a greeting.
+type smtpStub struct {
+	ln       net.Listener
+	user     string
+	pass     string
+	silent   bool
+	messages chan delivery
+}
+
+type delivery struct {
+	from string
+	to   []string
+	data string
+}
+
+func newSMTPStub(t *testing.T, user, pass string, silent bool) *smtpStub {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &smtpStub{ln: ln, user: user, pass: pass, silent: silent, messages: make(chan delivery, 4)}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			nc, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(nc)
+		}
+	}()
+	return s
+}
+
+func (s *smtpStub) config() config.EmailConfig {
+	host, port, _ := net.SplitHostPort(s.ln.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return config.EmailConfig{
+		Host:     host,
+		Port:     p,
+		Username: s.user,
+		Password: s.pass,
+		Security: "none",
+		From:     "Kaizoku <kaizoku@example.com>",
+		To:       []string{"reader@example.com", "Other <other@example.com>"},
+	}
+}
+
+func (s *smtpStub) serve(nc net.Conn) {
+	defer nc.Close()
+	if s.silent {
+		_, _ = io.Copy(io.Discard, nc)
+		return
+	}
+	tc := textproto.NewConn(nc)
+	_ = tc.PrintfLine("220 stub ESMTP")
+	var d delivery
+	for {
+		line, err := tc.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			_ = tc.PrintfLine("250-stub\r\n250 AUTH PLAIN")
+		case "AUTH":
+			_, resp, _ := strings.Cut(arg, " ")
+			creds, _ := base64.StdEncoding.DecodeString(resp)
+			if string(creds) != "\x00"+s.user+"\x00"+s.pass {
+				_ = tc.PrintfLine("535 5.7.8 authentication failed")
+				continue
+			}
+			_ = tc.PrintfLine("235 2.7.0 authenticated")
+		case "MAIL":
+			d = delivery{from: strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")}
+			_ = tc.PrintfLine("250 OK")
+		case "RCPT":
+			d.to = append(d.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			_ = tc.PrintfLine("250 OK")
+		case "DATA":
+			_ = tc.PrintfLine("354 go ahead")
+			data, err := tc.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			d.data = string(data)
+			s.messages <- d
+			_ = tc.PrintfLine("250 queued")
+		case "QUIT":
+			_ = tc.PrintfLine("221 bye")
+			return
+		default:
+			_ = tc.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestSend(t *testing.T) {
+	s := newSMTPStub(t, "kaizoku", "secret", false)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := Send(ctx, s.config(), Message{
+		Subject: "Kaizoku: 2 new chapters",
+		Text:    "One Piece: Ch. 1100",
+		HTML:    `<p>One Piece</p><img src="cid:cover-1">`,
+		Inline:  []InlineImage{{CID: "cover-1", ContentType: "image/png", Data: []byte("png-bytes")}},
+	})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	var d delivery
+	select {
+	case d = <-s.messages:
+	default:
+		t.Fatal("no message delivered")
+	}
+	if d.from != "kaizoku@example.com" || strings.Join(d.to, ",") != "reader@example.com,other@example.com" {
+		t.Errorf("envelope from %q to %v", d.from, d.to)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(d.data))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	if subj, _ := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject")); subj != "Kaizoku: 2 new chapters" {
+		t.Errorf("subject = %q", subj)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/related" {
+		t.Fatalf("content type = %q, %v", mediaType, err)
+	}
+	var types []string
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+		ct, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		types = append(types, ct)
+		if ct == "image/png" {
+			data, _ := io.ReadAll(part)
+			img, _ := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(data), "\r\n", ""))
+			if part.Header.Get("Content-ID") != "<cover-1>" || string(img) != "png-bytes" {
+				t.Errorf("inline image %q = %q", part.Header.Get("Content-ID"), img)
+			}
+		}
+	}
+	if strings.Join(types, ",") != "multipart/alternative,image/png" {
+		t.Errorf("parts = %v", types)
+	}
+}
+
+func TestSendAuthFailure(t *testing.T) {
+	s := newSMTPStub(t, "kaizoku", "secret", false)
+	cfg := s.config()
+	cfg.Password = "wrong"
+
+	err := Send(context.Background(), cfg, Message{Subject: "test"})
+	if err == nil || !strings.Contains(err.Error(), "smtp auth") {
+		t.Fatalf("err = %v, want an smtp auth error", err)
+	}
+	select {
+	case <-s.messages:
+		t.Fatal("message delivered despite failed authentication")
+	default:
+	}
+}
+
+func TestSendTimeout(t *testing.T) {
+	s := newSMTPStub(t, "", "", true)
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := Send(ctx, s.config(), Message{Subject: "test"}); err == nil {
+		t.Fatal("Send to a silent server succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Send returned after %v, want it bounded by the context", elapsed)
+	}
+}
+
+func TestSendCancelled(t *testing.T) {
+	s := newSMTPStub(t, "", "", true)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(200*time.Millisecond, cancel)
+
+	errc := make(chan error, 1)
+	go func() { errc <- Send(ctx, s.config(), Message{Subject: "test"}) }()
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Fatal("Send to a silent server succeeded")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Send did not return after the context was cancelled")
+	}
+}
+
+func TestSendRequiresStartTLS(t *testing.T) {
+	s := newSMTPStub(t, "", "", false)
+	cfg := s.config()
+	cfg.Security = "starttls"
+
+	if err := Send(context.Background(), cfg, Message{Subject: "test"}); err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Fatalf("err = %v, want a STARTTLS error", err)
+	}
+}
+
+func TestAddressOf(t *testing.T) {
+	for in, want := range map[string]string{
+		"Kaizoku <kaizoku@example.com>": "kaizoku@example.com",
+		" reader@example.com ":          "reader@example.com",
+	} {
+		if got := addressOf(in); got != want {
+			t.Errorf("addressOf(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Package mqtt publishes Kaizoku state to an MQTT broker for home-automation
+// systems such as Home Assistant. Connections use the Eclipse Paho client
+// with MQTT 3.1.1 and publish at QoS 0, which is all the publisher needs.
+package mqtt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+)
+
+const (
+	// connectTimeout bounds the TCP/TLS dial and the MQTT handshake.
+	connectTimeout = 30 * time.Second
+	// publishTimeout bounds writing one message to the connection.
+	publishTimeout = 10 * time.Second
+	// disconnectQuiesce is how long Close waits for queued work, in
+	// milliseconds.
+	disconnectQuiesce = 250
+)
+
+// Message is a message to publish.
+type Message struct {
+	Topic   string
+	Payload []byte
+	Retain  bool
+}
+
+// Options configures a connection.
+type Options struct {
+	// Broker is the broker URL: tcp:// or mqtt:// for plain connections,
+	// ssl://, tls:// or mqtts:// for TLS.
+	Broker    string
+	ClientID  string
+	Username  string
+	Password  string
+	KeepAlive time.Duration
+	// Will is published by the broker when the connection is lost.
+	Will *Message
+}
+
+// Conn is a connection to an MQTT broker. It does not reconnect by itself:
+// Done is closed when the connection is lost, and the caller dials again.
+type Conn struct {
+	client paho.Client
+
+	done chan struct{}
+	once sync.Once
+	err  error
+}
+
+// Dial connects to the broker and completes the MQTT handshake.
+func Dial(ctx context.Context, opts Options) (*Conn, error) {
+	broker, err := brokerURL(opts.Broker)
+	if err != nil {
+		return nil, err
+	}
+	keepAlive := opts.KeepAlive
+	if keepAlive <= 0 {
+		keepAlive = time.Minute
+	}
+
+	c := &Conn{done: make(chan struct{})}
+	co := paho.NewClientOptions().
+		AddBroker(broker).
+		SetClientID(opts.ClientID).
+		SetUsername(opts.Username).
+		SetPassword(opts.Password).
+		SetProtocolVersion(4).
+		SetCleanSession(true).
+		SetKeepAlive(keepAlive).
+		SetConnectTimeout(connectTimeout).
+		SetWriteTimeout(publishTimeout).
+		SetAutoReconnect(false).
+		SetConnectRetry(false).
+		SetConnectionLostHandler(func(_ paho.Client, err error) {
+			c.fail(fmt.Errorf("connection lost: %w", err))
+		})
+	if opts.Will != nil {
+		co.SetBinaryWill(opts.Will.Topic, opts.Will.Payload, 0, opts.Will.Retain)
+	}
+	c.client = paho.NewClient(co)
+
+	token := c.client.Connect()
+	select {
+	case <-token.Done():
+	case <-ctx.Done():
+		c.client.Disconnect(0)
+		return nil, ctx.Err()
+	}
+	if err := token.Error(); err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", broker, err)
+	}
+	return c, nil
+}
+
+// brokerURL checks the scheme of a broker URL and adds the default port.
+func brokerURL(broker string) (string, error) {
+	u, err := url.Parse(broker)
+	if err != nil {
+		return "", fmt.Errorf("parse broker url: %w", err)
+	}
+	port := "1883"
+	switch u.Scheme {
+	case "tcp", "mqtt":
+	case "ssl", "tls", "mqtts":
+		port = "8883"
+	default:
+		return "", fmt.Errorf("unsupported broker scheme %q", u.Scheme)
+	}
+	if u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Hostname(), port)
+	}
+	return u.String(), nil
+}
+
+// Publish sends a QoS 0 message.
+func (c *Conn) Publish(m Message) error {
+	token := c.client.Publish(m.Topic, 0, m.Retain, m.Payload)
+	var err error
+	if !token.WaitTimeout(publishTimeout) {
+		err = errors.New("timed out")
+	} else {
+		err = token.Error()
+	}
+	if err != nil {
+		// Drop the connection; the caller dials a fresh one.
+		c.client.Disconnect(0)
+		err = fmt.Errorf("publish %s: %w", m.Topic, err)
+		c.fail(err)
+		return err
+	}
+	return nil
+}
+
+// Close sends DISCONNECT and closes the connection. The broker does not
+// publish the will after a clean disconnect.
+func (c *Conn) Close() error {
+	c.client.Disconnect(disconnectQuiesce)
+	c.fail(errors.New("connection closed"))
+	return nil
+}
+
+// Done is closed when the connection is lost or closed.
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns why the connection ended, once Done is closed.
+func (c *Conn) Err() error {
+	<-c.done
+	return c.err
+}
+
+// fail ends the connection with err; later calls are ignored.
+func (c *Conn) fail(err error) {
+	c.once.Do(func() {
+		c.err = err
+		close(c.done)
+	})
+}
@@ -0,0 +1,264 @@
+package mqtt
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+)
+
+// testTimeout bounds every wait on the broker stub.
+const testTimeout = 5 * time.Second
+
+// stubBroker is an in-process MQTT broker that answers CONNECT with a
+// configurable return code and hands every later packet to the test.
+type stubBroker struct {
+	t        *testing.T
+	ln       net.Listener
+	connack  byte
+	connects chan *packets.ConnectPacket
+	packets  chan packets.ControlPacket
+	conns    chan net.Conn
+}
+
+func newStubBroker(t *testing.T, connack byte) *stubBroker {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	b := &stubBroker{
+		t:        t,
+		ln:       ln,
+		connack:  connack,
+		connects: make(chan *packets.ConnectPacket, 16),
+		packets:  make(chan packets.ControlPacket, 256),
+		conns:    make(chan net.Conn, 16),
+	}
+	t.Cleanup(func() { ln.Close() })
+	go b.accept()
+	return b
+}
+
+func (b *stubBroker) url() string {
+	return "tcp://" + b.ln.Addr().String()
+}
+
+func (b *stubBroker) accept() {
+	for {
+		nc, err := b.ln.Accept()
+		if err != nil {
+			return
+		}
+		go b.serve(nc)
+	}
+}
+
+func (b *stubBroker) serve(nc net.Conn) {
+	defer nc.Close()
+	pkt, err := packets.ReadPacket(nc)
+	if err != nil {
+		return
+	}
+	connect, ok := pkt.(*packets.ConnectPacket)
+	if !ok {
+		return
+	}
+	b.connects <- connect
+
+	ack := packets.NewControlPacket(packets.Connack).(*packets.ConnackPacket)
+	ack.ReturnCode = b.connack
+	if err := ack.Write(nc); err != nil || b.connack != packets.Accepted {
+		return
+	}
+	b.conns <- nc
+
+	for {
+		pkt, err := packets.ReadPacket(nc)
+		if err != nil {
+			return
+		}
+		if _, ok := pkt.(*packets.PingreqPacket); ok {
+			_ = packets.NewControlPacket(packets.Pingresp).Write(nc)
+			continue
+		}
+		b.packets <- pkt
+	}
+}
+
+// nextConnect waits for the next CONNECT.
+func (b *stubBroker) nextConnect() *packets.ConnectPacket {
+	b.t.Helper()
+	select {
+	case c := <-b.connects:
+		return c
+	case <-time.After(testTimeout):
+		b.t.Fatal("timed out waiting for CONNECT")
+		return nil
+	}
+}
+
+// nextConn waits for the next accepted connection.
+func (b *stubBroker) nextConn() net.Conn {
+	b.t.Helper()
+	select {
+	case nc := <-b.conns:
+		return nc
+	case <-time.After(testTimeout):
+		b.t.Fatal("timed out waiting for a connection")
+		return nil
+	}
+}
+
+// waitPublish waits for a PUBLISH to topic, skipping other packets.
+func (b *stubBroker) waitPublish(topic string) *packets.PublishPacket {
+	b.t.Helper()
+	deadline := time.After(testTimeout)
+	for {
+		select {
+		case pkt := <-b.packets:
+			if p, ok := pkt.(*packets.PublishPacket); ok && p.TopicName == topic {
+				return p
+			}
+		case <-deadline:
+			b.t.Fatalf("timed out waiting for PUBLISH to %s", topic)
+			return nil
+		}
+	}
+}
+
+// waitDisconnect waits for a DISCONNECT, skipping other packets.
+func (b *stubBroker) waitDisconnect() {
+	b.t.Helper()
+	deadline := time.After(testTimeout)
+	for {
+		select {
+		case pkt := <-b.packets:
+			if _, ok := pkt.(*packets.DisconnectPacket); ok {
+				return
+			}
+		case <-deadline:
+			b.t.Fatal("timed out waiting for DISCONNECT")
+		}
+	}
+}
+
+func TestDialSendsConnect(t *testing.T) {
+	b := newStubBroker(t, packets.Accepted)
+	conn, err := Dial(context.Background(), Options{
+		Broker:    b.url(),
+		ClientID:  "kaizoku-test",
+		Username:  "user",
+		Password:  "secret",
+		KeepAlive: 30 * time.Second,
+		Will:      &Message{Topic: "kaizoku/status", Payload: []byte("offline"), Retain: true},
+	})
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer conn.Close()
+
+	c := b.nextConnect()
+	if c.ProtocolName != "MQTT" || c.ProtocolVersion != 4 {
+		t.Errorf("protocol = %s %d, want MQTT 4", c.ProtocolName, c.ProtocolVersion)
+	}
+	if c.ClientIdentifier != "kaizoku-test" || !c.CleanSession || c.Keepalive != 30 {
+		t.Errorf("client id %q, clean session %v, keepalive %d", c.ClientIdentifier, c.CleanSession, c.Keepalive)
+	}
+	if !c.UsernameFlag || c.Username != "user" || !c.PasswordFlag || string(c.Password) != "secret" {
+		t.Errorf("credentials = %q/%q", c.Username, c.Password)
+	}
+	if !c.WillFlag || !c.WillRetain || c.WillTopic != "kaizoku/status" || string(c.WillMessage) != "offline" {
+		t.Errorf("will = %v %q %q retain %v", c.WillFlag, c.WillTopic, c.WillMessage, c.WillRetain)
+	}
+}
+
+func TestDialRefused(t *testing.T) {
+	for _, code := range []byte{
+		packets.ErrRefusedBadProtocolVersion,
+		packets.ErrRefusedIDRejected,
+		packets.ErrRefusedServerUnavailable,
+		packets.ErrRefusedBadUsernameOrPassword,
+		packets.ErrRefusedNotAuthorised,
+	} {
+		b := newStubBroker(t, code)
+		_, err := Dial(context.Background(), Options{Broker: b.url(), ClientID: "kaizoku"})
+		if err == nil {
+			t.Errorf("code %d: Dial succeeded", code)
+			continue
+		}
+		if !errors.Is(err, packets.ConnErrors[code]) {
+			t.Errorf("code %d: err = %v, want %v", code, err, packets.ConnErrors[code])
+		}
+	}
+}
+
+func TestDialUnreachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	if _, err := Dial(context.Background(), Options{Broker: "tcp://" + addr}); err == nil {
+		t.Fatal("Dial to a closed port succeeded")
+	}
+	if _, err := Dial(context.Background(), Options{Broker: "http://" + addr}); err == nil || !strings.Contains(err.Error(), "unsupported broker scheme") {
+		t.Fatalf("Dial with http scheme: err = %v", err)
+	}
+}
+
+func TestPublishPayloadSizes(t *testing.T) {
+	b := newStubBroker(t, packets.Accepted)
+	conn, err := Dial(context.Background(), Options{Broker: b.url(), ClientID: "kaizoku"})
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer conn.Close()
+
+	// Remaining lengths of one, two and three bytes.
+	for _, size := range []int{10, 127, 128, 300, 20000} {
+		topic := "kaizoku/test"
+		payload := bytes.Repeat([]byte{'x'}, size)
+		if err := conn.Publish(Message{Topic: topic, Payload: payload, Retain: size%2 == 0}); err != nil {
+			t.Fatalf("Publish %d bytes: %v", size, err)
+		}
+		p := b.waitPublish(topic)
+		if !bytes.Equal(p.Payload, payload) {
+			t.Errorf("%d bytes: received %d bytes", size, len(p.Payload))
+		}
+		if want := 2 + len(topic) + size; p.RemainingLength != want {
+			t.Errorf("%d bytes: remaining length %d, want %d", size, p.RemainingLength, want)
+		}
+		if p.Qos != 0 || p.Retain != (size%2 == 0) {
+			t.Errorf("%d bytes: qos %d retain %v", size, p.Qos, p.Retain)
+		}
+	}
+}
+
+func TestConnectionLost(t *testing.T) {
+	b := newStubBroker(t, packets.Accepted)
+	conn, err := Dial(context.Background(), Options{Broker: b.url(), ClientID: "kaizoku"})
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	b.nextConn().Close()
+
+	select {
+	case <-conn.Done():
+	case <-time.After(testTimeout):
+		t.Fatal("Done not closed after the broker dropped the connection")
+	}
+	if conn.Err() == nil {
+		t.Fatal("Err is nil after the connection was lost")
+	}
+	if err := conn.Publish(Message{Topic: "kaizoku/test"}); err == nil {
+		t.Fatal("Publish on a lost connection succeeded")
+	}
+}
@@ -0,0 +1,356 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/sourceevent"
+	"github.com/technobecet/kaizoku-go/internal/events"
+	"github.com/technobecet/kaizoku-go/internal/service/reporting"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// Reconnect backoff bounds; variables so tests can shorten them.
+var (
+	minBackoff = 5 * time.Second
+	maxBackoff = 5 * time.Minute
+)
+
+const (
+	// eventBuffer is how many bus events may wait for the broker; further
+	// events are dropped rather than holding up the publisher.
+	eventBuffer = 256
+)
+
+// MetricsFunc returns the current download queue counts.
+type MetricsFunc func(ctx context.Context) types.DownloadsMetrics
+
+// Publisher keeps a connection to the broker and publishes:
+//
+//	<prefix>/status              online/offline (retained, also the will)
+//	<prefix>/queue               download queue counts (retained)
+//	<prefix>/sources/<id>        health of each source (retained)
+//	<prefix>/chapter/latest      the last downloaded chapter (retained)
+//	<prefix>/events/<type>       every bus event as it happens
+//
+// plus Home Assistant discovery messages for the above when enabled.
+type Publisher struct {
+	cfg      config.MQTTConfig
+	health   config.EventsConfig
+	db       *ent.Client
+	metrics  MetricsFunc
+	interval time.Duration
+	events   chan events.Event
+
+	// sources remembers every source reported this session so that a source
+	// without events in the window is reported idle instead of keeping its
+	// last retained numbers. Only the Run goroutine uses it.
+	sources map[string]types.SourceStatsSummary
+}
+
+// NewPublisher creates a publisher. It does not connect until Run.
+func NewPublisher(cfg *config.Config, db *ent.Client, metrics MetricsFunc) *Publisher {
+	interval, err := time.ParseDuration(cfg.MQTT.Interval)
+	if err != nil || interval <= 0 {
+		interval = time.Minute
+	}
+	return &Publisher{
+		cfg:      cfg.MQTT,
+		health:   cfg.Events,
+		db:       db,
+		metrics:  metrics,
+		interval: interval,
+		events:   make(chan events.Event, eventBuffer),
+		sources:  make(map[string]types.SourceStatsSummary),
+	}
+}
+
+// HandleEvent queues an event for publishing. It is subscribed to the event
+// bus and never blocks.
+func (p *Publisher) HandleEvent(ctx context.Context, e events.Event) {
+	if p == nil {
+		return
+	}
+	select {
+	case p.events <- e:
+	default:
+		log.Debug().Str("type", string(e.Type)).Msg("mqtt: event buffer full, dropping event")
+	}
+}
+
+// Run publishes until ctx is cancelled, reconnecting with backoff whenever
+// the broker is unreachable.
+func (p *Publisher) Run(ctx context.Context) {
+	if p == nil {
+		return
+	}
+	backoff := minBackoff
+	for {
+		conn, err := Dial(ctx, p.options())
+		if err == nil {
+			log.Info().Str("broker", p.cfg.Broker).Msg("mqtt: connected")
+			backoff = minBackoff
+			err = p.serve(ctx, conn)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warn().Err(err).Str("broker", p.cfg.Broker).Dur("retryIn", backoff).Msg("mqtt: broker unavailable")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+func (p *Publisher) options() Options {
+	return Options{
+		Broker:   p.cfg.Broker,
+		ClientID: p.cfg.ClientID,
+		Username: p.cfg.Username,
+		Password: p.cfg.Password,
+		Will:     &Message{Topic: p.topic("status"), Payload: []byte("offline"), Retain: true},
+	}
+}
+
+// serve publishes over one connection until it is lost or ctx is cancelled.
+func (p *Publisher) serve(ctx context.Context, conn *Conn) error {
+	announced := make(map[string]bool)
+	if err := conn.Publish(Message{Topic: p.topic("status"), Payload: []byte("online"), Retain: true}); err != nil {
+		return err
+	}
+	if err := p.publishDiscovery(conn); err != nil {
+		return err
+	}
+	if err := p.publishState(ctx, conn, announced); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			_ = conn.Publish(Message{Topic: p.topic("status"), Payload: []byte("offline"), Retain: true})
+			return conn.Close()
+		case <-conn.Done():
+			return conn.Err()
+		case <-ticker.C:
+			if err := p.publishState(ctx, conn, announced); err != nil {
+				return err
+			}
+		case e := <-p.events:
+			if err := p.publishEvent(conn, e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// publishState publishes the queue counts and the health of every source.
+// announced holds the sources whose discovery message was sent on this
+// connection.
+func (p *Publisher) publishState(ctx context.Context, conn *Conn, announced map[string]bool) error {
+	if err := p.publishJSON(conn, p.topic("queue"), p.metrics(ctx), true); err != nil {
+		return err
+	}
+
+	window, err := time.ParseDuration(p.health.SourceErrorWindow)
+	if err != nil || window <= 0 {
+		window = time.Hour
+	}
+	evts, err := p.db.SourceEvent.Query().
+		Where(sourceevent.CreatedAtGTE(time.Now().Add(-window))).
+		All(ctx)
+	if err != nil {
+		// The broker is fine; try again on the next tick.
+		log.Warn().Err(err).Msg("mqtt: failed to query source events")
+		return nil
+	}
+	current := make(map[string]bool)
+	for _, s := range reporting.SourceSummaries(evts) {
+		p.sources[s.SourceID] = s
+		current[s.SourceID] = true
+	}
+
+	for id, s := range p.sources {
+		if !current[id] {
+			s = types.SourceStatsSummary{SourceID: id, SourceName: s.SourceName, Language: s.Language}
+		}
+		if !announced[id] {
+			if err := p.publishSourceDiscovery(conn, s); err != nil {
+				return err
+			}
+			announced[id] = true
+		}
+		if err := p.publishJSON(conn, p.topic("sources", objectID(id)), p.sourceState(s, window), true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sourceState is the payload of a source health topic.
+func (p *Publisher) sourceState(s types.SourceStatsSummary, window time.Duration) map[string]interface{} {
+	status := "ok"
+	switch {
+	case s.EventCount == 0:
+		status = "idle"
+	case p.health.SourceErrorRate > 0 && s.EventCount >= max(p.health.SourceErrorMinEvents, 1) &&
+		s.FailureRate/100 >= p.health.SourceErrorRate:
+		status = "degraded"
+	}
+	return map[string]interface{}{
+		"sourceId":      s.SourceID,
+		"name":          s.SourceName,
+		"language":      s.Language,
+		"events":        s.EventCount,
+		"failures":      s.FailureCount,
+		"failureRate":   s.FailureRate,
+		"avgDurationMs": s.AvgDurationMs,
+		"status":        status,
+		"window":        window.String(),
+	}
+}
+
+// publishEvent publishes a bus event to <prefix>/events/<type>. The payload
+// carries the event data with event_type set, which is what Home Assistant
+// event entities expect. Downloaded chapters are also kept retained on
+// <prefix>/chapter/latest.
+func (p *Publisher) publishEvent(conn *Conn, e events.Event) error {
+	payload := make(map[string]interface{}, len(e.Data)+3)
+	for k, v := range e.Data {
+		payload[k] = v
+	}
+	payload["event_type"] = string(e.Type)
+	payload["id"] = e.ID
+	payload["time"] = e.Time.Format(time.RFC3339)
+
+	if err := p.publishJSON(conn, p.topic("events", string(e.Type)), payload, false); err != nil {
+		return err
+	}
+	if e.Type == events.ChapterDownloaded {
+		return p.publishJSON(conn, p.topic("chapter", "latest"), payload, true)
+	}
+	return nil
+}
+
+// device groups the discovered entities under one Home Assistant device.
+func (p *Publisher) device() map[string]interface{} {
+	return map[string]interface{}{
+		"identifiers":  []string{p.node()},
+		"name":         "Kaizoku",
+		"manufacturer": "Kaizoku",
+		"model":        "Manga library manager",
+	}
+}
+
+// publishDiscovery announces the queue, latest chapter and chapter event
+// entities to Home Assistant.
+func (p *Publisher) publishDiscovery(conn *Conn) error {
+	if p.cfg.Discovery == "" {
+		return nil
+	}
+	queue := []struct{ key, name, icon string }{
+		{"downloads", "Downloads running", "mdi:download"},
+		{"queued", "Downloads queued", "mdi:tray-full"},
+		{"failed", "Downloads failed", "mdi:alert-circle"},
+	}
+	for _, q := range queue {
+		if err := p.publishConfig(conn, "sensor", "queue_"+q.key, map[string]interface{}{
+			"name":           q.name,
+			"icon":           q.icon,
+			"state_topic":    p.topic("queue"),
+			"value_template": "{{ value_json." + q.key + " }}",
+			"state_class":    "measurement",
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := p.publishConfig(conn, "sensor", "latest_chapter", map[string]interface{}{
+		"name":                  "Latest chapter",
+		"icon":                  "mdi:book-open-page-variant",
+		"state_topic":           p.topic("chapter", "latest"),
+		"value_template":        "{{ value_json.title }} - Chapter {{ value_json.chapterNumber }}",
+		"json_attributes_topic": p.topic("chapter", "latest"),
+	}); err != nil {
+		return err
+	}
+
+	return p.publishConfig(conn, "event", "chapter_downloaded", map[string]interface{}{
+		"name":        "Chapter downloaded",
+		"icon":        "mdi:book-arrow-down",
+		"state_topic": p.topic("events", string(events.ChapterDownloaded)),
+		"event_types": []string{string(events.ChapterDownloaded)},
+	})
+}
+
+// publishSourceDiscovery announces the failure rate sensor of a source.
+func (p *Publisher) publishSourceDiscovery(conn *Conn, s types.SourceStatsSummary) error {
+	if p.cfg.Discovery == "" {
+		return nil
+	}
+	name := s.SourceName
+	if s.Language != "" {
+		name += " (" + s.Language + ")"
+	}
+	topic := p.topic("sources", objectID(s.SourceID))
+	return p.publishConfig(conn, "sensor", "source_"+objectID(s.SourceID), map[string]interface{}{
+		"name":                  name + " failure rate",
+		"icon":                  "mdi:web",
+		"state_topic":           topic,
+		"value_template":        "{{ value_json.failureRate }}",
+		"unit_of_measurement":   "%",
+		"state_class":           "measurement",
+		"json_attributes_topic": topic,
+	})
+}
+
+// publishConfig publishes a retained discovery message for one entity.
+func (p *Publisher) publishConfig(conn *Conn, component, object string, cfg map[string]interface{}) error {
+	cfg["unique_id"] = p.node() + "_" + object
+	cfg["availability_topic"] = p.topic("status")
+	cfg["device"] = p.device()
+	return p.publishJSON(conn, p.cfg.Discovery+"/"+component+"/"+p.node()+"/"+object+"/config", cfg, true)
+}
+
+func (p *Publisher) publishJSON(conn *Conn, topic string, v interface{}, retain bool) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode %s: %w", topic, err)
+	}
+	return conn.Publish(Message{Topic: topic, Payload: data, Retain: retain})
+}
+
+// topic joins levels under the configured prefix.
+func (p *Publisher) topic(levels ...string) string {
+	return strings.Join(append([]string{p.cfg.Prefix}, levels...), "/")
+}
+
+// node is the Home Assistant node ID of this instance.
+func (p *Publisher) node() string {
+	if p.cfg.ClientID == "" {
+		return "kaizoku"
+	}
+	return objectID(p.cfg.ClientID)
+}
+
+// objectID reduces s to the characters allowed in topic levels and Home
+// Assistant object IDs.
+func objectID(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, s)
+}
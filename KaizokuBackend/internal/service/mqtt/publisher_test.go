@@ -0,0 +1,98 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/events"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// offlineDriver fails every query, like a database that is down. The
+// publisher logs source query failures and keeps publishing.
+type offlineDriver struct{}
+
+var errOffline = errors.New("database offline")
+
+func (offlineDriver) Exec(context.Context, string, any, any) error  { return errOffline }
+func (offlineDriver) Query(context.Context, string, any, any) error { return errOffline }
+func (offlineDriver) Tx(context.Context) (dialect.Tx, error)        { return nil, errOffline }
+func (offlineDriver) Close() error                                  { return nil }
+func (offlineDriver) Dialect() string                               { return dialect.Postgres }
+
+func TestPublisherReconnects(t *testing.T) {
+	oldMin := minBackoff
+	minBackoff = 10 * time.Millisecond
+	t.Cleanup(func() { minBackoff = oldMin })
+
+	b := newStubBroker(t, packets.Accepted)
+	cfg := &config.Config{MQTT: config.MQTTConfig{
+		Enabled:   true,
+		Broker:    b.url(),
+		ClientID:  "kaizoku",
+		Prefix:    "kaizoku",
+		Discovery: "homeassistant",
+		Interval:  "1h",
+	}}
+	metrics := func(context.Context) types.DownloadsMetrics {
+		return types.DownloadsMetrics{Downloads: 2, Queued: 5}
+	}
+	p := NewPublisher(cfg, ent.NewClient(ent.Driver(offlineDriver{})), metrics)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		p.Run(ctx)
+		close(done)
+	}()
+
+	// First connection: will, status, discovery and queue state.
+	if c := b.nextConnect(); c.WillTopic != "kaizoku/status" || string(c.WillMessage) != "offline" {
+		t.Fatalf("will = %q %q", c.WillTopic, c.WillMessage)
+	}
+	first := b.nextConn()
+	if s := b.waitPublish("kaizoku/status"); string(s.Payload) != "online" || !s.Retain {
+		t.Fatalf("status = %q retain %v", s.Payload, s.Retain)
+	}
+	b.waitPublish("homeassistant/sensor/kaizoku/queue_downloads/config")
+	var queue types.DownloadsMetrics
+	if err := json.Unmarshal(b.waitPublish("kaizoku/queue").Payload, &queue); err != nil || queue.Queued != 5 {
+		t.Fatalf("queue = %+v, %v", queue, err)
+	}
+
+	// The broker goes away: the publisher dials again and re-announces.
+	first.Close()
+	b.nextConnect()
+	b.nextConn()
+	if s := b.waitPublish("kaizoku/status"); string(s.Payload) != "online" {
+		t.Fatalf("status after reconnect = %q", s.Payload)
+	}
+	b.waitPublish("kaizoku/queue")
+
+	p.HandleEvent(ctx, events.New(events.ChapterDownloaded, map[string]interface{}{"title": "One Piece"}))
+	ev := b.waitPublish("kaizoku/events/" + string(events.ChapterDownloaded))
+	var payload map[string]interface{}
+	if err := json.Unmarshal(ev.Payload, &payload); err != nil || payload["event_type"] != string(events.ChapterDownloaded) {
+		t.Fatalf("event payload = %s, %v", ev.Payload, err)
+	}
+	b.waitPublish("kaizoku/chapter/latest")
+
+	// Shutdown publishes offline and disconnects cleanly.
+	cancel()
+	if s := b.waitPublish("kaizoku/status"); string(s.Payload) != "offline" {
+		t.Fatalf("status on shutdown = %q", s.Payload)
+	}
+	b.waitDisconnect()
+	select {
+	case <-done:
+	case <-time.After(testTimeout):
+		t.Fatal("Run did not return after cancel")
+	}
+}
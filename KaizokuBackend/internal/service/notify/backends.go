@@ -0,0 +1,162 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/technobecet/kaizoku-go/internal/ent"
+)
+
+// Message is a rendered notification ready to be sent.
+type Message struct {
+	Title   string
+	Message string
+	// Urgent marks failures that need attention; backends raise the priority.
+	Urgent bool
+}
+
+// Backend sends messages to one kind of push service.
+type Backend interface {
+	// Validate checks the kind-specific parts of a notifier.
+	Validate(n *ent.Notifier) error
+	// Send delivers m to the target described by n.
+	Send(ctx context.Context, client *http.Client, n *ent.Notifier, m Message) error
+}
+
+// backends maps a notifier kind to its implementation.
+var backends = map[string]Backend{
+	"ntfy":    ntfy{},
+	"gotify":  gotify{},
+	"apprise": apprise{},
+}
+
+// Kinds returns the supported notifier kinds.
+func Kinds() []string {
+	kinds := make([]string, 0, len(backends))
+	for k := range backends {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// ntfy publishes JSON messages to an ntfy server
+// (https://docs.ntfy.sh/publish/#publish-as-json).
+type ntfy struct{}
+
+func (ntfy) Validate(n *ent.Notifier) error {
+	if n.Topic == "" {
+		return fmt.Errorf("ntfy requires a topic")
+	}
+	return nil
+}
+
+func (ntfy) Send(ctx context.Context, client *http.Client, n *ent.Notifier, m Message) error {
+	priority := 3
+	if m.Urgent {
+		priority = 5
+	}
+	body := map[string]interface{}{
+		"topic":    n.Topic,
+		"title":    m.Title,
+		"message":  m.Message,
+		"priority": priority,
+	}
+	if m.Urgent {
+		body["tags"] = []string{"warning"}
+	}
+	return postJSON(ctx, client, strings.TrimSuffix(n.URL, "/"), bearer(n.Token), body)
+}
+
+// gotify posts to a Gotify server's message endpoint using an application
+// token (https://gotify.net/docs/pushmsg).
+type gotify struct{}
+
+func (gotify) Validate(n *ent.Notifier) error {
+	if n.Token == "" {
+		return fmt.Errorf("gotify requires an application token")
+	}
+	return nil
+}
+
+func (gotify) Send(ctx context.Context, client *http.Client, n *ent.Notifier, m Message) error {
+	priority := 5
+	if m.Urgent {
+		priority = 8
+	}
+	body := map[string]interface{}{
+		"title":    m.Title,
+		"message":  m.Message,
+		"priority": priority,
+	}
+	headers := map[string]string{"X-Gotify-Key": n.Token}
+	return postJSON(ctx, client, strings.TrimSuffix(n.URL, "/")+"/message", headers, body)
+}
+
+// apprise posts to an Apprise API notify endpoint, either stateful
+// (/notify/{key}) or a URL that already identifies the targets
+// (https://github.com/caronc/apprise-api).
+type apprise struct{}
+
+func (apprise) Validate(n *ent.Notifier) error {
+	return nil
+}
+
+func (apprise) Send(ctx context.Context, client *http.Client, n *ent.Notifier, m Message) error {
+	kind := "info"
+	if m.Urgent {
+		kind = "failure"
+	}
+	body := map[string]interface{}{
+		"title": m.Title,
+		"body":  m.Message,
+		"type":  kind,
+	}
+	if n.Topic != "" {
+		body["tag"] = n.Topic
+	}
+	return postJSON(ctx, client, n.URL, bearer(n.Token), body)
+}
+
+// bearer returns an Authorization header for token, or none when empty.
+func bearer(token string) map[string]string {
+	if token == "" {
+		return nil
+	}
+	return map[string]string{"Authorization": "Bearer " + token}
+}
+
+// postJSON sends body as JSON and treats any non-2xx status as an error.
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, body interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Kaizoku-Notifier")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("server returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(raw)))
+	}
+	return nil
+}
@@ -0,0 +1,501 @@
+// Package notify sends push notifications for library and download events
+// to ntfy, Gotify and Apprise API servers. Notifications are rendered and
+// stored when the event happens; those raised during a notifier's quiet
+// hours are held and later sent together as a single digest.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/notification"
+	"github.com/technobecet/kaizoku-go/internal/ent/notifier"
+	"github.com/technobecet/kaizoku-go/internal/ent/series"
+	"github.com/technobecet/kaizoku-go/internal/events"
+)
+
+// Notification statuses.
+const (
+	StatusPending = "pending"
+	StatusHeld    = "held"
+	StatusSent    = "sent"
+	StatusFailed  = "failed"
+)
+
+// TestEvent is the event type sent by the "test" action. It is sent to the
+// chosen notifier regardless of its event filter and quiet hours.
+const TestEvent events.Type = "notifier.test"
+
+const (
+	// requestTimeout bounds a single send attempt.
+	requestTimeout = 15 * time.Second
+	// maxDigestLines caps the length of a digest message.
+	maxDigestLines = 40
+)
+
+// defaultTemplates are the built-in title and message for each event.
+// Custom templates can embed them as {{.Title}} and {{.Message}}.
+var defaultTemplates = map[events.Type][2]string{
+	events.ChapterDownloaded: {
+		`{{.Data.title}}`,
+		`{{with .Data.chapterNumber}}Chapter {{.}}{{else}}A new chapter{{end}} downloaded{{with .Data.provider}} from {{.}}{{end}}.`,
+	},
+	events.ChapterFailed: {
+		`Download failed: {{.Data.title}}`,
+		`{{with .Data.chapterNumber}}Chapter {{.}}{{else}}A chapter{{end}}{{with .Data.provider}} from {{.}}{{end}} failed after all retries and fallback sources.`,
+	},
+	events.SeriesAdded: {
+		`Series added`,
+		`{{.Data.title}}`,
+	},
+	events.SeriesDeleted: {
+		`Series deleted`,
+		`{{.Data.title}}`,
+	},
+	events.ExtensionUpdated: {
+		`Extension updated`,
+		`{{.Data.name}} {{.Data.previousVersion}} → {{.Data.version}}`,
+	},
+	events.ExtensionRemoved: {
+		`Extension removed: {{.Data.name}}`,
+		`{{.Data.name}} ({{.Data.language}}) {{if eq .Data.reason "obsolete"}}was removed from its repository{{else}}was uninstalled outside Kaizoku{{end}}. Series using it will not get new chapters from it.`,
+	},
+	events.SourceErrorRate: {
+		`{{if eq .Data.state "above"}}Source failing{{else}}Source recovered{{end}}: {{.Data.sourceName}}`,
+		`{{.Data.failures}} of {{.Data.operations}} operations failed in the last {{.Data.window}}.`,
+	},
+	TestEvent: {
+		`Kaizoku test notification`,
+		`Notifications from Kaizoku are working.`,
+	},
+}
+
+// urgent reports whether an event needs attention and is sent with high priority.
+func urgent(e events.Event) bool {
+	switch e.Type {
+	case events.ChapterFailed, events.ExtensionRemoved:
+		return true
+	case events.SourceErrorRate:
+		return e.Data["state"] == "above"
+	}
+	return false
+}
+
+// templateData is what notification templates receive.
+type templateData struct {
+	Type events.Type
+	Time time.Time
+	Data map[string]interface{}
+	// Title and Message are the built-in renderings for the event.
+	Title   string
+	Message string
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Option("missingkey=zero").Parse(text)
+}
+
+// ValidateTemplate checks that a title or message template parses.
+func ValidateTemplate(text string) error {
+	if _, err := parseTemplate("template", text); err != nil {
+		return fmt.Errorf("parse template: %w", err)
+	}
+	return nil
+}
+
+func execute(name, text string, data interface{}) (string, error) {
+	tmpl, err := parseTemplate(name, text)
+	if err != nil {
+		return "", fmt.Errorf("parse %s template: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("render %s template: %w", name, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// Render produces the message a notifier sends for an event.
+func Render(n *ent.Notifier, e events.Event) (Message, error) {
+	// Round-trip the payload through JSON so templates see plain values
+	// (e.g. chapter numbers instead of pointers).
+	var data map[string]interface{}
+	raw, err := json.Marshal(e.Data)
+	if err != nil {
+		return Message{}, fmt.Errorf("encode event data: %w", err)
+	}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return Message{}, fmt.Errorf("decode event data: %w", err)
+	}
+
+	td := templateData{Type: e.Type, Time: e.Time, Data: data}
+	defaults, ok := defaultTemplates[e.Type]
+	if !ok {
+		defaults = [2]string{`{{.Type}}`, ``}
+	}
+	if td.Title, err = execute("title", defaults[0], td); err != nil {
+		return Message{}, err
+	}
+	if td.Message, err = execute("message", defaults[1], td); err != nil {
+		return Message{}, err
+	}
+
+	m := Message{Title: td.Title, Message: td.Message, Urgent: urgent(e)}
+	if n.TitleTemplate != "" {
+		if m.Title, err = execute("title", n.TitleTemplate, td); err != nil {
+			return Message{}, err
+		}
+	}
+	if n.MessageTemplate != "" {
+		if m.Message, err = execute("message", n.MessageTemplate, td); err != nil {
+			return Message{}, err
+		}
+	}
+	return m, nil
+}
+
+// ValidateQuietHours checks a quiet-hours window. Both ends must be given
+// as HH:MM, or both left empty.
+func ValidateQuietHours(start, end string) error {
+	if start == "" && end == "" {
+		return nil
+	}
+	if _, err := time.Parse("15:04", start); err != nil {
+		return errors.New("quiet hours start must be HH:MM")
+	}
+	if _, err := time.Parse("15:04", end); err != nil {
+		return errors.New("quiet hours end must be HH:MM")
+	}
+	return nil
+}
+
+// InQuietHours reports whether t (in server time) falls inside the
+// notifier's quiet hours. A window whose end is before its start spans
+// midnight, e.g. 22:00–07:00.
+func InQuietHours(n *ent.Notifier, t time.Time) bool {
+	start, err1 := time.Parse("15:04", n.QuietStart)
+	end, err2 := time.Parse("15:04", n.QuietEnd)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	now := t.Hour()*60 + t.Minute()
+	s := start.Hour()*60 + start.Minute()
+	e := end.Hour()*60 + end.Minute()
+	switch {
+	case s == e:
+		return false
+	case s < e:
+		return now >= s && now < e
+	default:
+		return now >= s || now < e
+	}
+}
+
+// Validate checks a notifier before it is saved.
+func Validate(n *ent.Notifier) error {
+	b, ok := backends[n.Kind]
+	if !ok {
+		return fmt.Errorf("kind must be one of %s", strings.Join(Kinds(), ", "))
+	}
+	u, err := url.Parse(n.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+	for _, e := range n.Events {
+		if !events.Valid(events.Type(e)) {
+			return fmt.Errorf("unknown event type %q", e)
+		}
+	}
+	if err := ValidateTemplate(n.TitleTemplate); err != nil {
+		return err
+	}
+	if err := ValidateTemplate(n.MessageTemplate); err != nil {
+		return err
+	}
+	if err := ValidateQuietHours(n.QuietStart, n.QuietEnd); err != nil {
+		return err
+	}
+	return b.Validate(n)
+}
+
+// Matches reports whether a notifier subscribes to an event type.
+func Matches(n *ent.Notifier, t events.Type) bool {
+	if len(n.Events) == 0 {
+		return true
+	}
+	for _, e := range n.Events {
+		if events.Type(e) == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Service renders, stores and sends notifications.
+type Service struct {
+	db     *ent.Client
+	client *http.Client
+}
+
+// NewService creates a notification service. A nil client uses a client
+// with a per-request timeout.
+func NewService(db *ent.Client, client *http.Client) *Service {
+	if client == nil {
+		client = &http.Client{Timeout: requestTimeout}
+	}
+	return &Service{db: db, client: client}
+}
+
+// CreateNotifications stores a notification of e for every enabled
+// notifier subscribed to it and returns the IDs of those to send now.
+// New-chapter events only notify for series that opted in. Notifications
+// raised during quiet hours are held for the digest.
+func (s *Service) CreateNotifications(ctx context.Context, e events.Event) ([]uuid.UUID, error) {
+	notifiers, err := s.db.Notifier.Query().Where(notifier.Enabled(true)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load notifiers: %w", err)
+	}
+	if len(notifiers) == 0 {
+		return nil, nil
+	}
+
+	if e.Type == events.ChapterDownloaded {
+		id, _ := e.Data["seriesId"].(string)
+		sid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, nil
+		}
+		optedIn, err := s.db.Series.Query().Where(series.ID(sid), series.Notify(true)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("check series opt-in: %w", err)
+		}
+		if !optedIn {
+			return nil, nil
+		}
+	}
+
+	now := time.Now()
+	var ids []uuid.UUID
+	for _, n := range notifiers {
+		if !Matches(n, e.Type) {
+			continue
+		}
+		status := StatusPending
+		if InQuietHours(n, now) {
+			status = StatusHeld
+		}
+		nt, err := s.CreateNotification(ctx, n, e, status)
+		if err != nil {
+			return ids, err
+		}
+		if nt.Status == StatusPending {
+			ids = append(ids, nt.ID)
+		}
+	}
+	return ids, nil
+}
+
+// CreateNotification stores a notification of e for one notifier with the
+// given status. A notifier whose templates fail to render gets a failed
+// notification instead, so the problem shows in the log.
+func (s *Service) CreateNotification(ctx context.Context, n *ent.Notifier, e events.Event, status string) (*ent.Notification, error) {
+	create := s.db.Notification.Create().
+		SetNotifierID(n.ID).
+		SetEventType(string(e.Type))
+
+	m, err := Render(n, e)
+	if err != nil {
+		create = create.SetTitle(string(e.Type)).SetMessage("").SetStatus(StatusFailed).SetError(err.Error())
+	} else {
+		create = create.SetTitle(m.Title).SetMessage(m.Message).SetUrgent(m.Urgent).SetStatus(status)
+	}
+
+	nt, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create notification: %w", err)
+	}
+	return nt, nil
+}
+
+// Send makes one attempt at sending a pending notification. It returns an
+// error when the attempt failed so the caller can retry; when final is
+// set, a failure marks the notification failed.
+func (s *Service) Send(ctx context.Context, id uuid.UUID, final bool) error {
+	nt, err := s.db.Notification.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("load notification: %w", err)
+	}
+	if nt.Status != StatusPending {
+		return nil
+	}
+	n, err := s.db.Notifier.Get(ctx, nt.NotifierID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return s.db.Notification.UpdateOneID(id).
+				SetStatus(StatusFailed).
+				SetError("notifier was deleted").
+				Exec(ctx)
+		}
+		return fmt.Errorf("load notifier: %w", err)
+	}
+
+	sendErr := backends[n.Kind].Send(ctx, s.client, n, Message{Title: nt.Title, Message: nt.Message, Urgent: nt.Urgent})
+
+	update := s.db.Notification.UpdateOneID(id)
+	switch {
+	case sendErr == nil:
+		update = update.SetStatus(StatusSent).SetError("").SetSentAt(time.Now())
+	case final:
+		update = update.SetStatus(StatusFailed).SetError(cleanError(sendErr))
+	default:
+		update = update.SetError(cleanError(sendErr))
+	}
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("record notification: %w", err)
+	}
+	return sendErr
+}
+
+// SendDigests sends the notifications held during quiet hours, one digest
+// per notifier whose quiet hours have ended. A failed digest stays held
+// and is retried on the next run.
+func (s *Service) SendDigests(ctx context.Context) (int, error) {
+	held, err := s.db.Notification.Query().
+		Where(notification.StatusEQ(StatusHeld)).
+		Order(ent.Asc(notification.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("load held notifications: %w", err)
+	}
+
+	byNotifier := make(map[uuid.UUID][]*ent.Notification)
+	for _, nt := range held {
+		byNotifier[nt.NotifierID] = append(byNotifier[nt.NotifierID], nt)
+	}
+
+	now := time.Now()
+	sent := 0
+	var errs []error
+	for notifierID, items := range byNotifier {
+		n, err := s.db.Notifier.Get(ctx, notifierID)
+		if err != nil && !ent.IsNotFound(err) {
+			errs = append(errs, err)
+			continue
+		}
+		ids := make([]uuid.UUID, len(items))
+		for i, nt := range items {
+			ids[i] = nt.ID
+		}
+		if n == nil || !n.Enabled {
+			// Nobody to send to anymore; drop the held messages.
+			if _, err := s.db.Notification.Update().
+				Where(notification.IDIn(ids...)).
+				SetStatus(StatusFailed).
+				SetError("notifier was deleted or disabled").
+				Save(ctx); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if InQuietHours(n, now) {
+			continue
+		}
+
+		if err := backends[n.Kind].Send(ctx, s.client, n, Digest(items)); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", n.Name, err))
+			if _, uerr := s.db.Notification.Update().
+				Where(notification.IDIn(ids...)).
+				SetError(cleanError(err)).
+				Save(ctx); uerr != nil {
+				errs = append(errs, uerr)
+			}
+			continue
+		}
+		if _, err := s.db.Notification.Update().
+			Where(notification.IDIn(ids...)).
+			SetStatus(StatusSent).
+			SetError("").
+			SetSentAt(now).
+			Save(ctx); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		sent++
+	}
+	return sent, errors.Join(errs...)
+}
+
+// Digest combines held notifications into one message, grouped by title
+// in the order they first occurred.
+func Digest(items []*ent.Notification) Message {
+	var order []string
+	grouped := make(map[string][]string)
+	urgentCount := 0
+	for _, nt := range items {
+		if _, ok := grouped[nt.Title]; !ok {
+			order = append(order, nt.Title)
+		}
+		grouped[nt.Title] = append(grouped[nt.Title], nt.Message)
+		if nt.Urgent {
+			urgentCount++
+		}
+	}
+
+	var b strings.Builder
+	lines, shown := 0, 0
+	for _, title := range order {
+		if lines >= maxDigestLines {
+			break
+		}
+		b.WriteString(title + "\n")
+		lines++
+		for _, msg := range grouped[title] {
+			if lines >= maxDigestLines {
+				break
+			}
+			if msg != "" {
+				b.WriteString("• " + msg + "\n")
+				lines++
+			}
+			shown++
+		}
+	}
+	if shown < len(items) {
+		fmt.Fprintf(&b, "…and %d more", len(items)-shown)
+	}
+
+	title := fmt.Sprintf("Kaizoku: %d notifications", len(items))
+	if len(items) == 1 {
+		title = "Kaizoku: 1 notification"
+	}
+	if urgentCount > 0 {
+		title += fmt.Sprintf(" (%d need attention)", urgentCount)
+	}
+	return Message{Title: title, Message: strings.TrimSpace(b.String()), Urgent: urgentCount > 0}
+}
+
+// Prune deletes sent and failed notifications older than the cutoff.
+func (s *Service) Prune(ctx context.Context, olderThan time.Time) (int, error) {
+	return s.db.Notification.Delete().
+		Where(
+			notification.StatusIn(StatusSent, StatusFailed),
+			notification.CreatedAtLT(olderThan),
+		).
+		Exec(ctx)
+}
+
+// cleanError makes an error safe for a Postgres text column, which rejects
+// invalid UTF-8 and NUL bytes.
+func cleanError(err error) string {
+	return strings.ReplaceAll(strings.ToValidUTF8(err.Error(), ""), "\x00", "")
+}
@@ -0,0 +1,56 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+)
+
+// clockSkew is the tolerance applied to the exp check.
+const clockSkew = time.Minute
+
+// signingAlgs are the ID token signature algorithms Kaizoku accepts.
+var signingAlgs = []string{gooidc.RS256, gooidc.RS384, gooidc.RS512, gooidc.ES256}
+
+// idTokenVerifier returns the verifier for the issuer in doc. Its key set is
+// cached and refetched when a token is signed with an unknown key, which is
+// how issuers rotate keys.
+func (p *Provider) idTokenVerifier(doc *discovery) *gooidc.IDTokenVerifier {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.verifier == nil || p.verifierJWKS != doc.JWKSURI {
+		keys := gooidc.NewRemoteKeySet(gooidc.ClientContext(context.Background(), p.client), doc.JWKSURI)
+		p.verifier = gooidc.NewVerifier(doc.Issuer, keys, &gooidc.Config{
+			ClientID:             p.cfg.ClientID,
+			SupportedSigningAlgs: signingAlgs,
+			Now:                  func() time.Time { return time.Now().Add(-clockSkew) },
+		})
+		p.verifierJWKS = doc.JWKSURI
+	}
+	return p.verifier
+}
+
+// verifyIDToken validates the signature and standard claims of an ID token
+// and returns its claims.
+func (p *Provider) verifyIDToken(ctx context.Context, doc *discovery, token, nonce string) (map[string]interface{}, error) {
+	idToken, err := p.idTokenVerifier(doc).Verify(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("verify id token: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, fmt.Errorf("id token nonce mismatch")
+	}
+
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("parse id token claims: %w", err)
+	}
+	// The verifier checks that the audience includes the client ID, but not
+	// who the token was issued to when there are several audiences.
+	if azp, ok := claims["azp"].(string); ok && len(idToken.Audience) > 1 && azp != p.cfg.ClientID {
+		return nil, fmt.Errorf("id token was issued to %q", azp)
+	}
+	return claims, nil
+}
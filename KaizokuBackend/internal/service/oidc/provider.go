@@ -0,0 +1,310 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/technobecet/kaizoku-go/internal/config"
+)
+
+const (
+	// pendingTTL is how long a login attempt may take between redirect and callback.
+	pendingTTL = 10 * time.Minute
+	// discoveryTTL is how long the discovery document is cached.
+	discoveryTTL = time.Hour
+)
+
+// ErrInvalidState is returned when the callback state is unknown or expired.
+var ErrInvalidState = errors.New("invalid or expired login state")
+
+// Identity is the verified user identity extracted from an ID token.
+type Identity struct {
+	Subject  string
+	Username string
+	Email    string
+	Groups   []string
+}
+
+// discovery holds the fields of the OpenID discovery document Kaizoku uses.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// pendingLogin is the server-side half of an in-flight authorization request.
+type pendingLogin struct {
+	verifier string
+	nonce    string
+	expires  time.Time
+}
+
+// Provider performs the OIDC authorization code flow with PKCE against a
+// single issuer. All issuer communication goes through the injected HTTP
+// client, so tests can point it at an httptest server.
+type Provider struct {
+	cfg    config.OIDCConfig
+	client *http.Client
+
+	mu           sync.Mutex
+	doc          *discovery
+	docFetched   time.Time
+	verifier     *gooidc.IDTokenVerifier
+	verifierJWKS string // JWKS URI the verifier was built for
+	pending      map[string]pendingLogin
+}
+
+// NewProvider creates a provider for the configured issuer. A nil client
+// uses http.DefaultClient.
+func NewProvider(cfg config.OIDCConfig, client *http.Client) *Provider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Provider{
+		cfg:     cfg,
+		client:  client,
+		pending: make(map[string]pendingLogin),
+	}
+}
+
+// Name returns the display name of the identity provider.
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+// randomString returns n random bytes encoded as URL-safe base64.
+func randomString(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// getJSON fetches url and decodes the JSON response into v.
+func (p *Provider) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// discover returns the (cached) discovery document.
+func (p *Provider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	if p.doc != nil && time.Since(p.docFetched) < discoveryTTL {
+		doc := p.doc
+		p.mu.Unlock()
+		return doc, nil
+	}
+	p.mu.Unlock()
+
+	issuer := strings.TrimSuffix(p.cfg.Issuer, "/")
+	var doc discovery
+	if err := p.getJSON(ctx, issuer+"/.well-known/openid-configuration", &doc); err != nil {
+		return nil, fmt.Errorf("fetch discovery document: %w", err)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("discovery issuer %q does not match configured issuer %q", doc.Issuer, p.cfg.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery document is missing required endpoints")
+	}
+
+	p.mu.Lock()
+	p.doc = &doc
+	p.docFetched = time.Now()
+	p.mu.Unlock()
+	return &doc, nil
+}
+
+// AuthURL starts a login attempt and returns the state value and the
+// authorization URL to redirect the browser to.
+func (p *Provider) AuthURL(ctx context.Context) (string, string, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	state, err := randomString(24)
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := randomString(24)
+	if err != nil {
+		return "", "", err
+	}
+	verifier, err := randomString(48)
+	if err != nil {
+		return "", "", err
+	}
+	challenge := sha256.Sum256([]byte(verifier))
+
+	now := time.Now()
+	p.mu.Lock()
+	for k, v := range p.pending {
+		if now.After(v.expires) {
+			delete(p.pending, k)
+		}
+	}
+	p.pending[state] = pendingLogin{verifier: verifier, nonce: nonce, expires: now.Add(pendingTTL)}
+	p.mu.Unlock()
+
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", p.cfg.ClientID)
+	q.Set("redirect_uri", p.cfg.RedirectURL)
+	q.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	q.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return state, doc.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+// Exchange completes a login attempt: it redeems the authorization code,
+// verifies the returned ID token and extracts the user identity.
+func (p *Provider) Exchange(ctx context.Context, state, code string) (*Identity, error) {
+	p.mu.Lock()
+	pl, ok := p.pending[state]
+	delete(p.pending, state)
+	p.mu.Unlock()
+	if !ok || time.Now().After(pl.expires) {
+		return nil, ErrInvalidState
+	}
+
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("client_id", p.cfg.ClientID)
+	form.Set("code_verifier", pl.verifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token request: status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var tok struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &tok); err != nil {
+		return nil, fmt.Errorf("decode token response: %w", err)
+	}
+	if tok.IDToken == "" {
+		return nil, fmt.Errorf("token response has no id_token")
+	}
+
+	claims, err := p.verifyIDToken(ctx, doc, tok.IDToken, pl.nonce)
+	if err != nil {
+		return nil, err
+	}
+	return p.identityFromClaims(claims)
+}
+
+// identityFromClaims extracts the subject, username and groups from verified claims.
+func (p *Provider) identityFromClaims(claims map[string]interface{}) (*Identity, error) {
+	id := &Identity{}
+	id.Subject, _ = claims["sub"].(string)
+	if id.Subject == "" {
+		return nil, fmt.Errorf("id token has no subject")
+	}
+	id.Email, _ = claims["email"].(string)
+
+	if p.cfg.UsernameClaim != "" {
+		id.Username, _ = claims[p.cfg.UsernameClaim].(string)
+	}
+	if id.Username == "" {
+		id.Username = id.Email
+	}
+	if id.Username == "" {
+		id.Username = id.Subject
+	}
+
+	switch g := claims[p.cfg.GroupsClaim].(type) {
+	case []interface{}:
+		for _, v := range g {
+			if s, ok := v.(string); ok {
+				id.Groups = append(id.Groups, s)
+			}
+		}
+	case string:
+		id.Groups = strings.FieldsFunc(g, func(r rune) bool { return r == ',' || r == ' ' })
+	}
+	return id, nil
+}
+
+// RoleFor maps group memberships to a Kaizoku role. The highest matching
+// role wins; users in no mapped group get the configured default role, and
+// an empty result means the user is not allowed to log in.
+func (p *Provider) RoleFor(groups []string) string {
+	member := func(list []string) bool {
+		for _, g := range groups {
+			for _, want := range list {
+				if g == want {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	switch {
+	case member(p.cfg.AdminGroups):
+		return "admin"
+	case member(p.cfg.ManagerGroups):
+		return "manager"
+	default:
+		return p.cfg.DefaultRole
+	}
+}
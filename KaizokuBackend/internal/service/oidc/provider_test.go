@@ -0,0 +1,317 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/technobecet/kaizoku-go/internal/config"
+)
+
+const testClientID = "kaizoku"
+
+// testKey is an RSA signing key of the test issuer.
+type testKey struct {
+	kid  string
+	priv *rsa.PrivateKey
+}
+
+func newTestKey(t *testing.T, kid string) *testKey {
+	t.Helper()
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testKey{kid: kid, priv: priv}
+}
+
+func (k *testKey) jwk() map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"kid": k.kid,
+		"use": "sig",
+		"alg": "RS256",
+		"n":   base64.RawURLEncoding.EncodeToString(k.priv.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.priv.E)).Bytes()),
+	}
+}
+
+// testIssuer is an OpenID provider served by httptest. Its token endpoint
+// checks the PKCE verifier and returns the ID token set by the test.
+type testIssuer struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	mu          sync.Mutex
+	keys        []*testKey
+	jwksFetches int
+	challenge   string
+	idToken     string
+}
+
+func newTestIssuer(t *testing.T, keys ...*testKey) *testIssuer {
+	iss := &testIssuer{t: t, keys: keys}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 iss.srv.URL,
+			"authorization_endpoint": iss.srv.URL + "/authorize",
+			"token_endpoint":         iss.srv.URL + "/token",
+			"jwks_uri":               iss.srv.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		iss.mu.Lock()
+		defer iss.mu.Unlock()
+		iss.jwksFetches++
+		set := []map[string]string{}
+		for _, k := range iss.keys {
+			set = append(set, k.jwk())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": set})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		iss.mu.Lock()
+		defer iss.mu.Unlock()
+		verifier := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+		if r.FormValue("grant_type") != "authorization_code" || r.FormValue("code") != "the-code" ||
+			base64.RawURLEncoding.EncodeToString(verifier[:]) != iss.challenge {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "at", "token_type": "Bearer", "id_token": iss.idToken})
+	})
+	iss.srv = httptest.NewServer(mux)
+	t.Cleanup(iss.srv.Close)
+	return iss
+}
+
+func (iss *testIssuer) provider() *Provider {
+	return NewProvider(config.OIDCConfig{
+		Issuer:        iss.srv.URL,
+		ClientID:      testClientID,
+		RedirectURL:   "https://kaizoku.example.com/api/auth/oidc/callback",
+		Scopes:        []string{"openid", "profile", "groups"},
+		UsernameClaim: "preferred_username",
+		GroupsClaim:   "groups",
+	}, iss.srv.Client())
+}
+
+// claims returns valid ID token claims for nonce.
+func (iss *testIssuer) claims(nonce string) map[string]interface{} {
+	now := time.Now()
+	return map[string]interface{}{
+		"iss":                iss.srv.URL,
+		"sub":                "user-1",
+		"aud":                testClientID,
+		"exp":                now.Add(5 * time.Minute).Unix(),
+		"iat":                now.Unix(),
+		"nonce":              nonce,
+		"preferred_username": "alice",
+		"email":              "alice@example.com",
+		"groups":             []string{"kaizoku-admins", "readers"},
+	}
+}
+
+// signJWT builds a compact JWS. RS* algorithms sign with key; HS256 uses
+// secret; "none" has no signature.
+func signJWT(t *testing.T, alg, kid string, key *rsa.PrivateKey, secret []byte, claims map[string]interface{}) string {
+	t.Helper()
+	header := map[string]string{"alg": alg, "typ": "JWT"}
+	if kid != "" {
+		header["kid"] = kid
+	}
+	h, _ := json.Marshal(header)
+	c, _ := json.Marshal(claims)
+	input := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c)
+
+	var sig []byte
+	switch alg {
+	case "RS256":
+		sum := sha256.Sum256([]byte(input))
+		var err error
+		if sig, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:]); err != nil {
+			t.Fatal(err)
+		}
+	case "HS256":
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(input))
+		sig = mac.Sum(nil)
+	case "none":
+	default:
+		t.Fatalf("unsupported test alg %s", alg)
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+// login runs a full authorization code flow. mint builds the ID token the
+// issuer returns from the nonce Kaizoku sent.
+func (iss *testIssuer) login(p *Provider, mint func(nonce string) string) (*Identity, error) {
+	iss.t.Helper()
+	ctx := context.Background()
+	state, authURL, err := p.AuthURL(ctx)
+	if err != nil {
+		iss.t.Fatalf("AuthURL: %v", err)
+	}
+	u, err := url.Parse(authURL)
+	if err != nil {
+		iss.t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("state") != state || q.Get("client_id") != testClientID || q.Get("code_challenge_method") != "S256" {
+		iss.t.Fatalf("authorization URL = %s", authURL)
+	}
+
+	iss.mu.Lock()
+	iss.challenge = q.Get("code_challenge")
+	iss.idToken = mint(q.Get("nonce"))
+	iss.mu.Unlock()
+	return p.Exchange(ctx, state, "the-code")
+}
+
+func TestExchangeValidToken(t *testing.T) {
+	key := newTestKey(t, "k1")
+	iss := newTestIssuer(t, key)
+	p := iss.provider()
+
+	id, err := iss.login(p, func(nonce string) string {
+		return signJWT(t, "RS256", "k1", key.priv, nil, iss.claims(nonce))
+	})
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if id.Subject != "user-1" || id.Username != "alice" || id.Email != "alice@example.com" {
+		t.Errorf("identity = %+v", id)
+	}
+	if strings.Join(id.Groups, ",") != "kaizoku-admins,readers" {
+		t.Errorf("groups = %v", id.Groups)
+	}
+}
+
+func TestExchangeRejectsInvalidTokens(t *testing.T) {
+	key := newTestKey(t, "k1")
+	other := newTestKey(t, "k1")
+	iss := newTestIssuer(t, key)
+
+	cases := []struct {
+		name string
+		mint func(nonce string) string
+		want string
+	}{
+		{"wrong audience", func(nonce string) string {
+			c := iss.claims(nonce)
+			c["aud"] = "someone-else"
+			return signJWT(t, "RS256", "k1", key.priv, nil, c)
+		}, "audience"},
+		{"other authorized party", func(nonce string) string {
+			c := iss.claims(nonce)
+			c["aud"] = []string{testClientID, "someone-else"}
+			c["azp"] = "someone-else"
+			return signJWT(t, "RS256", "k1", key.priv, nil, c)
+		}, "issued to"},
+		{"wrong issuer", func(nonce string) string {
+			c := iss.claims(nonce)
+			c["iss"] = "https://evil.example.com"
+			return signJWT(t, "RS256", "k1", key.priv, nil, c)
+		}, "different provider"},
+		{"expired", func(nonce string) string {
+			c := iss.claims(nonce)
+			c["exp"] = time.Now().Add(-2 * clockSkew).Unix()
+			return signJWT(t, "RS256", "k1", key.priv, nil, c)
+		}, "expired"},
+		{"bad signature", func(nonce string) string {
+			return signJWT(t, "RS256", "k1", other.priv, nil, iss.claims(nonce))
+		}, "signature"},
+		{"nonce mismatch", func(nonce string) string {
+			return signJWT(t, "RS256", "k1", key.priv, nil, iss.claims("replayed-nonce"))
+		}, "nonce"},
+		{"alg none", func(nonce string) string {
+			return signJWT(t, "none", "k1", nil, nil, iss.claims(nonce))
+		}, "verify id token"},
+		{"alg HS256 keyed with the public key", func(nonce string) string {
+			return signJWT(t, "HS256", "k1", nil, key.priv.N.Bytes(), iss.claims(nonce))
+		}, "verify id token"},
+		{"malformed", func(string) string { return "not.a.jwt" }, "verify id token"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := iss.login(iss.provider(), tc.mint)
+			if err == nil {
+				t.Fatal("Exchange accepted the token")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("err = %v, want it to mention %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestExchangeRefetchesKeysForUnknownKid(t *testing.T) {
+	k1 := newTestKey(t, "k1")
+	iss := newTestIssuer(t, k1)
+	p := iss.provider()
+
+	if _, err := iss.login(p, func(nonce string) string {
+		return signJWT(t, "RS256", "k1", k1.priv, nil, iss.claims(nonce))
+	}); err != nil {
+		t.Fatalf("login with k1: %v", err)
+	}
+
+	// The issuer rotates to a new key.
+	k2 := newTestKey(t, "k2")
+	iss.mu.Lock()
+	iss.keys = []*testKey{k2}
+	fetches := iss.jwksFetches
+	iss.mu.Unlock()
+
+	if _, err := iss.login(p, func(nonce string) string {
+		return signJWT(t, "RS256", "k2", k2.priv, nil, iss.claims(nonce))
+	}); err != nil {
+		t.Fatalf("login with rotated key: %v", err)
+	}
+	iss.mu.Lock()
+	defer iss.mu.Unlock()
+	if iss.jwksFetches != fetches+1 {
+		t.Fatalf("JWKS fetched %d times after rotation, want once", iss.jwksFetches-fetches)
+	}
+}
+
+func TestExchangeUnknownState(t *testing.T) {
+	iss := newTestIssuer(t, newTestKey(t, "k1"))
+	p := iss.provider()
+	if _, err := p.Exchange(context.Background(), "never-issued", "the-code"); !errors.Is(err, ErrInvalidState) {
+		t.Fatalf("err = %v, want ErrInvalidState", err)
+	}
+}
+
+func TestRoleFor(t *testing.T) {
+	p := NewProvider(config.OIDCConfig{
+		AdminGroups:   []string{"admins"},
+		ManagerGroups: []string{"managers"},
+		DefaultRole:   "viewer",
+	}, nil)
+	for groups, want := range map[string]string{
+		"admins,managers": "admin",
+		"managers":        "manager",
+		"others":          "viewer",
+	} {
+		if got := p.RoleFor(strings.Split(groups, ",")); got != want {
+			t.Errorf("RoleFor(%s) = %q, want %q", groups, got, want)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Package reading tracks per-user reading progress and derives read state
+// (unread counts, where to continue) from it.
+package reading
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/readprogress"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// Service reads and writes reading progress.
+type Service struct {
+	db *ent.Client
+}
+
+// NewService creates a new reading service.
+func NewService(db *ent.Client) *Service {
+	return &Service{db: db}
+}
+
+// Progress describes an update to a chapter's reading progress.
+type Progress struct {
+	Page      int
+	PageCount int
+	Completed bool
+}
+
+// SetProgress records the user's position in a chapter. A chapter whose
+// last page was reached is marked completed.
+func (s *Service) SetProgress(ctx context.Context, userID, seriesID uuid.UUID, number float64, p Progress) (*ent.ReadProgress, error) {
+	if p.Page < 0 {
+		p.Page = 0
+	}
+	if p.PageCount > 0 && p.Page >= p.PageCount-1 {
+		p.Completed = true
+	}
+
+	id, err := s.db.ReadProgress.Create().
+		SetUserID(userID).
+		SetSeriesID(seriesID).
+		SetChapterNumber(number).
+		SetPage(p.Page).
+		SetPageCount(p.PageCount).
+		SetCompleted(p.Completed).
+		SetUpdatedAt(time.Now()).
+		OnConflictColumns(readprogress.FieldUserID, readprogress.FieldSeriesID, readprogress.FieldChapterNumber).
+		UpdateNewValues().
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("save read progress: %w", err)
+	}
+	return s.db.ReadProgress.Get(ctx, id)
+}
+
+// MarkRead sets the completed flag on the given chapters, creating progress
+// records as needed. Marking a chapter unread also resets its page.
+func (s *Service) MarkRead(ctx context.Context, userID, seriesID uuid.UUID, numbers []float64, completed bool) error {
+	for _, n := range numbers {
+		err := s.db.ReadProgress.Create().
+			SetUserID(userID).
+			SetSeriesID(seriesID).
+			SetChapterNumber(n).
+			SetCompleted(completed).
+			SetUpdatedAt(time.Now()).
+			OnConflictColumns(readprogress.FieldUserID, readprogress.FieldSeriesID, readprogress.FieldChapterNumber).
+			Update(func(u *ent.ReadProgressUpsert) {
+				u.SetCompleted(completed)
+				u.UpdateUpdatedAt()
+				if !completed {
+					u.SetPage(0)
+				}
+			}).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("mark chapter %v: %w", n, err)
+		}
+	}
+	return nil
+}
+
+// SeriesProgress returns the user's progress records for one series.
+func (s *Service) SeriesProgress(ctx context.Context, userID, seriesID uuid.UUID) ([]*ent.ReadProgress, error) {
+	return s.db.ReadProgress.Query().
+		Where(readprogress.UserID(userID), readprogress.SeriesID(seriesID)).
+		Order(ent.Asc(readprogress.FieldChapterNumber)).
+		All(ctx)
+}
+
+// ChapterProgress returns the user's progress record for one chapter, or
+// nil if the chapter has not been opened.
+func (s *Service) ChapterProgress(ctx context.Context, userID, seriesID uuid.UUID, number float64) (*ent.ReadProgress, error) {
+	p, err := s.db.ReadProgress.Query().
+		Where(
+			readprogress.UserID(userID),
+			readprogress.SeriesID(seriesID),
+			readprogress.ChapterNumber(number),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return p, err
+}
+
+// UserProgress returns all of the user's progress records grouped by series.
+func (s *Service) UserProgress(ctx context.Context, userID uuid.UUID) (map[uuid.UUID][]*ent.ReadProgress, error) {
+	all, err := s.db.ReadProgress.Query().
+		Where(readprogress.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bySeries := make(map[uuid.UUID][]*ent.ReadProgress)
+	for _, p := range all {
+		bySeries[p.SeriesID] = append(bySeries[p.SeriesID], p)
+	}
+	return bySeries, nil
+}
+
+// State summarises a user's read state for a series.
+type State struct {
+	Unread   int
+	LastRead *time.Time
+	Continue *types.ContinueReading
+}
+
+// ComputeState derives the read state of a series from its available
+// chapter numbers and the user's progress records. The continue pointer is
+// the most recently read unfinished chapter, or else the first unread
+// chapter after the furthest completed one; it is nil once everything has
+// been read.
+func ComputeState(chapters []float64, progress []*ent.ReadProgress) State {
+	sorted := make([]float64, len(chapters))
+	copy(sorted, chapters)
+	sort.Float64s(sorted)
+
+	byNumber := make(map[float64]*ent.ReadProgress, len(progress))
+	var st State
+	var inProgress *ent.ReadProgress
+	for _, p := range progress {
+		byNumber[p.ChapterNumber] = p
+		if st.LastRead == nil || p.UpdatedAt.After(*st.LastRead) {
+			t := p.UpdatedAt
+			st.LastRead = &t
+		}
+		if !p.Completed && p.Page > 0 && (inProgress == nil || p.UpdatedAt.After(inProgress.UpdatedAt)) {
+			inProgress = p
+		}
+	}
+
+	furthest := -1
+	for i, n := range sorted {
+		if p, ok := byNumber[n]; ok && p.Completed {
+			furthest = i
+		} else {
+			st.Unread++
+		}
+	}
+
+	switch {
+	case inProgress != nil:
+		st.Continue = &types.ContinueReading{ChapterNumber: inProgress.ChapterNumber, Page: inProgress.Page}
+	case furthest+1 < len(sorted):
+		for _, n := range sorted[furthest+1:] {
+			if p, ok := byNumber[n]; !ok || !p.Completed {
+				st.Continue = &types.ContinueReading{ChapterNumber: n}
+				break
+			}
+		}
+	}
+	return st
+}
@@ -0,0 +1,171 @@
+// Package reporting aggregates source events into per-source statistics.
+package reporting
+
+import (
+	"math"
+	"time"
+
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/types"
+)
+
+// sourceAgg is an internal accumulator for per-source aggregation.
+type sourceAgg struct {
+	sourceID         string
+	sourceName       string
+	language         string
+	totalDuration    int64
+	maxDuration      int64
+	eventCount       int
+	successCount     int
+	failureCount     int
+	partialCount     int
+	lastEventAt      time.Time
+	lastErrorAt      time.Time
+	lastErrorMessage *string
+	hasError         bool
+	breakdown        map[string]*types.EventTypeBreakdown
+}
+
+// accumulateEvent updates a sourceAgg with data from one event.
+func accumulateEvent(agg *sourceAgg, e *ent.SourceEvent) {
+	agg.eventCount++
+	agg.totalDuration += e.DurationMs
+	if e.DurationMs > agg.maxDuration {
+		agg.maxDuration = e.DurationMs
+	}
+	switch e.Status {
+	case "success":
+		agg.successCount++
+	case "failed":
+		agg.failureCount++
+	case "partial":
+		agg.partialCount++
+	}
+	if e.CreatedAt.After(agg.lastEventAt) {
+		agg.lastEventAt = e.CreatedAt
+	}
+	if e.Status == "failed" && e.CreatedAt.After(agg.lastErrorAt) {
+		agg.lastErrorAt = e.CreatedAt
+		agg.lastErrorMessage = e.ErrorMessage
+		agg.hasError = true
+	}
+}
+
+// newSourceAgg creates a new sourceAgg from an event.
+func newSourceAgg(e *ent.SourceEvent) *sourceAgg {
+	return &sourceAgg{
+		sourceID:   e.SourceID,
+		sourceName: e.SourceName,
+		language:   e.Language,
+		breakdown:  make(map[string]*types.EventTypeBreakdown),
+	}
+}
+
+// toSummary converts a sourceAgg to a SourceStatsSummary.
+func toSummary(agg *sourceAgg) types.SourceStatsSummary {
+	var fr, avgDur float64
+	if agg.eventCount > 0 {
+		fr = math.Round(float64(agg.failureCount)/float64(agg.eventCount)*10000) / 100
+		avgDur = math.Round(float64(agg.totalDuration)/float64(agg.eventCount)*100) / 100
+	}
+	return types.SourceStatsSummary{
+		SourceID:      agg.sourceID,
+		SourceName:    agg.sourceName,
+		Language:      agg.language,
+		AvgDurationMs: avgDur,
+		EventCount:    agg.eventCount,
+		FailureCount:  agg.failureCount,
+		FailureRate:   fr,
+	}
+}
+
+// SourceSummaries aggregates events into one summary per source.
+func SourceSummaries(events []*ent.SourceEvent) []types.SourceStatsSummary {
+	sources := make(map[string]*sourceAgg)
+	for _, e := range events {
+		agg, ok := sources[e.SourceID]
+		if !ok {
+			agg = newSourceAgg(e)
+			sources[e.SourceID] = agg
+		}
+		accumulateEvent(agg, e)
+	}
+
+	summaries := make([]types.SourceStatsSummary, 0, len(sources))
+	for _, agg := range sources {
+		summaries = append(summaries, toSummary(agg))
+	}
+	return summaries
+}
+
+// SourceStats aggregates events into full statistics per source, in no
+// particular order.
+func SourceStats(events []*ent.SourceEvent) []types.SourceStats {
+	sources := make(map[string]*sourceAgg)
+	for _, e := range events {
+		agg, ok := sources[e.SourceID]
+		if !ok {
+			agg = newSourceAgg(e)
+			sources[e.SourceID] = agg
+		}
+		accumulateEvent(agg, e)
+
+		// Event type breakdown.
+		bd, ok := agg.breakdown[e.EventType]
+		if !ok {
+			bd = &types.EventTypeBreakdown{}
+			agg.breakdown[e.EventType] = bd
+		}
+		bd.Total++
+		if e.Status == "success" {
+			bd.Success++
+		} else if e.Status == "failed" {
+			bd.Failed++
+		}
+	}
+
+	result := make([]types.SourceStats, 0, len(sources))
+	for _, agg := range sources {
+		var sr float64
+		var avgDur float64
+		if agg.eventCount > 0 {
+			sr = math.Round(float64(agg.successCount)/float64(agg.eventCount)*10000) / 100
+			avgDur = math.Round(float64(agg.totalDuration)/float64(agg.eventCount)*100) / 100
+		}
+
+		var lastEventAt *string
+		if !agg.lastEventAt.IsZero() {
+			s := agg.lastEventAt.Format(time.RFC3339)
+			lastEventAt = &s
+		}
+		var lastErrorAt *string
+		if agg.hasError {
+			s := agg.lastErrorAt.Format(time.RFC3339)
+			lastErrorAt = &s
+		}
+
+		breakdown := make(map[string]types.EventTypeBreakdown, len(agg.breakdown))
+		for k, v := range agg.breakdown {
+			breakdown[k] = *v
+		}
+
+		result = append(result, types.SourceStats{
+			SourceID:         agg.sourceID,
+			SourceName:       agg.sourceName,
+			Language:         agg.language,
+			TotalEvents:      agg.eventCount,
+			SuccessCount:     agg.successCount,
+			FailureCount:     agg.failureCount,
+			PartialCount:     agg.partialCount,
+			SuccessRate:      sr,
+			AvgDurationMs:    avgDur,
+			MaxDurationMs:    agg.maxDuration,
+			LastEventAt:      lastEventAt,
+			LastErrorAt:      lastErrorAt,
+			LastErrorMessage: agg.lastErrorMessage,
+			Breakdown:        breakdown,
+		})
+	}
+	return result
+}
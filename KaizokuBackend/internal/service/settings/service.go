@@ -272,6 +272,17 @@ func serialize(s *types.Settings) map[string]string {
 		"FlareSolverrTimeout":                       s.FlareSolverrTimeout,
 		"FlareSolverrSessionTtl":                    s.FlareSolverrSessionTTL,
 		"FlareSolverrAsResponseFallback":            strconv.FormatBool(s.FlareSolverrAsResponseFallback),
+		"KomgaEnabled":                              strconv.FormatBool(s.KomgaEnabled),
+		"KomgaUrl":                                  s.KomgaURL,
+		"KomgaApiKey":                               s.KomgaAPIKey,
+		"KomgaLibraryPath":                          s.KomgaLibraryPath,
+		"KavitaEnabled":                             strconv.FormatBool(s.KavitaEnabled),
+		"KavitaUrl":                                 s.KavitaURL,
+		"KavitaApiKey":                              s.KavitaAPIKey,
+		"KavitaLibraryPath":                         s.KavitaLibraryPath,
+		"LibraryScanDelay":                          s.LibraryScanDelay,
+		"DownloadQuietHoursEnabled":                 strconv.FormatBool(s.DownloadQuietHoursEnabled),
+		"DownloadQuietHours":                        FormatQuietHours(s.DownloadQuietHours),
 		"IsWizardSetupComplete":                     strconv.FormatBool(s.IsWizardSetupComplete),
 		"WizardSetupStepCompleted":                  strconv.Itoa(s.WizardSetupStepCompleted),
 	}
@@ -330,6 +341,39 @@ func deserialize(kv map[string]string, s *types.Settings) {
 	if v, ok := kv["FlareSolverrAsResponseFallback"]; ok {
 		s.FlareSolverrAsResponseFallback, _ = strconv.ParseBool(v)
 	}
+	if v, ok := kv["KomgaEnabled"]; ok {
+		s.KomgaEnabled, _ = strconv.ParseBool(v)
+	}
+	if v, ok := kv["KomgaUrl"]; ok {
+		s.KomgaURL = v
+	}
+	if v, ok := kv["KomgaApiKey"]; ok {
+		s.KomgaAPIKey = v
+	}
+	if v, ok := kv["KomgaLibraryPath"]; ok {
+		s.KomgaLibraryPath = v
+	}
+	if v, ok := kv["KavitaEnabled"]; ok {
+		s.KavitaEnabled, _ = strconv.ParseBool(v)
+	}
+	if v, ok := kv["KavitaUrl"]; ok {
+		s.KavitaURL = v
+	}
+	if v, ok := kv["KavitaApiKey"]; ok {
+		s.KavitaAPIKey = v
+	}
+	if v, ok := kv["KavitaLibraryPath"]; ok {
+		s.KavitaLibraryPath = v
+	}
+	if v, ok := kv["LibraryScanDelay"]; ok {
+		s.LibraryScanDelay = v
+	}
+	if v, ok := kv["DownloadQuietHoursEnabled"]; ok {
+		s.DownloadQuietHoursEnabled, _ = strconv.ParseBool(v)
+	}
+	if v, ok := kv["DownloadQuietHours"]; ok {
+		s.DownloadQuietHours = ParseQuietHours(v)
+	}
 	if v, ok := kv["IsWizardSetupComplete"]; ok {
 		s.IsWizardSetupComplete, _ = strconv.ParseBool(v)
 	}
@@ -349,6 +393,68 @@ func splitPipe(s string) []string {
 	return strings.Split(s, "|")
 }
 
+// FormatQuietHours stores quiet-hours windows as "22:00-07:00" or, limited to
+// some weekdays, "09:00-17:00@1,2,3,4,5", separated by pipes.
+func FormatQuietHours(windows []types.QuietHoursWindow) string {
+	parts := make([]string, 0, len(windows))
+	for _, w := range windows {
+		part := w.Start + "-" + w.End
+		if len(w.Days) > 0 {
+			days := make([]string, len(w.Days))
+			for i, d := range w.Days {
+				days[i] = strconv.Itoa(d)
+			}
+			part += "@" + strings.Join(days, ",")
+		}
+		parts = append(parts, part)
+	}
+	return joinPipe(parts)
+}
+
+// ParseQuietHours reads windows stored by FormatQuietHours, skipping
+// malformed ones.
+func ParseQuietHours(s string) []types.QuietHoursWindow {
+	windows := []types.QuietHoursWindow{}
+	for _, part := range splitPipe(s) {
+		span, dayList, _ := strings.Cut(part, "@")
+		start, end, ok := strings.Cut(span, "-")
+		if !ok {
+			continue
+		}
+		w := types.QuietHoursWindow{Start: start, End: end, Days: []int{}}
+		if dayList != "" {
+			for _, d := range strings.Split(dayList, ",") {
+				if n, err := strconv.Atoi(d); err == nil {
+					w.Days = append(w.Days, n)
+				}
+			}
+		}
+		windows = append(windows, w)
+	}
+	return windows
+}
+
+// ValidateQuietHours checks quiet-hours windows before they are saved.
+func ValidateQuietHours(windows []types.QuietHoursWindow) error {
+	for i, w := range windows {
+		if _, err := time.Parse("15:04", w.Start); err != nil {
+			return fmt.Errorf("quiet hours window %d: start must be HH:MM", i+1)
+		}
+		if _, err := time.Parse("15:04", w.End); err != nil {
+			return fmt.Errorf("quiet hours window %d: end must be HH:MM", i+1)
+		}
+		if w.Start == w.End {
+			return fmt.Errorf("quiet hours window %d: start and end are equal", i+1)
+		}
+		for _, d := range w.Days {
+			if d < 0 || d > 6 {
+				return fmt.Errorf("quiet hours window %d: days must be 0 (Sunday) to 6 (Saturday)", i+1)
+			}
+		}
+	}
+	return nil
+}
+
 func parseDuration(s string) time.Duration {
 	// Parse "HH:MM:SS" format used by .NET TimeSpan
 	parts := strings.Split(s, ":")
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/tracing"
 )
 
 // ErrNotFound is returned when a Suwayomi resource returns HTTP 404.
@@ -50,8 +51,12 @@ func NewClient(baseURL string) *Client {
 	}
 }
 
-// doRequest performs an HTTP request with retry logic for 429 responses.
-func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+// doRequest performs an HTTP request with retry logic for 429/5xx responses.
+// Retries after a 429 wait at least as long as its Retry-After header asks.
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (resp *http.Response, err error) {
+	ctx, span := startRequestSpan(ctx, method, path)
+	defer func() { endRequestSpan(span, resp, err) }()
+
 	url := c.baseURL + path
 
 	var bodyReader io.Reader
@@ -64,9 +69,13 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 	}
 
 	var lastErr error
+	var wait time.Duration // Retry-After of the last 429 response
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
 		if attempt > 0 {
-			backoff := time.Duration(math.Pow(2, float64(attempt))) * time.Second
+			requestRetries.Inc(method, endpoint(path))
+			span.SetAttributes(tracing.Int("http.request.resend_count", attempt))
+			backoff := max(time.Duration(math.Pow(2, float64(attempt)))*time.Second, wait)
+			wait = 0
 			log.Warn().
 				Str("url", url).
 				Int("attempt", attempt).
@@ -92,8 +101,11 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		if body != nil {
 			req.Header.Set("Content-Type", "application/json")
 		}
+		setTraceHeader(ctx, req)
 
+		start := time.Now()
 		resp, err := c.httpClient.Do(req)
+		observeAttempt(method, path, start, resp, err)
 		if err != nil {
 			if !shouldRetry(ctx) {
 				return nil, fmt.Errorf("execute request: %w", err)
@@ -104,10 +116,12 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 
 		if resp.StatusCode == http.StatusTooManyRequests {
 			resp.Body.Close()
-			if !shouldRetry(ctx) {
-				return nil, fmt.Errorf("rate limited (429) on %s %s", method, path)
+			wait = handleRateLimit(ctx, resp)
+			rlErr := &RateLimitError{Method: method, Path: path, RetryAfter: wait}
+			if !shouldRetry(ctx) || wait > maxRetryAfter {
+				return nil, rlErr
 			}
-			lastErr = fmt.Errorf("rate limited (429) on %s %s", method, path)
+			lastErr = rlErr
 			continue
 		}
 
@@ -128,7 +142,10 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 }
 
 // doRequestURL performs an HTTP request to an absolute URL with retry logic for 429/5xx responses.
-func (c *Client) doRequestURL(ctx context.Context, method, url string, body interface{}) (*http.Response, error) {
+func (c *Client) doRequestURL(ctx context.Context, method, url string, body interface{}) (resp *http.Response, err error) {
+	ctx, span := startRequestSpan(ctx, method, url)
+	defer func() { endRequestSpan(span, resp, err) }()
+
 	var bodyReader io.Reader
 	if body != nil {
 		jsonBytes, err := json.Marshal(body)
@@ -139,9 +156,13 @@ func (c *Client) doRequestURL(ctx context.Context, method, url string, body inte
 	}
 
 	var lastErr error
+	var wait time.Duration // Retry-After of the last 429 response
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
 		if attempt > 0 {
-			backoff := time.Duration(math.Pow(2, float64(attempt))) * time.Second
+			requestRetries.Inc(method, endpoint(url))
+			span.SetAttributes(tracing.Int("http.request.resend_count", attempt))
+			backoff := max(time.Duration(math.Pow(2, float64(attempt)))*time.Second, wait)
+			wait = 0
 			log.Warn().
 				Str("url", url).
 				Int("attempt", attempt).
@@ -166,8 +187,11 @@ func (c *Client) doRequestURL(ctx context.Context, method, url string, body inte
 		if body != nil {
 			req.Header.Set("Content-Type", "application/json")
 		}
+		setTraceHeader(ctx, req)
 
+		start := time.Now()
 		resp, err := c.httpClient.Do(req)
+		observeAttempt(method, url, start, resp, err)
 		if err != nil {
 			if !shouldRetry(ctx) {
 				return nil, fmt.Errorf("execute request: %w", err)
@@ -178,10 +202,12 @@ func (c *Client) doRequestURL(ctx context.Context, method, url string, body inte
 
 		if resp.StatusCode == http.StatusTooManyRequests {
 			resp.Body.Close()
-			if !shouldRetry(ctx) {
-				return nil, fmt.Errorf("rate limited (429) on %s %s", method, url)
+			wait = handleRateLimit(ctx, resp)
+			rlErr := &RateLimitError{Method: method, Path: url, RetryAfter: wait}
+			if !shouldRetry(ctx) || wait > maxRetryAfter {
+				return nil, rlErr
 			}
-			lastErr = fmt.Errorf("rate limited (429) on %s %s", method, url)
+			lastErr = rlErr
 			continue
 		}
 
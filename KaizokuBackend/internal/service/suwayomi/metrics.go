@@ -0,0 +1,56 @@
+package suwayomi
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/technobecet/kaizoku-go/internal/metrics"
+)
+
+var (
+	requestDuration = metrics.Default.NewHistogramVec(
+		"kaizoku_suwayomi_request_duration_seconds",
+		"Latency of Suwayomi API requests, per attempt.",
+		[]float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}, "method", "endpoint", "code")
+	requestRetries = metrics.Default.NewCounterVec(
+		"kaizoku_suwayomi_request_retries_total",
+		"Suwayomi API requests retried after a failed attempt.",
+		"method", "endpoint")
+	rateLimited = metrics.Default.NewCounterVec(
+		"kaizoku_suwayomi_rate_limited_total",
+		"Suwayomi API responses with status 429.",
+		"method", "endpoint")
+)
+
+// endpoint reduces a request path or URL to a pattern with numeric IDs
+// replaced, e.g. /api/v1/manga/:id/chapter/:id, which keeps the number of
+// label values bounded.
+func endpoint(path string) string {
+	if u, err := url.Parse(path); err == nil {
+		path = u.Path
+	}
+	parts := strings.Split(path, "/")
+	for i, p := range parts {
+		if _, err := strconv.ParseInt(p, 10, 64); err == nil {
+			parts[i] = ":id"
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// observeAttempt records the latency of one request attempt. Transport
+// errors are labelled with code "error".
+func observeAttempt(method, path string, start time.Time, resp *http.Response, err error) {
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	ep := endpoint(path)
+	requestDuration.Observe(time.Since(start).Seconds(), method, ep, code)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		rateLimited.Inc(method, ep)
+	}
+}
@@ -0,0 +1,67 @@
+package suwayomi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRetryAfter is the longest Retry-After a request waits out before
+// retrying. A longer one fails the request with a RateLimitError.
+const maxRetryAfter = 2 * time.Minute
+
+// RateLimitError is returned when Suwayomi keeps answering 429 Too Many
+// Requests. RetryAfter is the wait the last response asked for, or 0.
+type RateLimitError struct {
+	Method     string
+	Path       string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limited (429) on %s %s, retry after %s", e.Method, e.Path, e.RetryAfter)
+	}
+	return fmt.Sprintf("rate limited (429) on %s %s", e.Method, e.Path)
+}
+
+const rateLimitHandlerKey ctxKey = "rateLimitHandler"
+
+// WithRateLimitHandler returns a context whose requests call fn on every 429
+// response, with the wait from its Retry-After header (0 when absent). The
+// caller uses it to slow down other requests to the same source.
+func WithRateLimitHandler(ctx context.Context, fn func(retryAfter time.Duration)) context.Context {
+	return context.WithValue(ctx, rateLimitHandlerKey, fn)
+}
+
+// handleRateLimit handles a 429 response: it reports it to the context's
+// handler and returns the wait the response asked for.
+func handleRateLimit(ctx context.Context, resp *http.Response) time.Duration {
+	wait := retryAfter(resp.Header.Get("Retry-After"), time.Now())
+	if fn, ok := ctx.Value(rateLimitHandlerKey).(func(time.Duration)); ok {
+		fn(wait)
+	}
+	return wait
+}
+
+// retryAfter parses a Retry-After header, given in seconds or as an HTTP
+// date. It returns 0 when the header is absent or invalid.
+func retryAfter(header string, now time.Time) time.Duration {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
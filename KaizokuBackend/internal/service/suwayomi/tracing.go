@@ -0,0 +1,33 @@
+package suwayomi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/technobecet/kaizoku-go/internal/tracing"
+)
+
+// startRequestSpan starts the client span that covers a request and all of
+// its retries.
+func startRequestSpan(ctx context.Context, method, path string) (context.Context, *tracing.Span) {
+	ep := endpoint(path)
+	return tracing.Start(ctx, "suwayomi "+method+" "+ep, tracing.KindClient,
+		tracing.String("http.request.method", method),
+		tracing.String("http.route", ep))
+}
+
+// endRequestSpan records the outcome of a request and ends its span.
+func endRequestSpan(span *tracing.Span, resp *http.Response, err error) {
+	if resp != nil {
+		span.SetAttributes(tracing.Int("http.response.status_code", resp.StatusCode))
+	}
+	span.RecordError(err)
+	span.End()
+}
+
+// setTraceHeader propagates the current trace to Suwayomi.
+func setTraceHeader(ctx context.Context, req *http.Request) {
+	if tp := tracing.TraceParent(ctx); tp != "" {
+		req.Header.Set("traceparent", tp)
+	}
+}
@@ -0,0 +1,274 @@
+// Package webhook delivers events to user-configured HTTP endpoints. Each
+// delivery is stored before it is sent, so the job queue can retry it and
+// the delivery log shows every outcome.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/technobecet/kaizoku-go/internal/ent"
+	"github.com/technobecet/kaizoku-go/internal/ent/webhook"
+	"github.com/technobecet/kaizoku-go/internal/events"
+)
+
+// Delivery statuses.
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// TestEvent is the event type sent by the "test" action. It is delivered
+// to the chosen webhook regardless of its event filter.
+const TestEvent events.Type = "webhook.test"
+
+const (
+	// requestTimeout bounds a single delivery attempt.
+	requestTimeout = 15 * time.Second
+	// maxResponseBody is how much of a response body is kept in the log.
+	maxResponseBody = 2048
+)
+
+// Service renders, signs and sends webhook deliveries.
+type Service struct {
+	db     *ent.Client
+	client *http.Client
+}
+
+// NewService creates a webhook service. A nil client uses a client with a
+// per-request timeout.
+func NewService(db *ent.Client, client *http.Client) *Service {
+	if client == nil {
+		client = &http.Client{Timeout: requestTimeout}
+	}
+	return &Service{db: db, client: client}
+}
+
+// ValidateURL checks that a webhook target is an absolute http(s) URL.
+func ValidateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+	return nil
+}
+
+// ValidateEvents checks that every entry is a known event type.
+func ValidateEvents(list []string) error {
+	for _, e := range list {
+		if !events.Valid(events.Type(e)) {
+			return fmt.Errorf("unknown event type %q", e)
+		}
+	}
+	return nil
+}
+
+// parseTemplate parses a body template. The json function encodes a value
+// as JSON, so strings can be embedded safely: {"text": {{json .Data.title}}}.
+func parseTemplate(text string) (*template.Template, error) {
+	return template.New("body").
+		Funcs(template.FuncMap{
+			"json": func(v interface{}) (string, error) {
+				b, err := json.Marshal(v)
+				return string(b), err
+			},
+		}).
+		Option("missingkey=zero").
+		Parse(text)
+}
+
+// ValidateTemplate checks that a body template parses. Whether it renders
+// valid JSON depends on the event, so that is checked per delivery.
+func ValidateTemplate(text string) error {
+	if _, err := parseTemplate(text); err != nil {
+		return fmt.Errorf("parse body template: %w", err)
+	}
+	return nil
+}
+
+// Render produces the request body for an event. Without a template the
+// event itself is sent as JSON.
+func Render(text string, e events.Event) ([]byte, error) {
+	if text == "" {
+		return json.Marshal(e)
+	}
+	tmpl, err := parseTemplate(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse body template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, e); err != nil {
+		return nil, fmt.Errorf("render body template: %w", err)
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, errors.New("body template did not produce valid JSON")
+	}
+	return buf.Bytes(), nil
+}
+
+// Sign returns the signature header value for a delivery: the hex
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the webhook secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Matches reports whether a webhook subscribes to an event type.
+func Matches(h *ent.Webhook, t events.Type) bool {
+	if len(h.Events) == 0 {
+		return true
+	}
+	for _, e := range h.Events {
+		if events.Type(e) == t {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateDeliveries stores a pending delivery of e for every enabled webhook
+// subscribed to it and returns their IDs. A webhook whose template fails to
+// render gets a failed delivery instead, so the problem shows in the log.
+func (s *Service) CreateDeliveries(ctx context.Context, e events.Event) ([]uuid.UUID, error) {
+	hooks, err := s.db.Webhook.Query().Where(webhook.Enabled(true)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load webhooks: %w", err)
+	}
+
+	var ids []uuid.UUID
+	for _, h := range hooks {
+		if !Matches(h, e.Type) {
+			continue
+		}
+		d, err := s.CreateDelivery(ctx, h, e)
+		if err != nil {
+			return ids, err
+		}
+		if d.Status == StatusPending {
+			ids = append(ids, d.ID)
+		}
+	}
+	return ids, nil
+}
+
+// CreateDelivery stores a delivery of e to one webhook.
+func (s *Service) CreateDelivery(ctx context.Context, h *ent.Webhook, e events.Event) (*ent.WebhookDelivery, error) {
+	create := s.db.WebhookDelivery.Create().
+		SetWebhookID(h.ID).
+		SetEventID(e.ID).
+		SetEventType(string(e.Type))
+
+	body, err := Render(h.BodyTemplate, e)
+	if err != nil {
+		create = create.SetPayload("").SetStatus(StatusFailed).SetError(err.Error())
+	} else {
+		create = create.SetPayload(string(body))
+	}
+
+	d, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create webhook delivery: %w", err)
+	}
+	return d, nil
+}
+
+// Deliver makes one attempt at sending a delivery and records the outcome.
+// It returns an error when the attempt failed so the caller can retry;
+// when final is set, a failure marks the delivery failed instead of
+// scheduling nextAttempt.
+func (s *Service) Deliver(ctx context.Context, id uuid.UUID, final bool, nextAttempt time.Time) error {
+	d, err := s.db.WebhookDelivery.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("load webhook delivery: %w", err)
+	}
+	if d.Status != StatusPending {
+		return nil
+	}
+	h, err := s.db.Webhook.Get(ctx, d.WebhookID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return s.db.WebhookDelivery.UpdateOneID(id).
+				SetStatus(StatusFailed).
+				SetError("webhook was deleted").
+				ClearNextAttemptAt().
+				Exec(ctx)
+		}
+		return fmt.Errorf("load webhook: %w", err)
+	}
+
+	start := time.Now()
+	status, respBody, sendErr := s.send(ctx, h, d)
+
+	update := s.db.WebhookDelivery.UpdateOneID(id).
+		AddAttempts(1).
+		SetDurationMs(time.Since(start).Milliseconds()).
+		SetResponseBody(respBody)
+	if status != 0 {
+		update = update.SetResponseStatus(status)
+	} else {
+		update = update.ClearResponseStatus()
+	}
+	switch {
+	case sendErr == nil:
+		update = update.SetStatus(StatusSucceeded).SetError("").ClearNextAttemptAt()
+	case final:
+		update = update.SetStatus(StatusFailed).SetError(sendErr.Error()).ClearNextAttemptAt()
+	default:
+		update = update.SetError(sendErr.Error()).SetNextAttemptAt(nextAttempt)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("record webhook delivery: %w", err)
+	}
+	return sendErr
+}
+
+// send POSTs a delivery and returns the response status and the start of
+// the response body. Any non-2xx status is an error.
+func (s *Service) send(ctx context.Context, h *ent.Webhook, d *ent.WebhookDelivery) (int, string, error) {
+	body := []byte(d.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, "", fmt.Errorf("build request: %w", err)
+	}
+	ts := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Kaizoku-Webhook")
+	req.Header.Set("X-Kaizoku-Event", d.EventType)
+	req.Header.Set("X-Kaizoku-Delivery", d.ID.String())
+	req.Header.Set("X-Kaizoku-Timestamp", strconv.FormatInt(ts, 10))
+	if h.Secret != "" {
+		req.Header.Set("X-Kaizoku-Signature", Sign(h.Secret, ts, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	// Postgres text columns reject invalid UTF-8 and NUL bytes.
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	snippet := strings.ReplaceAll(strings.ToValidUTF8(string(raw), ""), "\x00", "")
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, snippet, fmt.Errorf("endpoint returned status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, snippet, nil
+}
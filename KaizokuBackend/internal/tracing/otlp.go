@@ -0,0 +1,56 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// queueSize is how many finished spans may wait for export; further
+	// spans are dropped.
+	queueSize = 4096
+	// batchSize is the most spans sent in one request.
+	batchSize = 512
+	// flushInterval is how long a span waits at most before it is sent.
+	flushInterval = 5 * time.Second
+	// exportTimeout bounds one export request.
+	exportTimeout = 10 * time.Second
+)
+
+// newExporter creates the OTLP/HTTP exporter for cfg. Spans are posted to
+// <endpoint>/v1/traces unless the endpoint already names that path.
+func newExporter(cfg config.TracingConfig) (sdktrace.SpanExporter, error) {
+	url := strings.TrimRight(cfg.Endpoint, "/")
+	if !strings.HasSuffix(url, "/v1/traces") {
+		url += "/v1/traces"
+	}
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpointURL(url),
+		otlptracehttp.WithTimeout(exportTimeout),
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+	}
+	return otlptracehttp.New(context.Background(), opts...)
+}
+
+// newResource describes this process to the collector.
+func newResource(cfg config.TracingConfig) *resource.Resource {
+	service := cfg.ServiceName
+	if service == "" {
+		service = "kaizoku"
+	}
+	res, err := resource.Merge(resource.Default(),
+		resource.NewSchemaless(attribute.String("service.name", service)))
+	if err != nil {
+		return resource.NewSchemaless(attribute.String("service.name", service))
+	}
+	return res
+}
@@ -0,0 +1,174 @@
+// Package tracing records request and job traces with the OpenTelemetry SDK
+// and exports them to a collector over OTLP/HTTP. It wraps the small part of
+// the OpenTelemetry API that Kaizoku needs: spans with attributes,
+// parent-based ratio sampling and W3C traceparent propagation.
+//
+// Tracing is off until Init is called with tracing enabled; until then
+// Start returns a nil span, and every Span method is safe on nil.
+package tracing
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/rs/zerolog/log"
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the tracer that records Kaizoku's spans.
+const instrumentationName = "github.com/technobecet/kaizoku-go"
+
+// SpanKind is the role of a span.
+type SpanKind = trace.SpanKind
+
+const (
+	KindInternal = trace.SpanKindInternal
+	KindServer   = trace.SpanKindServer
+	KindClient   = trace.SpanKindClient
+	KindProducer = trace.SpanKindProducer
+	KindConsumer = trace.SpanKindConsumer
+)
+
+// Attr is a span attribute.
+type Attr = attribute.KeyValue
+
+func String(key, value string) Attr          { return attribute.String(key, value) }
+func Int(key string, value int) Attr         { return attribute.Int(key, value) }
+func Int64(key string, value int64) Attr     { return attribute.Int64(key, value) }
+func Float64(key string, value float64) Attr { return attribute.Float64(key, value) }
+func Bool(key string, value bool) Attr       { return attribute.Bool(key, value) }
+
+// Span is an operation in a trace.
+type Span struct {
+	span trace.Span
+}
+
+// SetAttributes adds attributes to the span, replacing any with the same
+// key.
+func (s *Span) SetAttributes(attrs ...Attr) {
+	if s == nil {
+		return
+	}
+	s.span.SetAttributes(attrs...)
+}
+
+// RecordError marks the span as failed. A nil error is ignored.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// SetError marks the span as failed with a message.
+func (s *Span) SetError(msg string) {
+	if s == nil {
+		return
+	}
+	s.span.SetStatus(codes.Error, msg)
+}
+
+// End finishes the span and queues it for export.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.span.End()
+}
+
+// Tracer creates spans and hands finished ones to the exporter.
+type Tracer struct {
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+}
+
+var global atomic.Pointer[Tracer]
+
+// propagator reads and writes W3C traceparent headers.
+var propagator = propagation.TraceContext{}
+
+// Init enables tracing when cfg.Enabled is set. A bad exporter
+// configuration is logged and leaves tracing off.
+func Init(cfg config.TracingConfig) {
+	if !cfg.Enabled {
+		return
+	}
+	exp, err := newExporter(cfg)
+	if err != nil {
+		log.Error().Err(err).Msg("tracing: failed to create OTLP exporter, tracing stays off")
+		return
+	}
+	start(cfg, sdktrace.WithBatcher(exp,
+		sdktrace.WithMaxQueueSize(queueSize),
+		sdktrace.WithMaxExportBatchSize(batchSize),
+		sdktrace.WithBatchTimeout(flushInterval)))
+}
+
+// start installs a tracer provider that hands spans to processor.
+func start(cfg config.TracingConfig, processor sdktrace.TracerProviderOption) {
+	ratio := cfg.SampleRatio
+	if ratio < 0 || ratio > 1 {
+		ratio = 1
+	}
+	provider := sdktrace.NewTracerProvider(
+		processor,
+		sdktrace.WithResource(newResource(cfg)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	global.Store(&Tracer{provider: provider, tracer: provider.Tracer(instrumentationName)})
+}
+
+// Shutdown exports the spans still queued and stops tracing.
+func Shutdown(ctx context.Context) error {
+	t := global.Swap(nil)
+	if t == nil {
+		return nil
+	}
+	return t.provider.Shutdown(ctx)
+}
+
+// Enabled reports whether tracing is on.
+func Enabled() bool {
+	return global.Load() != nil
+}
+
+// TraceParent returns the traceparent header of the current span, or ""
+// when there is none.
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier.Get("traceparent")
+}
+
+// ContextWithTraceParent continues the trace of a traceparent header, e.g.
+// one received over HTTP or stored with a job. An invalid header leaves ctx
+// unchanged.
+func ContextWithTraceParent(ctx context.Context, traceparent string) context.Context {
+	if traceparent == "" {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier{"traceparent": traceparent})
+}
+
+// Recording reports whether a sampled span is active in ctx. Callers use it
+// to skip spans that are only worth recording inside a larger trace.
+func Recording(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsSampled() && Enabled()
+}
+
+// Start begins a span as a child of the span in ctx, or as the root of a new
+// trace. It returns nil when tracing is off.
+func Start(ctx context.Context, name string, kind SpanKind, attrs ...Attr) (context.Context, *Span) {
+	t := global.Load()
+	if t == nil {
+		return ctx, nil
+	}
+	ctx, span := t.tracer.Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+	return ctx, &Span{span: span}
+}
@@ -0,0 +1,172 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/technobecet/kaizoku-go/internal/config"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const (
+	sampledParent   = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	unsampledParent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-00"
+)
+
+// startInMemory enables tracing with spans collected in memory.
+func startInMemory(t *testing.T, ratio float64) *tracetest.InMemoryExporter {
+	t.Helper()
+	exp := tracetest.NewInMemoryExporter()
+	start(config.TracingConfig{Enabled: true, SampleRatio: ratio}, sdktrace.WithSyncer(exp))
+	t.Cleanup(func() { _ = Shutdown(context.Background()) })
+	return exp
+}
+
+func TestDisabled(t *testing.T) {
+	if Enabled() {
+		t.Fatal("tracing enabled before Init")
+	}
+	ctx, span := Start(context.Background(), "op", KindInternal)
+	if span != nil {
+		t.Fatal("Start returned a span with tracing off")
+	}
+	span.SetAttributes(String("k", "v"))
+	span.RecordError(errors.New("boom"))
+	span.SetError("boom")
+	span.End()
+
+	// Propagation works without a tracer, so jobs keep the request's trace.
+	ctx = ContextWithTraceParent(ctx, sampledParent)
+	if got := TraceParent(ctx); got != sampledParent {
+		t.Fatalf("TraceParent = %q, want %q", got, sampledParent)
+	}
+	if Recording(ctx) {
+		t.Fatal("Recording with tracing off")
+	}
+}
+
+func TestStartContinuesTraceParent(t *testing.T) {
+	exp := startInMemory(t, 1)
+
+	ctx := ContextWithTraceParent(context.Background(), sampledParent)
+	ctx, span := Start(ctx, "GET /api/serie", KindServer, String("http.route", "/api/serie"))
+	if !Recording(ctx) {
+		t.Fatal("Recording = false inside a sampled span")
+	}
+	tp := TraceParent(ctx)
+	if !strings.HasPrefix(tp, "00-0af7651916cd43dd8448eb211c80319c-") || strings.Contains(tp, "b7ad6b7169203331") || !strings.HasSuffix(tp, "-01") {
+		t.Fatalf("TraceParent = %q, want the parent's trace with a new span ID", tp)
+	}
+	span.SetAttributes(Int("http.response.status_code", 500))
+	span.RecordError(errors.New("boom"))
+	span.End()
+
+	spans := exp.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("exported %d spans, want 1", len(spans))
+	}
+	got := spans[0]
+	if got.Name != "GET /api/serie" || got.SpanKind != KindServer {
+		t.Errorf("span = %q kind %v", got.Name, got.SpanKind)
+	}
+	if got.Parent.SpanID().String() != "b7ad6b7169203331" || !got.Parent.IsRemote() {
+		t.Errorf("parent = %v, want remote span b7ad6b7169203331", got.Parent.SpanID())
+	}
+	if got.Status.Code != codes.Error || got.Status.Description != "boom" {
+		t.Errorf("status = %v %q, want error \"boom\"", got.Status.Code, got.Status.Description)
+	}
+	attrs := map[string]string{}
+	for _, a := range got.Attributes {
+		attrs[string(a.Key)] = a.Value.Emit()
+	}
+	if attrs["http.route"] != "/api/serie" || attrs["http.response.status_code"] != "500" {
+		t.Errorf("attributes = %v", attrs)
+	}
+}
+
+func TestSampling(t *testing.T) {
+	exp := startInMemory(t, 0)
+
+	// New traces follow the ratio.
+	_, span := Start(context.Background(), "root", KindInternal)
+	span.End()
+	// Continued traces follow the caller's decision.
+	_, span = Start(ContextWithTraceParent(context.Background(), unsampledParent), "unsampled", KindServer)
+	span.End()
+	ctx, span := Start(ContextWithTraceParent(context.Background(), sampledParent), "sampled", KindServer)
+	_, child := Start(ctx, "child", KindClient)
+	child.End()
+	span.End()
+
+	var names []string
+	for _, s := range exp.GetSpans() {
+		names = append(names, s.Name)
+	}
+	if strings.Join(names, ",") != "child,sampled" {
+		t.Fatalf("exported %v, want [child sampled]", names)
+	}
+}
+
+func TestInvalidTraceParent(t *testing.T) {
+	startInMemory(t, 1)
+
+	for _, tp := range []string{"", "garbage", "00-00000000000000000000000000000000-b7ad6b7169203331-01"} {
+		ctx := ContextWithTraceParent(context.Background(), tp)
+		if got := TraceParent(ctx); got != "" {
+			t.Errorf("ContextWithTraceParent(%q): TraceParent = %q, want none", tp, got)
+		}
+	}
+}
+
+func TestExportsOverOTLPHTTP(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		paths    []string
+		apiKey   string
+		mimeType string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		apiKey = r.Header.Get("X-Api-Key")
+		mimeType = r.Header.Get("Content-Type")
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	Init(config.TracingConfig{
+		Enabled:     true,
+		Endpoint:    srv.URL + "/",
+		Headers:     map[string]string{"X-Api-Key": "secret"},
+		SampleRatio: 1,
+	})
+	if !Enabled() {
+		t.Fatal("Init did not enable tracing")
+	}
+	_, span := Start(context.Background(), "job", KindConsumer)
+	span.End()
+	if err := Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(paths) != 1 || paths[0] != "/v1/traces" {
+		t.Fatalf("collector requests = %v, want one POST to /v1/traces", paths)
+	}
+	if apiKey != "secret" {
+		t.Errorf("X-Api-Key = %q, want configured header", apiKey)
+	}
+	if mimeType != "application/x-protobuf" {
+		t.Errorf("Content-Type = %q", mimeType)
+	}
+}
@@ -37,6 +37,9 @@ type DownloadChapterArgs struct {
 	ReplacingProviderID uuid.UUID `json:"replacingProviderId,omitempty"`
 	ReplacingFilename   string    `json:"replacingFilename,omitempty"`
 	ReplacementRetry    int       `json:"replacementRetry,omitempty"`
+
+	// TraceParent links the download to the trace that queued it.
+	TraceParent string `json:"traceParent,omitempty"`
 }
 
 // Download queue status constants.
@@ -2,26 +2,57 @@ package types
 
 // Settings is the full settings DTO returned by GET /api/settings.
 type Settings struct {
-	StorageFolder                            string   `json:"storageFolder"`
-	PreferredLanguages                       []string `json:"preferredLanguages"`
-	MihonRepositories                        []string `json:"mihonRepositories"`
-	NumberOfSimultaneousDownloads            int      `json:"numberOfSimultaneousDownloads"`
-	NumberOfSimultaneousSearches             int      `json:"numberOfSimultaneousSearches"`
-	NumberOfSimultaneousDownloadsPerProvider int      `json:"numberOfSimultaneousDownloadsPerProvider"`
-	ChapterDownloadFailRetryTime             string   `json:"chapterDownloadFailRetryTime"`
-	ChapterDownloadFailRetries               int      `json:"chapterDownloadFailRetries"`
-	PerTitleUpdateSchedule                   string   `json:"perTitleUpdateSchedule"`
-	PerSourceUpdateSchedule                  string   `json:"perSourceUpdateSchedule"`
-	ExtensionsCheckForUpdateSchedule         string   `json:"extensionsCheckForUpdateSchedule"`
-	CategorizedFolders                       bool     `json:"categorizedFolders"`
-	Categories                               []string `json:"categories"`
-	FlareSolverrEnabled                      bool     `json:"flareSolverrEnabled"`
-	FlareSolverrURL                          string   `json:"flareSolverrUrl"`
-	FlareSolverrTimeout                      string   `json:"flareSolverrTimeout"`
-	FlareSolverrSessionTTL                   string   `json:"flareSolverrSessionTtl"`
-	FlareSolverrAsResponseFallback           bool     `json:"flareSolverrAsResponseFallback"`
-	IsWizardSetupComplete                    bool     `json:"isWizardSetupComplete"`
-	WizardSetupStepCompleted                 int      `json:"wizardSetupStepCompleted"`
+	StorageFolder                            string             `json:"storageFolder"`
+	PreferredLanguages                       []string           `json:"preferredLanguages"`
+	MihonRepositories                        []string           `json:"mihonRepositories"`
+	NumberOfSimultaneousDownloads            int                `json:"numberOfSimultaneousDownloads"`
+	NumberOfSimultaneousSearches             int                `json:"numberOfSimultaneousSearches"`
+	NumberOfSimultaneousDownloadsPerProvider int                `json:"numberOfSimultaneousDownloadsPerProvider"`
+	ChapterDownloadFailRetryTime             string             `json:"chapterDownloadFailRetryTime"`
+	ChapterDownloadFailRetries               int                `json:"chapterDownloadFailRetries"`
+	PerTitleUpdateSchedule                   string             `json:"perTitleUpdateSchedule"`
+	PerSourceUpdateSchedule                  string             `json:"perSourceUpdateSchedule"`
+	ExtensionsCheckForUpdateSchedule         string             `json:"extensionsCheckForUpdateSchedule"`
+	CategorizedFolders                       bool               `json:"categorizedFolders"`
+	Categories                               []string           `json:"categories"`
+	FlareSolverrEnabled                      bool               `json:"flareSolverrEnabled"`
+	FlareSolverrURL                          string             `json:"flareSolverrUrl"`
+	FlareSolverrTimeout                      string             `json:"flareSolverrTimeout"`
+	FlareSolverrSessionTTL                   string             `json:"flareSolverrSessionTtl"`
+	FlareSolverrAsResponseFallback           bool               `json:"flareSolverrAsResponseFallback"`
+	KomgaEnabled                             bool               `json:"komgaEnabled"`
+	KomgaURL                                 string             `json:"komgaUrl"`
+	KomgaAPIKey                              string             `json:"komgaApiKey"`
+	KomgaLibraryPath                         string             `json:"komgaLibraryPath"`
+	KavitaEnabled                            bool               `json:"kavitaEnabled"`
+	KavitaURL                                string             `json:"kavitaUrl"`
+	KavitaAPIKey                             string             `json:"kavitaApiKey"`
+	KavitaLibraryPath                        string             `json:"kavitaLibraryPath"`
+	LibraryScanDelay                         string             `json:"libraryScanDelay"`
+	DownloadQuietHoursEnabled                bool               `json:"downloadQuietHoursEnabled"`
+	DownloadQuietHours                       []QuietHoursWindow `json:"downloadQuietHours"`
+	IsWizardSetupComplete                    bool               `json:"isWizardSetupComplete"`
+	WizardSetupStepCompleted                 int                `json:"wizardSetupStepCompleted"`
+}
+
+// QuietHoursWindow is a recurring window, in server time, during which no
+// downloads start. A window whose end is before its start spans midnight,
+// e.g. 22:00–07:00. Days limits the window to the weekdays it starts on
+// (0 = Sunday); empty means every day.
+type QuietHoursWindow struct {
+	Start string `json:"start"` // HH:MM
+	End   string `json:"end"`   // HH:MM
+	Days  []int  `json:"days"`
+}
+
+// DownloadQueueState tells whether the download queue starts new downloads.
+// Running downloads always finish.
+type DownloadQueueState struct {
+	Active     bool    `json:"active"`     // new downloads start
+	Paused     bool    `json:"paused"`     // paused through the API
+	PausedAt   *string `json:"pausedAt"`   // when it was paused (RFC 3339)
+	QuietHours bool    `json:"quietHours"` // inside a quiet-hours window
+	QuietUntil *string `json:"quietUntil"` // when the quiet-hours window ends (RFC 3339)
 }
 
 // DefaultSettings returns the default settings matching .NET FirstTimeSettings.
@@ -44,6 +75,13 @@ func DefaultSettings() Settings {
 		FlareSolverrTimeout:                      "00:00:30",
 		FlareSolverrSessionTTL:                   "00:15:00",
 		FlareSolverrAsResponseFallback:           false,
+		KomgaEnabled:                             false,
+		KomgaURL:                                 "http://localhost:25600",
+		KavitaEnabled:                            false,
+		KavitaURL:                                "http://localhost:5000",
+		LibraryScanDelay:                         "00:00:30",
+		DownloadQuietHoursEnabled:                false,
+		DownloadQuietHours:                       []QuietHoursWindow{},
 		IsWizardSetupComplete:                    false,
 		WizardSetupStepCompleted:                 0,
 	}
@@ -74,9 +112,21 @@ type DownloadInfoList struct {
 
 // DownloadsMetrics contains download queue counts.
 type DownloadsMetrics struct {
-	Downloads int `json:"downloads"`
-	Queued    int `json:"queued"`
-	Failed    int `json:"failed"`
+	Downloads int              `json:"downloads"`
+	Queued    int              `json:"queued"`
+	Failed    int              `json:"failed"`
+	Throttled []SourceThrottle `json:"throttled"` // sources currently held back by their rate limits
+}
+
+// SourceThrottle is the state of a source whose rate limit is holding back
+// requests on this instance.
+type SourceThrottle struct {
+	Source          string  `json:"source"`
+	PagesPerMinute  int     `json:"pagesPerMinute"`
+	ChaptersPerHour int     `json:"chaptersPerHour"`
+	Waiting         int     `json:"waiting"`       // requests waiting for the limit
+	BlockedUntil    *string `json:"blockedUntil"`  // set after a 429 with Retry-After (RFC 3339)
+	NextChapterAt   *string `json:"nextChapterAt"` // when the chapters-per-hour limit lets the next download start (RFC 3339)
 }
 
 // SeriesInfo is the library list item with provider summaries.
@@ -99,6 +149,15 @@ type SeriesInfo struct {
 	PausedDownloads    bool                `json:"pausedDownloads"`
 	HasUnknown         bool                `json:"hasUnknown"`
 	Providers          []SmallProviderInfo `json:"providers"`
+	UnreadCount        int                 `json:"unreadCount"`
+	LastReadUTC        *string             `json:"lastReadUTC"`
+	ContinueReading    *ContinueReading    `json:"continueReading"`
+}
+
+// ContinueReading points at where a user should resume reading a series.
+type ContinueReading struct {
+	ChapterNumber float64 `json:"chapterNumber"`
+	Page          int     `json:"page"`
 }
 
 // SmallProviderInfo is a minimal provider summary.
@@ -185,6 +244,15 @@ type SeriesExtendedInfo struct {
 	LastChangeProvider *SmallProviderInfo     `json:"lastChangeProvider"`
 	IsActive           bool                   `json:"isActive"`
 	PausedDownloads    bool                   `json:"pausedDownloads"`
+	Notify             bool                   `json:"notify"`
+	// Cadence is the inferred release schedule: daily, weekly, biweekly,
+	// monthly, regular (every CadenceDays days), irregular, unknown or ended.
+	Cadence            string                 `json:"cadence"`
+	CadenceDays        int                    `json:"cadenceDays"`
+	NextRelease        *string                `json:"nextRelease"`
+	NextChapter        *float64               `json:"nextChapter"`
+	// Overdue is set when NextRelease has passed without a new chapter.
+	Overdue            bool                   `json:"overdue"`
 	HasUnknown         bool                   `json:"hasUnknown"`
 	Providers          []ProviderExtendedInfo `json:"providers"`
 	ChapterList        string                 `json:"chapterList"`
@@ -479,3 +547,239 @@ type TimelineBucket struct {
 	AvgDurationMs float64 `json:"avgDurationMs"`
 	TotalEvents   int     `json:"totalEvents"`
 }
+
+// --- Auth DTOs ---
+
+// APIKeyInfo describes an API key without its secret.
+type APIKeyInfo struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	Prefix     string  `json:"prefix"`
+	CreatedAt  string  `json:"createdAt"`
+	LastUsedAt *string `json:"lastUsedAt"`
+	RevokedAt  *string `json:"revokedAt"`
+}
+
+// CreatedAPIKey is returned once when a key is created; Key is the plaintext secret.
+type CreatedAPIKey struct {
+	APIKeyInfo
+	Key string `json:"key"`
+}
+
+// UserInfo describes a user account without its password.
+type UserInfo struct {
+	ID          string  `json:"id"`
+	Username    string  `json:"username"`
+	Role        string  `json:"role"`
+	Source      string  `json:"source"`
+	Disabled    bool    `json:"disabled"`
+	CreatedAt   string  `json:"createdAt"`
+	LastLoginAt *string `json:"lastLoginAt"`
+}
+
+// AuthInfo describes the caller of GET /api/auth/me.
+type AuthInfo struct {
+	AuthEnabled bool   `json:"authEnabled"`
+	Kind        string `json:"kind"`
+	Name        string `json:"name"`
+	UserID      string `json:"userId"`
+	Username    string `json:"username"`
+	Role        string `json:"role"`
+}
+
+// FeedTokenInfo describes the caller's feed token and the feed URLs that use
+// it. Token and the token in the URLs are only filled in when the token is
+// created. SeriesURL is a template; replace {id} with a series ID.
+type FeedTokenInfo struct {
+	Active      bool   `json:"active"`
+	Token       string `json:"token"`
+	LibraryURL  string `json:"libraryUrl"`
+	SeriesURL   string `json:"seriesUrl"`
+	CalendarURL string `json:"calendarUrl"`
+}
+
+// --- Audit DTOs ---
+
+// AuditChange is the old and new value of a single changed field.
+type AuditChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// AuditEventDTO is a single entry of the audit log.
+type AuditEventDTO struct {
+	ID         string                 `json:"id"`
+	ActorKind  string                 `json:"actorKind"`
+	ActorID    string                 `json:"actorId"`
+	ActorName  string                 `json:"actorName"`
+	Action     string                 `json:"action"`
+	TargetType string                 `json:"targetType"`
+	TargetIDs  []string               `json:"targetIds"`
+	Diff       map[string]AuditChange `json:"diff"`
+	Details    map[string]interface{} `json:"details"`
+	IP         string                 `json:"ip"`
+	CreatedAt  string                 `json:"createdAt"`
+}
+
+// AuditEventList wraps audit events with total count for pagination.
+type AuditEventList struct {
+	Total  int             `json:"total"`
+	Events []AuditEventDTO `json:"events"`
+}
+
+// --- Reader DTOs ---
+
+// ReaderChapter is a downloaded chapter that can be read in the browser.
+type ReaderChapter struct {
+	Number       float64 `json:"number"`
+	Title        string  `json:"title"`
+	Provider     string  `json:"provider"`
+	Scanlator    string  `json:"scanlator"`
+	Language     string  `json:"language"`
+	PageCount    int     `json:"pageCount"`
+	DownloadDate *string `json:"downloadDate"`
+}
+
+// ReaderPage is a single page of a chapter.
+type ReaderPage struct {
+	Index int    `json:"index"`
+	Name  string `json:"name"`
+	Size  int64  `json:"size"`
+	URL   string `json:"url"`
+}
+
+// ReaderChapterPages lists the pages of a chapter with its neighbours, for
+// navigating between chapters while reading.
+type ReaderChapterPages struct {
+	SeriesID    string        `json:"seriesId"`
+	SeriesTitle string        `json:"seriesTitle"`
+	Chapter     ReaderChapter `json:"chapter"`
+	RightToLeft bool          `json:"rightToLeft"`
+	Pages       []ReaderPage  `json:"pages"`
+	Previous    *float64      `json:"previous"`
+	Next        *float64      `json:"next"`
+}
+
+// ReadProgressInfo is a user's progress in one chapter.
+type ReadProgressInfo struct {
+	ChapterNumber float64 `json:"chapterNumber"`
+	Page          int     `json:"page"`
+	PageCount     int     `json:"pageCount"`
+	Completed     bool    `json:"completed"`
+	UpdatedAt     string  `json:"updatedAt"`
+}
+
+// --- Webhook DTOs ---
+
+// WebhookInfo is a configured webhook. The secret is never returned.
+type WebhookInfo struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	URL          string   `json:"url"`
+	Events       []string `json:"events"`
+	HasSecret    bool     `json:"hasSecret"`
+	BodyTemplate string   `json:"bodyTemplate"`
+	Enabled      bool     `json:"enabled"`
+	CreatedAt    string   `json:"createdAt"`
+	UpdatedAt    string   `json:"updatedAt"`
+}
+
+// WebhookDeliveryInfo is one entry of the webhook delivery log.
+type WebhookDeliveryInfo struct {
+	ID             string  `json:"id"`
+	WebhookID      string  `json:"webhookId"`
+	EventID        string  `json:"eventId"`
+	EventType      string  `json:"eventType"`
+	Payload        string  `json:"payload"`
+	Status         string  `json:"status"`
+	Attempts       int     `json:"attempts"`
+	ResponseStatus *int    `json:"responseStatus"`
+	ResponseBody   string  `json:"responseBody"`
+	Error          string  `json:"error"`
+	DurationMs     int64   `json:"durationMs"`
+	NextAttemptAt  *string `json:"nextAttemptAt"`
+	CreatedAt      string  `json:"createdAt"`
+	UpdatedAt      string  `json:"updatedAt"`
+}
+
+// WebhookDeliveryList wraps webhook deliveries with total count for pagination.
+type WebhookDeliveryList struct {
+	Total      int                   `json:"total"`
+	Deliveries []WebhookDeliveryInfo `json:"deliveries"`
+}
+
+// --- Notifier DTOs ---
+
+// NotifierInfo is a configured push-notification target. The token is never returned.
+type NotifierInfo struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Kind            string   `json:"kind"`
+	URL             string   `json:"url"`
+	Topic           string   `json:"topic"`
+	HasToken        bool     `json:"hasToken"`
+	Events          []string `json:"events"`
+	TitleTemplate   string   `json:"titleTemplate"`
+	MessageTemplate string   `json:"messageTemplate"`
+	QuietStart      string   `json:"quietStart"`
+	QuietEnd        string   `json:"quietEnd"`
+	Enabled         bool     `json:"enabled"`
+	CreatedAt       string   `json:"createdAt"`
+	UpdatedAt       string   `json:"updatedAt"`
+}
+
+// NotificationInfo is one entry of the notification log.
+type NotificationInfo struct {
+	ID         string  `json:"id"`
+	NotifierID string  `json:"notifierId"`
+	EventType  string  `json:"eventType"`
+	Title      string  `json:"title"`
+	Message    string  `json:"message"`
+	Urgent     bool    `json:"urgent"`
+	Status     string  `json:"status"`
+	Error      string  `json:"error"`
+	CreatedAt  string  `json:"createdAt"`
+	SentAt     *string `json:"sentAt"`
+}
+
+// NotificationList wraps notifications with total count for pagination.
+type NotificationList struct {
+	Total         int                `json:"total"`
+	Notifications []NotificationInfo `json:"notifications"`
+}
+
+// --- Hook DTOs ---
+
+// HookInfo is a script hook from config.yaml.
+type HookInfo struct {
+	Name       string   `json:"name"`
+	Event      string   `json:"event"`
+	Command    []string `json:"command"`
+	Timeout    string   `json:"timeout"`
+	OnFailure  string   `json:"onFailure"`
+	Retries    int      `json:"retries"`
+	RetryDelay string   `json:"retryDelay"`
+}
+
+// HookRunInfo is one entry of the hook run log.
+type HookRunInfo struct {
+	ID            string   `json:"id"`
+	Hook          string   `json:"hook"`
+	Event         string   `json:"event"`
+	SeriesID      *string  `json:"seriesId"`
+	SeriesTitle   string   `json:"seriesTitle"`
+	ChapterNumber *float64 `json:"chapterNumber"`
+	Attempt       int      `json:"attempt"`
+	Status        string   `json:"status"`
+	ExitCode      *int     `json:"exitCode"`
+	Output        string   `json:"output"`
+	Error         string   `json:"error"`
+	DurationMs    int64    `json:"durationMs"`
+	CreatedAt     string   `json:"createdAt"`
+}
+
+// HookRunList wraps hook runs with total count for pagination.
+type HookRunList struct {
+	Total int           `json:"total"`
+	Runs  []HookRunInfo `json:"runs"`
+}
@@ -146,6 +146,7 @@ const (
 	JobTypeVerifyAll                  JobType = 10
 	JobTypeDeepVerify                 JobType = 11
 	JobTypeUpgradeAllSources          JobType = 12
+	JobTypeDownloadQueue              JobType = 13
 )
 
 // QueueStatus represents the status of a queued job.
@@ -11,7 +11,9 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/technobecet/kaizoku-go/internal/types"
 )
@@ -336,6 +338,121 @@ func CountCBZPages(path string) int {
 	return count
 }
 
+// CBZPageInfo describes a page image inside a CBZ archive.
+type CBZPageInfo struct {
+	Name string
+	Size int64
+}
+
+// ListCBZPages returns the image entries of a CBZ archive in reading order.
+// Names are sorted naturally so "2.jpg" comes before "10.jpg" in archives
+// without zero-padded page numbers.
+func ListCBZPages(path string) ([]CBZPageInfo, error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("open cbz: %w", err)
+	}
+	defer r.Close()
+
+	files := pageFiles(&r.Reader)
+	pages := make([]CBZPageInfo, 0, len(files))
+	for _, f := range files {
+		pages = append(pages, CBZPageInfo{Name: f.Name, Size: int64(f.UncompressedSize64)})
+	}
+	return pages, nil
+}
+
+// pageFiles returns the image entries of an archive in natural name order.
+func pageFiles(r *zip.Reader) []*zip.File {
+	var files []*zip.File
+	for _, f := range r.File {
+		if !f.FileInfo().IsDir() && isImageFile(f.Name) {
+			files = append(files, f)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool { return naturalLess(files[i].Name, files[j].Name) })
+	return files
+}
+
+// CBZPage is an open page inside a CBZ archive. Closing it also closes the archive.
+type CBZPage struct {
+	io.Reader
+	Name     string
+	Size     int64
+	Modified time.Time
+
+	entry   io.Closer
+	archive *zip.ReadCloser
+}
+
+// Close closes the page entry and the archive.
+func (p *CBZPage) Close() error {
+	p.entry.Close()
+	return p.archive.Close()
+}
+
+// OpenCBZPage opens the page at the given zero-based index (in ListCBZPages
+// order) for streaming, without extracting the archive.
+func OpenCBZPage(path string, index int) (*CBZPage, error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("open cbz: %w", err)
+	}
+
+	files := pageFiles(&r.Reader)
+	if index < 0 || index >= len(files) {
+		r.Close()
+		return nil, os.ErrNotExist
+	}
+	f := files[index]
+	rc, err := f.Open()
+	if err != nil {
+		r.Close()
+		return nil, fmt.Errorf("open page %s: %w", f.Name, err)
+	}
+	return &CBZPage{
+		Reader:   rc,
+		Name:     f.Name,
+		Size:     int64(f.UncompressedSize64),
+		Modified: f.Modified,
+		entry:    rc,
+		archive:  r,
+	}, nil
+}
+
+// naturalLess compares strings case-insensitively, ordering embedded digit
+// runs by numeric value.
+func naturalLess(a, b string) bool {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	for a != "" && b != "" {
+		da, db := leadingDigits(a), leadingDigits(b)
+		if da != "" && db != "" {
+			na, nb := strings.TrimLeft(da, "0"), strings.TrimLeft(db, "0")
+			if len(na) != len(nb) {
+				return len(na) < len(nb)
+			}
+			if na != nb {
+				return na < nb
+			}
+			a, b = a[len(da):], b[len(db):]
+			continue
+		}
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) < len(b)
+}
+
+func leadingDigits(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return s[:i]
+}
+
 // isImageFile checks if a filename has an image extension.
 func isImageFile(name string) bool {
 	ext := strings.ToLower(filepath.Ext(name))
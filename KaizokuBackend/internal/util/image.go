@@ -0,0 +1,67 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif" // registers the GIF decoder
+	"image/jpeg"
+	"image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // registers the WebP decoder
+)
+
+const (
+	// resizeJPEGQuality is the JPEG quality used for resized pages.
+	resizeJPEGQuality = 85
+	// maxResizePixels caps the size of images ResizeImage decodes. A long
+	// webtoon strip is around 800x30000; anything much larger is served
+	// unchanged rather than decoded into memory.
+	maxResizePixels = 64 << 20
+)
+
+// ResizeImage scales an image down to maxWidth, keeping its aspect ratio.
+// PNG and GIF input is re-encoded as PNG to preserve transparency; anything
+// else becomes JPEG. It returns resized=false with the original data when the
+// image is already narrow enough or too large to decode safely (over
+// maxResizePixels), and an error when the format cannot be decoded (e.g.
+// AVIF), in which case callers should serve the original.
+func ResizeImage(data []byte, maxWidth int) (out []byte, contentType string, resized bool, err error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", false, fmt.Errorf("decode image config: %w", err)
+	}
+	if maxWidth <= 0 || cfg.Width <= maxWidth {
+		return data, "", false, nil
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > maxResizePixels {
+		return data, "", false, nil
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", false, fmt.Errorf("decode image: %w", err)
+	}
+
+	height := cfg.Height * maxWidth / cfg.Width
+	if height < 1 {
+		height = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, maxWidth, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Src, nil)
+
+	var buf bytes.Buffer
+	switch format {
+	case "png", "gif":
+		if err := png.Encode(&buf, dst); err != nil {
+			return nil, "", false, fmt.Errorf("encode png: %w", err)
+		}
+		return buf.Bytes(), "image/png", true, nil
+	default:
+		if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: resizeJPEGQuality}); err != nil {
+			return nil, "", false, fmt.Errorf("encode jpeg: %w", err)
+		}
+		return buf.Bytes(), "image/jpeg", true, nil
+	}
+}
@@ -0,0 +1,28 @@
+import { authService } from '~/services/authService'
+import type { AuthInfo } from '~/types'
+
+export function useAuth() {
+  const user = useState<AuthInfo | null>('kzk-auth', () => null)
+
+  async function refresh(): Promise<AuthInfo> {
+    user.value = await authService.me()
+    return user.value
+  }
+
+  async function login(username: string, password: string) {
+    await authService.login(username, password)
+    await refresh()
+  }
+
+  async function logout() {
+    try {
+      await authService.logout()
+    }
+    finally {
+      user.value = null
+      await navigateTo('/login')
+    }
+  }
+
+  return { user, refresh, login, logout }
+}
@@ -0,0 +1,23 @@
+import { ApiError } from '~/utils/api-client'
+
+// Sends the browser to the login page when authentication is enabled and
+// there is no valid session. The caller is looked up once and kept until
+// logout.
+export default defineNuxtRouteMiddleware(async (to) => {
+  if (to.path === '/login') {
+    return
+  }
+
+  const { user, refresh } = useAuth()
+  if (user.value) {
+    return
+  }
+  try {
+    await refresh()
+  }
+  catch (error) {
+    if (error instanceof ApiError && error.status === 401) {
+      return navigateTo({ path: '/login', query: { redirect: to.fullPath } })
+    }
+  }
+})
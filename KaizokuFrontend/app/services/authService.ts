@@ -0,0 +1,25 @@
+import { apiClient } from '~/utils/api-client'
+import { buildApiUrl } from '~/utils/api-config'
+import type { AuthConfig, AuthInfo } from '~/types'
+
+export const authService = {
+  async getConfig(): Promise<AuthConfig> {
+    return apiClient.get<AuthConfig>('/api/auth/config')
+  },
+
+  async me(): Promise<AuthInfo> {
+    return apiClient.get<AuthInfo>('/api/auth/me')
+  },
+
+  async login(username: string, password: string): Promise<void> {
+    return apiClient.post<void>('/api/auth/login', { username, password })
+  },
+
+  async logout(): Promise<void> {
+    return apiClient.post<void>('/api/auth/logout')
+  },
+
+  oidcLoginUrl(): string {
+    return buildApiUrl('/api/auth/oidc/login')
+  },
+}
@@ -637,3 +637,18 @@ export interface JobKindStatus {
 export interface JobsStatusResponse {
   kinds: JobKindStatus[]
 }
+
+export interface AuthConfig {
+  authEnabled: boolean
+  oidcEnabled: boolean
+  oidcName?: string
+}
+
+export interface AuthInfo {
+  authEnabled: boolean
+  kind?: string
+  name?: string
+  userId?: string
+  username?: string
+  role: string
+}
@@ -1,5 +1,17 @@
 import { getApiConfig } from './api-config'
 
+/** An error response from the API, with the message the backend returned. */
+export class ApiError extends Error {
+  constructor(
+    message: string,
+    readonly status: number,
+    readonly retryAfter?: number,
+  ) {
+    super(message)
+    this.name = 'ApiError'
+  }
+}
+
 class KaizokuApiClient {
   private resolveBaseUrl(): string {
     try {
@@ -28,7 +40,26 @@ class KaizokuApiClient {
     })
 
     if (!response.ok) {
-      throw new Error(`API Error: ${response.status} ${response.statusText}`)
+      // An expired or missing session sends the browser to the login page;
+      // the login endpoints report their own errors.
+      if (response.status === 401 && import.meta.client && !endpoint.startsWith('/api/auth/')
+        && window.location.pathname !== '/login') {
+        const redirect = window.location.pathname + window.location.search
+        window.location.assign(`/login?redirect=${encodeURIComponent(redirect)}`)
+      }
+
+      let message = `API Error: ${response.status} ${response.statusText}`
+      try {
+        const body = await response.json() as { error?: string }
+        if (body?.error) {
+          message = body.error
+        }
+      }
+      catch {
+        // Not a JSON error body; keep the status line.
+      }
+      const retryAfter = Number(response.headers.get('retry-after')) || undefined
+      throw new ApiError(message, response.status, retryAfter)
     }
 
     const contentLength = response.headers.get('content-length')